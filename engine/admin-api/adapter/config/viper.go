@@ -15,6 +15,9 @@ const (
 	ApplicationPortKey      = "application.port"
 	CORSEnabledKey          = "application.corsEnabled"
 
+	VersionDrainTimeoutKey      = "application.versionDrain.timeout"
+	VersionDrainPollIntervalKey = "application.versionDrain.pollInterval"
+
 	RegistryHostKey       = "registry.host"
 	GlobalRegistryKey     = "registry.global"
 	RegistryAuthSecretKey = "registry.basicAuthSecret"
@@ -48,6 +51,10 @@ const (
 	NatsManagerEndpointKey = "services.natsManager.endpoint"
 
 	LokiEndpointKey = "loki.endpoint"
+
+	ProductResourceQuotaCPUKey    = "products.resourceQuota.cpu"
+	ProductResourceQuotaMemoryKey = "products.resourceQuota.memory"
+	ProductResourceQuotaPodsKey   = "products.resourceQuota.pods"
 )
 
 func InitConfig() error {
@@ -86,6 +93,10 @@ func InitConfig() error {
 
 	viper.RegisterAlias(LokiEndpointKey, "LOKI_ADDRESS")
 
+	viper.RegisterAlias(ProductResourceQuotaCPUKey, "PRODUCT_RESOURCE_QUOTA_CPU")
+	viper.RegisterAlias(ProductResourceQuotaMemoryKey, "PRODUCT_RESOURCE_QUOTA_MEMORY")
+	viper.RegisterAlias(ProductResourceQuotaPodsKey, "PRODUCT_RESOURCE_QUOTA_PODS")
+
 	viper.RegisterAlias(K8sManagerEndpointKey, "SERVICES_K8S_MANAGER")
 	viper.RegisterAlias(NatsManagerEndpointKey, "SERVICES_NATS_MANAGER")
 
@@ -109,6 +120,8 @@ func InitConfig() error {
 func setDefaultConfig() {
 	viper.SetDefault(LogLevelKey, "INFO")
 	viper.SetDefault(VersionStatusTimeoutKey, 20*time.Minute)
+	viper.SetDefault(VersionDrainTimeoutKey, 5*time.Minute)
+	viper.SetDefault(VersionDrainPollIntervalKey, 5*time.Second)
 	viper.SetDefault(MinioTierEnabledKey, false)
 	viper.SetDefault(MinioTierTransitionDaysKey, 0)
 	viper.SetDefault(KeycloakPolicyAttributeKey, "policy")
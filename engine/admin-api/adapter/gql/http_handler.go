@@ -12,8 +12,11 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/go-logr/logr"
 	"github.com/gorilla/websocket"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/admission"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/logs"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/natsgc"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/process"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -29,6 +32,8 @@ type Params struct {
 	VersionInteractor      *version.Handler
 	ProcessHandler         *process.Handler
 	LogsUsecase            logs.LogsUsecase
+	AdmissionHandler       *admission.Handler
+	NatsGCHandler          *natsgc.Handler
 }
 
 func NewHTTPHandler(params Params) http.Handler {
@@ -88,5 +93,21 @@ func errorPresenter(ctx context.Context, e error) *gqlerror.Error {
 		}
 	}
 
+	var errNotAdmitted entity.AdmissionDeniedError
+	if errors.As(err, &errNotAdmitted) {
+		violations := make([]string, 0, len(errNotAdmitted.Violations))
+		for _, violation := range errNotAdmitted.Violations {
+			violations = append(violations, violation.String())
+		}
+
+		return &gqlerror.Error{
+			Message: errNotAdmitted.Error(),
+			Extensions: map[string]interface{}{
+				"code":       "admission_policy_violation",
+				"violations": violations,
+			},
+		}
+	}
+
 	return err
 }
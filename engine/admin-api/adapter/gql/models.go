@@ -3,7 +3,12 @@
 package gql
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
 )
 
 type AddUserToProductInput struct {
@@ -11,6 +16,29 @@ type AddUserToProductInput struct {
 	Product string `json:"product"`
 }
 
+type AdmissionPolicyInput struct {
+	Name        string                      `json:"name"`
+	Description *string                     `json:"description,omitempty"`
+	Type        entity.AdmissionPolicyType  `json:"type"`
+	Mode        entity.AdmissionPolicyMode  `json:"mode"`
+	Params      *AdmissionPolicyParamsInput `json:"params,omitempty"`
+}
+
+type AdmissionPolicyParamsInput struct {
+	Registries    []string `json:"registries,omitempty"`
+	WorkflowTypes []string `json:"workflowTypes,omitempty"`
+	MaxReplicas   *int     `json:"maxReplicas,omitempty"`
+	NodeSelectors []string `json:"nodeSelectors,omitempty"`
+}
+
+type ConfigurationStoreInput struct {
+	ProductID    string                    `json:"productID"`
+	VersionTag   *string                   `json:"versionTag,omitempty"`
+	WorkflowName *string                   `json:"workflowName,omitempty"`
+	ProcessName  *string                   `json:"processName,omitempty"`
+	Scope        entity.ConfigurationScope `json:"scope"`
+}
+
 type CreateProductInput struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
@@ -22,20 +50,114 @@ type CreateVersionInput struct {
 	ProductID string         `json:"productID"`
 }
 
+type DeadLetterMessagesInput struct {
+	ProductID    string `json:"productID"`
+	VersionTag   string `json:"versionTag"`
+	WorkflowName string `json:"workflowName"`
+	Sequences    []int  `json:"sequences,omitempty"`
+	Comment      string `json:"comment"`
+}
+
+type DeleteAdmissionPolicyInput struct {
+	ID string `json:"id"`
+}
+
+type DeleteObjectInput struct {
+	ProductID   string `json:"productID"`
+	ObjectStore string `json:"objectStore"`
+	Name        string `json:"name"`
+	Comment     string `json:"comment"`
+}
+
+type DeleteOrphanedNatsResourcesInput struct {
+	Resources []*NatsResourceInput `json:"resources"`
+	DryRun    *bool                `json:"dryRun,omitempty"`
+}
+
 type DeleteProcessInput struct {
 	ProductID string `json:"productID"`
 	ProcessID string `json:"processID"`
 	Version   string `json:"version"`
 }
 
+type DeleteProductConfigurationInput struct {
+	ProductID string `json:"productID"`
+	Key       string `json:"key"`
+	Comment   string `json:"comment"`
+}
+
 type DeletePublicProcessInput struct {
 	ProcessID string `json:"processID"`
 	Version   string `json:"version"`
 }
 
+type DryRunAdmissionPoliciesInput struct {
+	ProductID  string                `json:"productID"`
+	VersionTag string                `json:"versionTag"`
+	Policy     *AdmissionPolicyInput `json:"policy,omitempty"`
+}
+
+type InjectMessageInput struct {
+	ProductID  string                `json:"productID"`
+	VersionTag string                `json:"versionTag"`
+	Subject    string                `json:"subject"`
+	Headers    []*MessageHeaderInput `json:"headers,omitempty"`
+	Data       string                `json:"data"`
+	Encoding   MessageEncoding       `json:"encoding"`
+	Comment    string                `json:"comment"`
+}
+
+type MessageHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type MessageHeaderInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type Mutation struct {
 }
 
+type NatsResourceInput struct {
+	Name string                  `json:"name"`
+	Type entity.NatsResourceType `json:"type"`
+}
+
+type PeekProcessMessagesInput struct {
+	ProductID    string `json:"productID"`
+	VersionTag   string `json:"versionTag"`
+	WorkflowName string `json:"workflowName"`
+	ProcessName  string `json:"processName"`
+	Count        int    `json:"count"`
+}
+
+type ProcessAutoscalingInput struct {
+	MinReplicas                   int  `json:"minReplicas"`
+	MaxReplicas                   int  `json:"maxReplicas"`
+	CPUTargetPercentage           *int `json:"cpuTargetPercentage,omitempty"`
+	MemoryTargetPercentage        *int `json:"memoryTargetPercentage,omitempty"`
+	ScaleUpStabilizationSeconds   *int `json:"scaleUpStabilizationSeconds,omitempty"`
+	ScaleDownStabilizationSeconds *int `json:"scaleDownStabilizationSeconds,omitempty"`
+	ConsumerLagTarget             *int `json:"consumerLagTarget,omitempty"`
+}
+
+type ProductObjectStoreInput struct {
+	ProductID string `json:"productID"`
+	Name      string `json:"name"`
+	Comment   string `json:"comment"`
+}
+
+type ProductQuotaInput struct {
+	MaxStartedVersions *int    `json:"maxStartedVersions,omitempty"`
+	CPURequests        *string `json:"cpuRequests,omitempty"`
+	MemoryRequests     *string `json:"memoryRequests,omitempty"`
+	MaxGPUProcesses    *int    `json:"maxGPUProcesses,omitempty"`
+	MaxReplicas        *int    `json:"maxReplicas,omitempty"`
+	MaxPods            *int    `json:"maxPods,omitempty"`
+}
+
 type PublishVersionInput struct {
 	VersionTag string `json:"versionTag"`
 	Comment    string `json:"comment"`
@@ -66,20 +188,127 @@ type RemoveUserFromProductInput struct {
 	Product string `json:"product"`
 }
 
-type StartVersionInput struct {
+type RollbackConfigurationInput struct {
+	Store    *ConfigurationStoreInput `json:"store"`
+	Key      string                   `json:"key"`
+	Revision int                      `json:"revision"`
+	Comment  string                   `json:"comment"`
+}
+
+type RunWorkflowInput struct {
+	ProductID    string `json:"productID"`
+	VersionTag   string `json:"versionTag"`
+	WorkflowName string `json:"workflowName"`
+	Comment      string `json:"comment"`
+}
+
+type ScaleProcessInput struct {
+	ProductID    string                   `json:"productID"`
+	VersionTag   string                   `json:"versionTag"`
+	WorkflowName string                   `json:"workflowName"`
+	ProcessName  string                   `json:"processName"`
+	Replicas     *int                     `json:"replicas,omitempty"`
+	Autoscaling  *ProcessAutoscalingInput `json:"autoscaling,omitempty"`
+	Comment      string                   `json:"comment"`
+}
+
+type SetProductConfigurationInput struct {
+	ProductID string `json:"productID"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Comment   string `json:"comment"`
+}
+
+type ShadowInput struct {
+	ProductID  string `json:"productID"`
 	VersionTag string `json:"versionTag"`
 	Comment    string `json:"comment"`
-	ProductID  string `json:"productID"`
 }
 
-type StopVersionInput struct {
+type StartVersionInput struct {
 	VersionTag string `json:"versionTag"`
 	Comment    string `json:"comment"`
 	ProductID  string `json:"productID"`
 }
 
+type StopVersionInput struct {
+	VersionTag          string `json:"versionTag"`
+	Comment             string `json:"comment"`
+	ProductID           string `json:"productID"`
+	Drain               *bool  `json:"drain,omitempty"`
+	DrainTimeoutSeconds *int   `json:"drainTimeoutSeconds,omitempty"`
+}
+
 type UnpublishVersionInput struct {
 	VersionTag string `json:"versionTag"`
 	Comment    string `json:"comment"`
 	ProductID  string `json:"productID"`
 }
+
+type UpdateAdmissionPolicyInput struct {
+	ID     string                `json:"id"`
+	Policy *AdmissionPolicyInput `json:"policy"`
+}
+
+type UpdateProcessImageInput struct {
+	ProductID    string `json:"productID"`
+	VersionTag   string `json:"versionTag"`
+	WorkflowName string `json:"workflowName"`
+	ProcessName  string `json:"processName"`
+	Image        string `json:"image"`
+	Comment      string `json:"comment"`
+}
+
+type UpdateProductQuotaInput struct {
+	ProductID string             `json:"productID"`
+	Quota     *ProductQuotaInput `json:"quota,omitempty"`
+}
+
+type UploadObjectInput struct {
+	ProductID   string         `json:"productID"`
+	ObjectStore string         `json:"objectStore"`
+	Name        string         `json:"name"`
+	File        graphql.Upload `json:"file"`
+	Comment     string         `json:"comment"`
+}
+
+type MessageEncoding string
+
+const (
+	MessageEncodingJSON   MessageEncoding = "JSON"
+	MessageEncodingBase64 MessageEncoding = "BASE64"
+)
+
+var AllMessageEncoding = []MessageEncoding{
+	MessageEncodingJSON,
+	MessageEncodingBase64,
+}
+
+func (e MessageEncoding) IsValid() bool {
+	switch e {
+	case MessageEncodingJSON, MessageEncodingBase64:
+		return true
+	}
+	return false
+}
+
+func (e MessageEncoding) String() string {
+	return string(e)
+}
+
+func (e *MessageEncoding) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MessageEncoding(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MessageEncoding", str)
+	}
+	return nil
+}
+
+func (e MessageEncoding) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
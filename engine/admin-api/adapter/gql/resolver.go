@@ -4,6 +4,11 @@ package gql
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -11,11 +16,15 @@ import (
 	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/repository"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/admission"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/logs"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/natsgc"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/process"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
 )
 
+var errInvalidJSONData = errors.New("message data is not valid JSON")
+
 //nolint:gochecknoglobals // needs to be global to be used in the resolver
 var versionStatusChannels map[string]chan *entity.Version
 
@@ -35,6 +44,8 @@ type Resolver struct {
 	versionInteractor      *version.Handler
 	processHandler         *process.Handler
 	logsService            logs.LogsUsecase
+	admissionHandler       *admission.Handler
+	natsGCHandler          *natsgc.Handler
 }
 
 func NewGraphQLResolver(params Params) *Resolver {
@@ -48,6 +59,8 @@ func NewGraphQLResolver(params Params) *Resolver {
 		params.VersionInteractor,
 		params.ProcessHandler,
 		params.LogsUsecase,
+		params.AdmissionHandler,
+		params.NatsGCHandler,
 	}
 }
 
@@ -62,6 +75,118 @@ func (r *mutationResolver) CreateProduct(ctx context.Context, input CreateProduc
 	return product, nil
 }
 
+func (r *mutationResolver) UpdateProductQuota(ctx context.Context, input UpdateProductQuotaInput) (*entity.Product, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.productInteractor.UpdateQuota(ctx, loggedUser, input.ProductID, mapProductQuotaInput(input.Quota))
+}
+
+func mapProductQuotaInput(input *ProductQuotaInput) *entity.ProductQuota {
+	if input == nil {
+		return nil
+	}
+
+	optionalInt32 := func(value *int) int32 {
+		if value == nil {
+			return 0
+		}
+
+		return int32(*value)
+	}
+
+	optionalString := func(value *string) string {
+		if value == nil {
+			return ""
+		}
+
+		return *value
+	}
+
+	return &entity.ProductQuota{
+		MaxStartedVersions: optionalInt32(input.MaxStartedVersions),
+		CPURequests:        optionalString(input.CPURequests),
+		MemoryRequests:     optionalString(input.MemoryRequests),
+		MaxGPUProcesses:    optionalInt32(input.MaxGPUProcesses),
+		MaxReplicas:        optionalInt32(input.MaxReplicas),
+		MaxPods:            optionalInt32(input.MaxPods),
+	}
+}
+
+func (r *mutationResolver) CreateAdmissionPolicy(ctx context.Context, input AdmissionPolicyInput) (*entity.AdmissionPolicy, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.admissionHandler.Create(ctx, loggedUser, mapAdmissionPolicyInput(&input))
+}
+
+func (r *mutationResolver) UpdateAdmissionPolicy(
+	ctx context.Context,
+	input UpdateAdmissionPolicyInput,
+) (*entity.AdmissionPolicy, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	policy := mapAdmissionPolicyInput(input.Policy)
+	policy.ID = input.ID
+
+	return r.admissionHandler.Update(ctx, loggedUser, policy)
+}
+
+func (r *mutationResolver) DeleteAdmissionPolicy(ctx context.Context, input DeleteAdmissionPolicyInput) (string, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	if err := r.admissionHandler.Delete(ctx, loggedUser, input.ID); err != nil {
+		return "", err
+	}
+
+	return input.ID, nil
+}
+
+func (r *mutationResolver) DeleteOrphanedNatsResources(
+	ctx context.Context,
+	input DeleteOrphanedNatsResourcesInput,
+) ([]*entity.NatsResource, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	resources := make([]*entity.NatsResource, 0, len(input.Resources))
+	for _, resource := range input.Resources {
+		resources = append(resources, &entity.NatsResource{Name: resource.Name, Type: resource.Type})
+	}
+
+	dryRun := input.DryRun != nil && *input.DryRun
+
+	return r.natsGCHandler.DeleteOrphanedResources(ctx, loggedUser, resources, dryRun)
+}
+
+func mapAdmissionPolicyInput(input *AdmissionPolicyInput) *entity.AdmissionPolicy {
+	if input == nil {
+		return nil
+	}
+
+	policy := &entity.AdmissionPolicy{
+		Name: input.Name,
+		Type: input.Type,
+		Mode: input.Mode,
+	}
+
+	if input.Description != nil {
+		policy.Description = *input.Description
+	}
+
+	if input.Params != nil {
+		policy.Params.Registries = input.Params.Registries
+		policy.Params.NodeSelectors = input.Params.NodeSelectors
+
+		for _, workflowType := range input.Params.WorkflowTypes {
+			policy.Params.WorkflowTypes = append(policy.Params.WorkflowTypes, entity.WorkflowType(workflowType))
+		}
+
+		if input.Params.MaxReplicas != nil {
+			policy.Params.MaxReplicas = int32(*input.Params.MaxReplicas)
+		}
+	}
+
+	return policy
+}
+
 func (r *mutationResolver) CreateVersion(ctx context.Context, input CreateVersionInput) (*entity.Version, error) {
 	loggedUser := ctx.Value("user").(*entity.User)
 
@@ -143,7 +268,28 @@ func (r *mutationResolver) StartVersion(ctx context.Context, input StartVersionI
 func (r *mutationResolver) StopVersion(ctx context.Context, input StopVersionInput) (*entity.Version, error) {
 	loggedUser := ctx.Value("user").(*entity.User)
 
-	v, notifyCh, err := r.versionInteractor.Stop(ctx, loggedUser, input.ProductID, input.VersionTag, input.Comment)
+	var (
+		v        *entity.Version
+		notifyCh chan *entity.Version
+		err      error
+	)
+
+	if input.Drain != nil && *input.Drain {
+		opts := version.DrainOpts{
+			ProductID:  input.ProductID,
+			VersionTag: input.VersionTag,
+			Comment:    input.Comment,
+		}
+
+		if input.DrainTimeoutSeconds != nil {
+			opts.Timeout = time.Duration(*input.DrainTimeoutSeconds) * time.Second
+		}
+
+		v, notifyCh, err = r.versionInteractor.DrainAndStop(ctx, loggedUser, opts)
+	} else {
+		v, notifyCh, err = r.versionInteractor.Stop(ctx, loggedUser, input.ProductID, input.VersionTag, input.Comment)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +323,301 @@ func (r *mutationResolver) UnpublishVersion(ctx context.Context, input Unpublish
 	return r.versionInteractor.Unpublish(ctx, loggedUser, input.ProductID, input.VersionTag, input.Comment)
 }
 
+func (r *mutationResolver) UpdateProcessImage(ctx context.Context, input UpdateProcessImageInput) (*entity.Version, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.versionInteractor.UpdateProcessImage(ctx, loggedUser, version.UpdateProcessImageOpts{
+		ProductID:    input.ProductID,
+		VersionTag:   input.VersionTag,
+		WorkflowName: input.WorkflowName,
+		ProcessName:  input.ProcessName,
+		Image:        input.Image,
+		Comment:      input.Comment,
+	})
+}
+
+func (r *mutationResolver) ScaleProcess(ctx context.Context, input ScaleProcessInput) (*entity.Version, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	var replicas *int32
+
+	if input.Replicas != nil {
+		value := int32(*input.Replicas)
+		replicas = &value
+	}
+
+	return r.versionInteractor.ScaleProcess(ctx, loggedUser, version.ScaleProcessOpts{
+		ProductID:    input.ProductID,
+		VersionTag:   input.VersionTag,
+		WorkflowName: input.WorkflowName,
+		ProcessName:  input.ProcessName,
+		Replicas:     replicas,
+		Autoscaling:  mapProcessAutoscalingInput(input.Autoscaling),
+		Comment:      input.Comment,
+	})
+}
+
+func (r *mutationResolver) StartShadow(ctx context.Context, input ShadowInput) (*entity.Version, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.versionInteractor.StartShadow(ctx, loggedUser, version.ShadowOpts{
+		ProductID:  input.ProductID,
+		VersionTag: input.VersionTag,
+		Comment:    input.Comment,
+	})
+}
+
+func (r *mutationResolver) StopShadow(ctx context.Context, input ShadowInput) (*entity.Version, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.versionInteractor.StopShadow(ctx, loggedUser, version.ShadowOpts{
+		ProductID:  input.ProductID,
+		VersionTag: input.VersionTag,
+		Comment:    input.Comment,
+	})
+}
+
+func (r *mutationResolver) RunWorkflow(ctx context.Context, input RunWorkflowInput) ([]string, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.versionInteractor.RunWorkflow(ctx, loggedUser, version.RunWorkflowOpts{
+		ProductID:    input.ProductID,
+		VersionTag:   input.VersionTag,
+		WorkflowName: input.WorkflowName,
+		Comment:      input.Comment,
+	})
+}
+
+func (r *mutationResolver) ReplayDeadLetterMessages(ctx context.Context, input DeadLetterMessagesInput) (int, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.versionInteractor.ReplayDeadLetterMessages(ctx, loggedUser, mapDeadLetterMessagesInput(input))
+}
+
+func (r *mutationResolver) PurgeDeadLetterMessages(ctx context.Context, input DeadLetterMessagesInput) (int, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.versionInteractor.PurgeDeadLetterMessages(ctx, loggedUser, mapDeadLetterMessagesInput(input))
+}
+
+func (r *mutationResolver) PeekProcessMessages(
+	ctx context.Context,
+	input PeekProcessMessagesInput,
+) ([]*entity.StreamMessage, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.versionInteractor.PeekProcessMessages(ctx, loggedUser, version.PeekProcessMessagesOpts{
+		ProductID:    input.ProductID,
+		VersionTag:   input.VersionTag,
+		WorkflowName: input.WorkflowName,
+		ProcessName:  input.ProcessName,
+		Count:        input.Count,
+	})
+}
+
+func (r *mutationResolver) InjectMessage(ctx context.Context, input InjectMessageInput) (bool, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	data, err := decodeMessageData(input.Data, input.Encoding)
+	if err != nil {
+		return false, err
+	}
+
+	headers := make(map[string]string, len(input.Headers))
+	for _, header := range input.Headers {
+		headers[header.Key] = header.Value
+	}
+
+	err = r.versionInteractor.InjectMessage(ctx, loggedUser, version.InjectMessageOpts{
+		ProductID:  input.ProductID,
+		VersionTag: input.VersionTag,
+		Subject:    input.Subject,
+		Headers:    headers,
+		Data:       data,
+		Comment:    input.Comment,
+	})
+
+	return err == nil, err
+}
+
+func decodeMessageData(data string, encoding MessageEncoding) ([]byte, error) {
+	if encoding == MessageEncodingBase64 {
+		decoded, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, fmt.Errorf("decoding base64 message data: %w", err)
+		}
+
+		return decoded, nil
+	}
+
+	if !json.Valid([]byte(data)) {
+		return nil, errInvalidJSONData
+	}
+
+	return []byte(data), nil
+}
+
+func (r *mutationResolver) RollbackConfiguration(
+	ctx context.Context,
+	input RollbackConfigurationInput,
+) (*entity.ConfigurationRevision, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.versionInteractor.RollbackConfiguration(ctx, loggedUser, version.RollbackConfigurationOpts{
+		Store:    mapConfigurationStoreInput(input.Store),
+		Key:      input.Key,
+		Revision: uint64(input.Revision),
+		Comment:  input.Comment,
+	})
+}
+
+func (r *mutationResolver) SetProductConfiguration(
+	ctx context.Context,
+	input SetProductConfigurationInput,
+) (*entity.ConfigurationEntry, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.productInteractor.SetConfiguration(ctx, loggedUser, usecase.SetProductConfigurationOpts{
+		ProductID: input.ProductID,
+		Key:       input.Key,
+		Value:     input.Value,
+		Comment:   input.Comment,
+	})
+}
+
+func (r *mutationResolver) DeleteProductConfiguration(
+	ctx context.Context,
+	input DeleteProductConfigurationInput,
+) (string, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	err := r.productInteractor.DeleteConfiguration(ctx, loggedUser, usecase.DeleteProductConfigurationOpts{
+		ProductID: input.ProductID,
+		Key:       input.Key,
+		Comment:   input.Comment,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return input.Key, nil
+}
+
+func (r *mutationResolver) CreateProductObjectStore(
+	ctx context.Context,
+	input ProductObjectStoreInput,
+) (*entity.ProductObjectStore, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.productInteractor.CreateObjectStore(ctx, loggedUser, usecase.ProductObjectStoreOpts{
+		ProductID: input.ProductID,
+		Name:      input.Name,
+		Comment:   input.Comment,
+	})
+}
+
+func (r *mutationResolver) DeleteProductObjectStore(ctx context.Context, input ProductObjectStoreInput) (string, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	err := r.productInteractor.DeleteObjectStore(ctx, loggedUser, usecase.ProductObjectStoreOpts{
+		ProductID: input.ProductID,
+		Name:      input.Name,
+		Comment:   input.Comment,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return input.Name, nil
+}
+
+func (r *mutationResolver) UploadObject(ctx context.Context, input UploadObjectInput) (*entity.ObjectInfo, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.productInteractor.UploadObject(ctx, loggedUser, usecase.UploadObjectOpts{
+		ProductID:   input.ProductID,
+		ObjectStore: input.ObjectStore,
+		Name:        input.Name,
+		Content:     input.File.File,
+		Comment:     input.Comment,
+	})
+}
+
+func (r *mutationResolver) DeleteObject(ctx context.Context, input DeleteObjectInput) (string, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	err := r.productInteractor.DeleteObject(ctx, loggedUser, usecase.DeleteObjectOpts{
+		ProductID:   input.ProductID,
+		ObjectStore: input.ObjectStore,
+		Name:        input.Name,
+		Comment:     input.Comment,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return input.Name, nil
+}
+
+// mapConfigurationStoreInput leaves empty the optional fields not given, as not every scope needs them.
+func mapConfigurationStoreInput(input *ConfigurationStoreInput) entity.ConfigurationStore {
+	store := entity.ConfigurationStore{
+		ProductID: input.ProductID,
+		Scope:     input.Scope,
+	}
+
+	if input.VersionTag != nil {
+		store.VersionTag = *input.VersionTag
+	}
+
+	if input.WorkflowName != nil {
+		store.Workflow = *input.WorkflowName
+	}
+
+	if input.ProcessName != nil {
+		store.Process = *input.ProcessName
+	}
+
+	return store
+}
+
+func mapDeadLetterMessagesInput(input DeadLetterMessagesInput) version.DeadLetterMessagesOpts {
+	sequences := make([]uint64, 0, len(input.Sequences))
+	for _, sequence := range input.Sequences {
+		sequences = append(sequences, uint64(sequence))
+	}
+
+	return version.DeadLetterMessagesOpts{
+		ProductID:    input.ProductID,
+		VersionTag:   input.VersionTag,
+		WorkflowName: input.WorkflowName,
+		Sequences:    sequences,
+		Comment:      input.Comment,
+	}
+}
+
+func mapProcessAutoscalingInput(input *ProcessAutoscalingInput) *entity.ProcessAutoscaling {
+	if input == nil {
+		return nil
+	}
+
+	optionalInt32 := func(value *int) int32 {
+		if value == nil {
+			return 0
+		}
+
+		return int32(*value)
+	}
+
+	return &entity.ProcessAutoscaling{
+		MinReplicas:                   int32(input.MinReplicas),
+		MaxReplicas:                   int32(input.MaxReplicas),
+		CPUTargetPercentage:           optionalInt32(input.CPUTargetPercentage),
+		MemoryTargetPercentage:        optionalInt32(input.MemoryTargetPercentage),
+		ScaleUpStabilizationSeconds:   optionalInt32(input.ScaleUpStabilizationSeconds),
+		ScaleDownStabilizationSeconds: optionalInt32(input.ScaleDownStabilizationSeconds),
+		ConsumerLagTarget:             optionalInt32(input.ConsumerLagTarget),
+	}
+}
+
 func (r *mutationResolver) PublishVersion(ctx context.Context, input PublishVersionInput) ([]*entity.PublishedTrigger, error) {
 	loggedUser := ctx.Value("user").(*entity.User)
 
@@ -257,6 +698,31 @@ func (r *queryResolver) Products(ctx context.Context, productName *string) ([]*e
 	return r.productInteractor.FindAll(ctx, loggedUser, &filter)
 }
 
+func (r *queryResolver) AdmissionPolicies(ctx context.Context) ([]*entity.AdmissionPolicy, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.admissionHandler.List(ctx, loggedUser)
+}
+
+func (r *queryResolver) DryRunAdmissionPolicies(
+	ctx context.Context,
+	input DryRunAdmissionPoliciesInput,
+) (*entity.AdmissionReport, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.admissionHandler.DryRun(ctx, loggedUser, admission.DryRunOpts{
+		ProductID:  input.ProductID,
+		VersionTag: input.VersionTag,
+		Policy:     mapAdmissionPolicyInput(input.Policy),
+	})
+}
+
+func (r *queryResolver) OrphanedNatsResources(ctx context.Context) ([]*entity.NatsResource, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+
+	return r.natsGCHandler.GetOrphanedResources(ctx, loggedUser)
+}
+
 func (r *queryResolver) Version(ctx context.Context, productID string, tag *string) (*entity.Version, error) {
 	loggedUser := ctx.Value("user").(*entity.User)
 
@@ -279,6 +745,64 @@ func (r *queryResolver) Versions(ctx context.Context, productID string, status *
 	return r.versionInteractor.SearchByProduct(ctx, loggedUser, productID, &filter)
 }
 
+func (r *queryResolver) VersionManifests(ctx context.Context, productID, tag string) ([]*entity.KubernetesManifest, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.versionInteractor.RenderManifests(ctx, loggedUser, productID, tag)
+}
+
+func (r *queryResolver) ProductConfiguration(ctx context.Context, productID string) ([]*entity.ConfigurationEntry, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.productInteractor.GetConfiguration(ctx, loggedUser, productID)
+}
+
+func (r *queryResolver) ProductObjectStores(ctx context.Context, productID string) ([]*entity.ProductObjectStore, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.productInteractor.GetObjectStores(ctx, loggedUser, productID)
+}
+
+func (r *queryResolver) VersionObjectStores(ctx context.Context, productID, tag string) ([]string, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.versionInteractor.GetObjectStores(ctx, loggedUser, productID, tag)
+}
+
+func (r *queryResolver) Objects(ctx context.Context, productID, objectStore string) ([]*entity.ObjectInfo, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.productInteractor.ListObjects(ctx, loggedUser, productID, objectStore)
+}
+
+func (r *queryResolver) ProcessConfiguration(
+	ctx context.Context,
+	productID, versionTag, workflowName, processName string,
+) ([]*entity.ConfigurationEntry, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.versionInteractor.GetProcessConfiguration(ctx, loggedUser, productID, versionTag, workflowName, processName)
+}
+
+func (r *queryResolver) ConfigurationHistory(
+	ctx context.Context,
+	store ConfigurationStoreInput,
+	key string,
+) ([]*entity.ConfigurationRevision, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.versionInteractor.GetConfigurationHistory(ctx, loggedUser, mapConfigurationStoreInput(&store), key)
+}
+
+func (r *queryResolver) WorkflowGraph(
+	ctx context.Context,
+	productID, versionTag, workflowName string,
+) (*entity.WorkflowGraph, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.versionInteractor.GetWorkflowGraph(ctx, loggedUser, productID, versionTag, workflowName)
+}
+
+func (r *queryResolver) DeadLetterMessages(
+	ctx context.Context,
+	productID, versionTag, workflowName string,
+) ([]*entity.DeadLetterMessage, error) {
+	loggedUser := ctx.Value("user").(*entity.User)
+	return r.versionInteractor.GetDeadLetterMessages(ctx, loggedUser, productID, versionTag, workflowName)
+}
+
 func (r *queryResolver) RegisteredProcesses(
 	ctx context.Context, productID string,
 	processName, processVersion, processType *string,
@@ -364,6 +888,22 @@ func (r *versionResolver) PublicationAuthor(_ context.Context, obj *entity.Versi
 	return obj.PublicationAuthor, nil
 }
 
+func (r *versionPatchResolver) Date(_ context.Context, obj *entity.VersionPatch) (string, error) {
+	return obj.Date.Format(time.RFC3339), nil
+}
+
+func (r *versionShadowResolver) Date(_ context.Context, obj *entity.VersionShadow) (string, error) {
+	return obj.Date.Format(time.RFC3339), nil
+}
+
+func (r *versionDrainResolver) Date(_ context.Context, obj *entity.VersionDrain) (string, error) {
+	return obj.Date.Format(time.RFC3339), nil
+}
+
+func (r *versionDrainResolver) Deadline(_ context.Context, obj *entity.VersionDrain) (string, error) {
+	return obj.Deadline.Format(time.RFC3339), nil
+}
+
 func (r *registeredProcessResolver) UploadDate(_ context.Context, obj *entity.RegisteredProcess) (string, error) {
 	return obj.UploadDate.Format(time.RFC3339), nil
 }
@@ -372,6 +912,111 @@ func (r *registeredProcessResolver) Type(_ context.Context, obj *entity.Register
 	return obj.Type.String(), nil
 }
 
+func (r *admissionPolicyResolver) CreationDate(_ context.Context, obj *entity.AdmissionPolicy) (string, error) {
+	return obj.CreationDate.Format(time.RFC3339), nil
+}
+
+func (r *admissionPolicyParamsResolver) WorkflowTypes(_ context.Context, obj *entity.AdmissionPolicyParams) ([]string, error) {
+	workflowTypes := make([]string, 0, len(obj.WorkflowTypes))
+	for _, workflowType := range obj.WorkflowTypes {
+		workflowTypes = append(workflowTypes, string(workflowType))
+	}
+
+	return workflowTypes, nil
+}
+
+func (r *processEventResolver) LastTimestamp(_ context.Context, obj *entity.ProcessEvent) (string, error) {
+	return obj.LastTimestamp.Format(time.RFC3339), nil
+}
+
+func (r *deadLetterMessageResolver) Sequence(_ context.Context, obj *entity.DeadLetterMessage) (int, error) {
+	return int(obj.Sequence), nil
+}
+
+func (r *deadLetterMessageResolver) StreamSequence(_ context.Context, obj *entity.DeadLetterMessage) (int, error) {
+	return int(obj.StreamSequence), nil
+}
+
+func (r *deadLetterMessageResolver) Deliveries(_ context.Context, obj *entity.DeadLetterMessage) (int, error) {
+	return int(obj.Deliveries), nil
+}
+
+func (r *deadLetterMessageResolver) Headers(_ context.Context, obj *entity.DeadLetterMessage) ([]*MessageHeader, error) {
+	return mapMessageHeaders(obj.Headers), nil
+}
+
+func (r *deadLetterMessageResolver) Data(_ context.Context, obj *entity.DeadLetterMessage) (string, error) {
+	return base64.StdEncoding.EncodeToString(obj.Data), nil
+}
+
+func (r *deadLetterMessageResolver) Timestamp(_ context.Context, obj *entity.DeadLetterMessage) (string, error) {
+	return obj.Timestamp.Format(time.RFC3339), nil
+}
+
+func (r *streamMessageResolver) Sequence(_ context.Context, obj *entity.StreamMessage) (int, error) {
+	return int(obj.Sequence), nil
+}
+
+func (r *streamMessageResolver) Headers(_ context.Context, obj *entity.StreamMessage) ([]*MessageHeader, error) {
+	return mapMessageHeaders(obj.Headers), nil
+}
+
+func (r *streamMessageResolver) Data(
+	_ context.Context,
+	obj *entity.StreamMessage,
+	encoding *MessageEncoding,
+) (string, error) {
+	if encoding != nil && *encoding == MessageEncodingJSON {
+		if !json.Valid(obj.Data) {
+			return "", errInvalidJSONData
+		}
+
+		return string(obj.Data), nil
+	}
+
+	return base64.StdEncoding.EncodeToString(obj.Data), nil
+}
+
+func (r *streamMessageResolver) Timestamp(_ context.Context, obj *entity.StreamMessage) (string, error) {
+	return obj.Timestamp.Format(time.RFC3339), nil
+}
+
+func (r *objectInfoResolver) Size(_ context.Context, obj *entity.ObjectInfo) (int, error) {
+	return int(obj.Size), nil
+}
+
+func (r *objectInfoResolver) Modified(_ context.Context, obj *entity.ObjectInfo) (string, error) {
+	return obj.Modified.Format(time.RFC3339), nil
+}
+
+func (r *configurationEntryResolver) Revision(_ context.Context, obj *entity.ConfigurationEntry) (int, error) {
+	return int(obj.Revision), nil
+}
+
+func (r *configurationEntryResolver) Created(_ context.Context, obj *entity.ConfigurationEntry) (string, error) {
+	return obj.Created.Format(time.RFC3339), nil
+}
+
+func (r *configurationRevisionResolver) Revision(_ context.Context, obj *entity.ConfigurationRevision) (int, error) {
+	return int(obj.Revision), nil
+}
+
+func (r *configurationRevisionResolver) Created(_ context.Context, obj *entity.ConfigurationRevision) (string, error) {
+	return obj.Created.Format(time.RFC3339), nil
+}
+
+// mapMessageHeaders returns the message headers sorted by key.
+func mapMessageHeaders(headers map[string]string) []*MessageHeader {
+	headersList := make([]*MessageHeader, 0, len(headers))
+	for key, value := range headers {
+		headersList = append(headersList, &MessageHeader{Key: key, Value: value})
+	}
+
+	sort.Slice(headersList, func(i, j int) bool { return headersList[i].Key < headersList[j].Key })
+
+	return headersList
+}
+
 func (r *logFiltersResolver) From(_ context.Context, obj *entity.LogFilters, from string) error {
 	var err error
 	obj.From, err = time.Parse(time.RFC3339, from)
@@ -401,11 +1046,52 @@ func (r *Resolver) UserActivity() UserActivityResolver { return &userActivityRes
 // Version returns VersionResolver implementation.
 func (r *Resolver) Version() VersionResolver { return &versionResolver{r} }
 
+// VersionPatch returns VersionPatchResolver implementation.
+func (r *Resolver) VersionPatch() VersionPatchResolver { return &versionPatchResolver{r} }
+
+// VersionShadow returns VersionShadowResolver implementation.
+func (r *Resolver) VersionShadow() VersionShadowResolver { return &versionShadowResolver{r} }
+
+// VersionDrain returns VersionDrainResolver implementation.
+func (r *Resolver) VersionDrain() VersionDrainResolver { return &versionDrainResolver{r} }
+
 // RegisteredProcess returns RegisteredProcessResolver implementation.
 func (r *Resolver) RegisteredProcess() RegisteredProcessResolver {
 	return &registeredProcessResolver{r}
 }
 
+// AdmissionPolicy returns AdmissionPolicyResolver implementation.
+func (r *Resolver) AdmissionPolicy() AdmissionPolicyResolver { return &admissionPolicyResolver{r} }
+
+// AdmissionPolicyParams returns AdmissionPolicyParamsResolver implementation.
+func (r *Resolver) AdmissionPolicyParams() AdmissionPolicyParamsResolver {
+	return &admissionPolicyParamsResolver{r}
+}
+
+// ProcessEvent returns ProcessEventResolver implementation.
+func (r *Resolver) ProcessEvent() ProcessEventResolver { return &processEventResolver{r} }
+
+// DeadLetterMessage returns DeadLetterMessageResolver implementation.
+func (r *Resolver) DeadLetterMessage() DeadLetterMessageResolver {
+	return &deadLetterMessageResolver{r}
+}
+
+// StreamMessage returns StreamMessageResolver implementation.
+func (r *Resolver) StreamMessage() StreamMessageResolver { return &streamMessageResolver{r} }
+
+// ConfigurationEntry returns ConfigurationEntryResolver implementation.
+func (r *Resolver) ConfigurationEntry() ConfigurationEntryResolver {
+	return &configurationEntryResolver{r}
+}
+
+// ConfigurationRevision returns ConfigurationRevisionResolver implementation.
+func (r *Resolver) ConfigurationRevision() ConfigurationRevisionResolver {
+	return &configurationRevisionResolver{r}
+}
+
+// ObjectInfo returns ObjectInfoResolver implementation.
+func (r *Resolver) ObjectInfo() ObjectInfoResolver { return &objectInfoResolver{r} }
+
 // LogFilters returns LogFiltersResolver implementation.
 func (r *Resolver) LogFilters() LogFiltersResolver { return &logFiltersResolver{r} }
 
@@ -414,6 +1100,17 @@ type queryResolver struct{ *Resolver }
 type productResolver struct{ *Resolver }
 type userActivityResolver struct{ *Resolver }
 type versionResolver struct{ *Resolver }
+type versionPatchResolver struct{ *Resolver }
+type versionShadowResolver struct{ *Resolver }
+type versionDrainResolver struct{ *Resolver }
 type registeredProcessResolver struct{ *Resolver }
 
 type logFiltersResolver struct{ *Resolver }
+type admissionPolicyResolver struct{ *Resolver }
+type admissionPolicyParamsResolver struct{ *Resolver }
+type processEventResolver struct{ *Resolver }
+type deadLetterMessageResolver struct{ *Resolver }
+type streamMessageResolver struct{ *Resolver }
+type configurationEntryResolver struct{ *Resolver }
+type configurationRevisionResolver struct{ *Resolver }
+type objectInfoResolver struct{ *Resolver }
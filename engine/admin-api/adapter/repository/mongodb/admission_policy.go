@@ -0,0 +1,141 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/config"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/admission"
+)
+
+const (
+	_admissionPolicyRepoTimeout = 60 * time.Second
+)
+
+type AdmissionPolicyRepoMongoDB struct {
+	logger     logr.Logger
+	collection *mongo.Collection
+}
+
+func NewAdmissionPolicyRepoMongoDB(logger logr.Logger, client *mongo.Client) *AdmissionPolicyRepoMongoDB {
+	collection := client.Database(viper.GetString(config.MongoDBKaiDatabaseKey)).Collection("admissionPolicies")
+
+	admissionPolicyRepo := &AdmissionPolicyRepoMongoDB{
+		logger,
+		collection,
+	}
+
+	admissionPolicyRepo.createIndexes()
+
+	return admissionPolicyRepo
+}
+
+func (r *AdmissionPolicyRepoMongoDB) createIndexes() {
+	_, err := r.collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.M{"name": 1},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		r.logger.Error(err, "Error creating admission policies collection indexes")
+	}
+}
+
+func (r *AdmissionPolicyRepoMongoDB) Create(
+	ctx context.Context,
+	policy *entity.AdmissionPolicy,
+) (*entity.AdmissionPolicy, error) {
+	ctx, cancel := context.WithTimeout(ctx, _admissionPolicyRepoTimeout)
+	defer cancel()
+
+	policy.ID = primitive.NewObjectID().Hex()
+	policy.CreationDate = time.Now().UTC()
+
+	_, err := r.collection.InsertOne(ctx, policy)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, admission.ErrPolicyDuplicated
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (r *AdmissionPolicyRepoMongoDB) GetByID(ctx context.Context, policyID string) (*entity.AdmissionPolicy, error) {
+	ctx, cancel := context.WithTimeout(ctx, _admissionPolicyRepoTimeout)
+	defer cancel()
+
+	policy := &entity.AdmissionPolicy{}
+
+	err := r.collection.FindOne(ctx, bson.M{"_id": policyID}).Decode(policy)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, admission.ErrPolicyNotFound
+	}
+
+	return policy, err
+}
+
+func (r *AdmissionPolicyRepoMongoDB) FindAll(ctx context.Context) ([]*entity.AdmissionPolicy, error) {
+	ctx, cancel := context.WithTimeout(ctx, _admissionPolicyRepoTimeout)
+	defer cancel()
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"name": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*entity.AdmissionPolicy, 0)
+
+	err = cursor.All(ctx, &policies)
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (r *AdmissionPolicyRepoMongoDB) Update(ctx context.Context, policy *entity.AdmissionPolicy) error {
+	ctx, cancel := context.WithTimeout(ctx, _admissionPolicyRepoTimeout)
+	defer cancel()
+
+	res, err := r.collection.ReplaceOne(ctx, bson.M{"_id": policy.ID}, policy)
+	if mongo.IsDuplicateKeyError(err) {
+		return admission.ErrPolicyDuplicated
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return admission.ErrPolicyNotFound
+	}
+
+	return nil
+}
+
+func (r *AdmissionPolicyRepoMongoDB) Delete(ctx context.Context, policyID string) error {
+	ctx, cancel := context.WithTimeout(ctx, _admissionPolicyRepoTimeout)
+	defer cancel()
+
+	res, err := r.collection.DeleteOne(ctx, bson.M{"_id": policyID})
+	if err != nil {
+		return err
+	}
+
+	if res.DeletedCount == 0 {
+		return admission.ErrPolicyNotFound
+	}
+
+	return nil
+}
@@ -24,14 +24,65 @@ type versionDTO struct {
 	Status string `bson:"status"`
 
 	Error string `bson:"error"`
+
+	Patches []versionPatchDTO `bson:"patches,omitempty"`
+
+	Shadow *versionShadowDTO `bson:"shadow,omitempty"`
+
+	Drain *versionDrainDTO `bson:"drain,omitempty"`
+
+	KeyValueStore *keyValueStoreSettingsDTO `bson:"keyValueStore,omitempty"`
+}
+
+type versionShadowDTO struct {
+	PublishedVersion string    `bson:"publishedVersion"`
+	Author           string    `bson:"author"`
+	Date             time.Time `bson:"date"`
+}
+
+type versionDrainDTO struct {
+	Date            time.Time `bson:"date"`
+	Deadline        time.Time `bson:"deadline"`
+	PendingMessages int64     `bson:"pendingMessages"`
+	TimedOut        bool      `bson:"timedOut"`
+	Author          string    `bson:"author"`
+	Comment         string    `bson:"comment"`
+}
+
+type versionPatchDTO struct {
+	Workflow      string    `bson:"workflow"`
+	Process       string    `bson:"process"`
+	PreviousImage string    `bson:"previousImage"`
+	Image         string    `bson:"image"`
+	Author        string    `bson:"author"`
+	Date          time.Time `bson:"date"`
 }
 
 type workflowDTO struct {
-	ID        string                     `bson:"id"`
-	Name      string                     `bson:"name"`
-	Type      string                     `bson:"type"`
-	Config    []configurationVariableDTO `bson:"config,omitempty"`
-	Processes []processDTO               `bson:"processes"`
+	ID            string                     `bson:"id"`
+	Name          string                     `bson:"name"`
+	Type          string                     `bson:"type"`
+	Config        []configurationVariableDTO `bson:"config,omitempty"`
+	Processes     []processDTO               `bson:"processes"`
+	Job           *workflowJobDTO            `bson:"job,omitempty"`
+	Stream        *workflowStreamDTO         `bson:"stream,omitempty"`
+	KeyValueStore *keyValueStoreSettingsDTO  `bson:"keyValueStore,omitempty"`
+}
+
+type workflowJobDTO struct {
+	Schedule                string `bson:"schedule"`
+	BackoffLimit            int32  `bson:"backoffLimit"`
+	ActiveDeadlineSeconds   int64  `bson:"activeDeadlineSeconds"`
+	TTLSecondsAfterFinished int32  `bson:"ttlSecondsAfterFinished"`
+}
+
+type workflowStreamDTO struct {
+	Retention     string `bson:"retention,omitempty"`
+	MaxAgeSeconds int64  `bson:"maxAgeSeconds"`
+	MaxBytes      int64  `bson:"maxBytes"`
+	MaxMsgSize    int32  `bson:"maxMsgSize"`
+	Replicas      int32  `bson:"replicas"`
+	Storage       string `bson:"storage,omitempty"`
 }
 
 type processDTO struct {
@@ -48,11 +99,60 @@ type processDTO struct {
 	Networking     *processNetworkingDTO      `bson:"networking,omitempty"`
 	ResourceLimits *processResourceLimitsDTO  `bson:"resourceLimits,omitempty"`
 	NodeSelectors  map[string]string          `bson:"nodeSelectors,omitempty"`
+	Autoscaling    *processAutoscalingDTO     `bson:"autoscaling,omitempty"`
+	Probes         *processProbesDTO          `bson:"probes,omitempty"`
+	KeyValueStore  *keyValueStoreSettingsDTO  `bson:"keyValueStore,omitempty"`
+	MaxDeliver     int32                      `bson:"maxDeliver,omitempty"`
+}
+
+type processProbesDTO struct {
+	Liveness  *processProbeDTO `bson:"liveness,omitempty"`
+	Readiness *processProbeDTO `bson:"readiness,omitempty"`
+	Startup   *processProbeDTO `bson:"startup,omitempty"`
+}
+
+type processProbeDTO struct {
+	Type                string   `bson:"type"`
+	Path                string   `bson:"path,omitempty"`
+	Port                int      `bson:"port,omitempty"`
+	Command             []string `bson:"command,omitempty"`
+	GRPCService         string   `bson:"grpcService,omitempty"`
+	InitialDelaySeconds int32    `bson:"initialDelaySeconds"`
+	PeriodSeconds       int32    `bson:"periodSeconds"`
+	TimeoutSeconds      int32    `bson:"timeoutSeconds"`
+	SuccessThreshold    int32    `bson:"successThreshold"`
+	FailureThreshold    int32    `bson:"failureThreshold"`
+}
+
+type processAutoscalingDTO struct {
+	MinReplicas                   int32 `bson:"minReplicas"`
+	MaxReplicas                   int32 `bson:"maxReplicas"`
+	CPUTargetPercentage           int32 `bson:"cpuTargetPercentage"`
+	MemoryTargetPercentage        int32 `bson:"memoryTargetPercentage"`
+	ScaleUpStabilizationSeconds   int32 `bson:"scaleUpStabilizationSeconds"`
+	ScaleDownStabilizationSeconds int32 `bson:"scaleDownStabilizationSeconds"`
+	ConsumerLagTarget             int32 `bson:"consumerLagTarget"`
 }
 
 type processObjectStoreDTO struct {
-	Name  string `bson:"name"`
-	Scope string `bson:"scope"`
+	Name     string                  `bson:"name"`
+	Scope    string                  `bson:"scope"`
+	Settings *objectStoreSettingsDTO `bson:"settings,omitempty"`
+}
+
+type objectStoreSettingsDTO struct {
+	TTLSeconds int64  `bson:"ttlSeconds"`
+	MaxBytes   int64  `bson:"maxBytes"`
+	Replicas   int32  `bson:"replicas"`
+	Storage    string `bson:"storage,omitempty"`
+}
+
+type keyValueStoreSettingsDTO struct {
+	TTLSeconds int64  `bson:"ttlSeconds"`
+	MaxBytes   int64  `bson:"maxBytes"`
+	History    int32  `bson:"history"`
+	Replicas   int32  `bson:"replicas"`
+	Storage    string `bson:"storage,omitempty"`
 }
 
 type processNetworkingDTO struct {
@@ -19,24 +19,111 @@ func mapDTOToEntity(dto *versionDTO) *entity.Version {
 
 		Status: entity.VersionStatus(dto.Status),
 		Error:  dto.Error,
+
+		Patches: mapDTOToEntityPatches(dto.Patches),
+
+		Shadow: mapDTOToEntityShadow(dto.Shadow),
+
+		Drain: mapDTOToEntityDrain(dto.Drain),
+
+		KeyValueStore: mapDTOToEntityKeyValueStoreSettings(dto.KeyValueStore),
 	}
 }
 
+func mapDTOToEntityShadow(dto *versionShadowDTO) *entity.VersionShadow {
+	if dto == nil {
+		return nil
+	}
+
+	return &entity.VersionShadow{
+		PublishedVersion: dto.PublishedVersion,
+		Author:           dto.Author,
+		Date:             dto.Date,
+	}
+}
+
+func mapDTOToEntityDrain(dto *versionDrainDTO) *entity.VersionDrain {
+	if dto == nil {
+		return nil
+	}
+
+	return &entity.VersionDrain{
+		Date:            dto.Date,
+		Deadline:        dto.Deadline,
+		PendingMessages: dto.PendingMessages,
+		TimedOut:        dto.TimedOut,
+		Author:          dto.Author,
+		Comment:         dto.Comment,
+	}
+}
+
+func mapDTOToEntityPatches(dtos []versionPatchDTO) []entity.VersionPatch {
+	if dtos == nil {
+		return nil
+	}
+
+	patches := make([]entity.VersionPatch, 0, len(dtos))
+
+	for _, dto := range dtos {
+		patches = append(patches, entity.VersionPatch{
+			Workflow:      dto.Workflow,
+			Process:       dto.Process,
+			PreviousImage: dto.PreviousImage,
+			Image:         dto.Image,
+			Author:        dto.Author,
+			Date:          dto.Date,
+		})
+	}
+
+	return patches
+}
+
 func mapDTOToEntityWorkflows(dtos []workflowDTO) []entity.Workflow {
 	workflows := make([]entity.Workflow, 0, len(dtos))
 
 	for _, dto := range dtos {
 		workflows = append(workflows, entity.Workflow{
-			Name:      dto.Name,
-			Type:      entity.WorkflowType(dto.Type),
-			Config:    mapDTOConfigToEntityConfig(dto.Config),
-			Processes: mapDTOToEntityProcesses(dto.Processes),
+			Name:           dto.Name,
+			Type:           entity.WorkflowType(dto.Type),
+			Config:         mapDTOConfigToEntityConfig(dto.Config),
+			Processes:      mapDTOToEntityProcesses(dto.Processes),
+			Job:            mapDTOToEntityWorkflowJob(dto.Job),
+			StreamSettings: mapDTOToEntityWorkflowStreamSettings(dto.Stream),
+			KeyValueStore:  mapDTOToEntityKeyValueStoreSettings(dto.KeyValueStore),
 		})
 	}
 
 	return workflows
 }
 
+func mapDTOToEntityWorkflowJob(dto *workflowJobDTO) *entity.WorkflowJob {
+	if dto == nil {
+		return nil
+	}
+
+	return &entity.WorkflowJob{
+		Schedule:                dto.Schedule,
+		BackoffLimit:            dto.BackoffLimit,
+		ActiveDeadlineSeconds:   dto.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: dto.TTLSecondsAfterFinished,
+	}
+}
+
+func mapDTOToEntityWorkflowStreamSettings(dto *workflowStreamDTO) *entity.WorkflowStreamSettings {
+	if dto == nil {
+		return nil
+	}
+
+	return &entity.WorkflowStreamSettings{
+		Retention:     entity.StreamRetention(dto.Retention),
+		MaxAgeSeconds: dto.MaxAgeSeconds,
+		MaxBytes:      dto.MaxBytes,
+		MaxMsgSize:    dto.MaxMsgSize,
+		Replicas:      dto.Replicas,
+		Storage:       entity.StreamStorage(dto.Storage),
+	}
+}
+
 func mapDTOToEntityProcesses(dtos []processDTO) []entity.Process {
 	processes := make([]entity.Process, 0, len(dtos))
 
@@ -54,6 +141,10 @@ func mapDTOToEntityProcesses(dtos []processDTO) []entity.Process {
 			Networking:     mapDTOToEntityProcessNetworking(dto.Networking),
 			ResourceLimits: mapDTOToEntityProcessResourceLimits(dto.ResourceLimits),
 			NodeSelectors:  dto.NodeSelectors,
+			Autoscaling:    mapDTOToEntityProcessAutoscaling(dto.Autoscaling),
+			Probes:         mapDTOToEntityProcessProbes(dto.Probes),
+			KeyValueStore:  mapDTOToEntityKeyValueStoreSettings(dto.KeyValueStore),
+			MaxDeliver:     dto.MaxDeliver,
 		})
 	}
 
@@ -66,8 +157,83 @@ func mapDTOToEntityProcessObjectStore(dto *processObjectStoreDTO) *entity.Proces
 	}
 
 	return &entity.ProcessObjectStore{
-		Name:  dto.Name,
-		Scope: entity.ObjectStoreScope(dto.Scope),
+		Name:     dto.Name,
+		Scope:    entity.ObjectStoreScope(dto.Scope),
+		Settings: mapDTOToEntityObjectStoreSettings(dto.Settings),
+	}
+}
+
+func mapDTOToEntityObjectStoreSettings(dto *objectStoreSettingsDTO) *entity.ObjectStoreSettings {
+	if dto == nil {
+		return nil
+	}
+
+	return &entity.ObjectStoreSettings{
+		TTLSeconds: dto.TTLSeconds,
+		MaxBytes:   dto.MaxBytes,
+		Replicas:   dto.Replicas,
+		Storage:    entity.StreamStorage(dto.Storage),
+	}
+}
+
+func mapDTOToEntityKeyValueStoreSettings(dto *keyValueStoreSettingsDTO) *entity.KeyValueStoreSettings {
+	if dto == nil {
+		return nil
+	}
+
+	return &entity.KeyValueStoreSettings{
+		TTLSeconds: dto.TTLSeconds,
+		MaxBytes:   dto.MaxBytes,
+		History:    dto.History,
+		Replicas:   dto.Replicas,
+		Storage:    entity.StreamStorage(dto.Storage),
+	}
+}
+
+func mapDTOToEntityProcessAutoscaling(dto *processAutoscalingDTO) *entity.ProcessAutoscaling {
+	if dto == nil {
+		return nil
+	}
+
+	return &entity.ProcessAutoscaling{
+		MinReplicas:                   dto.MinReplicas,
+		MaxReplicas:                   dto.MaxReplicas,
+		CPUTargetPercentage:           dto.CPUTargetPercentage,
+		MemoryTargetPercentage:        dto.MemoryTargetPercentage,
+		ScaleUpStabilizationSeconds:   dto.ScaleUpStabilizationSeconds,
+		ScaleDownStabilizationSeconds: dto.ScaleDownStabilizationSeconds,
+		ConsumerLagTarget:             dto.ConsumerLagTarget,
+	}
+}
+
+func mapDTOToEntityProcessProbes(dto *processProbesDTO) *entity.ProcessProbes {
+	if dto == nil {
+		return nil
+	}
+
+	return &entity.ProcessProbes{
+		Liveness:  mapDTOToEntityProcessProbe(dto.Liveness),
+		Readiness: mapDTOToEntityProcessProbe(dto.Readiness),
+		Startup:   mapDTOToEntityProcessProbe(dto.Startup),
+	}
+}
+
+func mapDTOToEntityProcessProbe(dto *processProbeDTO) *entity.ProcessProbe {
+	if dto == nil {
+		return nil
+	}
+
+	return &entity.ProcessProbe{
+		Type:                entity.ProbeType(dto.Type),
+		Path:                dto.Path,
+		Port:                dto.Port,
+		Command:             dto.Command,
+		GRPCService:         dto.GRPCService,
+		InitialDelaySeconds: dto.InitialDelaySeconds,
+		PeriodSeconds:       dto.PeriodSeconds,
+		TimeoutSeconds:      dto.TimeoutSeconds,
+		SuccessThreshold:    dto.SuccessThreshold,
+		FailureThreshold:    dto.FailureThreshold,
 	}
 }
 
@@ -138,19 +304,78 @@ func mapEntityToDTO(versionEntity *entity.Version) *versionDTO {
 		Status: versionEntity.Status.String(),
 
 		Error: versionEntity.Error,
+
+		Patches: mapEntityToDTOPatches(versionEntity.Patches),
+
+		Shadow: mapEntityToDTOShadow(versionEntity.Shadow),
+
+		Drain: mapEntityToDTODrain(versionEntity.Drain),
+
+		KeyValueStore: mapEntityToDTOKeyValueStoreSettings(versionEntity.KeyValueStore),
 	}
 }
 
+func mapEntityToDTOShadow(shadow *entity.VersionShadow) *versionShadowDTO {
+	if shadow == nil {
+		return nil
+	}
+
+	return &versionShadowDTO{
+		PublishedVersion: shadow.PublishedVersion,
+		Author:           shadow.Author,
+		Date:             shadow.Date,
+	}
+}
+
+func mapEntityToDTODrain(drain *entity.VersionDrain) *versionDrainDTO {
+	if drain == nil {
+		return nil
+	}
+
+	return &versionDrainDTO{
+		Date:            drain.Date,
+		Deadline:        drain.Deadline,
+		PendingMessages: drain.PendingMessages,
+		TimedOut:        drain.TimedOut,
+		Author:          drain.Author,
+		Comment:         drain.Comment,
+	}
+}
+
+func mapEntityToDTOPatches(patches []entity.VersionPatch) []versionPatchDTO {
+	if patches == nil {
+		return nil
+	}
+
+	dtos := make([]versionPatchDTO, 0, len(patches))
+
+	for _, patch := range patches {
+		dtos = append(dtos, versionPatchDTO{
+			Workflow:      patch.Workflow,
+			Process:       patch.Process,
+			PreviousImage: patch.PreviousImage,
+			Image:         patch.Image,
+			Author:        patch.Author,
+			Date:          patch.Date,
+		})
+	}
+
+	return dtos
+}
+
 func mapEntityToDTOWorkflows(workflows []entity.Workflow) []workflowDTO {
 	dtos := make([]workflowDTO, len(workflows))
 	idx := 0
 
 	for _, workflow := range workflows {
 		dtos[idx] = workflowDTO{
-			Name:      workflow.Name,
-			Type:      workflow.Type.String(),
-			Config:    mapEntityConfigToDTOConfig(workflow.Config),
-			Processes: mapEntityToDTOProcesses(workflow.Processes),
+			Name:          workflow.Name,
+			Type:          workflow.Type.String(),
+			Config:        mapEntityConfigToDTOConfig(workflow.Config),
+			Processes:     mapEntityToDTOProcesses(workflow.Processes),
+			Job:           mapEntityToDTOWorkflowJob(workflow.Job),
+			Stream:        mapEntityToDTOWorkflowStream(workflow.StreamSettings),
+			KeyValueStore: mapEntityToDTOKeyValueStoreSettings(workflow.KeyValueStore),
 		}
 		idx++
 	}
@@ -158,6 +383,34 @@ func mapEntityToDTOWorkflows(workflows []entity.Workflow) []workflowDTO {
 	return dtos
 }
 
+func mapEntityToDTOWorkflowJob(job *entity.WorkflowJob) *workflowJobDTO {
+	if job == nil {
+		return nil
+	}
+
+	return &workflowJobDTO{
+		Schedule:                job.Schedule,
+		BackoffLimit:            job.BackoffLimit,
+		ActiveDeadlineSeconds:   job.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: job.TTLSecondsAfterFinished,
+	}
+}
+
+func mapEntityToDTOWorkflowStream(settings *entity.WorkflowStreamSettings) *workflowStreamDTO {
+	if settings == nil {
+		return nil
+	}
+
+	return &workflowStreamDTO{
+		Retention:     string(settings.Retention),
+		MaxAgeSeconds: settings.MaxAgeSeconds,
+		MaxBytes:      settings.MaxBytes,
+		MaxMsgSize:    settings.MaxMsgSize,
+		Replicas:      settings.Replicas,
+		Storage:       string(settings.Storage),
+	}
+}
+
 func mapEntityToDTOProcesses(processes []entity.Process) []processDTO {
 	dtos := make([]processDTO, 0, len(processes))
 
@@ -175,6 +428,10 @@ func mapEntityToDTOProcesses(processes []entity.Process) []processDTO {
 			Networking:     mapEntityToDTOProcessNetworking(process.Networking),
 			ResourceLimits: mapEntityToDTOProcessResourceLimits(process.ResourceLimits),
 			NodeSelectors:  process.NodeSelectors,
+			Autoscaling:    mapEntityToDTOProcessAutoscaling(process.Autoscaling),
+			Probes:         mapEntityToDTOProcessProbes(process.Probes),
+			KeyValueStore:  mapEntityToDTOKeyValueStoreSettings(process.KeyValueStore),
+			MaxDeliver:     process.MaxDeliver,
 		})
 	}
 
@@ -187,8 +444,36 @@ func mapEntityToDTOProcessObjectStore(objectStore *entity.ProcessObjectStore) *p
 	}
 
 	return &processObjectStoreDTO{
-		Name:  objectStore.Name,
-		Scope: objectStore.Scope.String(),
+		Name:     objectStore.Name,
+		Scope:    objectStore.Scope.String(),
+		Settings: mapEntityToDTOObjectStoreSettings(objectStore.Settings),
+	}
+}
+
+func mapEntityToDTOObjectStoreSettings(settings *entity.ObjectStoreSettings) *objectStoreSettingsDTO {
+	if settings == nil {
+		return nil
+	}
+
+	return &objectStoreSettingsDTO{
+		TTLSeconds: settings.TTLSeconds,
+		MaxBytes:   settings.MaxBytes,
+		Replicas:   settings.Replicas,
+		Storage:    string(settings.Storage),
+	}
+}
+
+func mapEntityToDTOKeyValueStoreSettings(settings *entity.KeyValueStoreSettings) *keyValueStoreSettingsDTO {
+	if settings == nil {
+		return nil
+	}
+
+	return &keyValueStoreSettingsDTO{
+		TTLSeconds: settings.TTLSeconds,
+		MaxBytes:   settings.MaxBytes,
+		History:    settings.History,
+		Replicas:   settings.Replicas,
+		Storage:    string(settings.Storage),
 	}
 }
 
@@ -242,3 +527,50 @@ func mapEntityConfigToDTOConfig(config []entity.ConfigurationVariable) []configu
 
 	return dtoConfig
 }
+
+func mapEntityToDTOProcessAutoscaling(autoscaling *entity.ProcessAutoscaling) *processAutoscalingDTO {
+	if autoscaling == nil {
+		return nil
+	}
+
+	return &processAutoscalingDTO{
+		MinReplicas:                   autoscaling.MinReplicas,
+		MaxReplicas:                   autoscaling.MaxReplicas,
+		CPUTargetPercentage:           autoscaling.CPUTargetPercentage,
+		MemoryTargetPercentage:        autoscaling.MemoryTargetPercentage,
+		ScaleUpStabilizationSeconds:   autoscaling.ScaleUpStabilizationSeconds,
+		ScaleDownStabilizationSeconds: autoscaling.ScaleDownStabilizationSeconds,
+		ConsumerLagTarget:             autoscaling.ConsumerLagTarget,
+	}
+}
+
+func mapEntityToDTOProcessProbes(probes *entity.ProcessProbes) *processProbesDTO {
+	if probes == nil {
+		return nil
+	}
+
+	return &processProbesDTO{
+		Liveness:  mapEntityToDTOProcessProbe(probes.Liveness),
+		Readiness: mapEntityToDTOProcessProbe(probes.Readiness),
+		Startup:   mapEntityToDTOProcessProbe(probes.Startup),
+	}
+}
+
+func mapEntityToDTOProcessProbe(probe *entity.ProcessProbe) *processProbeDTO {
+	if probe == nil {
+		return nil
+	}
+
+	return &processProbeDTO{
+		Type:                string(probe.Type),
+		Path:                probe.Path,
+		Port:                probe.Port,
+		Command:             probe.Command,
+		GRPCService:         probe.GRPCService,
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		SuccessThreshold:    probe.SuccessThreshold,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+}
@@ -37,10 +37,53 @@ var domainVersion = &entity.Version{
 	PublicationAuthor: &userID,
 	Status:            entity.VersionStatusPublished,
 
+	Patches: []entity.VersionPatch{
+		{
+			Workflow:      "workflow1",
+			Process:       "process1",
+			PreviousImage: "image0",
+			Image:         "image1",
+			Author:        userID,
+			Date:          publicationDate,
+		},
+	},
+
+	Shadow: &entity.VersionShadow{
+		PublishedVersion: "0.9.0",
+		Author:           userID,
+		Date:             publicationDate,
+	},
+
+	Drain: &entity.VersionDrain{
+		Date:            publicationDate,
+		Deadline:        publicationDate.Add(5 * time.Minute),
+		PendingMessages: 3,
+		TimedOut:        true,
+		Author:          userID,
+		Comment:         "drain comment",
+	},
+	KeyValueStore: &entity.KeyValueStoreSettings{
+		History: 5,
+		Storage: entity.StreamStorageFile,
+	},
+
 	Workflows: []entity.Workflow{
 		{
 			Name: "workflow1",
 			Type: entity.WorkflowTypeTraining,
+			Job: &entity.WorkflowJob{
+				Schedule:     "0 3 * * *",
+				BackoffLimit: 3,
+			},
+			StreamSettings: &entity.WorkflowStreamSettings{
+				Retention:     entity.StreamRetentionLimits,
+				MaxAgeSeconds: 3600,
+				Storage:       entity.StreamStorageMemory,
+			},
+			KeyValueStore: &entity.KeyValueStoreSettings{
+				TTLSeconds: 3600,
+				MaxBytes:   1024,
+			},
 			Config: []entity.ConfigurationVariable{
 				{
 					Key:   "key1",
@@ -93,6 +136,21 @@ var domainVersion = &entity.Version{
 					Replicas:      2,
 					GPU:           true,
 					Subscriptions: []string{"subscription3", "subscription4"},
+					Autoscaling: &entity.ProcessAutoscaling{
+						MinReplicas:         1,
+						MaxReplicas:         2,
+						CPUTargetPercentage: 75,
+						ConsumerLagTarget:   100,
+					},
+					Probes: &entity.ProcessProbes{
+						Readiness: &entity.ProcessProbe{
+							Type:          entity.ProbeTypeHTTP,
+							Path:          "/ready",
+							Port:          8080,
+							PeriodSeconds: 5,
+						},
+					},
+					MaxDeliver: 10,
 				},
 				{
 					Name:          "process3",
@@ -125,10 +183,53 @@ var DTOVersion = &versionDTO{
 	PublicationAuthor: &userID,
 	Status:            entity.VersionStatusPublished.String(),
 
+	Patches: []versionPatchDTO{
+		{
+			Workflow:      "workflow1",
+			Process:       "process1",
+			PreviousImage: "image0",
+			Image:         "image1",
+			Author:        userID,
+			Date:          publicationDate,
+		},
+	},
+
+	Shadow: &versionShadowDTO{
+		PublishedVersion: "0.9.0",
+		Author:           userID,
+		Date:             publicationDate,
+	},
+
+	Drain: &versionDrainDTO{
+		Date:            publicationDate,
+		Deadline:        publicationDate.Add(5 * time.Minute),
+		PendingMessages: 3,
+		TimedOut:        true,
+		Author:          userID,
+		Comment:         "drain comment",
+	},
+	KeyValueStore: &keyValueStoreSettingsDTO{
+		History: 5,
+		Storage: "FILE",
+	},
+
 	Workflows: []workflowDTO{
 		{
 			Name: "workflow1",
 			Type: entity.WorkflowTypeTraining.String(),
+			Job: &workflowJobDTO{
+				Schedule:     "0 3 * * *",
+				BackoffLimit: 3,
+			},
+			Stream: &workflowStreamDTO{
+				Retention:     "LIMITS",
+				MaxAgeSeconds: 3600,
+				Storage:       "MEMORY",
+			},
+			KeyValueStore: &keyValueStoreSettingsDTO{
+				TTLSeconds: 3600,
+				MaxBytes:   1024,
+			},
 			Config: []configurationVariableDTO{
 				{
 					Key:   "key1",
@@ -181,6 +282,21 @@ var DTOVersion = &versionDTO{
 					Replicas:      2,
 					GPU:           true,
 					Subscriptions: []string{"subscription3", "subscription4"},
+					Autoscaling: &processAutoscalingDTO{
+						MinReplicas:         1,
+						MaxReplicas:         2,
+						CPUTargetPercentage: 75,
+						ConsumerLagTarget:   100,
+					},
+					Probes: &processProbesDTO{
+						Readiness: &processProbeDTO{
+							Type:          "HTTP",
+							Path:          "/ready",
+							Port:          8080,
+							PeriodSeconds: 5,
+						},
+					},
+					MaxDeliver: 10,
 				},
 				{
 					Name:          "process3",
@@ -0,0 +1,280 @@
+package natsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// GetProcessConfiguration calls nats-manager to get the configuration of a process resolved from all its scopes.
+func (n *Client) GetProcessConfiguration(
+	ctx context.Context,
+	productID, versionTag, workflow, process string,
+) ([]*entity.ConfigurationEntry, error) {
+	res, err := n.client.GetProcessConfiguration(ctx, &natspb.GetProcessConfigurationRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   workflow,
+		Process:    process,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting process %q configuration: %w", process, err)
+	}
+
+	configuration := make([]*entity.ConfigurationEntry, 0, len(res.Configuration))
+
+	for _, entryDTO := range res.Configuration {
+		entry, err := n.mapDTOToConfigurationEntry(entryDTO)
+		if err != nil {
+			return nil, err
+		}
+
+		configuration = append(configuration, entry)
+	}
+
+	return configuration, nil
+}
+
+// GetConfigurationHistory calls nats-manager to get the revisions of a configuration key.
+func (n *Client) GetConfigurationHistory(
+	ctx context.Context,
+	store entity.ConfigurationStore,
+	key string,
+) ([]*entity.ConfigurationRevision, error) {
+	res, err := n.client.GetConfigurationHistory(ctx, &natspb.GetConfigurationHistoryRequest{
+		Store: n.mapConfigurationStoreToDTO(store),
+		Key:   key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting configuration key %q history: %w", key, err)
+	}
+
+	revisions := make([]*entity.ConfigurationRevision, 0, len(res.Revisions))
+
+	for _, revisionDTO := range res.Revisions {
+		revision, err := n.mapDTOToConfigurationRevision(revisionDTO)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+// RollbackConfiguration calls nats-manager to set a configuration key back to a previous revision.
+func (n *Client) RollbackConfiguration(
+	ctx context.Context,
+	store entity.ConfigurationStore,
+	key string,
+	revision uint64,
+) (*entity.ConfigurationRevision, error) {
+	res, err := n.client.RollbackConfiguration(ctx, &natspb.RollbackConfigurationRequest{
+		Store:    n.mapConfigurationStoreToDTO(store),
+		Key:      key,
+		Revision: revision,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error rolling back configuration key %q: %w", key, err)
+	}
+
+	return n.mapDTOToConfigurationRevision(res.Entry)
+}
+
+// GetConfiguration calls nats-manager to get the current entries of a configuration store.
+func (n *Client) GetConfiguration(
+	ctx context.Context,
+	store entity.ConfigurationStore,
+) ([]*entity.ConfigurationEntry, error) {
+	res, err := n.client.GetConfiguration(ctx, &natspb.GetConfigurationRequest{
+		Store: n.mapConfigurationStoreToDTO(store),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s configuration: %w", store.Scope, err)
+	}
+
+	configuration := make([]*entity.ConfigurationEntry, 0, len(res.Entries))
+
+	for _, entryDTO := range res.Entries {
+		entry, err := n.mapDTOToConfigurationEntry(&natspb.ConfigurationEntry{
+			Scope: mapConfigurationScopeToDTO(store.Scope),
+			Entry: entryDTO,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		configuration = append(configuration, entry)
+	}
+
+	return configuration, nil
+}
+
+// SetConfiguration calls nats-manager to set the value of a key of a configuration store.
+func (n *Client) SetConfiguration(
+	ctx context.Context,
+	store entity.ConfigurationStore,
+	key, value string,
+) (*entity.ConfigurationEntry, error) {
+	res, err := n.client.SetConfiguration(ctx, &natspb.SetConfigurationRequest{
+		Store: n.mapConfigurationStoreToDTO(store),
+		Key:   key,
+		Value: value,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting configuration key %q: %w", key, err)
+	}
+
+	return n.mapDTOToConfigurationEntry(&natspb.ConfigurationEntry{
+		Scope: mapConfigurationScopeToDTO(store.Scope),
+		Entry: res.Entry,
+	})
+}
+
+// DeleteConfiguration calls nats-manager to delete a key of a configuration store.
+func (n *Client) DeleteConfiguration(ctx context.Context, store entity.ConfigurationStore, key string) error {
+	_, err := n.client.DeleteConfiguration(ctx, &natspb.DeleteConfigurationRequest{
+		Store: n.mapConfigurationStoreToDTO(store),
+		Key:   key,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting configuration key %q: %w", key, err)
+	}
+
+	return nil
+}
+
+// WatchProcessConfiguration calls nats-manager to get the changes of the configuration of a process until
+// the context is done.
+func (n *Client) WatchProcessConfiguration(
+	ctx context.Context,
+	productID, versionTag, workflow, process string,
+) (<-chan *entity.ConfigurationEntry, error) {
+	stream, err := n.client.WatchProcessConfiguration(ctx, &natspb.WatchProcessConfigurationRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   workflow,
+		Process:    process,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("process configuration opening stream: %w", err)
+	}
+
+	ch := make(chan *entity.ConfigurationEntry, 1)
+
+	go func() {
+		defer close(ch)
+
+		for {
+			msg, err := stream.Recv()
+
+			if errors.Is(stream.Context().Err(), context.Canceled) {
+				n.logger.V(2).Info("[NatsManager.WatchProcessConfiguration] Context canceled.")
+				return
+			}
+
+			if errors.Is(err, io.EOF) {
+				n.logger.V(2).Info("[NatsManager.WatchProcessConfiguration] EOF msg received.")
+				return
+			}
+
+			if err != nil {
+				n.logger.Error(err, "[NatsManager.WatchProcessConfiguration] Unexpected error")
+				return
+			}
+
+			entry, err := n.mapDTOToConfigurationEntry(msg)
+			if err != nil {
+				n.logger.Error(err, "[NatsManager.WatchProcessConfiguration] Invalid configuration entry")
+				continue
+			}
+
+			select {
+			case ch <- entry:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func (n *Client) mapConfigurationStoreToDTO(store entity.ConfigurationStore) *natspb.KeyValueStoreRef {
+	return &natspb.KeyValueStoreRef{
+		ProductId:  store.ProductID,
+		VersionTag: store.VersionTag,
+		Workflow:   store.Workflow,
+		Process:    store.Process,
+		Scope:      mapConfigurationScopeToDTO(store.Scope),
+	}
+}
+
+func (n *Client) mapDTOToConfigurationEntry(entryDTO *natspb.ConfigurationEntry) (*entity.ConfigurationEntry, error) {
+	revision, err := n.mapDTOToConfigurationRevision(entryDTO.Entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ConfigurationEntry{
+		Key:      revision.Key,
+		Value:    revision.Value,
+		Scope:    mapDTOToConfigurationScope(entryDTO.Scope),
+		Revision: revision.Revision,
+		Created:  revision.Created,
+	}, nil
+}
+
+func (n *Client) mapDTOToConfigurationRevision(entryDTO *natspb.KeyValueEntry) (*entity.ConfigurationRevision, error) {
+	created, err := time.Parse(time.RFC3339, entryDTO.GetCreated())
+	if err != nil {
+		return nil, fmt.Errorf("parsing configuration key %q revision %d date: %w",
+			entryDTO.GetKey(), entryDTO.GetRevision(), err)
+	}
+
+	return &entity.ConfigurationRevision{
+		Key:      entryDTO.GetKey(),
+		Value:    entryDTO.GetValue(),
+		Revision: entryDTO.GetRevision(),
+		Deleted:  entryDTO.GetDeleted(),
+		Created:  created,
+	}, nil
+}
+
+func mapConfigurationScopeToDTO(scope entity.ConfigurationScope) natspb.KeyValueStoreScope {
+	switch scope {
+	case entity.ConfigurationScopeGlobal:
+		return natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL
+	case entity.ConfigurationScopeVersion:
+		return natspb.KeyValueStoreScope_KV_SCOPE_VERSION
+	case entity.ConfigurationScopeWorkflow:
+		return natspb.KeyValueStoreScope_KV_SCOPE_WORKFLOW
+	case entity.ConfigurationScopeProcess:
+		return natspb.KeyValueStoreScope_KV_SCOPE_PROCESS
+	default:
+		return natspb.KeyValueStoreScope_KV_SCOPE_UNDEFINED
+	}
+}
+
+func mapDTOToConfigurationScope(scope natspb.KeyValueStoreScope) entity.ConfigurationScope {
+	switch scope {
+	case natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL:
+		return entity.ConfigurationScopeGlobal
+	case natspb.KeyValueStoreScope_KV_SCOPE_VERSION:
+		return entity.ConfigurationScopeVersion
+	case natspb.KeyValueStoreScope_KV_SCOPE_WORKFLOW:
+		return entity.ConfigurationScopeWorkflow
+	case natspb.KeyValueStoreScope_KV_SCOPE_PROCESS:
+		return entity.ConfigurationScopeProcess
+	case natspb.KeyValueStoreScope_KV_SCOPE_UNDEFINED:
+		return ""
+	default:
+		return ""
+	}
+}
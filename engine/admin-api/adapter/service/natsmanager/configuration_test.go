@@ -0,0 +1,277 @@
+//go:build unit
+
+package natsmanager_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/mocks"
+)
+
+func (s *NatsManagerTestSuite) TestGetProcessConfiguration() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.GetProcessConfigurationRequest{
+			ProductId:  productID,
+			VersionTag: testVersion.Tag,
+			Workflow:   testWorkflow.Name,
+			Process:    testProcess.Name,
+		}
+	)
+
+	s.mockService.EXPECT().GetProcessConfiguration(ctx, clientReq).
+		Return(&natspb.GetProcessConfigurationResponse{
+			Configuration: []*natspb.ConfigurationEntry{
+				{
+					Scope: natspb.KeyValueStoreScope_KV_SCOPE_WORKFLOW,
+					Entry: &natspb.KeyValueEntry{Key: "key1", Value: "value1", Revision: 3, Created: "2024-01-01T10:00:00Z"},
+				},
+			},
+		}, nil)
+
+	actual, err := s.natsManagerClient.GetProcessConfiguration(
+		ctx, productID, testVersion.Tag, testWorkflow.Name, testProcess.Name,
+	)
+	s.Require().NoError(err)
+	s.Equal([]*entity.ConfigurationEntry{
+		{
+			Key:      "key1",
+			Value:    "value1",
+			Scope:    entity.ConfigurationScopeWorkflow,
+			Revision: 3,
+			Created:  time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}, actual)
+}
+
+func (s *NatsManagerTestSuite) TestGetProcessConfiguration_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().GetProcessConfiguration(ctx, gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.natsManagerClient.GetProcessConfiguration(
+		ctx, productID, testVersion.Tag, testWorkflow.Name, testProcess.Name,
+	)
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestGetConfigurationHistory() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.GetConfigurationHistoryRequest{
+			Store: &natspb.KeyValueStoreRef{
+				ProductId:  productID,
+				VersionTag: testVersion.Tag,
+				Scope:      natspb.KeyValueStoreScope_KV_SCOPE_VERSION,
+			},
+			Key: "key1",
+		}
+	)
+
+	s.mockService.EXPECT().GetConfigurationHistory(ctx, clientReq).
+		Return(&natspb.GetConfigurationHistoryResponse{
+			Revisions: []*natspb.KeyValueEntry{
+				{Key: "key1", Value: "value1", Revision: 1, Created: "2024-01-01T10:00:00Z"},
+				{Key: "key1", Revision: 2, Deleted: true, Created: "2024-01-01T11:00:00Z"},
+			},
+		}, nil)
+
+	actual, err := s.natsManagerClient.GetConfigurationHistory(ctx, entity.ConfigurationStore{
+		ProductID:  productID,
+		VersionTag: testVersion.Tag,
+		Scope:      entity.ConfigurationScopeVersion,
+	}, "key1")
+	s.Require().NoError(err)
+	s.Equal([]*entity.ConfigurationRevision{
+		{Key: "key1", Value: "value1", Revision: 1, Created: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{Key: "key1", Revision: 2, Deleted: true, Created: time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)},
+	}, actual)
+}
+
+func (s *NatsManagerTestSuite) TestRollbackConfiguration() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.RollbackConfigurationRequest{
+			Store: &natspb.KeyValueStoreRef{
+				ProductId: productID,
+				Scope:     natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL,
+			},
+			Key:      "key1",
+			Revision: 1,
+		}
+	)
+
+	s.mockService.EXPECT().RollbackConfiguration(ctx, clientReq).
+		Return(&natspb.RollbackConfigurationResponse{
+			Entry: &natspb.KeyValueEntry{Key: "key1", Value: "value1", Revision: 4, Created: "2024-01-01T10:00:00Z"},
+		}, nil)
+
+	actual, err := s.natsManagerClient.RollbackConfiguration(ctx, entity.ConfigurationStore{
+		ProductID: productID,
+		Scope:     entity.ConfigurationScopeGlobal,
+	}, "key1", 1)
+	s.Require().NoError(err)
+	s.Equal(&entity.ConfigurationRevision{
+		Key:      "key1",
+		Value:    "value1",
+		Revision: 4,
+		Created:  time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+	}, actual)
+}
+
+func (s *NatsManagerTestSuite) TestRollbackConfiguration_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().RollbackConfiguration(ctx, gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.natsManagerClient.RollbackConfiguration(ctx, entity.ConfigurationStore{
+		ProductID: productID,
+		Scope:     entity.ConfigurationScopeGlobal,
+	}, "key1", 1)
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestGetConfiguration() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.GetConfigurationRequest{
+			Store: &natspb.KeyValueStoreRef{
+				ProductId: productID,
+				Scope:     natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL,
+			},
+		}
+	)
+
+	s.mockService.EXPECT().GetConfiguration(ctx, clientReq).
+		Return(&natspb.GetConfigurationResponse{
+			Entries: []*natspb.KeyValueEntry{
+				{Key: "key1", Value: "value1", Revision: 2, Created: "2024-01-01T10:00:00Z"},
+			},
+		}, nil)
+
+	actual, err := s.natsManagerClient.GetConfiguration(ctx, entity.ConfigurationStore{
+		ProductID: productID,
+		Scope:     entity.ConfigurationScopeGlobal,
+	})
+	s.Require().NoError(err)
+	s.Equal([]*entity.ConfigurationEntry{
+		{
+			Key:      "key1",
+			Value:    "value1",
+			Scope:    entity.ConfigurationScopeGlobal,
+			Revision: 2,
+			Created:  time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}, actual)
+}
+
+func (s *NatsManagerTestSuite) TestSetConfiguration() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.SetConfigurationRequest{
+			Store: &natspb.KeyValueStoreRef{
+				ProductId: productID,
+				Scope:     natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL,
+			},
+			Key:   "key1",
+			Value: "value1",
+		}
+	)
+
+	s.mockService.EXPECT().SetConfiguration(ctx, clientReq).
+		Return(&natspb.SetConfigurationResponse{
+			Entry: &natspb.KeyValueEntry{Key: "key1", Value: "value1", Revision: 3, Created: "2024-01-01T10:00:00Z"},
+		}, nil)
+
+	actual, err := s.natsManagerClient.SetConfiguration(ctx, entity.ConfigurationStore{
+		ProductID: productID,
+		Scope:     entity.ConfigurationScopeGlobal,
+	}, "key1", "value1")
+	s.Require().NoError(err)
+	s.Equal(&entity.ConfigurationEntry{
+		Key:      "key1",
+		Value:    "value1",
+		Scope:    entity.ConfigurationScopeGlobal,
+		Revision: 3,
+		Created:  time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+	}, actual)
+}
+
+func (s *NatsManagerTestSuite) TestDeleteConfiguration() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.DeleteConfigurationRequest{
+			Store: &natspb.KeyValueStoreRef{
+				ProductId: productID,
+				Scope:     natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL,
+			},
+			Key: "key1",
+		}
+	)
+
+	s.mockService.EXPECT().DeleteConfiguration(ctx, clientReq).Return(&natspb.DeleteResponse{}, nil)
+
+	err := s.natsManagerClient.DeleteConfiguration(ctx, entity.ConfigurationStore{
+		ProductID: productID,
+		Scope:     entity.ConfigurationScopeGlobal,
+	}, "key1")
+	s.Require().NoError(err)
+}
+
+func (s *NatsManagerTestSuite) TestDeleteConfiguration_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().DeleteConfiguration(ctx, gomock.Any()).Return(nil, expectedError)
+
+	err := s.natsManagerClient.DeleteConfiguration(ctx, entity.ConfigurationStore{
+		ProductID: productID,
+		Scope:     entity.ConfigurationScopeGlobal,
+	}, "key1")
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestWatchProcessConfiguration() {
+	ctx := context.Background()
+	stream := mocks.NewMockNatsManagerService_WatchProcessConfigurationClient(gomock.NewController(s.T()))
+
+	s.mockService.EXPECT().WatchProcessConfiguration(ctx, &natspb.WatchProcessConfigurationRequest{
+		ProductId:  productID,
+		VersionTag: testVersion.Tag,
+		Workflow:   testWorkflow.Name,
+		Process:    testProcess.Name,
+	}).Return(stream, nil)
+
+	stream.EXPECT().Context().Return(ctx).AnyTimes()
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&natspb.ConfigurationEntry{
+			Scope: natspb.KeyValueStoreScope_KV_SCOPE_PROCESS,
+			Entry: &natspb.KeyValueEntry{Key: "key1", Value: "value2", Revision: 5, Created: "2024-01-01T10:00:00Z"},
+		}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+
+	updates, err := s.natsManagerClient.WatchProcessConfiguration(
+		ctx, productID, testVersion.Tag, testWorkflow.Name, testProcess.Name,
+	)
+	s.Require().NoError(err)
+
+	s.Equal(&entity.ConfigurationEntry{
+		Key:      "key1",
+		Value:    "value2",
+		Scope:    entity.ConfigurationScopeProcess,
+		Revision: 5,
+		Created:  time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+	}, <-updates)
+
+	_, open := <-updates
+	s.False(open)
+}
@@ -0,0 +1,41 @@
+package natsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// CreateVersionCredentials calls nats-manager to issue the NATS credentials of the version processes.
+// Credentials are empty when NATS authentication is disabled.
+func (n *Client) CreateVersionCredentials(ctx context.Context, productID string, version *entity.Version) (string, error) {
+	req := natspb.CreateVersionCredentialsRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+		Workflows:  n.mapWorkflowsToDTO(version.Workflows),
+	}
+
+	res, err := n.client.CreateVersionCredentials(ctx, &req)
+	if err != nil {
+		return "", fmt.Errorf("error creating version %q NATS credentials: %w", version.Tag, err)
+	}
+
+	return res.Credentials, nil
+}
+
+// RevokeVersionCredentials calls nats-manager to revoke the NATS credentials of the version processes.
+func (n *Client) RevokeVersionCredentials(ctx context.Context, productID, versionTag string) error {
+	req := natspb.RevokeVersionCredentialsRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+	}
+
+	_, err := n.client.RevokeVersionCredentials(ctx, &req)
+	if err != nil {
+		return fmt.Errorf("error revoking version %q NATS credentials: %w", versionTag, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+//go:build unit
+
+package natsmanager_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+)
+
+func (s *NatsManagerTestSuite) TestCreateVersionCredentials() {
+	ctx := context.Background()
+
+	req := &natspb.CreateVersionCredentialsRequest{
+		ProductId:  productID,
+		VersionTag: testVersion.Tag,
+		Workflows:  testReqWorkflows,
+	}
+
+	s.mockService.EXPECT().CreateVersionCredentials(ctx, req).
+		Return(&natspb.CreateVersionCredentialsResponse{Credentials: "test-credentials"}, nil)
+
+	credentials, err := s.natsManagerClient.CreateVersionCredentials(ctx, productID, testVersion)
+	s.Require().NoError(err)
+	s.Equal("test-credentials", credentials)
+}
+
+func (s *NatsManagerTestSuite) TestCreateVersionCredentials_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().CreateVersionCredentials(ctx, gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.natsManagerClient.CreateVersionCredentials(ctx, productID, testVersion)
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestRevokeVersionCredentials() {
+	ctx := context.Background()
+
+	req := &natspb.RevokeVersionCredentialsRequest{
+		ProductId:  productID,
+		VersionTag: testVersion.Tag,
+	}
+
+	s.mockService.EXPECT().RevokeVersionCredentials(ctx, req).Return(&natspb.DeleteResponse{}, nil)
+
+	err := s.natsManagerClient.RevokeVersionCredentials(ctx, productID, testVersion.Tag)
+	s.Require().NoError(err)
+}
+
+func (s *NatsManagerTestSuite) TestRevokeVersionCredentials_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().RevokeVersionCredentials(ctx, gomock.Any()).Return(nil, expectedError)
+
+	err := s.natsManagerClient.RevokeVersionCredentials(ctx, productID, testVersion.Tag)
+	s.ErrorIs(err, expectedError)
+}
@@ -0,0 +1,88 @@
+package natsmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// GetDeadLetterMessages calls nats-manager to get the messages the workflow processes could not handle.
+func (n *Client) GetDeadLetterMessages(
+	ctx context.Context,
+	productID, versionTag, workflow string,
+) ([]*entity.DeadLetterMessage, error) {
+	res, err := n.client.GetDeadLetterMessages(ctx, &natspb.GetDeadLetterMessagesRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   workflow,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting workflow %q dead-letter messages: %w", workflow, err)
+	}
+
+	messages := make([]*entity.DeadLetterMessage, 0, len(res.Messages))
+
+	for _, messageDTO := range res.Messages {
+		timestamp, err := time.Parse(time.RFC3339, messageDTO.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("parsing dead-letter message %d timestamp: %w", messageDTO.Sequence, err)
+		}
+
+		messages = append(messages, &entity.DeadLetterMessage{
+			Sequence:       messageDTO.Sequence,
+			Stream:         messageDTO.Stream,
+			StreamSequence: messageDTO.StreamSequence,
+			Consumer:       messageDTO.Consumer,
+			Deliveries:     messageDTO.Deliveries,
+			Subject:        messageDTO.Subject,
+			Headers:        messageDTO.Headers,
+			Data:           messageDTO.Data,
+			Timestamp:      timestamp,
+		})
+	}
+
+	return messages, nil
+}
+
+// ReplayDeadLetterMessages calls nats-manager to publish the given dead-letter messages again to their original
+// subject, or all of them when no sequence is given. It returns the number of replayed messages.
+func (n *Client) ReplayDeadLetterMessages(
+	ctx context.Context,
+	productID, versionTag, workflow string,
+	sequences []uint64,
+) (int, error) {
+	res, err := n.client.ReplayDeadLetterMessages(ctx, &natspb.ReplayDeadLetterMessagesRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   workflow,
+		Sequences:  sequences,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error replaying workflow %q dead-letter messages: %w", workflow, err)
+	}
+
+	return int(res.Replayed), nil
+}
+
+// PurgeDeadLetterMessages calls nats-manager to remove the given dead-letter messages, or all of them when no
+// sequence is given. It returns the number of removed messages.
+func (n *Client) PurgeDeadLetterMessages(
+	ctx context.Context,
+	productID, versionTag, workflow string,
+	sequences []uint64,
+) (int, error) {
+	res, err := n.client.PurgeDeadLetterMessages(ctx, &natspb.PurgeDeadLetterMessagesRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   workflow,
+		Sequences:  sequences,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error purging workflow %q dead-letter messages: %w", workflow, err)
+	}
+
+	return int(res.Purged), nil
+}
@@ -0,0 +1,104 @@
+//go:build unit
+
+package natsmanager_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+func (s *NatsManagerTestSuite) TestGetDeadLetterMessages() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.GetDeadLetterMessagesRequest{
+			ProductId:  productID,
+			VersionTag: testVersion.Tag,
+			Workflow:   testWorkflow.Name,
+		}
+	)
+
+	s.mockService.EXPECT().GetDeadLetterMessages(ctx, clientReq).
+		Return(&natspb.GetDeadLetterMessagesResponse{
+			Messages: []*natspb.DeadLetterMessage{
+				{
+					Sequence:       1,
+					Stream:         "test-stream",
+					StreamSequence: 10,
+					Consumer:       "test-consumer",
+					Deliveries:     3,
+					Subject:        "test-stream.test-process",
+					Headers:        map[string]string{"trace-id": "test-trace"},
+					Data:           []byte("test-data"),
+					Timestamp:      "2024-01-01T10:00:00Z",
+				},
+			},
+		}, nil)
+
+	actual, err := s.natsManagerClient.GetDeadLetterMessages(ctx, productID, testVersion.Tag, testWorkflow.Name)
+	s.Require().NoError(err)
+	s.Equal([]*entity.DeadLetterMessage{
+		{
+			Sequence:       1,
+			Stream:         "test-stream",
+			StreamSequence: 10,
+			Consumer:       "test-consumer",
+			Deliveries:     3,
+			Subject:        "test-stream.test-process",
+			Headers:        map[string]string{"trace-id": "test-trace"},
+			Data:           []byte("test-data"),
+			Timestamp:      time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}, actual)
+}
+
+func (s *NatsManagerTestSuite) TestGetDeadLetterMessages_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().GetDeadLetterMessages(ctx, gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.natsManagerClient.GetDeadLetterMessages(ctx, productID, testVersion.Tag, testWorkflow.Name)
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestReplayDeadLetterMessages() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.ReplayDeadLetterMessagesRequest{
+			ProductId:  productID,
+			VersionTag: testVersion.Tag,
+			Workflow:   testWorkflow.Name,
+			Sequences:  []uint64{1, 2},
+		}
+	)
+
+	s.mockService.EXPECT().ReplayDeadLetterMessages(ctx, clientReq).
+		Return(&natspb.ReplayDeadLetterMessagesResponse{Replayed: 2}, nil)
+
+	replayed, err := s.natsManagerClient.ReplayDeadLetterMessages(ctx, productID, testVersion.Tag, testWorkflow.Name, []uint64{1, 2})
+	s.Require().NoError(err)
+	s.Equal(2, replayed)
+}
+
+func (s *NatsManagerTestSuite) TestPurgeDeadLetterMessages() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.PurgeDeadLetterMessagesRequest{
+			ProductId:  productID,
+			VersionTag: testVersion.Tag,
+			Workflow:   testWorkflow.Name,
+		}
+	)
+
+	s.mockService.EXPECT().PurgeDeadLetterMessages(ctx, clientReq).
+		Return(&natspb.PurgeDeadLetterMessagesResponse{Purged: 5}, nil)
+
+	purged, err := s.natsManagerClient.PurgeDeadLetterMessages(ctx, productID, testVersion.Tag, testWorkflow.Name, nil)
+	s.Require().NoError(err)
+	s.Equal(5, purged)
+}
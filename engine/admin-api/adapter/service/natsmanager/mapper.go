@@ -15,12 +15,14 @@ func (n *Client) mapWorkflowsToDTO(workflows []entity.Workflow) []*natspb.Workfl
 			processToAppend := natspb.Process{
 				Name:          process.Name,
 				Subscriptions: process.Subscriptions,
+				KeyValueStore: mapKeyValueStoreSettingsToDTO(process.KeyValueStore),
 			}
 
 			if process.ObjectStore != nil {
 				processToAppend.ObjectStore = &natspb.ObjectStore{
-					Name:  process.ObjectStore.Name,
-					Scope: mapObjectStoreScopeToDTO(process.ObjectStore.Scope),
+					Name:     process.ObjectStore.Name,
+					Scope:    mapObjectStoreScopeToDTO(process.ObjectStore.Scope),
+					Settings: mapObjectStoreSettingsToDTO(process.ObjectStore.Settings),
 				}
 			}
 
@@ -28,8 +30,10 @@ func (n *Client) mapWorkflowsToDTO(workflows []entity.Workflow) []*natspb.Workfl
 		}
 
 		workflowsDTO = append(workflowsDTO, &natspb.Workflow{
-			Name:      w.Name,
-			Processes: processes,
+			Name:          w.Name,
+			Processes:     processes,
+			Stream:        mapStreamSettingsToDTO(w.StreamSettings),
+			KeyValueStore: mapKeyValueStoreSettingsToDTO(w.KeyValueStore),
 		})
 	}
 
@@ -104,13 +108,78 @@ func (n *Client) mapDTOToVersionKeyValueStoreConfig(
 }
 
 func mapObjectStoreScopeToDTO(scope entity.ObjectStoreScope) natspb.ObjectStoreScope {
-	//nolint:exhaustive // wrong lint rule
 	switch scope {
-	case "project":
-		return natspb.ObjectStoreScope_SCOPE_PROJECT
-	case "workflow":
+	case entity.ObjectStoreScopeProduct:
+		return natspb.ObjectStoreScope_SCOPE_PRODUCT
+	case entity.ObjectStoreScopeWorkflow:
 		return natspb.ObjectStoreScope_SCOPE_WORKFLOW
 	default:
 		return natspb.ObjectStoreScope_SCOPE_UNDEFINED
 	}
 }
+
+func mapStreamSettingsToDTO(settings *entity.WorkflowStreamSettings) *natspb.StreamSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &natspb.StreamSettings{
+		Retention:     mapStreamRetentionToDTO(settings.Retention),
+		MaxAgeSeconds: settings.MaxAgeSeconds,
+		MaxBytes:      settings.MaxBytes,
+		MaxMsgSize:    settings.MaxMsgSize,
+		Replicas:      settings.Replicas,
+		Storage:       mapStreamStorageToDTO(settings.Storage),
+	}
+}
+
+func mapStreamRetentionToDTO(retention entity.StreamRetention) natspb.StreamRetention {
+	switch retention {
+	case entity.StreamRetentionInterest:
+		return natspb.StreamRetention_RETENTION_INTEREST
+	case entity.StreamRetentionLimits:
+		return natspb.StreamRetention_RETENTION_LIMITS
+	case entity.StreamRetentionWorkQueue:
+		return natspb.StreamRetention_RETENTION_WORKQUEUE
+	default:
+		return natspb.StreamRetention_RETENTION_UNDEFINED
+	}
+}
+
+func mapStreamStorageToDTO(storage entity.StreamStorage) natspb.StreamStorage {
+	switch storage {
+	case entity.StreamStorageFile:
+		return natspb.StreamStorage_STORAGE_FILE
+	case entity.StreamStorageMemory:
+		return natspb.StreamStorage_STORAGE_MEMORY
+	default:
+		return natspb.StreamStorage_STORAGE_UNDEFINED
+	}
+}
+
+func mapObjectStoreSettingsToDTO(settings *entity.ObjectStoreSettings) *natspb.ObjectStoreSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &natspb.ObjectStoreSettings{
+		TtlSeconds: settings.TTLSeconds,
+		MaxBytes:   settings.MaxBytes,
+		Replicas:   settings.Replicas,
+		Storage:    mapStreamStorageToDTO(settings.Storage),
+	}
+}
+
+func mapKeyValueStoreSettingsToDTO(settings *entity.KeyValueStoreSettings) *natspb.KeyValueStoreSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &natspb.KeyValueStoreSettings{
+		TtlSeconds: settings.TTLSeconds,
+		MaxBytes:   settings.MaxBytes,
+		History:    settings.History,
+		Replicas:   settings.Replicas,
+		Storage:    mapStreamStorageToDTO(settings.Storage),
+	}
+}
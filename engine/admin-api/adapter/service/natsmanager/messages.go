@@ -0,0 +1,68 @@
+package natsmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// GetProcessMessages calls nats-manager to get the last messages published by a process.
+func (n *Client) GetProcessMessages(
+	ctx context.Context,
+	productID, versionTag, workflow, process string,
+	count int,
+) ([]*entity.StreamMessage, error) {
+	res, err := n.client.GetProcessMessages(ctx, &natspb.GetProcessMessagesRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   workflow,
+		Process:    process,
+		Count:      int32(count),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting process %q messages: %w", process, err)
+	}
+
+	messages := make([]*entity.StreamMessage, 0, len(res.Messages))
+
+	for _, messageDTO := range res.Messages {
+		timestamp, err := time.Parse(time.RFC3339, messageDTO.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("parsing message %d timestamp: %w", messageDTO.Sequence, err)
+		}
+
+		messages = append(messages, &entity.StreamMessage{
+			Sequence:  messageDTO.Sequence,
+			Subject:   messageDTO.Subject,
+			Headers:   messageDTO.Headers,
+			Data:      messageDTO.Data,
+			Timestamp: timestamp,
+		})
+	}
+
+	return messages, nil
+}
+
+// PublishMessage calls nats-manager to publish a message to a subject of the version streams.
+func (n *Client) PublishMessage(
+	ctx context.Context,
+	productID, versionTag, subject string,
+	headers map[string]string,
+	data []byte,
+) error {
+	_, err := n.client.PublishMessage(ctx, &natspb.PublishMessageRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Subject:    subject,
+		Headers:    headers,
+		Data:       data,
+	})
+	if err != nil {
+		return fmt.Errorf("error publishing message to subject %q: %w", subject, err)
+	}
+
+	return nil
+}
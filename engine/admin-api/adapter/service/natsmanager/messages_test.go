@@ -0,0 +1,79 @@
+//go:build unit
+
+package natsmanager_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+func (s *NatsManagerTestSuite) TestGetProcessMessages() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.GetProcessMessagesRequest{
+			ProductId:  productID,
+			VersionTag: testVersion.Tag,
+			Workflow:   testWorkflow.Name,
+			Process:    testProcess.Name,
+			Count:      5,
+		}
+	)
+
+	s.mockService.EXPECT().GetProcessMessages(ctx, clientReq).
+		Return(&natspb.GetProcessMessagesResponse{
+			Messages: []*natspb.StreamMessage{
+				{
+					Sequence:  3,
+					Subject:   "test-stream.test-process",
+					Headers:   map[string]string{"trace-id": "test-trace"},
+					Data:      []byte("test-data"),
+					Timestamp: "2024-01-01T10:00:00Z",
+				},
+			},
+		}, nil)
+
+	actual, err := s.natsManagerClient.GetProcessMessages(ctx, productID, testVersion.Tag, testWorkflow.Name, testProcess.Name, 5)
+	s.Require().NoError(err)
+	s.Equal([]*entity.StreamMessage{
+		{
+			Sequence:  3,
+			Subject:   "test-stream.test-process",
+			Headers:   map[string]string{"trace-id": "test-trace"},
+			Data:      []byte("test-data"),
+			Timestamp: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}, actual)
+}
+
+func (s *NatsManagerTestSuite) TestGetProcessMessages_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().GetProcessMessages(ctx, gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.natsManagerClient.GetProcessMessages(ctx, productID, testVersion.Tag, testWorkflow.Name, testProcess.Name, 5)
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestPublishMessage() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.PublishMessageRequest{
+			ProductId:  productID,
+			VersionTag: testVersion.Tag,
+			Subject:    "test-stream.test-process",
+			Headers:    map[string]string{"test-header": "test-value"},
+			Data:       []byte("test-data"),
+		}
+	)
+
+	s.mockService.EXPECT().PublishMessage(ctx, clientReq).Return(&natspb.PublishMessageResponse{}, nil)
+
+	err := s.natsManagerClient.PublishMessage(ctx, productID, testVersion.Tag, clientReq.Subject, clientReq.Headers, clientReq.Data)
+	s.Require().NoError(err)
+}
@@ -70,9 +70,10 @@ func (n *Client) CreateVersionKeyValueStores(
 	version *entity.Version,
 ) (*entity.KeyValueStores, error) {
 	req := natspb.CreateVersionKeyValueStoresRequest{
-		ProductId:  productID,
-		VersionTag: version.Tag,
-		Workflows:  n.mapWorkflowsToDTO(version.Workflows),
+		ProductId:     productID,
+		VersionTag:    version.Tag,
+		Workflows:     n.mapWorkflowsToDTO(version.Workflows),
+		KeyValueStore: mapKeyValueStoreSettingsToDTO(version.KeyValueStore),
 	}
 
 	res, err := n.client.CreateVersionKeyValueStores(ctx, &req)
@@ -83,6 +84,31 @@ func (n *Client) CreateVersionKeyValueStores(
 	return n.mapDTOToVersionKeyValueStoreConfig(res.KeyValueStore, res.Workflows), err
 }
 
+// GetVersionResources calls nats-manager to get the names of the NATS resources of the given version, without
+// creating them.
+func (n *Client) GetVersionResources(
+	ctx context.Context,
+	productID string,
+	version *entity.Version,
+) (*entity.VersionStreamingResources, error) {
+	req := natspb.GetVersionResourcesRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+		Workflows:  n.mapWorkflowsToDTO(version.Workflows),
+	}
+
+	res, err := n.client.GetVersionResources(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting version resources: %w", err)
+	}
+
+	return &entity.VersionStreamingResources{
+		Streams:        n.mapDTOToVersionStreamConfig(res.Streams),
+		ObjectStores:   n.mapDTOToVersionObjectStoreConfig(res.ObjectStores),
+		KeyValueStores: n.mapDTOToVersionKeyValueStoreConfig(res.KeyValueStore, res.KeyValueStores),
+	}, nil
+}
+
 // DeleteStreams calls nats-manager to delete NATS streams for given version.
 func (n *Client) DeleteStreams(ctx context.Context, productID, versionTag string) error {
 	req := natspb.DeleteStreamsRequest{
@@ -128,6 +128,50 @@ func (s *NatsManagerTestSuite) TestCreateStreams() {
 	s.Equal(expctedResponse, res)
 }
 
+func (s *NatsManagerTestSuite) TestCreateStreams_WithStreamSettings() {
+	ctx := context.Background()
+
+	workflow := testhelpers.NewWorkflowBuilder().
+		WithProcesses([]entity.Process{testProcess}).
+		WithStreamSettings(&entity.WorkflowStreamSettings{
+			Retention:     entity.StreamRetentionLimits,
+			MaxAgeSeconds: 3600,
+			MaxBytes:      1024,
+			MaxMsgSize:    512,
+			Replicas:      3,
+			Storage:       entity.StreamStorageMemory,
+		}).
+		Build()
+
+	version := testhelpers.NewVersionBuilder().
+		WithWorkflows([]entity.Workflow{workflow}).
+		Build()
+
+	req := &natspb.CreateStreamsRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+		Workflows: []*natspb.Workflow{
+			{
+				Name:      testReqWorkflows[0].Name,
+				Processes: testReqWorkflows[0].Processes,
+				Stream: &natspb.StreamSettings{
+					Retention:     natspb.StreamRetention_RETENTION_LIMITS,
+					MaxAgeSeconds: 3600,
+					MaxBytes:      1024,
+					MaxMsgSize:    512,
+					Replicas:      3,
+					Storage:       natspb.StreamStorage_STORAGE_MEMORY,
+				},
+			},
+		},
+	}
+
+	s.mockService.EXPECT().CreateStreams(ctx, req).Return(&natspb.CreateStreamsResponse{}, nil)
+
+	_, err := s.natsManagerClient.CreateStreams(ctx, productID, version)
+	s.Require().NoError(err)
+}
+
 func (s *NatsManagerTestSuite) TestCreateObjectStores() {
 	ctx := context.Background()
 
@@ -164,6 +208,58 @@ func (s *NatsManagerTestSuite) TestCreateObjectStores() {
 	s.Equal(expectedResponse, res)
 }
 
+func (s *NatsManagerTestSuite) TestCreateObjectStores_WithStoreSettings() {
+	ctx := context.Background()
+
+	process := testhelpers.NewProcessBuilder().
+		WithObjectStore(&entity.ProcessObjectStore{
+			Name:  "test-object-store",
+			Scope: entity.ObjectStoreScopeWorkflow,
+			Settings: &entity.ObjectStoreSettings{
+				TTLSeconds: 86400,
+				MaxBytes:   1024,
+				Storage:    entity.StreamStorageFile,
+			},
+		}).
+		Build()
+
+	version := testhelpers.NewVersionBuilder().
+		WithWorkflows([]entity.Workflow{
+			testhelpers.NewWorkflowBuilder().WithProcesses([]entity.Process{process}).Build(),
+		}).
+		Build()
+
+	req := &natspb.CreateObjectStoresRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+		Workflows: []*natspb.Workflow{
+			{
+				Name: testWorkflow.Name,
+				Processes: []*natspb.Process{
+					{
+						Name: process.Name,
+						ObjectStore: &natspb.ObjectStore{
+							Name:  "test-object-store",
+							Scope: natspb.ObjectStoreScope_SCOPE_WORKFLOW,
+							Settings: &natspb.ObjectStoreSettings{
+								TtlSeconds: 86400,
+								MaxBytes:   1024,
+								Storage:    natspb.StreamStorage_STORAGE_FILE,
+							},
+						},
+						Subscriptions: process.Subscriptions,
+					},
+				},
+			},
+		},
+	}
+
+	s.mockService.EXPECT().CreateObjectStores(ctx, req).Return(&natspb.CreateObjectStoresResponse{}, nil)
+
+	_, err := s.natsManagerClient.CreateObjectStores(ctx, productID, version)
+	s.Require().NoError(err)
+}
+
 func (s *NatsManagerTestSuite) TestCreateKeyValueStores() {
 	ctx := context.Background()
 
@@ -204,6 +300,63 @@ func (s *NatsManagerTestSuite) TestCreateKeyValueStores() {
 	s.Equal(expectedResponse, res)
 }
 
+func (s *NatsManagerTestSuite) TestCreateKeyValueStores_WithStoreSettings() {
+	ctx := context.Background()
+
+	process := testhelpers.NewProcessBuilder().
+		WithKeyValueStore(&entity.KeyValueStoreSettings{
+			History:  10,
+			Replicas: 3,
+		}).
+		Build()
+
+	workflow := testhelpers.NewWorkflowBuilder().
+		WithProcesses([]entity.Process{process}).
+		WithKeyValueStore(&entity.KeyValueStoreSettings{
+			TTLSeconds: 3600,
+			MaxBytes:   1024,
+			Storage:    entity.StreamStorageMemory,
+		}).
+		Build()
+
+	version := testhelpers.NewVersionBuilder().
+		WithWorkflows([]entity.Workflow{workflow}).
+		Build()
+	version.KeyValueStore = &entity.KeyValueStoreSettings{History: 5}
+
+	req := &natspb.CreateVersionKeyValueStoresRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+		Workflows: []*natspb.Workflow{
+			{
+				Name: workflow.Name,
+				Processes: []*natspb.Process{
+					{
+						Name:          process.Name,
+						Subscriptions: process.Subscriptions,
+						KeyValueStore: &natspb.KeyValueStoreSettings{
+							History:  10,
+							Replicas: 3,
+						},
+					},
+				},
+				KeyValueStore: &natspb.KeyValueStoreSettings{
+					TtlSeconds: 3600,
+					MaxBytes:   1024,
+					Storage:    natspb.StreamStorage_STORAGE_MEMORY,
+				},
+			},
+		},
+		KeyValueStore: &natspb.KeyValueStoreSettings{History: 5},
+	}
+
+	s.mockService.EXPECT().CreateVersionKeyValueStores(ctx, req).
+		Return(&natspb.CreateVersionKeyValueStoresResponse{}, nil)
+
+	_, err := s.natsManagerClient.CreateVersionKeyValueStores(ctx, productID, version)
+	s.Require().NoError(err)
+}
+
 func (s *NatsManagerTestSuite) TestDeleteStreams() {
 	ctx := context.Background()
 
@@ -232,6 +385,83 @@ func (s *NatsManagerTestSuite) TestDeleteObjectStores() {
 	s.Require().NoError(err)
 }
 
+func (s *NatsManagerTestSuite) TestGetVersionResources() {
+	ctx := context.Background()
+
+	req := &natspb.GetVersionResourcesRequest{
+		ProductId:  productID,
+		VersionTag: testVersion.Tag,
+		Workflows:  testReqWorkflows,
+	}
+
+	natsManagerResponse := &natspb.GetVersionResourcesResponse{
+		Streams: map[string]*natspb.WorkflowStreamConfig{
+			testWorkflow.Name: {
+				Stream: "test-stream",
+				Processes: map[string]*natspb.ProcessStreamConfig{
+					testProcess.Name: {Subject: "test-stream.test-process"},
+				},
+			},
+		},
+		ObjectStores: map[string]*natspb.WorkflowObjectStoreConfig{
+			testWorkflow.Name: {
+				Processes: map[string]string{testProcess.Name: "test-object-store-name"},
+			},
+		},
+		KeyValueStore: "test-version-kv-store",
+		KeyValueStores: map[string]*natspb.WorkflowKeyValueStoreConfig{
+			testWorkflow.Name: {
+				KeyValueStore: "test-workflow-kv-store",
+				Processes:     map[string]string{testProcess.Name: "test-process-kv-store"},
+			},
+		},
+	}
+
+	expectedResponse := &entity.VersionStreamingResources{
+		Streams: &entity.VersionStreams{
+			Workflows: map[string]entity.WorkflowStreamResources{
+				testWorkflow.Name: {
+					Stream: "test-stream",
+					Processes: map[string]entity.ProcessStreamConfig{
+						testProcess.Name: {Subject: "test-stream.test-process"},
+					},
+				},
+			},
+		},
+		ObjectStores: &entity.VersionObjectStores{
+			Workflows: map[string]entity.WorkflowObjectStoresConfig{
+				testWorkflow.Name: {
+					Processes: map[string]string{testProcess.Name: "test-object-store-name"},
+				},
+			},
+		},
+		KeyValueStores: &entity.KeyValueStores{
+			VersionKeyValueStore: "test-version-kv-store",
+			Workflows: map[string]*entity.WorkflowKeyValueStores{
+				testWorkflow.Name: {
+					KeyValueStore: "test-workflow-kv-store",
+					Processes:     map[string]string{testProcess.Name: "test-process-kv-store"},
+				},
+			},
+		},
+	}
+
+	s.mockService.EXPECT().GetVersionResources(ctx, req).Return(natsManagerResponse, nil)
+
+	res, err := s.natsManagerClient.GetVersionResources(ctx, productID, testVersion)
+	s.Require().NoError(err)
+	s.Equal(expectedResponse, res)
+}
+
+func (s *NatsManagerTestSuite) TestGetVersionResourcesManagerError() {
+	ctx := context.Background()
+
+	s.mockService.EXPECT().GetVersionResources(ctx, gomock.Any()).Return(nil, mockedError)
+
+	_, err := s.natsManagerClient.GetVersionResources(ctx, productID, testVersion)
+	s.ErrorIs(err, mockedError)
+}
+
 func (s *NatsManagerTestSuite) TestCreateStreamsManagerError() {
 	ctx := context.Background()
 
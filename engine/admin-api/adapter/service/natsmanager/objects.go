@@ -0,0 +1,142 @@
+package natsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// _objectChunkSize keeps uploaded object chunks well below the default gRPC message size limit.
+const _objectChunkSize = 512 * 1024
+
+// ListObjects calls nats-manager to get the objects stored in one of the object stores of the product.
+func (n *Client) ListObjects(ctx context.Context, product, objectStore string) ([]*entity.ObjectInfo, error) {
+	res, err := n.client.ListObjects(ctx, &natspb.ListObjectsRequest{
+		ProductId:   product,
+		ObjectStore: objectStore,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing objects of object store %q: %w", objectStore, err)
+	}
+
+	objects := make([]*entity.ObjectInfo, 0, len(res.Objects))
+
+	for _, objectDTO := range res.Objects {
+		object, err := n.mapDTOToObjectInfo(objectDTO)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+// DownloadObject calls nats-manager to get the content of an object and writes it to w as it is received.
+func (n *Client) DownloadObject(ctx context.Context, product, objectStore, name string, w io.Writer) error {
+	stream, err := n.client.GetObject(ctx, &natspb.GetObjectRequest{
+		ProductId:   product,
+		ObjectStore: objectStore,
+		Name:        name,
+	})
+	if err != nil {
+		return fmt.Errorf("downloading object %q: %w", name, err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("downloading object %q: %w", name, err)
+		}
+
+		if _, err := w.Write(chunk.Data); err != nil {
+			return fmt.Errorf("writing object %q: %w", name, err)
+		}
+	}
+}
+
+// UploadObject calls nats-manager to store the content read from r as an object, sending it in chunks.
+func (n *Client) UploadObject(
+	ctx context.Context,
+	product, objectStore, name string,
+	r io.Reader,
+) (*entity.ObjectInfo, error) {
+	stream, err := n.client.PutObject(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("uploading object %q: %w", name, err)
+	}
+
+	req := &natspb.PutObjectRequest{
+		ProductId:   product,
+		ObjectStore: objectStore,
+		Name:        name,
+	}
+
+	for {
+		buf := make([]byte, _objectChunkSize)
+
+		read, readErr := io.ReadFull(r, buf)
+		if readErr != nil && !errors.Is(readErr, io.EOF) && !errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("reading object %q: %w", name, readErr)
+		}
+
+		req.Data = buf[:read]
+
+		// A stream closed by the server returns io.EOF, the actual error is returned by CloseAndRecv.
+		err := stream.Send(req)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("uploading object %q: %w", name, err)
+		}
+
+		if err != nil || readErr != nil {
+			break
+		}
+
+		req = &natspb.PutObjectRequest{}
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("uploading object %q: %w", name, err)
+	}
+
+	return n.mapDTOToObjectInfo(res.Object)
+}
+
+// DeleteObject calls nats-manager to delete an object from one of the object stores of the product.
+func (n *Client) DeleteObject(ctx context.Context, product, objectStore, name string) error {
+	_, err := n.client.DeleteObject(ctx, &natspb.DeleteObjectRequest{
+		ProductId:   product,
+		ObjectStore: objectStore,
+		Name:        name,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting object %q: %w", name, err)
+	}
+
+	return nil
+}
+
+func (n *Client) mapDTOToObjectInfo(objectDTO *natspb.ObjectInfo) (*entity.ObjectInfo, error) {
+	modified, err := time.Parse(time.RFC3339, objectDTO.Modified)
+	if err != nil {
+		return nil, fmt.Errorf("parsing object %q modification time: %w", objectDTO.Name, err)
+	}
+
+	return &entity.ObjectInfo{
+		Name:     objectDTO.Name,
+		Size:     objectDTO.Size,
+		Digest:   objectDTO.Digest,
+		Modified: modified,
+	}, nil
+}
@@ -0,0 +1,160 @@
+//go:build unit
+
+package natsmanager_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/mocks"
+)
+
+const _testObjectStore = "object-store_test-product_models"
+
+func (s *NatsManagerTestSuite) TestListObjects() {
+	ctx := context.Background()
+
+	s.mockService.EXPECT().
+		ListObjects(ctx, &natspb.ListObjectsRequest{ProductId: productID, ObjectStore: _testObjectStore}).
+		Return(&natspb.ListObjectsResponse{Objects: []*natspb.ObjectInfo{
+			{Name: "model.bin", Size: 10, Digest: "SHA-256=digest", Modified: "2024-01-01T10:00:00Z"},
+		}}, nil)
+
+	objects, err := s.natsManagerClient.ListObjects(ctx, productID, _testObjectStore)
+	s.Require().NoError(err)
+	s.Equal([]*entity.ObjectInfo{
+		{Name: "model.bin", Size: 10, Digest: "SHA-256=digest", Modified: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+	}, objects)
+}
+
+func (s *NatsManagerTestSuite) TestListObjects_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("object store does not belong to the product")
+
+	s.mockService.EXPECT().ListObjects(ctx, gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.natsManagerClient.ListObjects(ctx, productID, _testObjectStore)
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestDownloadObject() {
+	ctx := context.Background()
+	stream := mocks.NewMockNatsManagerService_GetObjectClient(gomock.NewController(s.T()))
+
+	s.mockService.EXPECT().GetObject(ctx, &natspb.GetObjectRequest{
+		ProductId:   productID,
+		ObjectStore: _testObjectStore,
+		Name:        "model.bin",
+	}).Return(stream, nil)
+
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&natspb.ObjectChunk{Data: []byte("con")}, nil),
+		stream.EXPECT().Recv().Return(&natspb.ObjectChunk{Data: []byte("tent")}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+
+	var content bytes.Buffer
+
+	err := s.natsManagerClient.DownloadObject(ctx, productID, _testObjectStore, "model.bin", &content)
+	s.Require().NoError(err)
+	s.Equal("content", content.String())
+}
+
+func (s *NatsManagerTestSuite) TestDownloadObject_ServiceError() {
+	ctx := context.Background()
+	stream := mocks.NewMockNatsManagerService_GetObjectClient(gomock.NewController(s.T()))
+	expectedError := errors.New("object not found")
+
+	s.mockService.EXPECT().GetObject(ctx, gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Recv().Return(nil, expectedError)
+
+	err := s.natsManagerClient.DownloadObject(ctx, productID, _testObjectStore, "model.bin", &bytes.Buffer{})
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestUploadObject() {
+	ctx := context.Background()
+	stream := mocks.NewMockNatsManagerService_PutObjectClient(gomock.NewController(s.T()))
+
+	s.mockService.EXPECT().PutObject(ctx).Return(stream, nil)
+
+	gomock.InOrder(
+		stream.EXPECT().Send(&natspb.PutObjectRequest{
+			ProductId:   productID,
+			ObjectStore: _testObjectStore,
+			Name:        "model.bin",
+			Data:        []byte("content"),
+		}).Return(nil),
+		stream.EXPECT().CloseAndRecv().Return(&natspb.PutObjectResponse{Object: &natspb.ObjectInfo{
+			Name:     "model.bin",
+			Size:     7,
+			Digest:   "SHA-256=digest",
+			Modified: "2024-01-01T10:00:00Z",
+		}}, nil),
+	)
+
+	object, err := s.natsManagerClient.UploadObject(ctx, productID, _testObjectStore, "model.bin", strings.NewReader("content"))
+	s.Require().NoError(err)
+	s.Equal(&entity.ObjectInfo{
+		Name:     "model.bin",
+		Size:     7,
+		Digest:   "SHA-256=digest",
+		Modified: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+	}, object)
+}
+
+func (s *NatsManagerTestSuite) TestUploadObject_SendsLargeObjectsInChunks() {
+	ctx := context.Background()
+	stream := mocks.NewMockNatsManagerService_PutObjectClient(gomock.NewController(s.T()))
+	content := bytes.Repeat([]byte("a"), 1024*1024+1)
+
+	var sent [][]byte
+
+	s.mockService.EXPECT().PutObject(ctx).Return(stream, nil)
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *natspb.PutObjectRequest) error {
+		sent = append(sent, req.Data)
+		return nil
+	}).Times(3)
+	stream.EXPECT().CloseAndRecv().Return(&natspb.PutObjectResponse{Object: &natspb.ObjectInfo{
+		Name:     "model.bin",
+		Size:     uint64(len(content)),
+		Modified: "2024-01-01T10:00:00Z",
+	}}, nil)
+
+	_, err := s.natsManagerClient.UploadObject(ctx, productID, _testObjectStore, "model.bin", bytes.NewReader(content))
+	s.Require().NoError(err)
+	s.Equal(content, bytes.Join(sent, nil))
+}
+
+func (s *NatsManagerTestSuite) TestUploadObject_ServiceError() {
+	ctx := context.Background()
+	stream := mocks.NewMockNatsManagerService_PutObjectClient(gomock.NewController(s.T()))
+	expectedError := errors.New("object store does not belong to the product")
+
+	s.mockService.EXPECT().PutObject(ctx).Return(stream, nil)
+	stream.EXPECT().Send(gomock.Any()).Return(io.EOF)
+	stream.EXPECT().CloseAndRecv().Return(nil, expectedError)
+
+	_, err := s.natsManagerClient.UploadObject(ctx, productID, _testObjectStore, "model.bin", strings.NewReader("content"))
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestDeleteObject() {
+	ctx := context.Background()
+
+	s.mockService.EXPECT().DeleteObject(ctx, &natspb.DeleteObjectRequest{
+		ProductId:   productID,
+		ObjectStore: _testObjectStore,
+		Name:        "model.bin",
+	}).Return(&natspb.DeleteResponse{}, nil)
+
+	err := s.natsManagerClient.DeleteObject(ctx, productID, _testObjectStore, "model.bin")
+	s.Require().NoError(err)
+}
@@ -0,0 +1,47 @@
+package natsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+)
+
+// CreateProductObjectStore calls nats-manager to create an object store shared by every version of the product.
+func (n *Client) CreateProductObjectStore(ctx context.Context, product, name string) (string, error) {
+	res, err := n.client.CreateProductObjectStore(ctx, &natspb.CreateProductObjectStoreRequest{
+		ProductId: product,
+		Name:      name,
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating product object store %q: %w", name, err)
+	}
+
+	return res.ObjectStore, nil
+}
+
+// GetProductObjectStores calls nats-manager to get the names of the object stores shared by every version
+// of the product.
+func (n *Client) GetProductObjectStores(ctx context.Context, product string) ([]string, error) {
+	res, err := n.client.GetProductObjectStores(ctx, &natspb.GetProductObjectStoresRequest{
+		ProductId: product,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting product object stores: %w", err)
+	}
+
+	return res.Names, nil
+}
+
+// DeleteProductObjectStore calls nats-manager to delete an object store shared by every version of the product.
+func (n *Client) DeleteProductObjectStore(ctx context.Context, product, name string) error {
+	_, err := n.client.DeleteProductObjectStore(ctx, &natspb.DeleteProductObjectStoreRequest{
+		ProductId: product,
+		Name:      name,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting product object store %q: %w", name, err)
+	}
+
+	return nil
+}
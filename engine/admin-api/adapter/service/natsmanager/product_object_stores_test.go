@@ -0,0 +1,67 @@
+//go:build unit
+
+package natsmanager_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *NatsManagerTestSuite) TestCreateProductObjectStore() {
+	ctx := context.Background()
+
+	s.mockService.EXPECT().
+		CreateProductObjectStore(ctx, &natspb.CreateProductObjectStoreRequest{ProductId: productID, Name: "models"}).
+		Return(&natspb.CreateProductObjectStoreResponse{ObjectStore: "object-store_test-product_models"}, nil)
+
+	objectStore, err := s.natsManagerClient.CreateProductObjectStore(ctx, productID, "models")
+	s.Require().NoError(err)
+	s.Equal("object-store_test-product_models", objectStore)
+}
+
+func (s *NatsManagerTestSuite) TestGetProductObjectStores() {
+	ctx := context.Background()
+
+	s.mockService.EXPECT().
+		GetProductObjectStores(ctx, &natspb.GetProductObjectStoresRequest{ProductId: productID}).
+		Return(&natspb.GetProductObjectStoresResponse{Names: []string{"models"}}, nil)
+
+	names, err := s.natsManagerClient.GetProductObjectStores(ctx, productID)
+	s.Require().NoError(err)
+	s.Equal([]string{"models"}, names)
+}
+
+func (s *NatsManagerTestSuite) TestDeleteProductObjectStore_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("object store not found")
+
+	s.mockService.EXPECT().DeleteProductObjectStore(ctx, gomock.Any()).Return(nil, expectedError)
+
+	err := s.natsManagerClient.DeleteProductObjectStore(ctx, productID, "models")
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestCreateObjectStores_ProductScope() {
+	ctx := context.Background()
+
+	process := testhelpers.NewProcessBuilder().
+		WithObjectStore(&entity.ProcessObjectStore{Name: "models", Scope: entity.ObjectStoreScopeProduct}).
+		Build()
+	workflow := testhelpers.NewWorkflowBuilder().WithProcesses([]entity.Process{process}).Build()
+	version := testhelpers.NewVersionBuilder().WithWorkflows([]entity.Workflow{workflow}).Build()
+
+	s.mockService.EXPECT().
+		CreateObjectStores(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *natspb.CreateObjectStoresRequest, _ ...any) (*natspb.CreateObjectStoresResponse, error) {
+			s.Equal(natspb.ObjectStoreScope_SCOPE_PRODUCT, req.Workflows[0].Processes[0].ObjectStore.Scope)
+			return &natspb.CreateObjectStoresResponse{}, nil
+		})
+
+	_, err := s.natsManagerClient.CreateObjectStores(ctx, productID, version)
+	s.Require().NoError(err)
+}
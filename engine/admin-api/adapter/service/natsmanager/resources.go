@@ -0,0 +1,78 @@
+package natsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// ListResources calls nats-manager to get the streams, object stores and key-value stores following
+// the KAI naming schemes.
+func (n *Client) ListResources(ctx context.Context) ([]*entity.NatsResource, error) {
+	res, err := n.client.ListResources(ctx, &natspb.ListResourcesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("listing NATS resources: %w", err)
+	}
+
+	resources := make([]*entity.NatsResource, 0, len(res.Resources))
+
+	for _, resource := range res.Resources {
+		resources = append(resources, &entity.NatsResource{
+			Name:       resource.Name,
+			Type:       mapNatsResourceTypeToEntity(resource.Type),
+			ProductID:  resource.ProductId,
+			VersionKey: resource.VersionKey,
+		})
+	}
+
+	return resources, nil
+}
+
+// DeleteResources calls nats-manager to delete the given streams, object stores and key-value stores.
+func (n *Client) DeleteResources(ctx context.Context, resources []*entity.NatsResource) error {
+	resourcesDTO := make([]*natspb.NatsResource, 0, len(resources))
+
+	for _, resource := range resources {
+		resourcesDTO = append(resourcesDTO, &natspb.NatsResource{
+			Name:       resource.Name,
+			Type:       mapNatsResourceTypeToDTO(resource.Type),
+			ProductId:  resource.ProductID,
+			VersionKey: resource.VersionKey,
+		})
+	}
+
+	_, err := n.client.DeleteResources(ctx, &natspb.DeleteResourcesRequest{Resources: resourcesDTO})
+	if err != nil {
+		return fmt.Errorf("deleting NATS resources: %w", err)
+	}
+
+	return nil
+}
+
+func mapNatsResourceTypeToEntity(resourceType natspb.NatsResourceType) entity.NatsResourceType {
+	switch resourceType {
+	case natspb.NatsResourceType_RESOURCE_TYPE_STREAM:
+		return entity.NatsResourceTypeStream
+	case natspb.NatsResourceType_RESOURCE_TYPE_OBJECT_STORE:
+		return entity.NatsResourceTypeObjectStore
+	case natspb.NatsResourceType_RESOURCE_TYPE_KEY_VALUE_STORE:
+		return entity.NatsResourceTypeKeyValueStore
+	default:
+		return ""
+	}
+}
+
+func mapNatsResourceTypeToDTO(resourceType entity.NatsResourceType) natspb.NatsResourceType {
+	switch resourceType {
+	case entity.NatsResourceTypeStream:
+		return natspb.NatsResourceType_RESOURCE_TYPE_STREAM
+	case entity.NatsResourceTypeObjectStore:
+		return natspb.NatsResourceType_RESOURCE_TYPE_OBJECT_STORE
+	case entity.NatsResourceTypeKeyValueStore:
+		return natspb.NatsResourceType_RESOURCE_TYPE_KEY_VALUE_STORE
+	default:
+		return natspb.NatsResourceType_RESOURCE_TYPE_UNDEFINED
+	}
+}
@@ -0,0 +1,94 @@
+//go:build unit
+
+package natsmanager_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+func (s *NatsManagerTestSuite) TestListResources() {
+	ctx := context.Background()
+
+	s.mockService.EXPECT().ListResources(ctx, &natspb.ListResourcesRequest{}).Return(&natspb.ListResourcesResponse{
+		Resources: []*natspb.NatsResource{
+			{
+				Name:       "test-product_v1_0_0_test-workflow",
+				Type:       natspb.NatsResourceType_RESOURCE_TYPE_STREAM,
+				ProductId:  productID,
+				VersionKey: "v1_0_0_test-workflow",
+			},
+			{
+				Name:      "key-store_test-product",
+				Type:      natspb.NatsResourceType_RESOURCE_TYPE_KEY_VALUE_STORE,
+				ProductId: productID,
+			},
+		},
+	}, nil)
+
+	resources, err := s.natsManagerClient.ListResources(ctx)
+	s.Require().NoError(err)
+	s.Equal([]*entity.NatsResource{
+		{
+			Name:       "test-product_v1_0_0_test-workflow",
+			Type:       entity.NatsResourceTypeStream,
+			ProductID:  productID,
+			VersionKey: "v1_0_0_test-workflow",
+		},
+		{
+			Name:      "key-store_test-product",
+			Type:      entity.NatsResourceTypeKeyValueStore,
+			ProductID: productID,
+		},
+	}, resources)
+}
+
+func (s *NatsManagerTestSuite) TestListResources_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().ListResources(ctx, gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.natsManagerClient.ListResources(ctx)
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestDeleteResources() {
+	ctx := context.Background()
+
+	req := &natspb.DeleteResourcesRequest{
+		Resources: []*natspb.NatsResource{
+			{
+				Name:       "object-store_test-product_files",
+				Type:       natspb.NatsResourceType_RESOURCE_TYPE_OBJECT_STORE,
+				ProductId:  productID,
+				VersionKey: "",
+			},
+		},
+	}
+
+	s.mockService.EXPECT().DeleteResources(ctx, req).Return(&natspb.DeleteResponse{}, nil)
+
+	err := s.natsManagerClient.DeleteResources(ctx, []*entity.NatsResource{
+		{
+			Name:      "object-store_test-product_files",
+			Type:      entity.NatsResourceTypeObjectStore,
+			ProductID: productID,
+		},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *NatsManagerTestSuite) TestDeleteResources_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().DeleteResources(ctx, gomock.Any()).Return(nil, expectedError)
+
+	err := s.natsManagerClient.DeleteResources(ctx, nil)
+	s.ErrorIs(err, expectedError)
+}
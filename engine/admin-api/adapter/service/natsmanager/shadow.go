@@ -0,0 +1,51 @@
+package natsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// StartShadow calls nats-manager to mirror the messages entering the triggers of the published version
+// into the streams of the candidate version.
+func (n *Client) StartShadow(
+	ctx context.Context,
+	productID, publishedVersionTag, candidateVersionTag string,
+	workflows []entity.ShadowWorkflow,
+) error {
+	workflowsDTO := make([]*natspb.ShadowWorkflow, 0, len(workflows))
+	for _, workflow := range workflows {
+		workflowsDTO = append(workflowsDTO, &natspb.ShadowWorkflow{
+			Name:     workflow.Name,
+			Triggers: workflow.Triggers,
+		})
+	}
+
+	_, err := n.client.StartShadow(ctx, &natspb.StartShadowRequest{
+		ProductId:           productID,
+		PublishedVersionTag: publishedVersionTag,
+		CandidateVersionTag: candidateVersionTag,
+		Workflows:           workflowsDTO,
+	})
+	if err != nil {
+		return fmt.Errorf("starting version %q shadow: %w", candidateVersionTag, err)
+	}
+
+	return nil
+}
+
+// StopShadow calls nats-manager to stop mirroring the published version into the candidate version.
+func (n *Client) StopShadow(ctx context.Context, productID, publishedVersionTag, candidateVersionTag string) error {
+	_, err := n.client.StopShadow(ctx, &natspb.StopShadowRequest{
+		ProductId:           productID,
+		PublishedVersionTag: publishedVersionTag,
+		CandidateVersionTag: candidateVersionTag,
+	})
+	if err != nil {
+		return fmt.Errorf("stopping version %q shadow: %w", candidateVersionTag, err)
+	}
+
+	return nil
+}
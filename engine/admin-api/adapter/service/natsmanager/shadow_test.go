@@ -0,0 +1,65 @@
+//go:build unit
+
+package natsmanager_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+func (s *NatsManagerTestSuite) TestStartShadow() {
+	ctx := context.Background()
+
+	req := &natspb.StartShadowRequest{
+		ProductId:           productID,
+		PublishedVersionTag: "v1.0.0",
+		CandidateVersionTag: "v2.0.0",
+		Workflows:           []*natspb.ShadowWorkflow{{Name: "test-workflow", Triggers: []string{"entrypoint"}}},
+	}
+
+	s.mockService.EXPECT().StartShadow(ctx, req).Return(&natspb.StartShadowResponse{}, nil)
+
+	err := s.natsManagerClient.StartShadow(ctx, productID, "v1.0.0", "v2.0.0", []entity.ShadowWorkflow{
+		{Name: "test-workflow", Triggers: []string{"entrypoint"}},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *NatsManagerTestSuite) TestStartShadow_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().StartShadow(ctx, gomock.Any()).Return(nil, expectedError)
+
+	err := s.natsManagerClient.StartShadow(ctx, productID, "v1.0.0", "v2.0.0", nil)
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *NatsManagerTestSuite) TestStopShadow() {
+	ctx := context.Background()
+
+	req := &natspb.StopShadowRequest{
+		ProductId:           productID,
+		PublishedVersionTag: "v1.0.0",
+		CandidateVersionTag: "v2.0.0",
+	}
+
+	s.mockService.EXPECT().StopShadow(ctx, req).Return(&natspb.DeleteResponse{}, nil)
+
+	err := s.natsManagerClient.StopShadow(ctx, productID, "v1.0.0", "v2.0.0")
+	s.Require().NoError(err)
+}
+
+func (s *NatsManagerTestSuite) TestStopShadow_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().StopShadow(ctx, gomock.Any()).Return(nil, expectedError)
+
+	err := s.natsManagerClient.StopShadow(ctx, productID, "v1.0.0", "v2.0.0")
+	s.ErrorIs(err, expectedError)
+}
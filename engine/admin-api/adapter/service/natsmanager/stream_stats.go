@@ -0,0 +1,61 @@
+package natsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// GetVersionStreamStats calls nats-manager to get the stats of the version workflow streams.
+func (n *Client) GetVersionStreamStats(
+	ctx context.Context,
+	productID string,
+	version *entity.Version,
+) ([]*entity.WorkflowStreamStats, error) {
+	res, err := n.client.GetVersionStreamStats(ctx, &natspb.GetVersionStreamStatsRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+		Workflows:  n.mapWorkflowsToDTO(version.Workflows),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting version stream stats: %w", err)
+	}
+
+	stats := make([]*entity.WorkflowStreamStats, 0, len(res.Workflows))
+
+	for _, workflowDTO := range res.Workflows {
+		processes := make([]*entity.ProcessStreamStats, 0, len(workflowDTO.Processes))
+
+		for _, processDTO := range workflowDTO.Processes {
+			processes = append(processes, &entity.ProcessStreamStats{
+				Process:   processDTO.Process,
+				Subject:   processDTO.Subject,
+				Messages:  int64(processDTO.Messages),
+				Consumers: mapDTOToConsumerStats(processDTO.Consumers),
+			})
+		}
+
+		stats = append(stats, &entity.WorkflowStreamStats{
+			Workflow:      workflowDTO.Workflow,
+			Stream:        workflowDTO.Stream,
+			Messages:      int64(workflowDTO.Messages),
+			Bytes:         int64(workflowDTO.Bytes),
+			FirstSequence: int64(workflowDTO.FirstSequence),
+			LastSequence:  int64(workflowDTO.LastSequence),
+			Consumers:     mapDTOToConsumerStats(workflowDTO.Consumers),
+			Processes:     processes,
+		})
+	}
+
+	return stats, nil
+}
+
+func mapDTOToConsumerStats(dto *natspb.ConsumerStats) entity.ConsumerStats {
+	return entity.ConsumerStats{
+		Pending:     int64(dto.GetPending()),
+		AckPending:  int64(dto.GetAckPending()),
+		Redelivered: int64(dto.GetRedelivered()),
+	}
+}
@@ -0,0 +1,78 @@
+//go:build unit
+
+package natsmanager_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+func (s *NatsManagerTestSuite) TestGetVersionStreamStats() {
+	var (
+		ctx       = context.Background()
+		clientReq = &natspb.GetVersionStreamStatsRequest{
+			ProductId:  productID,
+			VersionTag: testVersion.Tag,
+			Workflows:  testReqWorkflows,
+		}
+	)
+
+	s.mockService.EXPECT().GetVersionStreamStats(ctx, clientReq).
+		Return(&natspb.GetVersionStreamStatsResponse{
+			Workflows: []*natspb.WorkflowStreamStats{
+				{
+					Workflow:      testWorkflow.Name,
+					Stream:        "test-stream",
+					Messages:      10,
+					Bytes:         512,
+					FirstSequence: 1,
+					LastSequence:  10,
+					Consumers:     &natspb.ConsumerStats{Pending: 3, AckPending: 1, Redelivered: 2},
+					Processes: []*natspb.ProcessStreamStats{
+						{
+							Process:   testProcess.Name,
+							Subject:   "test-stream.test-process",
+							Messages:  4,
+							Consumers: &natspb.ConsumerStats{Pending: 3},
+						},
+					},
+				},
+			},
+		}, nil)
+
+	actual, err := s.natsManagerClient.GetVersionStreamStats(ctx, productID, testVersion)
+	s.Require().NoError(err)
+	s.Equal([]*entity.WorkflowStreamStats{
+		{
+			Workflow:      testWorkflow.Name,
+			Stream:        "test-stream",
+			Messages:      10,
+			Bytes:         512,
+			FirstSequence: 1,
+			LastSequence:  10,
+			Consumers:     entity.ConsumerStats{Pending: 3, AckPending: 1, Redelivered: 2},
+			Processes: []*entity.ProcessStreamStats{
+				{
+					Process:   testProcess.Name,
+					Subject:   "test-stream.test-process",
+					Messages:  4,
+					Consumers: entity.ConsumerStats{Pending: 3},
+				},
+			},
+		},
+	}, actual)
+}
+
+func (s *NatsManagerTestSuite) TestGetVersionStreamStats_ServiceError() {
+	ctx := context.Background()
+	expectedError := errors.New("service error")
+
+	s.mockService.EXPECT().GetVersionStreamStats(ctx, gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.natsManagerClient.GetVersionStreamStats(ctx, productID, testVersion)
+	s.ErrorIs(err, expectedError)
+}
@@ -2,6 +2,7 @@ package versionservice
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/versionpb"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
@@ -37,6 +38,7 @@ func mapWorkflowsToDTO(workflows []entity.Workflow, versionConfig *entity.Versio
 			Stream:        wStreamCfg.Stream,
 			KeyValueStore: wKeyValueCfg.KeyValueStore,
 			Type:          mapWorkflowTypeToDTO(w.Type),
+			Job:           mapWorkflowJobToDTO(w.Job),
 		})
 	}
 
@@ -58,6 +60,19 @@ func mapWorkflowTypeToDTO(workflowType entity.WorkflowType) versionpb.WorkflowTy
 	}
 }
 
+func mapWorkflowJobToDTO(job *entity.WorkflowJob) *versionpb.WorkflowJob {
+	if job == nil {
+		return nil
+	}
+
+	return &versionpb.WorkflowJob{
+		Schedule:                job.Schedule,
+		BackoffLimit:            job.BackoffLimit,
+		ActiveDeadlineSeconds:   job.ActiveDeadlineSeconds,
+		TtlSecondsAfterFinished: job.TTLSecondsAfterFinished,
+	}
+}
+
 func mapProcessesToDTO(
 	processes []entity.Process,
 	streamConfig *entity.WorkflowStreamResources,
@@ -88,6 +103,9 @@ func mapProcessesToDTO(
 			Config:        mapProcessConfigToDTO(p.Config),
 			Type:          mapProcessTypeToDTO(p.Type),
 			NodeSelectors: p.NodeSelectors,
+			Autoscaling:   mapProcessAutoscalingToDTO(p.Autoscaling),
+			Probes:        mapProcessProbesToDTO(p.Probes),
+			MaxDeliver:    p.MaxDeliver,
 		}
 
 		processObjectStore := objStoreConfig.Processes.GetProcessObjectStoreConfig(p.Name)
@@ -122,6 +140,22 @@ func mapProcessesToDTO(
 	return processesDTO, nil
 }
 
+func mapProcessAutoscalingToDTO(autoscaling *entity.ProcessAutoscaling) *versionpb.ProcessAutoscaling {
+	if autoscaling == nil {
+		return nil
+	}
+
+	return &versionpb.ProcessAutoscaling{
+		MinReplicas:                   autoscaling.MinReplicas,
+		MaxReplicas:                   autoscaling.MaxReplicas,
+		CpuTargetPercentage:           autoscaling.CPUTargetPercentage,
+		MemoryTargetPercentage:        autoscaling.MemoryTargetPercentage,
+		ScaleUpStabilizationSeconds:   autoscaling.ScaleUpStabilizationSeconds,
+		ScaleDownStabilizationSeconds: autoscaling.ScaleDownStabilizationSeconds,
+		ConsumerLagTarget:             autoscaling.ConsumerLagTarget,
+	}
+}
+
 func mapProcessConfigToDTO(config []entity.ConfigurationVariable) map[string]string {
 	if len(config) == 0 {
 		return nil
@@ -161,3 +195,101 @@ func mapDTOToPublishedTriggers(dto map[string]string) []entity.PublishedTrigger
 
 	return publishedTriggers
 }
+
+func mapProcessProbesToDTO(probes *entity.ProcessProbes) *versionpb.ProcessProbes {
+	if probes == nil {
+		return nil
+	}
+
+	return &versionpb.ProcessProbes{
+		Liveness:  mapProcessProbeToDTO(probes.Liveness),
+		Readiness: mapProcessProbeToDTO(probes.Readiness),
+		Startup:   mapProcessProbeToDTO(probes.Startup),
+	}
+}
+
+func mapProcessProbeToDTO(probe *entity.ProcessProbe) *versionpb.ProcessProbe {
+	if probe == nil {
+		return nil
+	}
+
+	return &versionpb.ProcessProbe{
+		Type:                mapProbeTypeToDTO(probe.Type),
+		Path:                probe.Path,
+		Port:                int32(probe.Port),
+		Command:             probe.Command,
+		GrpcService:         probe.GRPCService,
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		SuccessThreshold:    probe.SuccessThreshold,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+}
+
+func mapProbeTypeToDTO(probeType entity.ProbeType) versionpb.ProbeType {
+	switch probeType {
+	case entity.ProbeTypeHTTP:
+		return versionpb.ProbeType_ProbeTypeHTTP
+	case entity.ProbeTypeGRPC:
+		return versionpb.ProbeType_ProbeTypeGRPC
+	case entity.ProbeTypeExec:
+		return versionpb.ProbeType_ProbeTypeExec
+	case entity.ProbeTypeTCP:
+		return versionpb.ProbeType_ProbeTypeTCP
+	default:
+		return versionpb.ProbeType_ProbeTypeUnknown
+	}
+}
+
+// mapProductQuotaToDTO maps the product quota limits enforced by the product namespace resource quota.
+func mapProductQuotaToDTO(quota *entity.ProductQuota) *versionpb.ProductResourceQuota {
+	if quota == nil {
+		return nil
+	}
+
+	return &versionpb.ProductResourceQuota{
+		Cpu:    quota.CPURequests,
+		Memory: quota.MemoryRequests,
+		Pods:   quota.MaxPods,
+	}
+}
+
+func mapDTOToManifests(dto []*versionpb.Manifest) []*entity.KubernetesManifest {
+	manifests := make([]*entity.KubernetesManifest, 0, len(dto))
+
+	for _, manifest := range dto {
+		manifests = append(manifests, &entity.KubernetesManifest{
+			Kind: manifest.Kind,
+			Name: manifest.Name,
+			YAML: manifest.Yaml,
+		})
+	}
+
+	return manifests
+}
+
+func mapDTOToProcessRuntimeStatus(dto *versionpb.ProcessStatusResponse) *entity.ProcessRuntimeStatus {
+	events := make([]entity.ProcessEvent, 0, len(dto.Events))
+
+	for _, event := range dto.Events {
+		// k8s-manager always sends RFC3339 timestamps, events without one keep the zero time.
+		lastTimestamp, _ := time.Parse(time.RFC3339, event.LastTimestamp)
+
+		events = append(events, entity.ProcessEvent{
+			Type:          event.Type,
+			Reason:        event.Reason,
+			Message:       event.Message,
+			Count:         event.Count,
+			LastTimestamp: lastTimestamp,
+		})
+	}
+
+	return &entity.ProcessRuntimeStatus{
+		ReadyReplicas:         dto.ReadyReplicas,
+		DesiredReplicas:       dto.DesiredReplicas,
+		Restarts:              dto.Restarts,
+		LastTerminationReason: dto.LastTerminationReason,
+		Events:                events,
+	}
+}
@@ -0,0 +1,66 @@
+//go:build unit
+
+package versionservice_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/versionpb"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+	"google.golang.org/grpc"
+)
+
+func (s *StartVersionTestSuite) TestRenderManifests() {
+	ctx := context.Background()
+
+	var (
+		product       = testhelpers.NewProductBuilder().Build()
+		version       = testhelpers.NewVersionBuilder().Build()
+		versionConfig = s.getConfigForVersion(version)
+	)
+
+	res := &versionpb.RenderManifestsResponse{
+		Manifests: []*versionpb.Manifest{
+			{Kind: "ConfigMap", Name: "test-config", Yaml: "kind: ConfigMap"},
+			{Kind: "Deployment", Name: "test-process", Yaml: "kind: Deployment"},
+		},
+	}
+
+	s.mockService.EXPECT().
+		RenderManifests(ctx, gomock.AssignableToTypeOf(&versionpb.StartRequest{})).
+		DoAndReturn(func(_ context.Context, req *versionpb.StartRequest, _ ...grpc.CallOption) (*versionpb.RenderManifestsResponse, error) {
+			s.Equal(product.ID, req.ProductId)
+			s.Equal(version.Tag, req.VersionTag)
+			s.Len(req.Workflows, len(version.Workflows))
+
+			return res, nil
+		})
+
+	manifests, err := s.k8sVersionClient.RenderManifests(ctx, product, version, versionConfig)
+	s.Require().NoError(err)
+
+	s.Equal([]*entity.KubernetesManifest{
+		{Kind: "ConfigMap", Name: "test-config", YAML: "kind: ConfigMap"},
+		{Kind: "Deployment", Name: "test-process", YAML: "kind: Deployment"},
+	}, manifests)
+}
+
+func (s *StartVersionTestSuite) TestRenderManifests_ClientError() {
+	ctx := context.Background()
+
+	var (
+		product       = testhelpers.NewProductBuilder().Build()
+		version       = testhelpers.NewVersionBuilder().Build()
+		versionConfig = s.getConfigForVersion(version)
+	)
+
+	expectedError := errors.New("client error")
+
+	s.mockService.EXPECT().RenderManifests(gomock.Any(), gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.k8sVersionClient.RenderManifests(ctx, product, version, versionConfig)
+	s.ErrorIs(err, expectedError)
+}
@@ -0,0 +1,96 @@
+//go:build unit
+
+package versionservice_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/versionpb"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/versionservice"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScaleProcessTestSuite struct {
+	suite.Suite
+	logger           logr.Logger
+	mockService      *mocks.MockVersionServiceClient
+	k8sVersionClient *versionservice.K8sVersionService
+}
+
+func TestScaleProcessTestSuite(t *testing.T) {
+	suite.Run(t, new(ScaleProcessTestSuite))
+}
+
+func (s *ScaleProcessTestSuite) SetupSuite() {
+	mockController := gomock.NewController(s.T())
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	service := mocks.NewMockVersionServiceClient(mockController)
+
+	k8sVersionClient, err := versionservice.New(logger, service)
+	s.Require().NoError(err)
+
+	s.logger = logger
+	s.mockService = service
+	s.k8sVersionClient = k8sVersionClient
+}
+
+func (s *ScaleProcessTestSuite) TestScaleProcess() {
+	ctx := context.Background()
+
+	replicas := int32(3)
+	scaling := &entity.ProcessScaling{
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Replicas: &replicas,
+		Autoscaling: &entity.ProcessAutoscaling{
+			MinReplicas:                   1,
+			MaxReplicas:                   5,
+			MemoryTargetPercentage:        80,
+			ScaleDownStabilizationSeconds: 60,
+			ConsumerLagTarget:             100,
+		},
+	}
+
+	req := &versionpb.ScaleProcessRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+		Workflow:   scaling.Workflow,
+		Process:    scaling.Process,
+		Replicas:   &replicas,
+		Autoscaling: &versionpb.ProcessAutoscaling{
+			MinReplicas:                   1,
+			MaxReplicas:                   5,
+			MemoryTargetPercentage:        80,
+			ScaleDownStabilizationSeconds: 60,
+			ConsumerLagTarget:             100,
+		},
+	}
+
+	s.mockService.EXPECT().ScaleProcess(gomock.Any(), req).Return(&versionpb.Response{Message: "ok"}, nil)
+
+	err := s.k8sVersionClient.ScaleProcess(ctx, productID, version.Tag, scaling)
+	s.Require().NoError(err)
+}
+
+func (s *ScaleProcessTestSuite) TestScaleProcess_ClientError() {
+	ctx := context.Background()
+
+	expectedError := errors.New("client error")
+	replicas := int32(3)
+
+	s.mockService.EXPECT().ScaleProcess(gomock.Any(), gomock.Any()).Return(nil, expectedError)
+
+	err := s.k8sVersionClient.ScaleProcess(ctx, productID, version.Tag, &entity.ProcessScaling{
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Replicas: &replicas,
+	})
+	s.Assert().ErrorIs(err, expectedError)
+}
@@ -96,6 +96,14 @@ func (s *StartVersionTestSuite) TestStartVersion() {
 			WithConfig([]entity.ConfigurationVariable{
 				{Key: "test-key", Value: "test-value"},
 			}).
+			WithProbes(&entity.ProcessProbes{
+				Startup: &entity.ProcessProbe{
+					Type:             entity.ProbeTypeGRPC,
+					GRPCService:      "health",
+					FailureThreshold: 60,
+				},
+			}).
+			WithMaxDeliver(10).
 			Build()
 
 		workflow = testhelpers.NewWorkflowBuilder().
@@ -115,6 +123,8 @@ func (s *StartVersionTestSuite) TestStartVersion() {
 		processKVStore     = workflowKVStoreCfg.Processes[process.Name]
 	)
 
+	versionConfig.NatsCredentials = "test-nats-credentials"
+
 	req := &versionpb.StartRequest{
 		ProductId:            product.ID,
 		VersionTag:           version.Tag,
@@ -155,6 +165,14 @@ func (s *StartVersionTestSuite) TestStartVersion() {
 						Config: map[string]string{
 							process.Config[0].Key: process.Config[0].Value,
 						},
+						Probes: &versionpb.ProcessProbes{
+							Startup: &versionpb.ProcessProbe{
+								Type:             versionpb.ProbeType_ProbeTypeGRPC,
+								GrpcService:      "health",
+								FailureThreshold: 60,
+							},
+						},
+						MaxDeliver: process.MaxDeliver,
 					},
 				},
 			},
@@ -166,6 +184,7 @@ func (s *StartVersionTestSuite) TestStartVersion() {
 			Username: product.ServiceAccount.Username,
 			Password: product.ServiceAccount.Password,
 		},
+		NatsCredentials: versionConfig.NatsCredentials,
 	}
 
 	customMatcher := newStartRequestMatcher(req)
@@ -0,0 +1,82 @@
+//go:build unit
+
+package versionservice_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/versionpb"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/service/versionservice"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateProcessImageTestSuite struct {
+	suite.Suite
+	logger           logr.Logger
+	mockService      *mocks.MockVersionServiceClient
+	k8sVersionClient *versionservice.K8sVersionService
+}
+
+func TestUpdateProcessImageTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateProcessImageTestSuite))
+}
+
+func (s *UpdateProcessImageTestSuite) SetupSuite() {
+	mockController := gomock.NewController(s.T())
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	service := mocks.NewMockVersionServiceClient(mockController)
+
+	k8sVersionClient, err := versionservice.New(logger, service)
+	s.Require().NoError(err)
+
+	s.logger = logger
+	s.mockService = service
+	s.k8sVersionClient = k8sVersionClient
+}
+
+func (s *UpdateProcessImageTestSuite) TestUpdateProcessImage() {
+	ctx := context.Background()
+
+	patch := &entity.VersionPatch{
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Image:    "test-image@hotfix",
+	}
+
+	req := &versionpb.UpdateProcessImageRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+		Workflow:   patch.Workflow,
+		Process:    patch.Process,
+		Image:      patch.Image,
+	}
+
+	s.mockService.EXPECT().UpdateProcessImage(gomock.Any(), req).Return(&versionpb.Response{Message: "ok"}, nil)
+
+	err := s.k8sVersionClient.UpdateProcessImage(ctx, productID, version.Tag, patch)
+	s.Require().NoError(err)
+}
+
+func (s *UpdateProcessImageTestSuite) TestUpdateProcessImage_ClientError() {
+	ctx := context.Background()
+
+	expectedError := errors.New("client error")
+
+	patch := &entity.VersionPatch{
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Image:    "test-image@hotfix",
+	}
+
+	s.mockService.EXPECT().UpdateProcessImage(gomock.Any(), gomock.Any()).Return(nil, expectedError)
+
+	err := s.k8sVersionClient.UpdateProcessImage(ctx, productID, version.Tag, patch)
+	s.Assert().ErrorIs(err, expectedError)
+}
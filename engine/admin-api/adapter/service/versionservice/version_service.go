@@ -41,12 +41,47 @@ func (k *K8sVersionService) Start(
 	version *entity.Version,
 	versionConfig *entity.VersionStreamingResources,
 ) error {
+	req, err := getStartRequest(product, version, versionConfig)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.client.Start(ctx, req)
+
+	return err
+}
+
+// RenderManifests returns the k8s manifests that starting the version would create, without applying them.
+func (k *K8sVersionService) RenderManifests(
+	ctx context.Context,
+	product *entity.Product,
+	version *entity.Version,
+	versionConfig *entity.VersionStreamingResources,
+) ([]*entity.KubernetesManifest, error) {
+	req, err := getStartRequest(product, version, versionConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := k.client.RenderManifests(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("render manifests of version %q: %w", version.Tag, err)
+	}
+
+	return mapDTOToManifests(res.Manifests), nil
+}
+
+func getStartRequest(
+	product *entity.Product,
+	version *entity.Version,
+	versionConfig *entity.VersionStreamingResources,
+) (*versionpb.StartRequest, error) {
 	wf, err := mapWorkflowsToDTO(version.Workflows, versionConfig)
 	if err != nil {
-		return fmt.Errorf("map workflows to DTO: %w", err)
+		return nil, fmt.Errorf("map workflows to DTO: %w", err)
 	}
 
-	req := versionpb.StartRequest{
+	return &versionpb.StartRequest{
 		ProductId:            product.ID,
 		VersionTag:           version.Tag,
 		Workflows:            wf,
@@ -59,11 +94,8 @@ func (k *K8sVersionService) Start(
 			Username: product.ServiceAccount.Username,
 			Password: product.ServiceAccount.Password,
 		},
-	}
-
-	_, err = k.client.Start(ctx, &req)
-
-	return err
+		NatsCredentials: versionConfig.NatsCredentials,
+	}, nil
 }
 
 func (k *K8sVersionService) Stop(ctx context.Context, productID string, version *entity.Version) error {
@@ -154,8 +186,11 @@ func (k *K8sVersionService) WatchProcessStatus(ctx context.Context, productID, v
 			}
 
 			ch <- &entity.Process{
-				Name:   msg.Name,
-				Status: status,
+				Name:          msg.Name,
+				Status:        status,
+				StatusMessage: msg.Message,
+				Attempts:      msg.Attempts,
+				Runtime:       mapDTOToProcessRuntimeStatus(msg),
 			}
 		}
 	}()
@@ -163,6 +198,41 @@ func (k *K8sVersionService) WatchProcessStatus(ctx context.Context, productID, v
 	return ch, nil
 }
 
+// GetProcessStatus returns the current status of the processes of a running version.
+func (k *K8sVersionService) GetProcessStatus(
+	ctx context.Context,
+	productID, versionTag string,
+) ([]*entity.ProcessStatusReport, error) {
+	res, err := k.client.GetProcessStatus(ctx, &versionpb.ProcessStatusRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get process status of version %q: %w", versionTag, err)
+	}
+
+	reports := make([]*entity.ProcessStatusReport, 0, len(res.Processes))
+
+	for _, process := range res.Processes {
+		status := entity.ProcessStatus(process.Status)
+		if !status.IsValid() {
+			k.logger.Info("Invalid process status", "process", process.Name, "status", status)
+			continue
+		}
+
+		reports = append(reports, &entity.ProcessStatusReport{
+			Workflow:      process.Workflow,
+			Process:       process.Name,
+			Status:        status,
+			StatusMessage: process.Message,
+			Attempts:      process.Attempts,
+			Runtime:       mapDTOToProcessRuntimeStatus(process),
+		})
+	}
+
+	return reports, nil
+}
+
 func (k *K8sVersionService) RegisterProcess(ctx context.Context, productID, processID, processImage string) (string, error) {
 	res, err := k.client.RegisterProcess(ctx, &versionpb.RegisterProcessRequest{
 		ProductId:    productID,
@@ -184,3 +254,97 @@ func (k *K8sVersionService) GetPublishedTriggers(ctx context.Context, productID
 
 	return mapDTOToPublishedTriggers(res.NetworkUrls), nil
 }
+
+// UpdateProcessImage rolls a running process to a new image, k8s-manager rolls it back if it doesn't get ready.
+func (k *K8sVersionService) UpdateProcessImage(
+	ctx context.Context,
+	productID, versionTag string,
+	patch *entity.VersionPatch,
+) error {
+	req := versionpb.UpdateProcessImageRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   patch.Workflow,
+		Process:    patch.Process,
+		Image:      patch.Image,
+	}
+
+	_, err := k.client.UpdateProcessImage(ctx, &req)
+	if err != nil {
+		return fmt.Errorf("update process %q image in version %q: %w", patch.Process, versionTag, err)
+	}
+
+	return nil
+}
+
+// ScaleProcess changes the replicas or autoscaling bounds of a running process.
+func (k *K8sVersionService) ScaleProcess(
+	ctx context.Context,
+	productID, versionTag string,
+	scaling *entity.ProcessScaling,
+) error {
+	req := versionpb.ScaleProcessRequest{
+		ProductId:   productID,
+		VersionTag:  versionTag,
+		Workflow:    scaling.Workflow,
+		Process:     scaling.Process,
+		Replicas:    scaling.Replicas,
+		Autoscaling: mapProcessAutoscalingToDTO(scaling.Autoscaling),
+	}
+
+	_, err := k.client.ScaleProcess(ctx, &req)
+	if err != nil {
+		return fmt.Errorf("scale process %q in version %q: %w", scaling.Process, versionTag, err)
+	}
+
+	return nil
+}
+
+// RunWorkflow triggers a new run of the jobs of a batch workflow and returns the created job names.
+func (k *K8sVersionService) RunWorkflow(ctx context.Context, productID, versionTag, workflow string) ([]string, error) {
+	res, err := k.client.RunWorkflow(ctx, &versionpb.RunWorkflowRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   workflow,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("run workflow %q in version %q: %w", workflow, versionTag, err)
+	}
+
+	return res.Jobs, nil
+}
+
+func (k *K8sVersionService) CreateProductNamespace(ctx context.Context, product *entity.Product) error {
+	_, err := k.client.CreateProductNamespace(ctx, &versionpb.CreateProductNamespaceRequest{
+		ProductId:     product.ID,
+		ResourceQuota: mapProductQuotaToDTO(product.Quota),
+	})
+	if err != nil {
+		return fmt.Errorf("create product %q namespace: %w", product.ID, err)
+	}
+
+	return nil
+}
+
+func (k *K8sVersionService) UpdateProductNamespace(ctx context.Context, product *entity.Product) error {
+	_, err := k.client.UpdateProductNamespace(ctx, &versionpb.UpdateProductNamespaceRequest{
+		ProductId:     product.ID,
+		ResourceQuota: mapProductQuotaToDTO(product.Quota),
+	})
+	if err != nil {
+		return fmt.Errorf("update product %q namespace: %w", product.ID, err)
+	}
+
+	return nil
+}
+
+func (k *K8sVersionService) DeleteProductNamespace(ctx context.Context, productID string) error {
+	_, err := k.client.DeleteProductNamespace(ctx, &versionpb.DeleteProductNamespaceRequest{
+		ProductId: productID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete product %q namespace: %w", productID, err)
+	}
+
+	return nil
+}
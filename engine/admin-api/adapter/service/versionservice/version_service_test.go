@@ -7,6 +7,7 @@ import (
 	"errors"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -122,6 +123,190 @@ func (s *VersionServiceTestSuite) TestWatchProcessStatus() {
 	s.Nil(process)
 }
 
+func (s *VersionServiceTestSuite) TestGetProcessStatus() {
+	ctx := context.Background()
+
+	req := &versionpb.ProcessStatusRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+	}
+
+	res := &versionpb.GetProcessStatusResponse{
+		Processes: []*versionpb.ProcessStatusResponse{
+			{
+				Name:                  "test-process-name",
+				Workflow:              "test-workflow",
+				Status:                "STARTING",
+				ReadyReplicas:         0,
+				DesiredReplicas:       1,
+				Restarts:              3,
+				LastTerminationReason: "OOMKilled",
+				Events: []*versionpb.ProcessEvent{
+					{
+						Type:          "Warning",
+						Reason:        "BackOff",
+						Message:       "Back-off restarting failed container",
+						Count:         3,
+						LastTimestamp: "2024-01-01T10:00:00Z",
+					},
+				},
+			},
+			{
+				Name:   "invalid-process",
+				Status: "UNKNOWN",
+			},
+		},
+	}
+
+	s.mockService.EXPECT().GetProcessStatus(ctx, req).Return(res, nil)
+
+	reports, err := s.k8sVersionClient.GetProcessStatus(ctx, productID, version.Tag)
+	s.Require().NoError(err)
+
+	s.Equal([]*entity.ProcessStatusReport{
+		{
+			Workflow: "test-workflow",
+			Process:  "test-process-name",
+			Status:   entity.ProcessStatusStarting,
+			Runtime: &entity.ProcessRuntimeStatus{
+				DesiredReplicas:       1,
+				Restarts:              3,
+				LastTerminationReason: "OOMKilled",
+				Events: []entity.ProcessEvent{
+					{
+						Type:          "Warning",
+						Reason:        "BackOff",
+						Message:       "Back-off restarting failed container",
+						Count:         3,
+						LastTimestamp: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+		},
+	}, reports)
+}
+
+func (s *VersionServiceTestSuite) TestGetProcessStatus_ClientError() {
+	ctx := context.Background()
+
+	expectedError := errors.New("k8s error")
+
+	s.mockService.EXPECT().GetProcessStatus(gomock.Any(), gomock.Any()).Return(nil, expectedError)
+
+	_, err := s.k8sVersionClient.GetProcessStatus(ctx, productID, version.Tag)
+	s.ErrorIs(err, expectedError)
+}
+
+func (s *VersionServiceTestSuite) TestWatchProcessStatus_FailedJob() {
+	ctx := context.Background()
+
+	req := &versionpb.ProcessStatusRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+	}
+
+	processStatusResponse := &versionpb.ProcessStatusResponse{
+		ProcessId: "test-process-id",
+		Name:      "test-process-name",
+		Workflow:  "test-workflow",
+		Status:    "FAILED",
+		Attempts:  3,
+		Message:   "Job has reached the specified backoff limit",
+	}
+
+	stream := mocks.NewMockVersionService_WatchProcessStatusClient(gomock.NewController(s.T()))
+
+	s.mockService.EXPECT().WatchProcessStatus(ctx, req).Return(stream, nil)
+	stream.EXPECT().Recv().Return(processStatusResponse, nil)
+	stream.EXPECT().Context().Return(ctx).Times(2)
+	stream.EXPECT().Recv().Return(nil, io.EOF)
+
+	statusChannel, err := s.k8sVersionClient.WatchProcessStatus(ctx, productID, version.Tag)
+	s.Require().NoError(err)
+
+	process := <-statusChannel
+	s.Require().Equal(entity.ProcessStatusFailed, process.Status)
+	s.Equal(int32(3), process.Attempts)
+	s.Equal(processStatusResponse.Message, process.StatusMessage)
+
+	process = <-statusChannel
+	s.Nil(process)
+}
+
+func (s *VersionServiceTestSuite) TestRunWorkflow() {
+	ctx := context.Background()
+
+	req := &versionpb.RunWorkflowRequest{
+		ProductId:  productID,
+		VersionTag: version.Tag,
+		Workflow:   "test-workflow",
+	}
+
+	s.mockService.EXPECT().RunWorkflow(ctx, req).Return(&versionpb.RunWorkflowResponse{Jobs: []string{"test-job"}}, nil)
+
+	jobs, err := s.k8sVersionClient.RunWorkflow(ctx, productID, version.Tag, "test-workflow")
+	s.Require().NoError(err)
+	s.Equal([]string{"test-job"}, jobs)
+}
+
+func (s *VersionServiceTestSuite) TestCreateProductNamespace() {
+	ctx := context.Background()
+
+	product := &entity.Product{
+		ID:    productID,
+		Quota: &entity.ProductQuota{CPURequests: "2", MemoryRequests: "4Gi", MaxPods: 10, MaxReplicas: 5},
+	}
+
+	req := &versionpb.CreateProductNamespaceRequest{
+		ProductId: productID,
+		ResourceQuota: &versionpb.ProductResourceQuota{
+			Cpu:    "2",
+			Memory: "4Gi",
+			Pods:   10,
+		},
+	}
+
+	s.mockService.EXPECT().CreateProductNamespace(ctx, req).Return(&versionpb.Response{}, nil)
+
+	err := s.k8sVersionClient.CreateProductNamespace(ctx, product)
+	s.Require().NoError(err)
+}
+
+func (s *VersionServiceTestSuite) TestUpdateProductNamespace() {
+	ctx := context.Background()
+
+	product := &entity.Product{
+		ID:    productID,
+		Quota: &entity.ProductQuota{CPURequests: "4", MemoryRequests: "8Gi"},
+	}
+
+	req := &versionpb.UpdateProductNamespaceRequest{
+		ProductId: productID,
+		ResourceQuota: &versionpb.ProductResourceQuota{
+			Cpu:    "4",
+			Memory: "8Gi",
+		},
+	}
+
+	s.mockService.EXPECT().UpdateProductNamespace(ctx, req).Return(&versionpb.Response{}, nil)
+
+	err := s.k8sVersionClient.UpdateProductNamespace(ctx, product)
+	s.Require().NoError(err)
+}
+
+func (s *VersionServiceTestSuite) TestDeleteProductNamespace_Error() {
+	ctx := context.Background()
+
+	req := &versionpb.DeleteProductNamespaceRequest{
+		ProductId: productID,
+	}
+
+	s.mockService.EXPECT().DeleteProductNamespace(ctx, req).Return(nil, errors.New("mocked error"))
+
+	err := s.k8sVersionClient.DeleteProductNamespace(ctx, productID)
+	s.Require().Error(err)
+}
+
 func (s *VersionServiceTestSuite) TestWatchProcessStatusManagerError() {
 	ctx := context.Background()
 
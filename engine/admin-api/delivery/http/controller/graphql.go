@@ -8,7 +8,9 @@ import (
 	"github.com/konstellation-io/kai/engine/admin-api/adapter/gql"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/admission"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/logs"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/natsgc"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/process"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
 	"github.com/labstack/echo/v4"
@@ -31,6 +33,8 @@ type GraphQLController struct {
 	versionInteractor      *version.Handler
 	processHandler         *process.Handler
 	LogsUsecase            logs.LogsUsecase
+	admissionHandler       *admission.Handler
+	natsGCHandler          *natsgc.Handler
 }
 
 type Params struct {
@@ -41,6 +45,8 @@ type Params struct {
 	VersionInteractor      *version.Handler
 	ProcessHandler         *process.Handler
 	LogsUsecase            logs.LogsUsecase
+	AdmissionHandler       *admission.Handler
+	NatsGCHandler          *natsgc.Handler
 }
 
 func NewGraphQLController(
@@ -54,6 +60,8 @@ func NewGraphQLController(
 		params.VersionInteractor,
 		params.ProcessHandler,
 		params.LogsUsecase,
+		params.AdmissionHandler,
+		params.NatsGCHandler,
 	}
 }
 
@@ -77,6 +85,8 @@ func (g *GraphQLController) GraphQLHandler(c echo.Context) error {
 		VersionInteractor:      g.versionInteractor,
 		ProcessHandler:         g.processHandler,
 		LogsUsecase:            g.LogsUsecase,
+		AdmissionHandler:       g.admissionHandler,
+		NatsGCHandler:          g.natsGCHandler,
 	})
 
 	h.ServeHTTP(c.Response(), r.WithContext(ctx))
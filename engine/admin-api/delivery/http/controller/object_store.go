@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/admin-api/delivery/http/httperrors"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+//go:generate mockgen -source=${GOFILE} -destination=../../../mocks/controller_${GOFILE} -package=mocks
+
+type ObjectStore interface {
+	DownloadObjectHandler(c echo.Context) error
+}
+
+// ObjectStoreController serves the objects of the product object stores, which can't be downloaded through
+// GraphQL as they may be large binary files.
+type ObjectStoreController struct {
+	logger            logr.Logger
+	productInteractor *usecase.ProductInteractor
+}
+
+func NewObjectStoreController(logger logr.Logger, productInteractor *usecase.ProductInteractor) *ObjectStoreController {
+	return &ObjectStoreController{
+		logger:            logger,
+		productInteractor: productInteractor,
+	}
+}
+
+// DownloadObjectHandler streams the content of an object as an attachment. The object name is the rest of the
+// path after the object store, so names containing slashes can be downloaded too.
+func (o *ObjectStoreController) DownloadObjectHandler(c echo.Context) error {
+	user, ok := c.Get(UserContextKey).(*entity.User)
+	if !ok {
+		return httperrors.HTTPErrUnauthorized
+	}
+
+	productID := c.Param("productID")
+	objectStore := c.Param("objectStore")
+
+	name, err := url.PathUnescape(c.Param("*"))
+	if err != nil || name == "" {
+		return httperrors.HTTPErrInvalidObjectName
+	}
+
+	w := &objectResponseWriter{res: c.Response(), name: name}
+
+	err = o.productInteractor.DownloadObject(c.Request().Context(), user, productID, objectStore, name, w)
+	if err != nil {
+		o.logger.Error(err, "Error downloading object", "product", productID, "objectStore", objectStore, "name", name)
+
+		if w.res.Committed {
+			// The status is already sent, the client notices the download is incomplete.
+			return nil
+		}
+
+		var errUnauthorized auth.UnauthorizedError
+		if errors.As(err, &errUnauthorized) {
+			return httperrors.HTTPErrForbidden
+		}
+
+		return httperrors.HTTPErrUnexpected
+	}
+
+	w.writeHeader()
+
+	return nil
+}
+
+// objectResponseWriter delays sending the response headers until the first chunk of the object is received,
+// so errors found before can still be returned with the right status.
+type objectResponseWriter struct {
+	res  *echo.Response
+	name string
+}
+
+func (w *objectResponseWriter) Write(p []byte) (int, error) {
+	w.writeHeader()
+	return w.res.Write(p)
+}
+
+func (w *objectResponseWriter) writeHeader() {
+	if w.res.Committed {
+		return
+	}
+
+	w.res.Header().Set(echo.HeaderContentType, echo.MIMEOctetStream)
+	w.res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", path.Base(w.name)))
+	w.res.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,123 @@
+//go:build unit
+
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/delivery/http/controller"
+	"github.com/konstellation-io/kai/engine/admin-api/delivery/http/httperrors"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase"
+	"github.com/konstellation-io/kai/engine/admin-api/mocks"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	_productID   = "test-product"
+	_objectStore = "object-store_test-product_models"
+)
+
+type objectStoreControllerSuite struct {
+	suite.Suite
+
+	accessControl *mocks.MockAccessControl
+	natsService   *mocks.MockNatsManagerService
+	controller    *controller.ObjectStoreController
+}
+
+func TestObjectStoreControllerSuite(t *testing.T) {
+	suite.Run(t, new(objectStoreControllerSuite))
+}
+
+func (s *objectStoreControllerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+
+	s.accessControl = mocks.NewMockAccessControl(ctrl)
+	s.natsService = mocks.NewMockNatsManagerService(ctrl)
+
+	productInteractor := usecase.NewProductInteractor(&usecase.ProductInteractorOpts{
+		Logger:        logger,
+		AccessControl: s.accessControl,
+		NatsService:   s.natsService,
+	})
+	s.controller = controller.NewObjectStoreController(logger, productInteractor)
+}
+
+func (s *objectStoreControllerSuite) newContext(name string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("productID", "objectStore", "*")
+	c.SetParamValues(_productID, _objectStore, name)
+
+	return c, rec
+}
+
+func (s *objectStoreControllerSuite) TestDownloadObject() {
+	user := testhelpers.NewUserBuilder().Build()
+	c, rec := s.newContext("models/model%201.bin")
+	c.Set(controller.UserContextKey, user)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.natsService.EXPECT().
+		DownloadObject(gomock.Any(), _productID, _objectStore, "models/model 1.bin", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _ string, w io.Writer) error {
+			_, err := w.Write([]byte("content"))
+			return err
+		})
+
+	err := s.controller.DownloadObjectHandler(c)
+	s.Require().NoError(err)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("content", rec.Body.String())
+	s.Equal(echo.MIMEOctetStream, rec.Header().Get(echo.HeaderContentType))
+	s.Equal(`attachment; filename="model 1.bin"`, rec.Header().Get(echo.HeaderContentDisposition))
+}
+
+func (s *objectStoreControllerSuite) TestDownloadObject_Unauthenticated() {
+	c, _ := s.newContext("model.bin")
+
+	err := s.controller.DownloadObjectHandler(c)
+	s.ErrorIs(err, httperrors.HTTPErrUnauthorized)
+}
+
+func (s *objectStoreControllerSuite) TestDownloadObject_Forbidden() {
+	user := testhelpers.NewUserBuilder().Build()
+	c, _ := s.newContext("model.bin")
+	c.Set(controller.UserContextKey, user)
+
+	s.accessControl.EXPECT().
+		CheckProductGrants(user, _productID, auth.ActViewProduct).
+		Return(auth.UnauthorizedError{Product: _productID, Action: auth.ActViewProduct})
+
+	err := s.controller.DownloadObjectHandler(c)
+	s.ErrorIs(err, httperrors.HTTPErrForbidden)
+}
+
+func (s *objectStoreControllerSuite) TestDownloadObject_ErrorDownloading() {
+	user := testhelpers.NewUserBuilder().Build()
+	c, rec := s.newContext("model.bin")
+	c.Set(controller.UserContextKey, user)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.natsService.EXPECT().
+		DownloadObject(gomock.Any(), _productID, _objectStore, "model.bin", gomock.Any()).
+		Return(errors.New("object not found"))
+
+	err := s.controller.DownloadObjectHandler(c)
+	s.ErrorIs(err, httperrors.HTTPErrUnexpected)
+	s.Empty(rec.Header().Get(echo.HeaderContentDisposition))
+}
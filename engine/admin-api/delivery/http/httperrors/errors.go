@@ -31,4 +31,8 @@ var (
 	HTTPErrInvalidSession = newHTTPError(http.StatusUnauthorized, "invalid_session", "The session is not valid")
 
 	HTTPErrUnauthorized = newHTTPError(http.StatusUnauthorized, "unauthorized", "Unauthorized")
+
+	HTTPErrForbidden = newHTTPError(http.StatusForbidden, "forbidden", "Forbidden")
+
+	HTTPErrInvalidObjectName = newHTTPError(http.StatusBadRequest, "invalid_object_name", "Invalid object name")
 )
@@ -25,6 +25,7 @@ const logFormat = "${time_rfc3339} INFO remote_ip=${remote_ip}, method=${method}
 func NewApp(
 	logger logr.Logger,
 	gqlController controller.GraphQL,
+	objectStoreController controller.ObjectStore,
 ) *App {
 	e := echo.New()
 	e.HideBanner = true
@@ -53,6 +54,10 @@ func NewApp(
 	r.Any("", gqlController.GraphQLHandler)
 	r.GET("/playground", gqlController.PlaygroundHandler)
 
+	o := e.Group("/products/:productID/object-stores/:objectStore/objects")
+	o.Use(jwtAuthMiddleware)
+	o.GET("/*", objectStoreController.DownloadObjectHandler)
+
 	return &App{
 		e,
 		logger,
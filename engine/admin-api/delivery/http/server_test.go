@@ -23,10 +23,12 @@ func TestServerCall_GraphqlFailsIfUnauthorized(t *testing.T) {
 	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
 
 	gqlController := mocks.NewMockGraphQL(ctrl)
+	objectStoreController := mocks.NewMockObjectStore(ctrl)
 
 	app := httpapp.NewApp(
 		logger,
 		gqlController,
+		objectStoreController,
 	)
 
 	go app.Start()
@@ -0,0 +1,241 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidAdmissionPolicyType = errors.New("invalid admission policy type")
+	ErrInvalidAdmissionPolicyMode = errors.New("invalid admission policy mode")
+	ErrInvalidAdmissionPolicy     = errors.New("invalid admission policy")
+	ErrVersionNotAdmitted         = errors.New("version violates admission policies")
+)
+
+type AdmissionPolicyType string
+
+const (
+	// AdmissionPolicyTypeAllowedRegistries requires every process image to come from one of the given registries.
+	AdmissionPolicyTypeAllowedRegistries AdmissionPolicyType = "ALLOWED_REGISTRIES"
+	// AdmissionPolicyTypeRequiredResourceLimits requires every process to define its CPU and memory limits.
+	AdmissionPolicyTypeRequiredResourceLimits AdmissionPolicyType = "REQUIRED_RESOURCE_LIMITS"
+	// AdmissionPolicyTypeForbiddenGPU forbids GPU processes in the given workflow types.
+	AdmissionPolicyTypeForbiddenGPU AdmissionPolicyType = "FORBIDDEN_GPU"
+	// AdmissionPolicyTypeMaxReplicas caps the replicas of every process, including its autoscaling maximum.
+	AdmissionPolicyTypeMaxReplicas AdmissionPolicyType = "MAX_REPLICAS"
+	// AdmissionPolicyTypeRequiredGPUNodeSelectors requires GPU processes to set the given node selectors.
+	AdmissionPolicyTypeRequiredGPUNodeSelectors AdmissionPolicyType = "REQUIRED_GPU_NODE_SELECTORS"
+)
+
+func (t AdmissionPolicyType) Validate() error {
+	switch t {
+	case AdmissionPolicyTypeAllowedRegistries, AdmissionPolicyTypeRequiredResourceLimits, AdmissionPolicyTypeForbiddenGPU,
+		AdmissionPolicyTypeMaxReplicas, AdmissionPolicyTypeRequiredGPUNodeSelectors:
+		return nil
+	default:
+		return ErrInvalidAdmissionPolicyType
+	}
+}
+
+func (t AdmissionPolicyType) String() string {
+	return string(t)
+}
+
+type AdmissionPolicyMode string
+
+const (
+	// AdmissionPolicyModeEnforce rejects the versions that violate the policy.
+	AdmissionPolicyModeEnforce AdmissionPolicyMode = "ENFORCE"
+	// AdmissionPolicyModeDryRun only reports the violations as warnings, so new policies can be tried safely.
+	AdmissionPolicyModeDryRun AdmissionPolicyMode = "DRY_RUN"
+)
+
+func (m AdmissionPolicyMode) Validate() error {
+	switch m {
+	case AdmissionPolicyModeEnforce, AdmissionPolicyModeDryRun:
+		return nil
+	default:
+		return ErrInvalidAdmissionPolicyMode
+	}
+}
+
+func (m AdmissionPolicyMode) String() string {
+	return string(m)
+}
+
+// AdmissionPolicy is a platform wide rule that versions must pass to be created and started.
+type AdmissionPolicy struct {
+	ID             string                `bson:"_id"`
+	Name           string                `bson:"name"`
+	Description    string                `bson:"description"`
+	Type           AdmissionPolicyType   `bson:"type"`
+	Mode           AdmissionPolicyMode   `bson:"mode"`
+	Params         AdmissionPolicyParams `bson:"params"`
+	CreationDate   time.Time             `bson:"creationDate"`
+	CreationAuthor string                `bson:"creationAuthor"`
+}
+
+// AdmissionPolicyParams holds the settings of the policy. Each policy type only uses its own params.
+type AdmissionPolicyParams struct {
+	Registries    []string       `bson:"registries,omitempty"`
+	WorkflowTypes []WorkflowType `bson:"workflowTypes,omitempty"`
+	MaxReplicas   int32          `bson:"maxReplicas,omitempty"`
+	NodeSelectors []string       `bson:"nodeSelectors,omitempty"`
+}
+
+func (p *AdmissionPolicy) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidAdmissionPolicy)
+	}
+
+	if err := p.Type.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.Mode.Validate(); err != nil {
+		return err
+	}
+
+	switch p.Type {
+	case AdmissionPolicyTypeAllowedRegistries:
+		if len(p.Params.Registries) == 0 {
+			return fmt.Errorf("%w: at least one registry is required", ErrInvalidAdmissionPolicy)
+		}
+	case AdmissionPolicyTypeForbiddenGPU:
+		if len(p.Params.WorkflowTypes) == 0 {
+			return fmt.Errorf("%w: at least one workflow type is required", ErrInvalidAdmissionPolicy)
+		}
+	case AdmissionPolicyTypeMaxReplicas:
+		if p.Params.MaxReplicas < 1 {
+			return fmt.Errorf("%w: max replicas must be greater than zero", ErrInvalidAdmissionPolicy)
+		}
+	case AdmissionPolicyTypeRequiredGPUNodeSelectors:
+		if len(p.Params.NodeSelectors) == 0 {
+			return fmt.Errorf("%w: at least one node selector is required", ErrInvalidAdmissionPolicy)
+		}
+	case AdmissionPolicyTypeRequiredResourceLimits:
+	}
+
+	return nil
+}
+
+// Evaluate returns a violation for each process of the version that does not pass the policy.
+func (p *AdmissionPolicy) Evaluate(version *Version) []AdmissionViolation {
+	var violations []AdmissionViolation
+
+	for _, workflow := range version.Workflows {
+		for _, process := range workflow.Processes {
+			if message := p.evaluateProcess(workflow, process); message != "" {
+				violations = append(violations, AdmissionViolation{
+					Policy:   p.Name,
+					Workflow: workflow.Name,
+					Process:  process.Name,
+					Message:  message,
+				})
+			}
+		}
+	}
+
+	return violations
+}
+
+func (p *AdmissionPolicy) evaluateProcess(workflow Workflow, process Process) string {
+	switch p.Type {
+	case AdmissionPolicyTypeAllowedRegistries:
+		if !slices.ContainsFunc(p.Params.Registries, func(registry string) bool {
+			return strings.HasPrefix(process.Image, strings.TrimSuffix(registry, "/")+"/")
+		}) {
+			return fmt.Sprintf("image %q is not from an allowed registry", process.Image)
+		}
+	case AdmissionPolicyTypeRequiredResourceLimits:
+		limits := process.ResourceLimits
+		if limits == nil || limits.CPU == nil || limits.Memory == nil {
+			return "cpu and memory resource limits are required"
+		}
+	case AdmissionPolicyTypeForbiddenGPU:
+		if process.GPU && slices.Contains(p.Params.WorkflowTypes, workflow.Type) {
+			return fmt.Sprintf("gpu is not allowed in %s workflows", workflow.Type)
+		}
+	case AdmissionPolicyTypeMaxReplicas:
+		replicas := process.Replicas
+		if process.Autoscaling != nil {
+			replicas = max(replicas, process.Autoscaling.MaxReplicas)
+		}
+
+		if replicas > p.Params.MaxReplicas {
+			return fmt.Sprintf("%d replicas exceed the maximum of %d", replicas, p.Params.MaxReplicas)
+		}
+	case AdmissionPolicyTypeRequiredGPUNodeSelectors:
+		if !process.GPU {
+			return ""
+		}
+
+		for _, nodeSelector := range p.Params.NodeSelectors {
+			if _, ok := process.NodeSelectors[nodeSelector]; !ok {
+				return fmt.Sprintf("gpu processes require the %q node selector", nodeSelector)
+			}
+		}
+	}
+
+	return ""
+}
+
+// AdmissionViolation describes a process that does not pass a policy.
+type AdmissionViolation struct {
+	Policy   string
+	Workflow string
+	Process  string
+	Message  string
+}
+
+func (v AdmissionViolation) String() string {
+	return fmt.Sprintf("[%s] %s.%s: %s", v.Policy, v.Workflow, v.Process, v.Message)
+}
+
+// AdmissionReport is the result of evaluating the admission policies against a version.
+// Violations of policies in dry-run mode are reported as warnings.
+type AdmissionReport struct {
+	Violations []AdmissionViolation
+	Warnings   []AdmissionViolation
+}
+
+func EvaluateAdmissionPolicies(policies []*AdmissionPolicy, version *Version) *AdmissionReport {
+	report := &AdmissionReport{}
+
+	for _, policy := range policies {
+		violations := policy.Evaluate(version)
+
+		if policy.Mode == AdmissionPolicyModeDryRun {
+			report.Warnings = append(report.Warnings, violations...)
+		} else {
+			report.Violations = append(report.Violations, violations...)
+		}
+	}
+
+	return report
+}
+
+func (r *AdmissionReport) IsAdmitted() bool {
+	return len(r.Violations) == 0
+}
+
+// AdmissionDeniedError is returned when a version violates any enforced admission policy.
+type AdmissionDeniedError struct {
+	Violations []AdmissionViolation
+}
+
+func (e AdmissionDeniedError) Error() string {
+	violations := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		violations = append(violations, violation.String())
+	}
+
+	return fmt.Sprintf("%s:\n%s", ErrVersionNotAdmitted, strings.Join(violations, "\n"))
+}
+
+func (e AdmissionDeniedError) Unwrap() error {
+	return ErrVersionNotAdmitted
+}
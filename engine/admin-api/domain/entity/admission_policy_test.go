@@ -0,0 +1,248 @@
+//go:build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdmissionPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      entity.AdmissionPolicy
+		expectedErr error
+	}{
+		{
+			name: "valid max replicas policy",
+			policy: entity.AdmissionPolicy{
+				Name:   "max-replicas",
+				Type:   entity.AdmissionPolicyTypeMaxReplicas,
+				Mode:   entity.AdmissionPolicyModeEnforce,
+				Params: entity.AdmissionPolicyParams{MaxReplicas: 3},
+			},
+		},
+		{
+			name: "valid resource limits policy without params",
+			policy: entity.AdmissionPolicy{
+				Name: "resource-limits",
+				Type: entity.AdmissionPolicyTypeRequiredResourceLimits,
+				Mode: entity.AdmissionPolicyModeDryRun,
+			},
+		},
+		{
+			name: "missing name",
+			policy: entity.AdmissionPolicy{
+				Type: entity.AdmissionPolicyTypeRequiredResourceLimits,
+				Mode: entity.AdmissionPolicyModeEnforce,
+			},
+			expectedErr: entity.ErrInvalidAdmissionPolicy,
+		},
+		{
+			name: "invalid type",
+			policy: entity.AdmissionPolicy{
+				Name: "unknown",
+				Type: "UNKNOWN",
+				Mode: entity.AdmissionPolicyModeEnforce,
+			},
+			expectedErr: entity.ErrInvalidAdmissionPolicyType,
+		},
+		{
+			name: "invalid mode",
+			policy: entity.AdmissionPolicy{
+				Name: "resource-limits",
+				Type: entity.AdmissionPolicyTypeRequiredResourceLimits,
+				Mode: "AUDIT",
+			},
+			expectedErr: entity.ErrInvalidAdmissionPolicyMode,
+		},
+		{
+			name: "allowed registries without registries",
+			policy: entity.AdmissionPolicy{
+				Name: "registries",
+				Type: entity.AdmissionPolicyTypeAllowedRegistries,
+				Mode: entity.AdmissionPolicyModeEnforce,
+			},
+			expectedErr: entity.ErrInvalidAdmissionPolicy,
+		},
+		{
+			name: "forbidden gpu without workflow types",
+			policy: entity.AdmissionPolicy{
+				Name: "no-gpu",
+				Type: entity.AdmissionPolicyTypeForbiddenGPU,
+				Mode: entity.AdmissionPolicyModeEnforce,
+			},
+			expectedErr: entity.ErrInvalidAdmissionPolicy,
+		},
+		{
+			name: "max replicas without a positive maximum",
+			policy: entity.AdmissionPolicy{
+				Name: "max-replicas",
+				Type: entity.AdmissionPolicyTypeMaxReplicas,
+				Mode: entity.AdmissionPolicyModeEnforce,
+			},
+			expectedErr: entity.ErrInvalidAdmissionPolicy,
+		},
+		{
+			name: "gpu node selectors without node selectors",
+			policy: entity.AdmissionPolicy{
+				Name: "gpu-nodes",
+				Type: entity.AdmissionPolicyTypeRequiredGPUNodeSelectors,
+				Mode: entity.AdmissionPolicyModeEnforce,
+			},
+			expectedErr: entity.ErrInvalidAdmissionPolicy,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestAdmissionPolicy_Evaluate(t *testing.T) {
+	version := &entity.Version{
+		Tag: "v1.0.0",
+		Workflows: []entity.Workflow{
+			{
+				Name: "training",
+				Type: entity.WorkflowTypeTraining,
+				Processes: []entity.Process{
+					{
+						Name:          "trainer",
+						Image:         "registry.kai.local/trainer:v1",
+						Replicas:      1,
+						GPU:           true,
+						NodeSelectors: map[string]string{"gpu-type": "a100"},
+						ResourceLimits: &entity.ProcessResourceLimits{
+							CPU:    &entity.ResourceLimit{Request: "1", Limit: "2"},
+							Memory: &entity.ResourceLimit{Request: "1Gi", Limit: "2Gi"},
+						},
+					},
+				},
+			},
+			{
+				Name: "serving",
+				Type: entity.WorkflowTypeServing,
+				Processes: []entity.Process{
+					{
+						Name:        "predictor",
+						Image:       "docker.io/predictor:v1",
+						Replicas:    2,
+						GPU:         true,
+						Autoscaling: &entity.ProcessAutoscaling{MaxReplicas: 5},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		policy             entity.AdmissionPolicy
+		expectedViolations []string
+	}{
+		{
+			name: "allowed registries",
+			policy: entity.AdmissionPolicy{
+				Type:   entity.AdmissionPolicyTypeAllowedRegistries,
+				Params: entity.AdmissionPolicyParams{Registries: []string{"registry.kai.local/"}},
+			},
+			expectedViolations: []string{"serving.predictor"},
+		},
+		{
+			name:               "required resource limits",
+			policy:             entity.AdmissionPolicy{Type: entity.AdmissionPolicyTypeRequiredResourceLimits},
+			expectedViolations: []string{"serving.predictor"},
+		},
+		{
+			name: "forbidden gpu",
+			policy: entity.AdmissionPolicy{
+				Type:   entity.AdmissionPolicyTypeForbiddenGPU,
+				Params: entity.AdmissionPolicyParams{WorkflowTypes: []entity.WorkflowType{entity.WorkflowTypeServing}},
+			},
+			expectedViolations: []string{"serving.predictor"},
+		},
+		{
+			name: "max replicas counts the autoscaling maximum",
+			policy: entity.AdmissionPolicy{
+				Type:   entity.AdmissionPolicyTypeMaxReplicas,
+				Params: entity.AdmissionPolicyParams{MaxReplicas: 3},
+			},
+			expectedViolations: []string{"serving.predictor"},
+		},
+		{
+			name: "required gpu node selectors",
+			policy: entity.AdmissionPolicy{
+				Type:   entity.AdmissionPolicyTypeRequiredGPUNodeSelectors,
+				Params: entity.AdmissionPolicyParams{NodeSelectors: []string{"gpu-type"}},
+			},
+			expectedViolations: []string{"serving.predictor"},
+		},
+		{
+			name: "no violations",
+			policy: entity.AdmissionPolicy{
+				Type:   entity.AdmissionPolicyTypeMaxReplicas,
+				Params: entity.AdmissionPolicyParams{MaxReplicas: 5},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var violations []string
+			for _, violation := range tc.policy.Evaluate(version) {
+				violations = append(violations, violation.Workflow+"."+violation.Process)
+			}
+
+			assert.Equal(t, tc.expectedViolations, violations)
+		})
+	}
+}
+
+func TestEvaluateAdmissionPolicies(t *testing.T) {
+	version := &entity.Version{
+		Workflows: []entity.Workflow{
+			{
+				Name:      "workflow",
+				Processes: []entity.Process{{Name: "process", Image: "docker.io/process:v1", Replicas: 4}},
+			},
+		},
+	}
+
+	enforced := &entity.AdmissionPolicy{
+		Name:   "max-replicas",
+		Type:   entity.AdmissionPolicyTypeMaxReplicas,
+		Mode:   entity.AdmissionPolicyModeEnforce,
+		Params: entity.AdmissionPolicyParams{MaxReplicas: 2},
+	}
+	dryRun := &entity.AdmissionPolicy{
+		Name:   "registries",
+		Type:   entity.AdmissionPolicyTypeAllowedRegistries,
+		Mode:   entity.AdmissionPolicyModeDryRun,
+		Params: entity.AdmissionPolicyParams{Registries: []string{"registry.kai.local"}},
+	}
+
+	report := entity.EvaluateAdmissionPolicies([]*entity.AdmissionPolicy{dryRun}, version)
+	assert.True(t, report.IsAdmitted())
+	assert.Len(t, report.Warnings, 1)
+
+	report = entity.EvaluateAdmissionPolicies([]*entity.AdmissionPolicy{enforced, dryRun}, version)
+	assert.False(t, report.IsAdmitted())
+	assert.Equal(t, []entity.AdmissionViolation{{
+		Policy:   "max-replicas",
+		Workflow: "workflow",
+		Process:  "process",
+		Message:  "4 replicas exceed the maximum of 2",
+	}}, report.Violations)
+	assert.Len(t, report.Warnings, 1)
+}
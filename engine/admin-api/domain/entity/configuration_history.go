@@ -0,0 +1,54 @@
+package entity
+
+import "time"
+
+type ConfigurationScope string
+
+const (
+	ConfigurationScopeGlobal   ConfigurationScope = "GLOBAL"
+	ConfigurationScopeVersion  ConfigurationScope = "VERSION"
+	ConfigurationScopeWorkflow ConfigurationScope = "WORKFLOW"
+	ConfigurationScopeProcess  ConfigurationScope = "PROCESS"
+)
+
+func (s ConfigurationScope) IsValid() bool {
+	switch s {
+	case ConfigurationScopeGlobal, ConfigurationScopeVersion, ConfigurationScopeWorkflow, ConfigurationScopeProcess:
+		return true
+	}
+
+	return false
+}
+
+func (s ConfigurationScope) String() string {
+	return string(s)
+}
+
+// ConfigurationStore identifies the key-value store of a configuration scope. Workflow and process are only
+// used by the scopes that need them.
+type ConfigurationStore struct {
+	ProductID  string
+	VersionTag string
+	Workflow   string
+	Process    string
+	Scope      ConfigurationScope
+}
+
+// ConfigurationEntry is the value of a configuration key and the scope it comes from.
+type ConfigurationEntry struct {
+	Key      string
+	Value    string
+	Scope    ConfigurationScope
+	Revision uint64
+	Created  time.Time
+}
+
+// ConfigurationRevision is a change of a configuration key kept in its key-value store history.
+type ConfigurationRevision struct {
+	Key      string
+	Value    string
+	Revision uint64
+	// Deleted is true when the revision removed the key.
+	Deleted bool
+	Created time.Time
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// DeadLetterMessage is a workflow message that a process could not handle within its maximum deliveries.
+// Sequence identifies it in the workflow dead-letter stream, while Subject, Headers and Data are the ones of
+// the original message, which are empty when the workflow stream no longer keeps it.
+type DeadLetterMessage struct {
+	Sequence       uint64
+	Stream         string
+	StreamSequence uint64
+	Consumer       string
+	Deliveries     uint64
+	Subject        string
+	Headers        map[string]string
+	Data           []byte
+	Timestamp      time.Time
+}
@@ -0,0 +1,8 @@
+package entity
+
+// KubernetesManifest is a k8s resource rendered as YAML.
+type KubernetesManifest struct {
+	Kind string
+	Name string
+	YAML string
+}
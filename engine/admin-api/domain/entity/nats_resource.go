@@ -0,0 +1,46 @@
+package entity
+
+import "strings"
+
+type NatsResourceType string
+
+const (
+	NatsResourceTypeStream        NatsResourceType = "STREAM"
+	NatsResourceTypeObjectStore   NatsResourceType = "OBJECT_STORE"
+	NatsResourceTypeKeyValueStore NatsResourceType = "KEY_VALUE_STORE"
+)
+
+func (t NatsResourceType) IsValid() bool {
+	switch t {
+	case NatsResourceTypeStream, NatsResourceTypeObjectStore, NatsResourceTypeKeyValueStore:
+		return true
+	}
+
+	return false
+}
+
+func (t NatsResourceType) String() string {
+	return string(t)
+}
+
+// NatsResource is a stream, object store or key-value store created by nats-manager for a product.
+// VersionKey is the part of the name that follows the product, starting with the version tag, and
+// it's empty for the resources shared by every version of the product.
+type NatsResource struct {
+	Name       string
+	Type       NatsResourceType
+	ProductID  string
+	VersionKey string
+}
+
+// IsVersionResource returns true if the resource belongs to a version instead of being shared by the product.
+func (r *NatsResource) IsVersionResource() bool {
+	return r.VersionKey != ""
+}
+
+// BelongsToVersion returns true if the resource was created for the version with the given tag.
+func (r *NatsResource) BelongsToVersion(versionTag string) bool {
+	tag := strings.ReplaceAll(versionTag, ".", "_")
+
+	return r.VersionKey == tag || strings.HasPrefix(r.VersionKey, tag+"_")
+}
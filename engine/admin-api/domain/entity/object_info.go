@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// ObjectInfo describes an object stored in a NATS object store.
+type ObjectInfo struct {
+	Name     string
+	Size     uint64
+	Digest   string
+	Modified time.Time
+}
@@ -1,9 +1,17 @@
 package entity
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
-	ErrInvalidProcessType = errors.New("invalid process type")
+	ErrInvalidProcessType        = errors.New("invalid process type")
+	ErrInvalidProcessAutoscaling = errors.New("invalid process autoscaling, max replicas must be greater or equal than min replicas")
+	ErrNothingToScale            = errors.New("replicas or autoscaling settings are required to scale a process")
+	ErrInvalidProbeType          = errors.New("invalid probe type, must be HTTP, GRPC, EXEC or TCP")
+	ErrMissingProbeCommand       = errors.New("exec probes require a command")
+	ErrInvalidMaxDeliver         = errors.New("invalid process max deliver, must be greater or equal than zero")
 )
 
 type Process struct {
@@ -19,7 +27,70 @@ type Process struct {
 	Networking     *ProcessNetworking
 	ResourceLimits *ProcessResourceLimits
 	Status         ProcessStatus
+	StatusMessage  string
+	Attempts       int32
+	Runtime        *ProcessRuntimeStatus
+	StreamStats    *ProcessStreamStats
 	NodeSelectors  map[string]string
+	Autoscaling    *ProcessAutoscaling
+	Probes         *ProcessProbes
+	KeyValueStore  *KeyValueStoreSettings
+	// MaxDeliver is how many times a message is delivered to the process before it's sent to the
+	// workflow dead-letter stream. Zero uses the platform default.
+	MaxDeliver int32
+}
+
+// GetResourceUsage returns the resources requested by all the process replicas.
+func (p *Process) GetResourceUsage() (ResourceUsage, error) {
+	replicas := int64(max(p.Replicas, 1))
+	if p.Autoscaling != nil {
+		replicas = max(replicas, int64(p.Autoscaling.MaxReplicas))
+	}
+
+	usage, err := p.GetReplicaResourceUsage()
+	if err != nil {
+		return ResourceUsage{}, err
+	}
+
+	if p.GPU {
+		usage.GPUProcesses = 1
+	}
+
+	usage.Replicas = replicas
+	usage.Pods = replicas
+	usage.CPUMillis *= replicas
+	usage.MemoryBytes *= replicas
+
+	return usage, nil
+}
+
+// GetReplicaResourceUsage returns the resources requested by a single replica of the process.
+func (p *Process) GetReplicaResourceUsage() (ResourceUsage, error) {
+	usage := ResourceUsage{Replicas: 1, Pods: 1}
+
+	if p.ResourceLimits == nil {
+		return usage, nil
+	}
+
+	if p.ResourceLimits.CPU != nil {
+		cpu, err := ParseCPUQuantity(p.ResourceLimits.CPU.Request)
+		if err != nil {
+			return ResourceUsage{}, err
+		}
+
+		usage.CPUMillis = cpu
+	}
+
+	if p.ResourceLimits.Memory != nil {
+		memory, err := ParseMemoryQuantity(p.ResourceLimits.Memory.Request)
+		if err != nil {
+			return ResourceUsage{}, err
+		}
+
+		usage.MemoryBytes = memory
+	}
+
+	return usage, nil
 }
 
 type ProcessType string
@@ -44,8 +115,9 @@ func (pt ProcessType) String() string {
 }
 
 type ProcessObjectStore struct {
-	Name  string
-	Scope ObjectStoreScope
+	Name     string
+	Scope    ObjectStoreScope
+	Settings *ObjectStoreSettings
 }
 
 type ObjectStoreScope string
@@ -72,6 +144,57 @@ const (
 	NetworkingProtocolGRPC NetworkingProtocol = "GRPC"
 )
 
+// ProcessProbes holds the health checks of a process. Networked processes
+// without probes are checked on their port.
+type ProcessProbes struct {
+	Liveness  *ProcessProbe
+	Readiness *ProcessProbe
+	Startup   *ProcessProbe
+}
+
+type ProcessProbe struct {
+	Type                ProbeType
+	Path                string
+	Port                int
+	Command             []string
+	GRPCService         string
+	InitialDelaySeconds int32
+	PeriodSeconds       int32
+	TimeoutSeconds      int32
+	SuccessThreshold    int32
+	FailureThreshold    int32
+}
+
+type ProbeType string
+
+const (
+	ProbeTypeHTTP ProbeType = "HTTP"
+	ProbeTypeGRPC ProbeType = "GRPC"
+	ProbeTypeExec ProbeType = "EXEC"
+	ProbeTypeTCP  ProbeType = "TCP"
+)
+
+func (pt ProbeType) Validate() error {
+	switch pt {
+	case ProbeTypeHTTP, ProbeTypeGRPC, ProbeTypeExec, ProbeTypeTCP:
+		return nil
+	default:
+		return ErrInvalidProbeType
+	}
+}
+
+func (p *ProcessProbe) Validate() error {
+	if err := p.Type.Validate(); err != nil {
+		return err
+	}
+
+	if p.Type == ProbeTypeExec && len(p.Command) == 0 {
+		return ErrMissingProbeCommand
+	}
+
+	return nil
+}
+
 type ResourceLimit struct {
 	Request string
 	Limit   string
@@ -82,6 +205,51 @@ type ProcessResourceLimits struct {
 	Memory *ResourceLimit
 }
 
+// ProcessAutoscaling holds the horizontal autoscaling settings of a process.
+// Zero values fall back to the defaults of the cluster. A ConsumerLagTarget scales the process
+// on its pending messages instead, allowing idle processes to scale down to zero replicas.
+type ProcessAutoscaling struct {
+	MinReplicas                   int32
+	MaxReplicas                   int32
+	CPUTargetPercentage           int32
+	MemoryTargetPercentage        int32
+	ScaleUpStabilizationSeconds   int32
+	ScaleDownStabilizationSeconds int32
+	ConsumerLagTarget             int32
+}
+
+func (a *ProcessAutoscaling) Validate() error {
+	if a.MinReplicas < 0 || a.MaxReplicas < a.MinReplicas {
+		return ErrInvalidProcessAutoscaling
+	}
+
+	if a.ConsumerLagTarget < 0 || (a.ConsumerLagTarget > 0 && a.MaxReplicas < 1) {
+		return ErrInvalidProcessAutoscaling
+	}
+
+	return nil
+}
+
+// ProcessScaling describes a scaling change for a process of a running version.
+type ProcessScaling struct {
+	Workflow    string
+	Process     string
+	Replicas    *int32
+	Autoscaling *ProcessAutoscaling
+}
+
+func (s *ProcessScaling) Validate() error {
+	if s.Replicas == nil && s.Autoscaling == nil {
+		return ErrNothingToScale
+	}
+
+	if s.Autoscaling != nil {
+		return s.Autoscaling.Validate()
+	}
+
+	return nil
+}
+
 type ProcessStatus string
 
 const (
@@ -89,11 +257,16 @@ const (
 	ProcessStatusStarted  ProcessStatus = "STARTED"
 	ProcessStatusStopped  ProcessStatus = "STOPPED"
 	ProcessStatusError    ProcessStatus = "ERROR"
+
+	// Processes of batch workflows finish with one of these statuses.
+	ProcessStatusSucceeded ProcessStatus = "SUCCEEDED"
+	ProcessStatusFailed    ProcessStatus = "FAILED"
 )
 
 func (ps ProcessStatus) IsValid() bool {
 	switch ps {
-	case ProcessStatusStarting, ProcessStatusStopped, ProcessStatusStarted, ProcessStatusError:
+	case ProcessStatusStarting, ProcessStatusStopped, ProcessStatusStarted, ProcessStatusError,
+		ProcessStatusSucceeded, ProcessStatusFailed:
 		return true
 	default:
 		return false
@@ -103,3 +276,33 @@ func (ps ProcessStatus) IsValid() bool {
 func (ps ProcessStatus) String() string {
 	return string(ps)
 }
+
+// ProcessRuntimeStatus is the live state of the process pods, so users can see why a process is unhealthy.
+type ProcessRuntimeStatus struct {
+	ReadyReplicas   int32
+	DesiredReplicas int32
+	Restarts        int32
+	// LastTerminationReason is the reason of the last container termination, like OOMKilled or Error,
+	// or CrashLoopBackOff while a container is waiting to be restarted.
+	LastTerminationReason string
+	// Events are the most recent k8s events of the process pods, newest first.
+	Events []ProcessEvent
+}
+
+type ProcessEvent struct {
+	Type          string
+	Reason        string
+	Message       string
+	Count         int32
+	LastTimestamp time.Time
+}
+
+// ProcessStatusReport is the status reported by k8s for a process of a running version.
+type ProcessStatusReport struct {
+	Workflow      string
+	Process       string
+	Status        ProcessStatus
+	StatusMessage string
+	Attempts      int32
+	Runtime       *ProcessRuntimeStatus
+}
@@ -25,3 +25,62 @@ func TestProcessType_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessScaling_Validate(t *testing.T) {
+	replicas := int32(2)
+
+	testCases := []struct {
+		name        string
+		scaling     entity.ProcessScaling
+		expecterErr error
+	}{
+		{"valid replicas", entity.ProcessScaling{Replicas: &replicas}, nil},
+		{
+			"valid autoscaling",
+			entity.ProcessScaling{Autoscaling: &entity.ProcessAutoscaling{MinReplicas: 1, MaxReplicas: 3}},
+			nil,
+		},
+		{"nothing to scale", entity.ProcessScaling{}, entity.ErrNothingToScale},
+		{
+			"max replicas lower than min replicas",
+			entity.ProcessScaling{Autoscaling: &entity.ProcessAutoscaling{MinReplicas: 3, MaxReplicas: 1}},
+			entity.ErrInvalidProcessAutoscaling,
+		},
+		{
+			"valid consumer lag autoscaling down to zero replicas",
+			entity.ProcessScaling{Autoscaling: &entity.ProcessAutoscaling{MaxReplicas: 4, ConsumerLagTarget: 100}},
+			nil,
+		},
+		{
+			"consumer lag autoscaling without max replicas",
+			entity.ProcessScaling{Autoscaling: &entity.ProcessAutoscaling{ConsumerLagTarget: 100}},
+			entity.ErrInvalidProcessAutoscaling,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.scaling.Validate(), tc.expecterErr)
+		})
+	}
+}
+
+func TestProcessProbe_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		probe       entity.ProcessProbe
+		expecterErr error
+	}{
+		{"valid http probe", entity.ProcessProbe{Type: entity.ProbeTypeHTTP, Path: "/health"}, nil},
+		{"valid tcp probe", entity.ProcessProbe{Type: entity.ProbeTypeTCP}, nil},
+		{"valid exec probe", entity.ProcessProbe{Type: entity.ProbeTypeExec, Command: []string{"true"}}, nil},
+		{"invalid probe type", entity.ProcessProbe{Type: "invalid-probe-type"}, entity.ErrInvalidProbeType},
+		{"exec probe without command", entity.ProcessProbe{Type: entity.ProbeTypeExec}, entity.ErrMissingProbeCommand},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.probe.Validate(), tc.expecterErr)
+		})
+	}
+}
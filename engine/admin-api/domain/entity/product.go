@@ -21,6 +21,7 @@ type Product struct {
 	KeyValueStore      string             `bson:"keyValueStore"`
 	PublishedVersion   *string            `bson:"publishedVersion"`
 	ServiceAccount     ServiceAccount     `bson:"serviceAccount"`
+	Quota              *ProductQuota      `bson:"quota,omitempty"`
 }
 
 type MinioConfiguration struct {
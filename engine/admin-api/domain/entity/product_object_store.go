@@ -0,0 +1,22 @@
+package entity
+
+import "fmt"
+
+// ProductObjectStore is a NATS object store shared by every version of a product. Processes use it
+// through product scoped object stores, and it isn't deleted when versions stop.
+type ProductObjectStore struct {
+	Name   string
+	Bucket string
+}
+
+func NewProductObjectStore(productID, name string) *ProductObjectStore {
+	return &ProductObjectStore{
+		Name:   name,
+		Bucket: GetProductObjectStoreName(productID, name),
+	}
+}
+
+// GetProductObjectStoreName returns the name nats-manager gives to the object store of a product.
+func GetProductObjectStoreName(productID, name string) string {
+	return fmt.Sprintf("object-store_%s_%s", productID, name)
+}
@@ -0,0 +1,184 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var (
+	ErrInvalidProductQuota  = errors.New("invalid product quota")
+	ErrProductQuotaExceeded = errors.New("product quota exceeded")
+	ErrInvalidQuantity      = errors.New("invalid resource quantity")
+)
+
+// ProductQuota caps the resources that the running versions of a product can request.
+// Zero values mean there is no limit for that resource. The CPU and memory requests and the
+// pods are also enforced by the resource quota of the product namespace, when products are
+// deployed in their own namespace.
+type ProductQuota struct {
+	MaxStartedVersions int32  `bson:"maxStartedVersions"`
+	CPURequests        string `bson:"cpuRequests"`
+	MemoryRequests     string `bson:"memoryRequests"`
+	MaxGPUProcesses    int32  `bson:"maxGPUProcesses"`
+	MaxReplicas        int32  `bson:"maxReplicas"`
+	MaxPods            int32  `bson:"maxPods"`
+}
+
+func (q *ProductQuota) Validate() error {
+	if q.MaxStartedVersions < 0 || q.MaxGPUProcesses < 0 || q.MaxReplicas < 0 || q.MaxPods < 0 {
+		return fmt.Errorf("%w: limits cannot be negative", ErrInvalidProductQuota)
+	}
+
+	if _, err := ParseCPUQuantity(q.CPURequests); err != nil {
+		return fmt.Errorf("%w: cpu requests: %w", ErrInvalidProductQuota, err)
+	}
+
+	if _, err := ParseMemoryQuantity(q.MemoryRequests); err != nil {
+		return fmt.Errorf("%w: memory requests: %w", ErrInvalidProductQuota, err)
+	}
+
+	return nil
+}
+
+// Check returns a ProductQuotaExceededError if adding the requested resources to the ones
+// already in use goes over any of the quota limits.
+func (q *ProductQuota) Check(inUse, requested ResourceUsage) error {
+	total := inUse.Add(requested)
+
+	var exceeded []QuotaExcess
+
+	addIfExceeded := func(resource string, inUse, requested, total, limit int64, format func(int64) string) {
+		if limit > 0 && total > limit {
+			exceeded = append(exceeded, QuotaExcess{
+				Resource:  resource,
+				InUse:     format(inUse),
+				Requested: format(requested),
+				Limit:     format(limit),
+			})
+		}
+	}
+
+	formatCount := func(value int64) string { return strconv.FormatInt(value, 10) }
+
+	cpuLimit, _ := ParseCPUQuantity(q.CPURequests)
+	memoryLimit, _ := ParseMemoryQuantity(q.MemoryRequests)
+
+	addIfExceeded("started versions", inUse.StartedVersions, requested.StartedVersions, total.StartedVersions,
+		int64(q.MaxStartedVersions), formatCount)
+	addIfExceeded("cpu requests", inUse.CPUMillis, requested.CPUMillis, total.CPUMillis, cpuLimit, formatCPU)
+	addIfExceeded("memory requests", inUse.MemoryBytes, requested.MemoryBytes, total.MemoryBytes, memoryLimit, formatMemory)
+	addIfExceeded("gpu processes", inUse.GPUProcesses, requested.GPUProcesses, total.GPUProcesses,
+		int64(q.MaxGPUProcesses), formatCount)
+	addIfExceeded("replicas", inUse.Replicas, requested.Replicas, total.Replicas, int64(q.MaxReplicas), formatCount)
+	addIfExceeded("pods", inUse.Pods, requested.Pods, total.Pods, int64(q.MaxPods), formatCount)
+
+	if len(exceeded) > 0 {
+		return ProductQuotaExceededError{Exceeded: exceeded}
+	}
+
+	return nil
+}
+
+// ResourceUsage sums the resources requested by one or more versions. Every process replica runs
+// in its own pod, so Pods counts the replicas plus the extra pods of rolling updates.
+type ResourceUsage struct {
+	StartedVersions int64
+	CPUMillis       int64
+	MemoryBytes     int64
+	GPUProcesses    int64
+	Replicas        int64
+	Pods            int64
+}
+
+func (u ResourceUsage) Add(other ResourceUsage) ResourceUsage {
+	return ResourceUsage{
+		StartedVersions: u.StartedVersions + other.StartedVersions,
+		CPUMillis:       u.CPUMillis + other.CPUMillis,
+		MemoryBytes:     u.MemoryBytes + other.MemoryBytes,
+		GPUProcesses:    u.GPUProcesses + other.GPUProcesses,
+		Replicas:        u.Replicas + other.Replicas,
+		Pods:            u.Pods + other.Pods,
+	}
+}
+
+type QuotaExcess struct {
+	Resource  string
+	InUse     string
+	Requested string
+	Limit     string
+}
+
+// ProductQuotaExceededError details every resource that would go over the product quota.
+type ProductQuotaExceededError struct {
+	Exceeded []QuotaExcess
+}
+
+func (e ProductQuotaExceededError) Error() string {
+	breakdown := make([]string, 0, len(e.Exceeded))
+
+	for _, excess := range e.Exceeded {
+		breakdown = append(breakdown, fmt.Sprintf(
+			"%s: in use %s, requested %s, limit %s", excess.Resource, excess.InUse, excess.Requested, excess.Limit,
+		))
+	}
+
+	return fmt.Sprintf("%s: %s", ErrProductQuotaExceeded, strings.Join(breakdown, "; "))
+}
+
+func (e ProductQuotaExceededError) Unwrap() error {
+	return ErrProductQuotaExceeded
+}
+
+// ParseCPUQuantity parses a Kubernetes CPU quantity like "500m" or "1.5" into millicores.
+// An empty quantity is parsed as zero.
+func ParseCPUQuantity(quantity string) (int64, error) {
+	parsed, err := parseQuantity(quantity)
+	if err != nil {
+		return 0, err
+	}
+
+	return parsed.MilliValue(), nil
+}
+
+// ParseMemoryQuantity parses a Kubernetes memory quantity like "512Mi" or "1G" into bytes.
+// An empty quantity is parsed as zero.
+func ParseMemoryQuantity(quantity string) (int64, error) {
+	parsed, err := parseQuantity(quantity)
+	if err != nil {
+		return 0, err
+	}
+
+	return parsed.Value(), nil
+}
+
+func parseQuantity(quantity string) (resource.Quantity, error) {
+	if quantity == "" {
+		return resource.Quantity{}, nil
+	}
+
+	parsed, err := resource.ParseQuantity(quantity)
+	if err != nil || parsed.Sign() < 0 {
+		return resource.Quantity{}, fmt.Errorf("%w: %q", ErrInvalidQuantity, quantity)
+	}
+
+	return parsed, nil
+}
+
+func formatCPU(millis int64) string {
+	return fmt.Sprintf("%dm", millis)
+}
+
+func formatMemory(bytes int64) string {
+	switch {
+	case bytes >= 1<<30 && bytes%(1<<30) == 0:
+		return fmt.Sprintf("%dGi", bytes>>30)
+	case bytes >= 1<<20 && bytes%(1<<20) == 0:
+		return fmt.Sprintf("%dMi", bytes>>20)
+	default:
+		return strconv.FormatInt(bytes, 10)
+	}
+}
@@ -0,0 +1,161 @@
+//go:build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCPUQuantity(t *testing.T) {
+	tests := []struct {
+		quantity string
+		expected int64
+		wantErr  bool
+	}{
+		{"", 0, false},
+		{"250m", 250, false},
+		{"2", 2000, false},
+		{"0.5", 500, false},
+		{"1.5m", 2, false},
+		{"one", 0, true},
+		{"-1", 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.quantity, func(t *testing.T) {
+			millis, err := entity.ParseCPUQuantity(tc.quantity)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidQuantity)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, millis)
+		})
+	}
+}
+
+func TestParseMemoryQuantity(t *testing.T) {
+	tests := []struct {
+		quantity string
+		expected int64
+		wantErr  bool
+	}{
+		{"", 0, false},
+		{"1024", 1024, false},
+		{"128Mi", 128 << 20, false},
+		{"1.5Gi", 3 << 29, false},
+		{"2G", 2e9, false},
+		{"-1Mi", 0, true},
+		{"10Xi", 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.quantity, func(t *testing.T) {
+			bytes, err := entity.ParseMemoryQuantity(tc.quantity)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidQuantity)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, bytes)
+		})
+	}
+}
+
+func TestProductQuota_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		quota   entity.ProductQuota
+		wantErr bool
+	}{
+		{"empty quota", entity.ProductQuota{}, false},
+		{"valid quota", entity.ProductQuota{MaxStartedVersions: 2, CPURequests: "4", MemoryRequests: "8Gi", MaxReplicas: 10}, false},
+		{"negative limit", entity.ProductQuota{MaxReplicas: -1}, true},
+		{"negative pods", entity.ProductQuota{MaxPods: -1}, true},
+		{"invalid cpu", entity.ProductQuota{CPURequests: "four"}, true},
+		{"invalid memory", entity.ProductQuota{MemoryRequests: "8 gigas"}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if tc.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidProductQuota)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProductQuota_Check(t *testing.T) {
+	quota := &entity.ProductQuota{
+		MaxStartedVersions: 1,
+		MemoryRequests:     "1Gi",
+		MaxGPUProcesses:    1,
+	}
+
+	inUse := entity.ResourceUsage{StartedVersions: 1, MemoryBytes: 512 << 20, GPUProcesses: 1}
+	requested := entity.ResourceUsage{StartedVersions: 1, MemoryBytes: 256 << 20}
+
+	err := quota.Check(inUse, requested)
+	require.ErrorIs(t, err, entity.ErrProductQuotaExceeded)
+	assert.EqualError(t, err, "product quota exceeded: started versions: in use 1, requested 1, limit 1")
+
+	assert.NoError(t, quota.Check(entity.ResourceUsage{}, requested))
+}
+
+func TestProductQuota_Check_MaxPods(t *testing.T) {
+	quota := &entity.ProductQuota{MaxPods: 4}
+
+	inUse := entity.ResourceUsage{StartedVersions: 1, Replicas: 3, Pods: 3}
+	requested := entity.ResourceUsage{StartedVersions: 1, Replicas: 2, Pods: 2}
+
+	err := quota.Check(inUse, requested)
+	require.ErrorIs(t, err, entity.ErrProductQuotaExceeded)
+	assert.EqualError(t, err, "product quota exceeded: pods: in use 3, requested 2, limit 4")
+
+	assert.NoError(t, quota.Check(entity.ResourceUsage{}, requested))
+}
+
+func TestVersion_GetResourceUsage(t *testing.T) {
+	version := &entity.Version{
+		Workflows: []entity.Workflow{
+			{
+				Name: "workflow",
+				Processes: []entity.Process{
+					{
+						Name:     "autoscaled",
+						Replicas: 1,
+						GPU:      true,
+						ResourceLimits: &entity.ProcessResourceLimits{
+							CPU:    &entity.ResourceLimit{Request: "100m"},
+							Memory: &entity.ResourceLimit{Request: "64Mi"},
+						},
+						Autoscaling: &entity.ProcessAutoscaling{MinReplicas: 1, MaxReplicas: 3},
+					},
+					{
+						Name: "without-limits",
+					},
+				},
+			},
+		},
+	}
+
+	usage, err := version.GetResourceUsage()
+	require.NoError(t, err)
+	assert.Equal(t, entity.ResourceUsage{
+		StartedVersions: 1,
+		CPUMillis:       300,
+		MemoryBytes:     192 << 20,
+		GPUProcesses:    1,
+		Replicas:        4,
+		Pods:            4,
+	}, usage)
+}
@@ -0,0 +1,61 @@
+package entity
+
+import "errors"
+
+// MaxKeyValueStoreHistory is the highest number of values per key a NATS key-value store can keep.
+const MaxKeyValueStoreHistory = 64
+
+var (
+	ErrInvalidStoreLimits          = errors.New("invalid store limits, must be greater or equal than zero")
+	ErrInvalidKeyValueStoreHistory = errors.New("invalid key-value store history, must be between 0 and 64")
+	ErrProductObjectStoreSettings  = errors.New("product object stores cannot define settings")
+)
+
+// ObjectStoreSettings holds the JetStream settings of a process object store. Zero values keep the defaults.
+type ObjectStoreSettings struct {
+	TTLSeconds int64
+	MaxBytes   int64
+	Replicas   int32
+	Storage    StreamStorage
+}
+
+func (s *ObjectStoreSettings) Validate() error {
+	if s.Storage != "" {
+		if err := s.Storage.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if s.TTLSeconds < 0 || s.MaxBytes < 0 || s.Replicas < 0 {
+		return ErrInvalidStoreLimits
+	}
+
+	return nil
+}
+
+// KeyValueStoreSettings holds the JetStream settings of a key-value store. Zero values keep the defaults.
+type KeyValueStoreSettings struct {
+	TTLSeconds int64
+	MaxBytes   int64
+	History    int32
+	Replicas   int32
+	Storage    StreamStorage
+}
+
+func (s *KeyValueStoreSettings) Validate() error {
+	if s.Storage != "" {
+		if err := s.Storage.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if s.TTLSeconds < 0 || s.MaxBytes < 0 || s.Replicas < 0 {
+		return ErrInvalidStoreLimits
+	}
+
+	if s.History < 0 || s.History > MaxKeyValueStoreHistory {
+		return ErrInvalidKeyValueStoreHistory
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+//go:build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStoreSettings_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		settings    entity.ObjectStoreSettings
+		expectedErr error
+	}{
+		{"empty settings", entity.ObjectStoreSettings{}, nil},
+		{"valid settings", entity.ObjectStoreSettings{TTLSeconds: 60, Storage: entity.StreamStorageMemory}, nil},
+		{"invalid storage", entity.ObjectStoreSettings{Storage: "disk"}, entity.ErrInvalidStreamStorage},
+		{"negative max bytes", entity.ObjectStoreSettings{MaxBytes: -1}, entity.ErrInvalidStoreLimits},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.settings.Validate(), tc.expectedErr)
+		})
+	}
+}
+
+func TestKeyValueStoreSettings_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		settings    entity.KeyValueStoreSettings
+		expectedErr error
+	}{
+		{"empty settings", entity.KeyValueStoreSettings{}, nil},
+		{"valid settings", entity.KeyValueStoreSettings{History: 64, Replicas: 3}, nil},
+		{"invalid storage", entity.KeyValueStoreSettings{Storage: "disk"}, entity.ErrInvalidStreamStorage},
+		{"negative ttl", entity.KeyValueStoreSettings{TTLSeconds: -1}, entity.ErrInvalidStoreLimits},
+		{"history too long", entity.KeyValueStoreSettings{History: 65}, entity.ErrInvalidKeyValueStoreHistory},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.settings.Validate(), tc.expectedErr)
+		})
+	}
+}
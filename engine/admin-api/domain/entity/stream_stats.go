@@ -0,0 +1,27 @@
+package entity
+
+// WorkflowStreamStats holds the messages stored in a workflow stream and the state of its consumers.
+type WorkflowStreamStats struct {
+	Workflow      string
+	Stream        string
+	Messages      int64
+	Bytes         int64
+	FirstSequence int64
+	LastSequence  int64
+	Consumers     ConsumerStats
+	Processes     []*ProcessStreamStats
+}
+
+// ProcessStreamStats holds the messages published to a process subject and the state of the process consumers.
+type ProcessStreamStats struct {
+	Process   string
+	Subject   string
+	Messages  int64
+	Consumers ConsumerStats
+}
+
+type ConsumerStats struct {
+	Pending     int64
+	AckPending  int64
+	Redelivered int64
+}
@@ -14,6 +14,21 @@ const (
 	UserActivityTypeStartVersion        UserActivityType = "START_VERSION"
 	UserActivityTypeStopVersion         UserActivityType = "STOP_VERSION"
 	UserActivityTypeUpdateProductGrants UserActivityType = "UPDATE_PRODUCT_GRANTS"
+	UserActivityTypeUpdateProcessImage  UserActivityType = "UPDATE_PROCESS_IMAGE"
+	UserActivityTypeScaleProcess        UserActivityType = "SCALE_PROCESS"
+	UserActivityTypeRunWorkflow         UserActivityType = "RUN_WORKFLOW"
+	UserActivityTypeReplayDeadLetters   UserActivityType = "REPLAY_DEAD_LETTER_MESSAGES"
+	UserActivityTypePurgeDeadLetters    UserActivityType = "PURGE_DEAD_LETTER_MESSAGES"
+	UserActivityTypeInjectMessage       UserActivityType = "INJECT_MESSAGE"
+	UserActivityTypeRollbackConfig      UserActivityType = "ROLLBACK_CONFIGURATION"
+	UserActivityTypeSetProductConfig    UserActivityType = "SET_PRODUCT_CONFIGURATION"
+	UserActivityTypeDeleteProductConfig UserActivityType = "DELETE_PRODUCT_CONFIGURATION"
+	UserActivityTypeCreateObjectStore   UserActivityType = "CREATE_PRODUCT_OBJECT_STORE"
+	UserActivityTypeDeleteObjectStore   UserActivityType = "DELETE_PRODUCT_OBJECT_STORE"
+	UserActivityTypeUploadObject        UserActivityType = "UPLOAD_OBJECT"
+	UserActivityTypeDeleteObject        UserActivityType = "DELETE_OBJECT"
+	UserActivityTypeStartShadow         UserActivityType = "START_SHADOW"
+	UserActivityTypeStopShadow          UserActivityType = "STOP_SHADOW"
 )
 
 func (e UserActivityType) IsValid() bool {
@@ -24,7 +39,22 @@ func (e UserActivityType) IsValid() bool {
 		UserActivityTypeUnpublishVersion,
 		UserActivityTypeStartVersion,
 		UserActivityTypeStopVersion,
-		UserActivityTypeUpdateProductGrants:
+		UserActivityTypeUpdateProductGrants,
+		UserActivityTypeUpdateProcessImage,
+		UserActivityTypeScaleProcess,
+		UserActivityTypeRunWorkflow,
+		UserActivityTypeReplayDeadLetters,
+		UserActivityTypePurgeDeadLetters,
+		UserActivityTypeInjectMessage,
+		UserActivityTypeRollbackConfig,
+		UserActivityTypeSetProductConfig,
+		UserActivityTypeDeleteProductConfig,
+		UserActivityTypeCreateObjectStore,
+		UserActivityTypeDeleteObjectStore,
+		UserActivityTypeUploadObject,
+		UserActivityTypeDeleteObject,
+		UserActivityTypeStartShadow,
+		UserActivityTypeStopShadow:
 		return true
 	}
 
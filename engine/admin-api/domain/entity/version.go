@@ -2,11 +2,21 @@ package entity
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
+// _cronFields is the number of fields of a cron schedule: minute, hour, day of month, month and day of week.
+const _cronFields = 5
+
 var (
-	ErrInvalidVersionStatus = errors.New("invalid version status")
+	ErrInvalidVersionStatus   = errors.New("invalid version status")
+	ErrInvalidStreamRetention = errors.New("invalid stream retention, must be INTEREST, LIMITS or WORKQUEUE")
+	ErrInvalidStreamStorage   = errors.New("invalid stream storage, must be FILE or MEMORY")
+	ErrInvalidStreamLimits    = errors.New("invalid stream limits, must be greater or equal than zero")
+	ErrInvalidWorkflowJob     = errors.New("invalid workflow job, limits must be greater or equal than zero")
+	ErrInvalidJobSchedule     = errors.New("invalid workflow job schedule, must be a cron expression")
 )
 
 type ConfigurationVariable struct {
@@ -30,6 +40,24 @@ type Version struct {
 	Error  string
 
 	PublishedTriggers []PublishedTrigger
+
+	Patches []VersionPatch
+
+	Shadow *VersionShadow
+
+	Drain *VersionDrain
+
+	KeyValueStore *KeyValueStoreSettings
+}
+
+// VersionPatch records an in-place change applied to a running version.
+type VersionPatch struct {
+	Workflow      string
+	Process       string
+	PreviousImage string
+	Image         string
+	Author        string
+	Date          time.Time
 }
 
 type PublishedTrigger struct {
@@ -44,6 +72,7 @@ const (
 	VersionStatusStarting  VersionStatus = "STARTING"
 	VersionStatusStarted   VersionStatus = "STARTED"
 	VersionStatusPublished VersionStatus = "PUBLISHED"
+	VersionStatusDraining  VersionStatus = "DRAINING"
 	VersionStatusStopping  VersionStatus = "STOPPING"
 	VersionStatusStopped   VersionStatus = "STOPPED"
 	VersionStatusError     VersionStatus = "ERROR"
@@ -57,7 +86,7 @@ func (vs VersionStatus) String() string {
 func (vs VersionStatus) Validate() error {
 	switch vs {
 	case VersionStatusCreated, VersionStatusStarting, VersionStatusStarted, VersionStatusPublished,
-		VersionStatusStopping, VersionStatusStopped, VersionStatusError, VersionStatusCritical:
+		VersionStatusDraining, VersionStatusStopping, VersionStatusStopped, VersionStatusError, VersionStatusCritical:
 		return nil
 	default:
 		return ErrInvalidVersionStatus
@@ -98,16 +127,208 @@ func (v *Version) CanBeStarted() bool {
 	}
 }
 
+// CanBeStopped tells if the version can be stopped. Draining versions can be stopped too, cancelling the drain.
 func (v *Version) CanBeStopped() bool {
+	return v.Status == VersionStatusStarted || v.Status == VersionStatusDraining
+}
+
+func (v *Version) CanBeDrained() bool {
 	return v.Status == VersionStatusStarted
 }
 
+func (v *Version) CanBePatched() bool {
+	return v.Status == VersionStatusStarted || v.Status == VersionStatusPublished
+}
+
+// IsRunning tells if the version processes are deployed, so they count against the product quota.
+func (v *Version) IsRunning() bool {
+	switch v.Status {
+	case VersionStatusStarting, VersionStatusStarted, VersionStatusPublished, VersionStatusDraining:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetResourceUsage sums the resources requested by all the version processes. Processes with
+// autoscaling count their maximum replicas, as that is what they can grow to.
+func (v *Version) GetResourceUsage() (ResourceUsage, error) {
+	usage := ResourceUsage{StartedVersions: 1}
+
+	for _, workflow := range v.Workflows {
+		for _, process := range workflow.Processes {
+			processUsage, err := process.GetResourceUsage()
+			if err != nil {
+				return ResourceUsage{}, fmt.Errorf("process %q of workflow %q: %w", process.Name, workflow.Name, err)
+			}
+
+			usage = usage.Add(processUsage)
+		}
+	}
+
+	return usage, nil
+}
+
+// GetProcess returns a reference to the given process so it can be modified in place.
+func (v *Version) GetProcess(workflowName, processName string) (*Process, bool) {
+	for i := range v.Workflows {
+		if v.Workflows[i].Name != workflowName {
+			continue
+		}
+
+		for j := range v.Workflows[i].Processes {
+			if v.Workflows[i].Processes[j].Name == processName {
+				return &v.Workflows[i].Processes[j], true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// SetProcessesStatus updates the version processes with the status reported by k8s.
+func (v *Version) SetProcessesStatus(reports []*ProcessStatusReport) {
+	for _, report := range reports {
+		process, found := v.GetProcess(report.Workflow, report.Process)
+		if !found {
+			continue
+		}
+
+		process.Status = report.Status
+		process.StatusMessage = report.StatusMessage
+		process.Attempts = report.Attempts
+		process.Runtime = report.Runtime
+	}
+}
+
+// SetStreamStats updates the version workflows and processes with the stats of their NATS streams.
+func (v *Version) SetStreamStats(stats []*WorkflowStreamStats) {
+	for _, workflowStats := range stats {
+		workflow, found := v.GetWorkflow(workflowStats.Workflow)
+		if !found {
+			continue
+		}
+
+		workflow.StreamStats = workflowStats
+
+		for _, processStats := range workflowStats.Processes {
+			if process, found := v.GetProcess(workflow.Name, processStats.Process); found {
+				process.StreamStats = processStats
+			}
+		}
+	}
+}
+
+// GetWorkflow returns a reference to the given workflow.
+func (v *Version) GetWorkflow(workflowName string) (*Workflow, bool) {
+	for i := range v.Workflows {
+		if v.Workflows[i].Name == workflowName {
+			return &v.Workflows[i], true
+		}
+	}
+
+	return nil, false
+}
+
 type Workflow struct {
-	Name      string
-	Type      WorkflowType
-	Config    []ConfigurationVariable
-	Processes []Process
-	Stream    string
+	Name           string
+	Type           WorkflowType
+	Config         []ConfigurationVariable
+	Processes      []Process
+	Stream         string
+	Job            *WorkflowJob
+	StreamSettings *WorkflowStreamSettings
+	StreamStats    *WorkflowStreamStats
+	KeyValueStore  *KeyValueStoreSettings
+}
+
+// IsBatch tells if the workflow processes run to completion as jobs. Only workflows with job settings are batch.
+func (w *Workflow) IsBatch() bool {
+	return w.Job != nil
+}
+
+// WorkflowJob holds how the processes of a batch workflow are run. Zero values use the cluster defaults.
+type WorkflowJob struct {
+	Schedule                string
+	BackoffLimit            int32
+	ActiveDeadlineSeconds   int64
+	TTLSecondsAfterFinished int32
+}
+
+func (j *WorkflowJob) Validate() error {
+	if j.BackoffLimit < 0 || j.ActiveDeadlineSeconds < 0 || j.TTLSecondsAfterFinished < 0 {
+		return ErrInvalidWorkflowJob
+	}
+
+	// Only the shape of the schedule is checked, Kubernetes validates its fields when the cron job is created.
+	if j.Schedule != "" && !strings.HasPrefix(j.Schedule, "@") && len(strings.Fields(j.Schedule)) != _cronFields {
+		return ErrInvalidJobSchedule
+	}
+
+	return nil
+}
+
+type StreamRetention string
+
+const (
+	StreamRetentionInterest  StreamRetention = "INTEREST"
+	StreamRetentionLimits    StreamRetention = "LIMITS"
+	StreamRetentionWorkQueue StreamRetention = "WORKQUEUE"
+)
+
+func (r StreamRetention) Validate() error {
+	switch r {
+	case StreamRetentionInterest, StreamRetentionLimits, StreamRetentionWorkQueue:
+		return nil
+	default:
+		return ErrInvalidStreamRetention
+	}
+}
+
+type StreamStorage string
+
+const (
+	StreamStorageFile   StreamStorage = "FILE"
+	StreamStorageMemory StreamStorage = "MEMORY"
+)
+
+func (s StreamStorage) Validate() error {
+	switch s {
+	case StreamStorageFile, StreamStorageMemory:
+		return nil
+	default:
+		return ErrInvalidStreamStorage
+	}
+}
+
+// WorkflowStreamSettings holds the JetStream settings of the workflow stream. Zero values use the NATS defaults.
+type WorkflowStreamSettings struct {
+	Retention     StreamRetention
+	MaxAgeSeconds int64
+	MaxBytes      int64
+	MaxMsgSize    int32
+	Replicas      int32
+	Storage       StreamStorage
+}
+
+func (s *WorkflowStreamSettings) Validate() error {
+	if s.Retention != "" {
+		if err := s.Retention.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if s.Storage != "" {
+		if err := s.Storage.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if s.MaxAgeSeconds < 0 || s.MaxBytes < 0 || s.MaxMsgSize < 0 || s.Replicas < 0 {
+		return ErrInvalidStreamLimits
+	}
+
+	return nil
 }
 
 func (v *Version) cleanError() {
@@ -16,6 +16,9 @@ type VersionStreamingResources struct {
 	Streams        *VersionStreams
 	ObjectStores   *VersionObjectStores
 	KeyValueStores *KeyValueStores
+	// NatsCredentials are the credentials the version processes use to connect to NATS, empty when
+	// NATS authentication is disabled.
+	NatsCredentials string
 }
 
 func NewVersionConfig(streamsConfig *VersionStreams, objectStoresConfig *VersionObjectStores,
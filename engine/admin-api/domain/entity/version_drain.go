@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// VersionDrain tracks the drain of a version being stopped. The version triggers stop receiving new traffic and
+// the version waits, until the deadline at most, for the messages in its streams to be processed.
+type VersionDrain struct {
+	Date            time.Time
+	Deadline        time.Time
+	PendingMessages int64
+	TimedOut        bool
+	// Author and Comment are kept to register the stop action when a drain is resumed after a restart.
+	Author  string
+	Comment string
+}
+
+// GetPendingMessages adds up the messages the consumers of the given streams have yet to deliver or to get acked.
+func GetPendingMessages(streamStats []*WorkflowStreamStats) int64 {
+	var pending int64
+
+	for _, stats := range streamStats {
+		pending += stats.Consumers.Pending + stats.Consumers.AckPending
+	}
+
+	return pending
+}
@@ -0,0 +1,20 @@
+//go:build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPendingMessages(t *testing.T) {
+	streamStats := []*entity.WorkflowStreamStats{
+		{Workflow: "serving", Consumers: entity.ConsumerStats{Pending: 3, AckPending: 1, Redelivered: 7}},
+		{Workflow: "feedback", Consumers: entity.ConsumerStats{Pending: 2}},
+	}
+
+	assert.Equal(t, int64(6), entity.GetPendingMessages(streamStats))
+	assert.Zero(t, entity.GetPendingMessages(nil))
+}
@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+// VersionShadow records that a started version receives a copy of the messages entering the triggers of
+// the published version, to validate it on real traffic without affecting the responses.
+type VersionShadow struct {
+	PublishedVersion string
+	Author           string
+	Date             time.Time
+}
+
+// ShadowWorkflow is a workflow whose triggers receive the traffic of the same triggers of the published version.
+type ShadowWorkflow struct {
+	Name     string
+	Triggers []string
+}
+
+func (v *Version) CanBeShadowed() bool {
+	return v.Status == VersionStatusStarted
+}
+
+// GetShadowWorkflows returns, by workflow, the triggers the version has in common with the published version.
+// Workflows without triggers in common are left out, as there is no traffic to mirror to them.
+func (v *Version) GetShadowWorkflows(publishedVersion *Version) []ShadowWorkflow {
+	shadowWorkflows := make([]ShadowWorkflow, 0, len(v.Workflows))
+
+	for _, workflow := range v.Workflows {
+		triggers := make([]string, 0)
+
+		for _, process := range workflow.Processes {
+			if process.Type != ProcessTypeTrigger {
+				continue
+			}
+
+			publishedProcess, found := publishedVersion.GetProcess(workflow.Name, process.Name)
+			if found && publishedProcess.Type == ProcessTypeTrigger {
+				triggers = append(triggers, process.Name)
+			}
+		}
+
+		if len(triggers) > 0 {
+			shadowWorkflows = append(shadowWorkflows, ShadowWorkflow{Name: workflow.Name, Triggers: triggers})
+		}
+	}
+
+	return shadowWorkflows
+}
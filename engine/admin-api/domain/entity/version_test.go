@@ -28,3 +28,130 @@ func TestVersion_UnsetPublishStatus(t *testing.T) {
 	assert.Equal(t, entity.VersionStatusStarted, version.Status)
 	assert.Nil(t, version.PublicationAuthor)
 }
+
+func TestVersion_SetProcessesStatus(t *testing.T) {
+	version := testhelpers.NewVersionBuilder().Build()
+	workflow := version.Workflows[0]
+	runtime := &entity.ProcessRuntimeStatus{
+		ReadyReplicas:         0,
+		DesiredReplicas:       1,
+		Restarts:              4,
+		LastTerminationReason: "OOMKilled",
+	}
+
+	version.SetProcessesStatus([]*entity.ProcessStatusReport{
+		{
+			Workflow: workflow.Name,
+			Process:  workflow.Processes[0].Name,
+			Status:   entity.ProcessStatusStarting,
+			Runtime:  runtime,
+		},
+		{
+			Workflow: workflow.Name,
+			Process:  "unknown-process",
+			Status:   entity.ProcessStatusStarted,
+		},
+	})
+
+	assert.Equal(t, entity.ProcessStatusStarting, version.Workflows[0].Processes[0].Status)
+	assert.Equal(t, runtime, version.Workflows[0].Processes[0].Runtime)
+}
+
+func TestVersion_SetStreamStats(t *testing.T) {
+	version := testhelpers.NewVersionBuilder().Build()
+	workflow := version.Workflows[0]
+	processStats := &entity.ProcessStreamStats{
+		Process:   workflow.Processes[0].Name,
+		Subject:   "test-stream." + workflow.Processes[0].Name,
+		Messages:  3,
+		Consumers: entity.ConsumerStats{Pending: 2, Redelivered: 1},
+	}
+	workflowStats := &entity.WorkflowStreamStats{
+		Workflow:  workflow.Name,
+		Stream:    "test-stream",
+		Messages:  3,
+		Processes: []*entity.ProcessStreamStats{processStats, {Process: "unknown-process"}},
+	}
+
+	version.SetStreamStats([]*entity.WorkflowStreamStats{
+		workflowStats,
+		{Workflow: "unknown-workflow"},
+	})
+
+	assert.Equal(t, workflowStats, version.Workflows[0].StreamStats)
+	assert.Equal(t, processStats, version.Workflows[0].Processes[0].StreamStats)
+}
+
+func TestVersion_GetShadowWorkflows(t *testing.T) {
+	trigger := testhelpers.NewProcessBuilder().WithName("entrypoint").WithType(entity.ProcessTypeTrigger).Build()
+	newTrigger := testhelpers.NewProcessBuilder().WithName("new-entrypoint").WithType(entity.ProcessTypeTrigger).Build()
+	task := testhelpers.NewProcessBuilder().WithName("task").Build()
+
+	publishedVersion := testhelpers.NewVersionBuilder().WithWorkflows([]entity.Workflow{
+		testhelpers.NewWorkflowBuilder().WithProcesses([]entity.Process{trigger, task}).Build(),
+	}).Build()
+
+	candidateWorkflow := testhelpers.NewWorkflowBuilder().WithProcesses([]entity.Process{trigger, newTrigger, task}).Build()
+	newWorkflow := testhelpers.NewWorkflowBuilder().WithProcesses([]entity.Process{trigger}).Build()
+	newWorkflow.Name = "new-workflow"
+
+	candidateVersion := testhelpers.NewVersionBuilder().
+		WithTag("v2.0.0").
+		WithWorkflows([]entity.Workflow{candidateWorkflow, newWorkflow}).
+		Build()
+
+	shadowWorkflows := candidateVersion.GetShadowWorkflows(publishedVersion)
+
+	assert.Equal(t, []entity.ShadowWorkflow{
+		{Name: candidateWorkflow.Name, Triggers: []string{"entrypoint"}},
+	}, shadowWorkflows)
+}
+
+func TestWorkflowStreamSettings_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		settings    entity.WorkflowStreamSettings
+		expectedErr error
+	}{
+		{"empty settings", entity.WorkflowStreamSettings{}, nil},
+		{
+			"valid settings",
+			entity.WorkflowStreamSettings{
+				Retention:     entity.StreamRetentionLimits,
+				MaxAgeSeconds: 3600,
+				Replicas:      3,
+				Storage:       entity.StreamStorageMemory,
+			},
+			nil,
+		},
+		{"invalid retention", entity.WorkflowStreamSettings{Retention: "forever"}, entity.ErrInvalidStreamRetention},
+		{"invalid storage", entity.WorkflowStreamSettings{Storage: "disk"}, entity.ErrInvalidStreamStorage},
+		{"negative max age", entity.WorkflowStreamSettings{MaxAgeSeconds: -1}, entity.ErrInvalidStreamLimits},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.settings.Validate(), tc.expectedErr)
+		})
+	}
+}
+
+func TestWorkflowJob_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		job         entity.WorkflowJob
+		expectedErr error
+	}{
+		{"on demand job", entity.WorkflowJob{}, nil},
+		{"scheduled job", entity.WorkflowJob{Schedule: "*/15 * * * *", BackoffLimit: 3}, nil},
+		{"scheduled job with macro", entity.WorkflowJob{Schedule: "@daily"}, nil},
+		{"invalid schedule", entity.WorkflowJob{Schedule: "every day"}, entity.ErrInvalidJobSchedule},
+		{"negative backoff limit", entity.WorkflowJob{BackoffLimit: -1}, entity.ErrInvalidWorkflowJob},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.job.Validate(), tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrDuplicatedWorkflowName    = errors.New("duplicated workflow name")
+	ErrDuplicatedProcessName     = errors.New("duplicated process name")
+	ErrUnknownSubscription       = errors.New("subscription to a process that does not exist")
+	ErrSubscriptionCycle         = errors.New("subscriptions form a cycle")
+	ErrProcessWithoutSubscribers = errors.New("no process subscribes to the process")
+	ErrTriggerWithoutExit        = errors.New("trigger has no path to an exit process")
+	ErrBatchNetworkedTrigger     = errors.New("batch workflows cannot have networked triggers")
+)
+
+// WorkflowGraphError points to the process whose subscriptions make the workflow graph invalid.
+type WorkflowGraphError struct {
+	Workflow string
+	Process  string
+	Err      error
+}
+
+func (e WorkflowGraphError) Error() string {
+	if e.Process == "" {
+		return fmt.Sprintf("workflow %q: %s", e.Workflow, e.Err)
+	}
+
+	return fmt.Sprintf("workflow %q, process %q: %s", e.Workflow, e.Process, e.Err)
+}
+
+func (e WorkflowGraphError) Unwrap() error {
+	return e.Err
+}
+
+// WorkflowGraph represents how messages flow between the processes of a workflow.
+type WorkflowGraph struct {
+	Nodes []WorkflowGraphNode
+	Edges []WorkflowGraphEdge
+}
+
+type WorkflowGraphNode struct {
+	Process string
+	Type    ProcessType
+}
+
+// WorkflowGraphEdge goes from the process publishing the messages to the process subscribed to them.
+type WorkflowGraphEdge struct {
+	From string
+	To   string
+}
+
+// NewWorkflowGraph returns the graph of the workflow processes and their subscriptions. Subscriptions to
+// unknown processes and to other workflows are left out.
+func NewWorkflowGraph(workflow *Workflow) *WorkflowGraph {
+	graph := &WorkflowGraph{
+		Nodes: make([]WorkflowGraphNode, 0, len(workflow.Processes)),
+		Edges: make([]WorkflowGraphEdge, 0),
+	}
+
+	for _, process := range workflow.Processes {
+		graph.Nodes = append(graph.Nodes, WorkflowGraphNode{Process: process.Name, Type: process.Type})
+
+		for _, subscription := range process.Subscriptions {
+			if publisher, found := workflow.getSubscribedProcess(subscription); found {
+				graph.Edges = append(graph.Edges, WorkflowGraphEdge{From: publisher, To: process.Name})
+			}
+		}
+	}
+
+	return graph
+}
+
+// ValidateWorkflowGraphs checks the subscriptions of every workflow of the version, including the subscriptions
+// to processes of other workflows. Processes subscribed from another workflow count as having subscribers.
+func (v *Version) ValidateWorkflowGraphs() error {
+	errs := make([]error, 0)
+	workflowNames := make(map[string]bool, len(v.Workflows))
+	// subscribedFromOtherWorkflows holds, for each workflow, its processes subscribed from other workflows
+	subscribedFromOtherWorkflows := make(map[string]map[string]bool, len(v.Workflows))
+
+	for i := range v.Workflows {
+		workflow := &v.Workflows[i]
+
+		for _, process := range workflow.Processes {
+			for _, subscription := range process.Subscriptions {
+				publisherWorkflow, subject, isCrossWorkflow := v.getCrossWorkflowSubscription(workflow, subscription)
+				if !isCrossWorkflow {
+					continue
+				}
+
+				publisher, found := publisherWorkflow.getSubscribedProcess(subject)
+				if !found {
+					errs = append(errs, WorkflowGraphError{
+						Workflow: workflow.Name,
+						Process:  process.Name,
+						Err:      fmt.Errorf("%w: %q", ErrUnknownSubscription, subscription),
+					})
+
+					continue
+				}
+
+				if subscribedFromOtherWorkflows[publisherWorkflow.Name] == nil {
+					subscribedFromOtherWorkflows[publisherWorkflow.Name] = make(map[string]bool)
+				}
+
+				subscribedFromOtherWorkflows[publisherWorkflow.Name][publisher] = true
+			}
+		}
+	}
+
+	for i := range v.Workflows {
+		workflow := &v.Workflows[i]
+
+		if workflowNames[workflow.Name] {
+			errs = append(errs, WorkflowGraphError{Workflow: workflow.Name, Err: ErrDuplicatedWorkflowName})
+			continue
+		}
+
+		workflowNames[workflow.Name] = true
+
+		if err := workflow.validateGraph(v, subscribedFromOtherWorkflows[workflow.Name]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ResolveSubscription returns the workflow publishing to the given subscription of a process of the workflow and the
+// subscription subject within that workflow stream. Subscriptions refer to processes of the same workflow unless
+// they are prefixed with the name of another workflow of the version, as in "serving.predictor".
+func (v *Version) ResolveSubscription(workflow *Workflow, subscription string) (string, string) {
+	if publisher, subject, isCrossWorkflow := v.getCrossWorkflowSubscription(workflow, subscription); isCrossWorkflow {
+		return publisher.Name, subject
+	}
+
+	return workflow.Name, subscription
+}
+
+// getCrossWorkflowSubscription returns the workflow and the subject of a subscription to another workflow. Processes
+// of the own workflow take precedence, so subscriptions to their subtopics are never taken as cross-workflow ones.
+func (v *Version) getCrossWorkflowSubscription(workflow *Workflow, subscription string) (*Workflow, string, bool) {
+	if _, found := workflow.getSubscribedProcess(subscription); found {
+		return nil, "", false
+	}
+
+	workflowName, subject, hasSubject := strings.Cut(subscription, ".")
+	if !hasSubject || workflowName == workflow.Name {
+		return nil, "", false
+	}
+
+	for i := range v.Workflows {
+		if v.Workflows[i].Name == workflowName {
+			return &v.Workflows[i], subject, true
+		}
+	}
+
+	return nil, "", false
+}
+
+// ValidateGraph checks that the workflow processes subscribe to existing processes without cycles, that every
+// process is subscribed by another one and that every trigger reaches an exit process. Triggers subscribe to
+// the exit processes to get the responses, so these subscriptions are not part of the messages flow.
+// Batch workflows don't need triggers nor exit processes, so only the subscriptions themselves are checked.
+func (w *Workflow) ValidateGraph() error {
+	return w.validateGraph(nil, nil)
+}
+
+// validateGraph validates the workflow graph within the given version, skipping the subscriptions to other
+// workflows, which are checked by the version, and taking the processes subscribed from other workflows as
+// having subscribers.
+func (w *Workflow) validateGraph(version *Version, subscribedFromOtherWorkflows map[string]bool) error {
+	errs := make([]error, 0)
+	addError := func(process string, err error) {
+		errs = append(errs, WorkflowGraphError{Workflow: w.Name, Process: process, Err: err})
+	}
+
+	processNames := make(map[string]bool, len(w.Processes))
+	subscribed := make(map[string]bool, len(w.Processes))
+	// subscribers holds the processes receiving the messages of each process, without responses to triggers
+	subscribers := make(map[string][]string, len(w.Processes))
+
+	for process := range subscribedFromOtherWorkflows {
+		subscribed[process] = true
+	}
+
+	for _, process := range w.Processes {
+		if processNames[process.Name] {
+			addError(process.Name, ErrDuplicatedProcessName)
+			continue
+		}
+
+		processNames[process.Name] = true
+
+		for _, subscription := range process.Subscriptions {
+			publisher, found := w.getSubscribedProcess(subscription)
+			if !found {
+				if version != nil {
+					if _, _, isCrossWorkflow := version.getCrossWorkflowSubscription(w, subscription); isCrossWorkflow {
+						continue
+					}
+				}
+
+				addError(process.Name, fmt.Errorf("%w: %q", ErrUnknownSubscription, subscription))
+
+				continue
+			}
+
+			subscribed[publisher] = true
+
+			if process.Type != ProcessTypeTrigger {
+				subscribers[publisher] = append(subscribers[publisher], process.Name)
+			}
+		}
+	}
+
+	for _, cycle := range w.findSubscriptionCycles(subscribers) {
+		addError(cycle[0], fmt.Errorf("%w: %s", ErrSubscriptionCycle, strings.Join(cycle, " -> ")))
+	}
+
+	if w.IsBatch() {
+		for _, process := range w.Processes {
+			if process.Type == ProcessTypeTrigger && process.Networking != nil {
+				addError(process.Name, ErrBatchNetworkedTrigger)
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+
+	for _, process := range w.Processes {
+		if process.Type != ProcessTypeExit && !subscribed[process.Name] {
+			addError(process.Name, ErrProcessWithoutSubscribers)
+		}
+
+		if process.Type == ProcessTypeTrigger && !w.reachesExitProcess(process.Name, subscribers) {
+			addError(process.Name, ErrTriggerWithoutExit)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// getSubscribedProcess returns the process publishing to the subscription subject, which is either
+// the process name or one of its subtopics.
+func (w *Workflow) getSubscribedProcess(subscription string) (string, bool) {
+	for _, process := range w.Processes {
+		if subscription == process.Name {
+			return process.Name, true
+		}
+	}
+
+	for _, process := range w.Processes {
+		if strings.HasPrefix(subscription, process.Name+".") {
+			return process.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// findSubscriptionCycles returns the cycles of the messages flow, each one starting and ending in the same process.
+func (w *Workflow) findSubscriptionCycles(subscribers map[string][]string) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var (
+		cycles = make([][]string, 0)
+		state  = make(map[string]int, len(w.Processes))
+		path   = make([]string, 0, len(w.Processes))
+		visit  func(process string)
+	)
+
+	visit = func(process string) {
+		state[process] = visiting
+		path = append(path, process)
+
+		for _, subscriber := range subscribers[process] {
+			switch state[subscriber] {
+			case visiting:
+				for i, pathProcess := range path {
+					if pathProcess == subscriber {
+						cycle := append([]string{}, path[i:]...)
+						cycles = append(cycles, append(cycle, subscriber))
+
+						break
+					}
+				}
+			case unvisited:
+				visit(subscriber)
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[process] = visited
+	}
+
+	for _, process := range w.Processes {
+		if state[process.Name] == unvisited {
+			visit(process.Name)
+		}
+	}
+
+	return cycles
+}
+
+func (w *Workflow) reachesExitProcess(trigger string, subscribers map[string][]string) bool {
+	exitProcesses := make(map[string]bool)
+
+	for _, process := range w.Processes {
+		if process.Type == ProcessTypeExit {
+			exitProcesses[process.Name] = true
+		}
+	}
+
+	reached := map[string]bool{trigger: true}
+	pending := []string{trigger}
+
+	for len(pending) > 0 {
+		process := pending[0]
+		pending = pending[1:]
+
+		if exitProcesses[process] {
+			return true
+		}
+
+		for _, subscriber := range subscribers[process] {
+			if !reached[subscriber] {
+				reached[subscriber] = true
+				pending = append(pending, subscriber)
+			}
+		}
+	}
+
+	return false
+}
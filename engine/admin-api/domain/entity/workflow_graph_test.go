@@ -0,0 +1,244 @@
+//go:build unit
+
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGraphProcess(name string, processType entity.ProcessType, subscriptions ...string) entity.Process {
+	return entity.Process{Name: name, Type: processType, Subscriptions: subscriptions}
+}
+
+func newValidWorkflow() entity.Workflow {
+	return entity.Workflow{
+		Name: "test-workflow",
+		Type: entity.WorkflowTypeData,
+		Processes: []entity.Process{
+			newGraphProcess("entrypoint", entity.ProcessTypeTrigger, "exitpoint"),
+			newGraphProcess("etl", entity.ProcessTypeTask, "entrypoint"),
+			newGraphProcess("classificator", entity.ProcessTypeTask, "etl.emails"),
+			newGraphProcess("exitpoint", entity.ProcessTypeExit, "etl", "classificator"),
+		},
+	}
+}
+
+func TestWorkflow_ValidateGraph(t *testing.T) {
+	workflow := newValidWorkflow()
+
+	assert.NoError(t, workflow.ValidateGraph())
+}
+
+func TestWorkflow_ValidateGraph_UnknownSubscription(t *testing.T) {
+	workflow := newValidWorkflow()
+	workflow.Processes[2].Subscriptions = []string{"etl", "elt"}
+
+	err := workflow.ValidateGraph()
+
+	assert.ErrorIs(t, err, entity.ErrUnknownSubscription)
+	assert.ErrorContains(t, err, `workflow "test-workflow", process "classificator"`)
+	assert.ErrorContains(t, err, `"elt"`)
+}
+
+func TestWorkflow_ValidateGraph_DuplicatedProcessName(t *testing.T) {
+	workflow := newValidWorkflow()
+	workflow.Processes = append(workflow.Processes, newGraphProcess("etl", entity.ProcessTypeTask, "entrypoint"))
+
+	err := workflow.ValidateGraph()
+
+	assert.ErrorIs(t, err, entity.ErrDuplicatedProcessName)
+	assert.ErrorContains(t, err, `process "etl"`)
+}
+
+func TestWorkflow_ValidateGraph_Cycle(t *testing.T) {
+	workflow := newValidWorkflow()
+	workflow.Processes[1].Subscriptions = []string{"entrypoint", "classificator"}
+
+	err := workflow.ValidateGraph()
+
+	assert.ErrorIs(t, err, entity.ErrSubscriptionCycle)
+	assert.ErrorContains(t, err, "etl -> classificator -> etl")
+}
+
+func TestWorkflow_ValidateGraph_SelfSubscription(t *testing.T) {
+	workflow := newValidWorkflow()
+	workflow.Processes[2].Subscriptions = []string{"etl", "classificator"}
+
+	err := workflow.ValidateGraph()
+
+	assert.ErrorIs(t, err, entity.ErrSubscriptionCycle)
+	assert.ErrorContains(t, err, "classificator -> classificator")
+}
+
+func TestWorkflow_ValidateGraph_ProcessWithoutSubscribers(t *testing.T) {
+	workflow := newValidWorkflow()
+	workflow.Processes[3].Subscriptions = []string{"etl"}
+
+	err := workflow.ValidateGraph()
+
+	var graphErr entity.WorkflowGraphError
+
+	require.ErrorAs(t, err, &graphErr)
+	assert.Equal(t, "classificator", graphErr.Process)
+	assert.ErrorIs(t, err, entity.ErrProcessWithoutSubscribers)
+}
+
+func TestWorkflow_ValidateGraph_TriggerWithoutExit(t *testing.T) {
+	workflow := entity.Workflow{
+		Name: "test-workflow",
+		Type: entity.WorkflowTypeData,
+		Processes: []entity.Process{
+			newGraphProcess("entrypoint", entity.ProcessTypeTrigger, "exitpoint"),
+			newGraphProcess("etl", entity.ProcessTypeTask, "entrypoint"),
+			newGraphProcess("other-trigger", entity.ProcessTypeTrigger),
+			newGraphProcess("exitpoint", entity.ProcessTypeExit, "other-trigger"),
+		},
+	}
+
+	err := workflow.ValidateGraph()
+
+	assert.ErrorIs(t, err, entity.ErrTriggerWithoutExit)
+	assert.ErrorContains(t, err, `process "entrypoint": trigger has no path to an exit process`)
+	assert.ErrorContains(t, err, `process "etl": no process subscribes to the process`)
+}
+
+func TestWorkflow_ValidateGraph_BatchWorkflow(t *testing.T) {
+	workflow := entity.Workflow{
+		Name: "test-workflow",
+		Type: entity.WorkflowTypeTraining,
+		Job:  &entity.WorkflowJob{},
+		Processes: []entity.Process{
+			newGraphProcess("train", entity.ProcessTypeTask),
+		},
+	}
+
+	assert.NoError(t, workflow.ValidateGraph())
+}
+
+func TestWorkflow_ValidateGraph_BatchWorkflowWithNetworkedTrigger(t *testing.T) {
+	trigger := newGraphProcess("entrypoint", entity.ProcessTypeTrigger)
+	trigger.Networking = &entity.ProcessNetworking{TargetPort: 8080, DestinationPort: 80}
+
+	workflow := entity.Workflow{
+		Name: "test-workflow",
+		Type: entity.WorkflowTypeTraining,
+		Job:  &entity.WorkflowJob{},
+		Processes: []entity.Process{
+			trigger,
+			newGraphProcess("train", entity.ProcessTypeTask, "entrypoint"),
+		},
+	}
+
+	err := workflow.ValidateGraph()
+
+	assert.ErrorIs(t, err, entity.ErrBatchNetworkedTrigger)
+}
+
+func TestVersion_ValidateWorkflowGraphs_DuplicatedWorkflowName(t *testing.T) {
+	version := entity.Version{
+		Workflows: []entity.Workflow{newValidWorkflow(), newValidWorkflow()},
+	}
+
+	err := version.ValidateWorkflowGraphs()
+
+	assert.ErrorIs(t, err, entity.ErrDuplicatedWorkflowName)
+	assert.ErrorContains(t, err, `workflow "test-workflow": duplicated workflow name`)
+}
+
+func newFeedbackWorkflow(subscriptions ...string) entity.Workflow {
+	return entity.Workflow{
+		Name: "feedback",
+		Type: entity.WorkflowTypeFeedback,
+		Processes: []entity.Process{
+			newGraphProcess("evaluator", entity.ProcessTypeTask, subscriptions...),
+			newGraphProcess("exitpoint", entity.ProcessTypeExit, "evaluator"),
+		},
+	}
+}
+
+func TestVersion_ValidateWorkflowGraphs_CrossWorkflowSubscription(t *testing.T) {
+	serving := newValidWorkflow()
+	// classificator publishes only to the feedback workflow
+	serving.Processes[3].Subscriptions = []string{"etl"}
+	version := entity.Version{
+		Workflows: []entity.Workflow{serving, newFeedbackWorkflow("test-workflow.classificator")},
+	}
+
+	assert.NoError(t, version.ValidateWorkflowGraphs())
+}
+
+func TestVersion_ValidateWorkflowGraphs_UnknownCrossWorkflowSubscription(t *testing.T) {
+	version := entity.Version{
+		Workflows: []entity.Workflow{newValidWorkflow(), newFeedbackWorkflow("test-workflow.predictor")},
+	}
+
+	err := version.ValidateWorkflowGraphs()
+
+	assert.ErrorIs(t, err, entity.ErrUnknownSubscription)
+	assert.ErrorContains(t, err, `workflow "feedback", process "evaluator"`)
+	assert.ErrorContains(t, err, `"test-workflow.predictor"`)
+}
+
+func TestVersion_ValidateWorkflowGraphs_UnknownWorkflowSubscription(t *testing.T) {
+	version := entity.Version{
+		Workflows: []entity.Workflow{newValidWorkflow(), newFeedbackWorkflow("serving.classificator")},
+	}
+
+	err := version.ValidateWorkflowGraphs()
+
+	assert.ErrorIs(t, err, entity.ErrUnknownSubscription)
+	assert.ErrorContains(t, err, `"serving.classificator"`)
+}
+
+func TestVersion_ResolveSubscription(t *testing.T) {
+	version := entity.Version{
+		Workflows: []entity.Workflow{newValidWorkflow(), newFeedbackWorkflow("test-workflow.classificator")},
+	}
+	feedback := &version.Workflows[1]
+
+	tests := []struct {
+		subscription     string
+		expectedWorkflow string
+		expectedSubject  string
+	}{
+		{subscription: "evaluator", expectedWorkflow: "feedback", expectedSubject: "evaluator"},
+		{subscription: "evaluator.scores", expectedWorkflow: "feedback", expectedSubject: "evaluator.scores"},
+		{subscription: "test-workflow.etl", expectedWorkflow: "test-workflow", expectedSubject: "etl"},
+		{subscription: "test-workflow.etl.emails", expectedWorkflow: "test-workflow", expectedSubject: "etl.emails"},
+		{subscription: "unknown.etl", expectedWorkflow: "feedback", expectedSubject: "unknown.etl"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.subscription, func(t *testing.T) {
+			workflow, subject := version.ResolveSubscription(feedback, tc.subscription)
+
+			assert.Equal(t, tc.expectedWorkflow, workflow)
+			assert.Equal(t, tc.expectedSubject, subject)
+		})
+	}
+}
+
+func TestNewWorkflowGraph(t *testing.T) {
+	workflow := newValidWorkflow()
+	workflow.Processes[2].Subscriptions = append(workflow.Processes[2].Subscriptions, "unknown")
+
+	graph := entity.NewWorkflowGraph(&workflow)
+
+	assert.Equal(t, []entity.WorkflowGraphNode{
+		{Process: "entrypoint", Type: entity.ProcessTypeTrigger},
+		{Process: "etl", Type: entity.ProcessTypeTask},
+		{Process: "classificator", Type: entity.ProcessTypeTask},
+		{Process: "exitpoint", Type: entity.ProcessTypeExit},
+	}, graph.Nodes)
+	assert.Equal(t, []entity.WorkflowGraphEdge{
+		{From: "exitpoint", To: "entrypoint"},
+		{From: "entrypoint", To: "etl"},
+		{From: "etl", To: "classificator"},
+		{From: "etl", To: "exitpoint"},
+		{From: "classificator", To: "exitpoint"},
+	}, graph.Edges)
+}
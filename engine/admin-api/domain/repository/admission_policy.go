@@ -0,0 +1,17 @@
+package repository
+
+//go:generate mockgen -source=${GOFILE} -destination=../../mocks/repo_${GOFILE} -package=mocks
+
+import (
+	"context"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+type AdmissionPolicyRepo interface {
+	Create(ctx context.Context, policy *entity.AdmissionPolicy) (*entity.AdmissionPolicy, error)
+	GetByID(ctx context.Context, policyID string) (*entity.AdmissionPolicy, error)
+	FindAll(ctx context.Context) ([]*entity.AdmissionPolicy, error)
+	Update(ctx context.Context, policy *entity.AdmissionPolicy) error
+	Delete(ctx context.Context, policyID string) error
+}
@@ -9,10 +9,18 @@ type Action string
 const DefaultAdminRole = "ADMIN"
 
 const (
-	ActViewProduct   Action = "view_product"
-	ActCreateProduct Action = "create_product"
+	ActViewProduct                Action = "view_product"
+	ActCreateProduct              Action = "create_product"
+	ActManageProductQuotas        Action = "manage_product_quotas"
+	ActManageProductConfiguration Action = "manage_product_configuration"
+	ActManageProductObjectStores  Action = "manage_product_object_stores"
+	ActManageObjects              Action = "manage_objects"
 
-	ActManageVersion Action = "manage_version"
+	ActManageAdmissionPolicies Action = "manage_admission_policies"
+	ActManageNatsResources     Action = "manage_nats_resources"
+
+	ActManageVersion        Action = "manage_version"
+	ActDebugVersionMessages Action = "debug_version_messages"
 
 	ActRegisterProcess         Action = "register_process"
 	ActDeleteRegisteredProcess Action = "delete_registered_process"
@@ -32,7 +40,9 @@ func (e Action) IsValid() bool {
 	case ActViewProduct, ActCreateProduct, ActManageVersion,
 		ActRegisterProcess, ActDeleteRegisteredProcess, ActRegisterPublicProcess,
 		ActDeletePublicProcess, ActManageCriticalVersion, ActViewUserActivities,
-		ActManageProductUsers:
+		ActManageProductUsers, ActManageProductQuotas, ActManageAdmissionPolicies,
+		ActDebugVersionMessages, ActManageProductConfiguration, ActManageProductObjectStores,
+		ActManageObjects, ActManageNatsResources:
 		return true
 	}
 
@@ -64,5 +74,9 @@ func GetDefaultMaintainerGrants() []Action {
 		ActDeleteRegisteredProcess,
 		ActManageCriticalVersion,
 		ActManageProductUsers,
+		ActDebugVersionMessages,
+		ActManageProductConfiguration,
+		ActManageProductObjectStores,
+		ActManageObjects,
 	)
 }
@@ -4,6 +4,7 @@ package service
 
 import (
 	"context"
+	"io"
 
 	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
 )
@@ -11,11 +12,52 @@ import (
 type NatsManagerService interface {
 	CreateStreams(ctx context.Context, product string, version *entity.Version) (*entity.VersionStreams, error)
 	CreateObjectStores(ctx context.Context, product string, version *entity.Version) (*entity.VersionObjectStores, error)
+	CreateProductObjectStore(ctx context.Context, product, name string) (string, error)
+	GetProductObjectStores(ctx context.Context, product string) ([]string, error)
+	DeleteProductObjectStore(ctx context.Context, product, name string) error
+	ListObjects(ctx context.Context, product, objectStore string) ([]*entity.ObjectInfo, error)
+	DownloadObject(ctx context.Context, product, objectStore, name string, w io.Writer) error
+	UploadObject(ctx context.Context, product, objectStore, name string, r io.Reader) (*entity.ObjectInfo, error)
+	DeleteObject(ctx context.Context, product, objectStore, name string) error
 	CreateVersionKeyValueStores(ctx context.Context, product string, version *entity.Version) (*entity.KeyValueStores, error)
+	GetVersionResources(ctx context.Context, product string, version *entity.Version) (*entity.VersionStreamingResources, error)
 	CreateGlobalKeyValueStore(ctx context.Context, product string) (string, error)
 	UpdateKeyValueConfiguration(ctx context.Context, configurations []entity.KeyValueConfiguration) error
+	GetProcessConfiguration(
+		ctx context.Context, product, versionTag, workflow, process string,
+	) ([]*entity.ConfigurationEntry, error)
+	GetConfigurationHistory(
+		ctx context.Context, store entity.ConfigurationStore, key string,
+	) ([]*entity.ConfigurationRevision, error)
+	RollbackConfiguration(
+		ctx context.Context, store entity.ConfigurationStore, key string, revision uint64,
+	) (*entity.ConfigurationRevision, error)
+	GetConfiguration(ctx context.Context, store entity.ConfigurationStore) ([]*entity.ConfigurationEntry, error)
+	SetConfiguration(
+		ctx context.Context, store entity.ConfigurationStore, key, value string,
+	) (*entity.ConfigurationEntry, error)
+	DeleteConfiguration(ctx context.Context, store entity.ConfigurationStore, key string) error
+	WatchProcessConfiguration(
+		ctx context.Context, product, versionTag, workflow, process string,
+	) (<-chan *entity.ConfigurationEntry, error)
+	CreateVersionCredentials(ctx context.Context, product string, version *entity.Version) (string, error)
+	RevokeVersionCredentials(ctx context.Context, product, versionTag string) error
+	StartShadow(
+		ctx context.Context, product, publishedVersionTag, candidateVersionTag string, workflows []entity.ShadowWorkflow,
+	) error
+	StopShadow(ctx context.Context, product, publishedVersionTag, candidateVersionTag string) error
+	ListResources(ctx context.Context) ([]*entity.NatsResource, error)
+	DeleteResources(ctx context.Context, resources []*entity.NatsResource) error
 	DeleteStreams(ctx context.Context, product string, versionTag string) error
 	DeleteObjectStores(ctx context.Context, product, versionTag string) error
 	DeleteVersionKeyValueStores(ctx context.Context, product string, version *entity.Version) error
 	DeleteGlobalKeyValueStore(ctx context.Context, product string) error
+	GetVersionStreamStats(ctx context.Context, product string, version *entity.Version) ([]*entity.WorkflowStreamStats, error)
+	GetProcessMessages(
+		ctx context.Context, product, versionTag, workflow, process string, count int,
+	) ([]*entity.StreamMessage, error)
+	PublishMessage(ctx context.Context, product, versionTag, subject string, headers map[string]string, data []byte) error
+	GetDeadLetterMessages(ctx context.Context, product, versionTag, workflow string) ([]*entity.DeadLetterMessage, error)
+	ReplayDeadLetterMessages(ctx context.Context, product, versionTag, workflow string, sequences []uint64) (int, error)
+	PurgeDeadLetterMessages(ctx context.Context, product, versionTag, workflow string, sequences []uint64) (int, error)
 }
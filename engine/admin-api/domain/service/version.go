@@ -10,10 +10,20 @@ import (
 
 type VersionService interface {
 	Start(ctx context.Context, product *entity.Product, version *entity.Version, versionConfig *entity.VersionStreamingResources) error
+	RenderManifests(
+		ctx context.Context, product *entity.Product, version *entity.Version, versionConfig *entity.VersionStreamingResources,
+	) ([]*entity.KubernetesManifest, error)
 	Stop(ctx context.Context, productID string, version *entity.Version) error
 	Publish(ctx context.Context, productID, versionTag string) (map[string]string, error)
 	Unpublish(ctx context.Context, productID string, version *entity.Version) error
 	WatchProcessStatus(ctx context.Context, productID, versionTag string) (<-chan *entity.Process, error)
+	GetProcessStatus(ctx context.Context, productID, versionTag string) ([]*entity.ProcessStatusReport, error)
 	RegisterProcess(ctx context.Context, productID, processID, processImage string) (string, error)
 	GetPublishedTriggers(ctx context.Context, productID string) ([]entity.PublishedTrigger, error)
+	UpdateProcessImage(ctx context.Context, productID, versionTag string, patch *entity.VersionPatch) error
+	ScaleProcess(ctx context.Context, productID, versionTag string, scaling *entity.ProcessScaling) error
+	RunWorkflow(ctx context.Context, productID, versionTag, workflow string) ([]string, error)
+	CreateProductNamespace(ctx context.Context, product *entity.Product) error
+	UpdateProductNamespace(ctx context.Context, product *entity.Product) error
+	DeleteProductNamespace(ctx context.Context, productID string) error
 }
@@ -0,0 +1,154 @@
+package admission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/repository"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+var (
+	ErrPolicyNotFound   = errors.New("admission policy not found")
+	ErrPolicyDuplicated = errors.New("there is already an admission policy with the same name")
+)
+
+// Handler contains the app logic to manage the admission policies that versions must pass.
+type Handler struct {
+	logger        logr.Logger
+	policyRepo    repository.AdmissionPolicyRepo
+	versionRepo   repository.VersionRepo
+	accessControl auth.AccessControl
+}
+
+type HandlerParams struct {
+	Logger              logr.Logger
+	AdmissionPolicyRepo repository.AdmissionPolicyRepo
+	VersionRepo         repository.VersionRepo
+	AccessControl       auth.AccessControl
+}
+
+func NewHandler(params *HandlerParams) *Handler {
+	return &Handler{
+		logger:        params.Logger,
+		policyRepo:    params.AdmissionPolicyRepo,
+		versionRepo:   params.VersionRepo,
+		accessControl: params.AccessControl,
+	}
+}
+
+// Create stores a new admission policy. It is evaluated from then on when versions are created and started.
+func (h *Handler) Create(
+	ctx context.Context,
+	user *entity.User,
+	policy *entity.AdmissionPolicy,
+) (*entity.AdmissionPolicy, error) {
+	if err := h.accessControl.CheckRoleGrants(user, auth.ActManageAdmissionPolicies); err != nil {
+		return nil, err
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	policy.CreationAuthor = user.Email
+
+	createdPolicy, err := h.policyRepo.Create(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("creating admission policy: %w", err)
+	}
+
+	h.logger.Info("Admission policy created", "policy", createdPolicy.Name, "userEmail", user.Email)
+
+	return createdPolicy, nil
+}
+
+// Update replaces the rule, mode and description of an existing admission policy.
+func (h *Handler) Update(
+	ctx context.Context,
+	user *entity.User,
+	policy *entity.AdmissionPolicy,
+) (*entity.AdmissionPolicy, error) {
+	if err := h.accessControl.CheckRoleGrants(user, auth.ActManageAdmissionPolicies); err != nil {
+		return nil, err
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	storedPolicy, err := h.policyRepo.GetByID(ctx, policy.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.CreationDate = storedPolicy.CreationDate
+	policy.CreationAuthor = storedPolicy.CreationAuthor
+
+	if err := h.policyRepo.Update(ctx, policy); err != nil {
+		return nil, fmt.Errorf("updating admission policy: %w", err)
+	}
+
+	h.logger.Info("Admission policy updated", "policy", policy.Name, "userEmail", user.Email)
+
+	return policy, nil
+}
+
+func (h *Handler) Delete(ctx context.Context, user *entity.User, policyID string) error {
+	if err := h.accessControl.CheckRoleGrants(user, auth.ActManageAdmissionPolicies); err != nil {
+		return err
+	}
+
+	if err := h.policyRepo.Delete(ctx, policyID); err != nil {
+		return fmt.Errorf("deleting admission policy: %w", err)
+	}
+
+	h.logger.Info("Admission policy deleted", "policyID", policyID, "userEmail", user.Email)
+
+	return nil
+}
+
+func (h *Handler) List(ctx context.Context, user *entity.User) ([]*entity.AdmissionPolicy, error) {
+	if err := h.accessControl.CheckRoleGrants(user, auth.ActManageAdmissionPolicies); err != nil {
+		return nil, err
+	}
+
+	return h.policyRepo.FindAll(ctx)
+}
+
+type DryRunOpts struct {
+	ProductID  string
+	VersionTag string
+	// Policy is evaluated instead of the stored policies when given, to try it before saving it.
+	Policy *entity.AdmissionPolicy
+}
+
+// DryRun evaluates the admission policies against an existing version without enforcing them.
+func (h *Handler) DryRun(ctx context.Context, user *entity.User, opts DryRunOpts) (*entity.AdmissionReport, error) {
+	if err := h.accessControl.CheckRoleGrants(user, auth.ActManageAdmissionPolicies); err != nil {
+		return nil, err
+	}
+
+	version, err := h.versionRepo.GetByTag(ctx, opts.ProductID, opts.VersionTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Policy != nil {
+		if err := opts.Policy.Validate(); err != nil {
+			return nil, err
+		}
+
+		return entity.EvaluateAdmissionPolicies([]*entity.AdmissionPolicy{opts.Policy}, version), nil
+	}
+
+	policies, err := h.policyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting admission policies: %w", err)
+	}
+
+	return entity.EvaluateAdmissionPolicies(policies, version), nil
+}
@@ -0,0 +1,226 @@
+//go:build unit
+
+package admission_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/zapr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/admission"
+	"github.com/konstellation-io/kai/engine/admin-api/mocks"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+const (
+	_productID  = "productID"
+	_versionTag = "v1.0.0"
+)
+
+type AdmissionHandlerTestSuite struct {
+	suite.Suite
+	ctrl          *gomock.Controller
+	policyRepo    *mocks.MockAdmissionPolicyRepo
+	versionRepo   *mocks.MockVersionRepo
+	accessControl *mocks.MockAccessControl
+	handler       *admission.Handler
+}
+
+func TestAdmissionHandlerSuite(t *testing.T) {
+	suite.Run(t, new(AdmissionHandlerTestSuite))
+}
+
+func (s *AdmissionHandlerTestSuite) SetupSuite() {
+	s.ctrl = gomock.NewController(s.T())
+	s.policyRepo = mocks.NewMockAdmissionPolicyRepo(s.ctrl)
+	s.versionRepo = mocks.NewMockVersionRepo(s.ctrl)
+	s.accessControl = mocks.NewMockAccessControl(s.ctrl)
+
+	s.handler = admission.NewHandler(&admission.HandlerParams{
+		Logger:              zapr.NewLogger(zap.NewNop()),
+		AdmissionPolicyRepo: s.policyRepo,
+		VersionRepo:         s.versionRepo,
+		AccessControl:       s.accessControl,
+	})
+}
+
+func (s *AdmissionHandlerTestSuite) getTestPolicy() *entity.AdmissionPolicy {
+	return &entity.AdmissionPolicy{
+		Name:   "max-replicas",
+		Type:   entity.AdmissionPolicyTypeMaxReplicas,
+		Mode:   entity.AdmissionPolicyModeEnforce,
+		Params: entity.AdmissionPolicyParams{MaxReplicas: 1},
+	}
+}
+
+func (s *AdmissionHandlerTestSuite) TestCreate() {
+	// GIVEN a valid policy
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	policy := s.getTestPolicy()
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageAdmissionPolicies).Return(nil)
+	s.policyRepo.EXPECT().Create(ctx, policy).Return(policy, nil)
+
+	// WHEN creating the policy
+	createdPolicy, err := s.handler.Create(ctx, user, policy)
+	s.Require().NoError(err)
+
+	// THEN the policy is stored with its author
+	s.Equal(user.Email, createdPolicy.CreationAuthor)
+}
+
+func (s *AdmissionHandlerTestSuite) TestCreate_InvalidPolicy() {
+	// GIVEN a policy without its params
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	policy := s.getTestPolicy()
+	policy.Params.MaxReplicas = 0
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageAdmissionPolicies).Return(nil)
+
+	// WHEN creating the policy
+	_, err := s.handler.Create(ctx, user, policy)
+
+	// THEN the policy is rejected
+	s.ErrorIs(err, entity.ErrInvalidAdmissionPolicy)
+}
+
+func (s *AdmissionHandlerTestSuite) TestCreate_UserNotAuthorized() {
+	// GIVEN a user without grants
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	expectedErr := errors.New("unauthorized")
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageAdmissionPolicies).Return(expectedErr)
+
+	// WHEN creating the policy
+	_, err := s.handler.Create(ctx, user, s.getTestPolicy())
+
+	// THEN an error is returned
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *AdmissionHandlerTestSuite) TestUpdate_KeepsCreationFields() {
+	// GIVEN a stored policy
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+
+	storedPolicy := s.getTestPolicy()
+	storedPolicy.ID = "policy-id"
+	storedPolicy.CreationAuthor = "author@test.com"
+	storedPolicy.CreationDate = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	policy := s.getTestPolicy()
+	policy.ID = storedPolicy.ID
+	policy.Mode = entity.AdmissionPolicyModeDryRun
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageAdmissionPolicies).Return(nil)
+	s.policyRepo.EXPECT().GetByID(ctx, policy.ID).Return(storedPolicy, nil)
+	s.policyRepo.EXPECT().Update(ctx, policy).Return(nil)
+
+	// WHEN updating the policy
+	updatedPolicy, err := s.handler.Update(ctx, user, policy)
+	s.Require().NoError(err)
+
+	// THEN the new mode is kept along the original creation fields
+	s.Equal(entity.AdmissionPolicyModeDryRun, updatedPolicy.Mode)
+	s.Equal(storedPolicy.CreationAuthor, updatedPolicy.CreationAuthor)
+	s.Equal(storedPolicy.CreationDate, updatedPolicy.CreationDate)
+}
+
+func (s *AdmissionHandlerTestSuite) TestUpdate_PolicyNotFound() {
+	// GIVEN a non existing policy
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	policy := s.getTestPolicy()
+	policy.ID = "policy-id"
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageAdmissionPolicies).Return(nil)
+	s.policyRepo.EXPECT().GetByID(ctx, policy.ID).Return(nil, admission.ErrPolicyNotFound)
+
+	// WHEN updating the policy
+	_, err := s.handler.Update(ctx, user, policy)
+
+	// THEN an error is returned
+	s.ErrorIs(err, admission.ErrPolicyNotFound)
+}
+
+func (s *AdmissionHandlerTestSuite) TestDelete() {
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageAdmissionPolicies).Return(nil)
+	s.policyRepo.EXPECT().Delete(ctx, "policy-id").Return(nil)
+
+	err := s.handler.Delete(ctx, user, "policy-id")
+	s.NoError(err)
+}
+
+func (s *AdmissionHandlerTestSuite) TestList() {
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	policies := []*entity.AdmissionPolicy{s.getTestPolicy()}
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageAdmissionPolicies).Return(nil)
+	s.policyRepo.EXPECT().FindAll(ctx).Return(policies, nil)
+
+	result, err := s.handler.List(ctx, user)
+	s.Require().NoError(err)
+
+	s.Equal(policies, result)
+}
+
+func (s *AdmissionHandlerTestSuite) TestDryRun_StoredPolicies() {
+	// GIVEN an existing version with more replicas than allowed by a stored policy
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	version := testhelpers.NewVersionBuilder().Build()
+	version.Workflows[0].Processes[0].Replicas = 2
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageAdmissionPolicies).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(version, nil)
+	s.policyRepo.EXPECT().FindAll(ctx).Return([]*entity.AdmissionPolicy{s.getTestPolicy()}, nil)
+
+	// WHEN evaluating the policies
+	report, err := s.handler.DryRun(ctx, user, admission.DryRunOpts{ProductID: _productID, VersionTag: _versionTag})
+	s.Require().NoError(err)
+
+	// THEN the violation is reported
+	s.False(report.IsAdmitted())
+	s.Len(report.Violations, 1)
+}
+
+func (s *AdmissionHandlerTestSuite) TestDryRun_GivenPolicy() {
+	// GIVEN an existing version and a policy that is not stored yet
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	version := testhelpers.NewVersionBuilder().Build()
+
+	policy := s.getTestPolicy()
+	policy.Type = entity.AdmissionPolicyTypeAllowedRegistries
+	policy.Params = entity.AdmissionPolicyParams{Registries: []string{"registry.kai.local"}}
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageAdmissionPolicies).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(version, nil)
+
+	// WHEN evaluating the given policy
+	report, err := s.handler.DryRun(ctx, user, admission.DryRunOpts{
+		ProductID:  _productID,
+		VersionTag: _versionTag,
+		Policy:     policy,
+	})
+	s.Require().NoError(err)
+
+	// THEN only the given policy is evaluated
+	s.False(report.IsAdmitted())
+	s.Len(report.Violations, 1)
+	s.Equal(policy.Name, report.Violations[0].Policy)
+}
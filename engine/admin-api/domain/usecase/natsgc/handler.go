@@ -0,0 +1,151 @@
+package natsgc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/repository"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+// Handler contains the app logic to find and delete the NATS resources left behind by failed starts
+// or compensation errors, those that match no existing product or no running version.
+type Handler struct {
+	logger             logr.Logger
+	natsManagerService service.NatsManagerService
+	productRepo        repository.ProductRepo
+	versionRepo        repository.VersionRepo
+	accessControl      auth.AccessControl
+}
+
+type HandlerParams struct {
+	Logger             logr.Logger
+	NatsManagerService service.NatsManagerService
+	ProductRepo        repository.ProductRepo
+	VersionRepo        repository.VersionRepo
+	AccessControl      auth.AccessControl
+}
+
+func NewHandler(params *HandlerParams) *Handler {
+	return &Handler{
+		logger:             params.Logger,
+		natsManagerService: params.NatsManagerService,
+		productRepo:        params.ProductRepo,
+		versionRepo:        params.VersionRepo,
+		accessControl:      params.AccessControl,
+	}
+}
+
+// GetOrphanedResources returns the NATS resources whose product doesn't exist or, for version resources,
+// whose version doesn't exist in the product or is not running. Only starting, started, published and draining
+// versions need their NATS resources.
+func (h *Handler) GetOrphanedResources(ctx context.Context, user *entity.User) ([]*entity.NatsResource, error) {
+	if err := h.accessControl.CheckRoleGrants(user, auth.ActManageNatsResources); err != nil {
+		return nil, err
+	}
+
+	return h.getOrphanedResources(ctx)
+}
+
+// DeleteOrphanedResources deletes the confirmed resources that are still orphaned, skipping those that
+// belong to a product or version created since they were reported. In dry-run mode nothing is deleted.
+// It returns the resources that are, or would be, deleted.
+func (h *Handler) DeleteOrphanedResources(
+	ctx context.Context,
+	user *entity.User,
+	confirmedResources []*entity.NatsResource,
+	dryRun bool,
+) ([]*entity.NatsResource, error) {
+	if err := h.accessControl.CheckRoleGrants(user, auth.ActManageNatsResources); err != nil {
+		return nil, err
+	}
+
+	orphanedResources, err := h.getOrphanedResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := make(map[entity.NatsResourceType]map[string]*entity.NatsResource)
+
+	for _, resource := range orphanedResources {
+		if orphans[resource.Type] == nil {
+			orphans[resource.Type] = make(map[string]*entity.NatsResource)
+		}
+
+		orphans[resource.Type][resource.Name] = resource
+	}
+
+	resourcesToDelete := make([]*entity.NatsResource, 0, len(confirmedResources))
+
+	for _, confirmed := range confirmedResources {
+		resource, ok := orphans[confirmed.Type][confirmed.Name]
+		if !ok {
+			h.logger.Info("Skipping NATS resource that is not orphaned",
+				"resource", confirmed.Name, "type", confirmed.Type)
+
+			continue
+		}
+
+		resourcesToDelete = append(resourcesToDelete, resource)
+	}
+
+	if dryRun || len(resourcesToDelete) == 0 {
+		return resourcesToDelete, nil
+	}
+
+	if err := h.natsManagerService.DeleteResources(ctx, resourcesToDelete); err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("Orphaned NATS resources deleted", "count", len(resourcesToDelete), "userEmail", user.Email)
+
+	return resourcesToDelete, nil
+}
+
+func (h *Handler) getOrphanedResources(ctx context.Context) ([]*entity.NatsResource, error) {
+	resources, err := h.natsManagerService.ListResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := h.productRepo.FindAll(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting products: %w", err)
+	}
+
+	productVersions := make(map[string][]*entity.Version, len(products))
+
+	for _, product := range products {
+		versions, err := h.versionRepo.SearchByProduct(ctx, product.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("getting product %q versions: %w", product.ID, err)
+		}
+
+		productVersions[product.ID] = versions
+	}
+
+	orphanedResources := make([]*entity.NatsResource, 0)
+
+	for _, resource := range resources {
+		versions, productExists := productVersions[resource.ProductID]
+
+		if !productExists || (resource.IsVersionResource() && !belongsToAnyRunningVersion(resource, versions)) {
+			orphanedResources = append(orphanedResources, resource)
+		}
+	}
+
+	return orphanedResources, nil
+}
+
+func belongsToAnyRunningVersion(resource *entity.NatsResource, versions []*entity.Version) bool {
+	for _, version := range versions {
+		if version.IsRunning() && resource.BelongsToVersion(version.Tag) {
+			return true
+		}
+	}
+
+	return false
+}
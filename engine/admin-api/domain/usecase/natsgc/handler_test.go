@@ -0,0 +1,198 @@
+//go:build unit
+
+package natsgc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/zapr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/natsgc"
+	"github.com/konstellation-io/kai/engine/admin-api/mocks"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+const _productID = "test-product"
+
+var (
+	_versionStream = &entity.NatsResource{
+		Name:       "test-product_v1_0_0_test-workflow",
+		Type:       entity.NatsResourceTypeStream,
+		ProductID:  _productID,
+		VersionKey: "v1_0_0_test-workflow",
+	}
+	_orphanedVersionStream = &entity.NatsResource{
+		Name:       "test-product_v2_0_0_test-workflow",
+		Type:       entity.NatsResourceTypeStream,
+		ProductID:  _productID,
+		VersionKey: "v2_0_0_test-workflow",
+	}
+	_stoppedVersionStream = &entity.NatsResource{
+		Name:       "test-product_v3_0_0_test-workflow",
+		Type:       entity.NatsResourceTypeStream,
+		ProductID:  _productID,
+		VersionKey: "v3_0_0_test-workflow",
+	}
+	_globalKeyValueStore = &entity.NatsResource{
+		Name:      "key-store_test-product",
+		Type:      entity.NatsResourceTypeKeyValueStore,
+		ProductID: _productID,
+	}
+	_orphanedObjectStore = &entity.NatsResource{
+		Name:       "deleted-product_v1_0_0_test-object-store",
+		Type:       entity.NatsResourceTypeObjectStore,
+		ProductID:  "deleted-product",
+		VersionKey: "v1_0_0_test-object-store",
+	}
+)
+
+type NatsGCHandlerTestSuite struct {
+	suite.Suite
+	ctrl               *gomock.Controller
+	natsManagerService *mocks.MockNatsManagerService
+	productRepo        *mocks.MockProductRepo
+	versionRepo        *mocks.MockVersionRepo
+	accessControl      *mocks.MockAccessControl
+	handler            *natsgc.Handler
+}
+
+func TestNatsGCHandlerSuite(t *testing.T) {
+	suite.Run(t, new(NatsGCHandlerTestSuite))
+}
+
+func (s *NatsGCHandlerTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.natsManagerService = mocks.NewMockNatsManagerService(s.ctrl)
+	s.productRepo = mocks.NewMockProductRepo(s.ctrl)
+	s.versionRepo = mocks.NewMockVersionRepo(s.ctrl)
+	s.accessControl = mocks.NewMockAccessControl(s.ctrl)
+
+	s.handler = natsgc.NewHandler(&natsgc.HandlerParams{
+		Logger:             zapr.NewLogger(zap.NewNop()),
+		NatsManagerService: s.natsManagerService,
+		ProductRepo:        s.productRepo,
+		VersionRepo:        s.versionRepo,
+		AccessControl:      s.accessControl,
+	})
+}
+
+func (s *NatsGCHandlerTestSuite) expectExistingResources(ctx context.Context) {
+	product := testhelpers.NewProductBuilder().WithID(_productID).Build()
+	version := testhelpers.NewVersionBuilder().WithTag("v1.0.0").WithStatus(entity.VersionStatusStarted).Build()
+	stoppedVersion := testhelpers.NewVersionBuilder().WithTag("v3.0.0").WithStatus(entity.VersionStatusStopped).Build()
+
+	s.natsManagerService.EXPECT().ListResources(ctx).Return([]*entity.NatsResource{
+		_versionStream, _orphanedVersionStream, _stoppedVersionStream, _globalKeyValueStore, _orphanedObjectStore,
+	}, nil)
+	s.productRepo.EXPECT().FindAll(ctx, nil).Return([]*entity.Product{product}, nil)
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return([]*entity.Version{version, stoppedVersion}, nil)
+}
+
+func (s *NatsGCHandlerTestSuite) TestGetOrphanedResources() {
+	// GIVEN resources of a started version, a stopped version, a deleted version and a deleted product
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageNatsResources).Return(nil)
+	s.expectExistingResources(ctx)
+
+	// WHEN getting the orphaned resources
+	resources, err := s.handler.GetOrphanedResources(ctx, user)
+	s.Require().NoError(err)
+
+	// THEN only the resources of the stopped and deleted versions and the deleted product are returned
+	s.ElementsMatch([]*entity.NatsResource{_orphanedVersionStream, _stoppedVersionStream, _orphanedObjectStore}, resources)
+}
+
+func (s *NatsGCHandlerTestSuite) TestGetOrphanedResources_UnauthorizedUser() {
+	// GIVEN a user without grants
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	grantErr := errors.New("unauthorized")
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageNatsResources).Return(grantErr)
+
+	// WHEN getting the orphaned resources
+	_, err := s.handler.GetOrphanedResources(ctx, user)
+
+	// THEN the grants error is returned
+	s.ErrorIs(err, grantErr)
+}
+
+func (s *NatsGCHandlerTestSuite) TestGetOrphanedResources_ErrorListingResources() {
+	// GIVEN nats-manager fails listing the resources
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	natsErr := errors.New("nats error")
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageNatsResources).Return(nil)
+	s.natsManagerService.EXPECT().ListResources(ctx).Return(nil, natsErr)
+
+	// WHEN getting the orphaned resources
+	_, err := s.handler.GetOrphanedResources(ctx, user)
+
+	// THEN the error is returned
+	s.ErrorIs(err, natsErr)
+}
+
+func (s *NatsGCHandlerTestSuite) TestDeleteOrphanedResources() {
+	// GIVEN confirmed resources where one of them is no longer orphaned
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageNatsResources).Return(nil)
+	s.expectExistingResources(ctx)
+	s.natsManagerService.EXPECT().
+		DeleteResources(ctx, []*entity.NatsResource{_orphanedVersionStream}).
+		Return(nil)
+
+	// WHEN deleting the orphaned resources
+	deletedResources, err := s.handler.DeleteOrphanedResources(
+		ctx, user, []*entity.NatsResource{_orphanedVersionStream, _versionStream}, false,
+	)
+	s.Require().NoError(err)
+
+	// THEN only the orphaned resource is deleted
+	s.Equal([]*entity.NatsResource{_orphanedVersionStream}, deletedResources)
+}
+
+func (s *NatsGCHandlerTestSuite) TestDeleteOrphanedResources_DryRun() {
+	// GIVEN confirmed orphaned resources
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageNatsResources).Return(nil)
+	s.expectExistingResources(ctx)
+
+	// WHEN deleting the orphaned resources in dry-run mode
+	deletedResources, err := s.handler.DeleteOrphanedResources(
+		ctx, user, []*entity.NatsResource{_orphanedVersionStream, _orphanedObjectStore}, true,
+	)
+	s.Require().NoError(err)
+
+	// THEN the resources that would be deleted are returned without deleting them
+	s.Equal([]*entity.NatsResource{_orphanedVersionStream, _orphanedObjectStore}, deletedResources)
+}
+
+func (s *NatsGCHandlerTestSuite) TestDeleteOrphanedResources_ErrorDeletingResources() {
+	// GIVEN nats-manager fails deleting the resources
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	natsErr := errors.New("nats error")
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageNatsResources).Return(nil)
+	s.expectExistingResources(ctx)
+	s.natsManagerService.EXPECT().DeleteResources(ctx, gomock.Any()).Return(natsErr)
+
+	// WHEN deleting the orphaned resources
+	_, err := s.handler.DeleteOrphanedResources(ctx, user, []*entity.NatsResource{_orphanedObjectStore}, false)
+
+	// THEN the error is returned
+	s.ErrorIs(err, natsErr)
+}
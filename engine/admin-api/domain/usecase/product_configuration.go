@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+var (
+	ErrProductWithoutKeyValueStore  = errors.New("error product has no global key-value store")
+	ErrSettingProductConfiguration  = errors.New("error setting product configuration")
+	ErrDeletingProductConfiguration = errors.New("error deleting product configuration")
+)
+
+type SetProductConfigurationOpts struct {
+	ProductID string
+	Key       string
+	Value     string
+	Comment   string
+}
+
+type DeleteProductConfigurationOpts struct {
+	ProductID string
+	Key       string
+	Comment   string
+}
+
+// GetConfiguration returns the keys of the product global key-value store, shared by all its versions.
+func (i *ProductInteractor) GetConfiguration(
+	ctx context.Context,
+	user *entity.User,
+	productID string,
+) ([]*entity.ConfigurationEntry, error) {
+	if err := i.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
+		return nil, err
+	}
+
+	store, err := i.getGlobalConfigurationStore(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.natsService.GetConfiguration(ctx, store)
+}
+
+// SetConfiguration sets a key of the product global key-value store. Running versions see the change
+// right away, unless they override the key in a more specific scope.
+func (i *ProductInteractor) SetConfiguration(
+	ctx context.Context,
+	user *entity.User,
+	opts SetProductConfigurationOpts,
+) (*entity.ConfigurationEntry, error) {
+	if err := i.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageProductConfiguration); err != nil {
+		return nil, err
+	}
+
+	store, err := i.getGlobalConfigurationStore(ctx, opts.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := i.natsService.SetConfiguration(ctx, store, opts.Key, opts.Value)
+	if err != nil {
+		i.registerProductActivity(
+			i.userActivity.RegisterSetProductConfigurationAction,
+			user, opts.ProductID, opts.Key, ErrSettingProductConfiguration.Error(),
+		)
+
+		return nil, fmt.Errorf("%w: %w", ErrSettingProductConfiguration, err)
+	}
+
+	i.registerProductActivity(
+		i.userActivity.RegisterSetProductConfigurationAction,
+		user, opts.ProductID, opts.Key, opts.Comment,
+	)
+
+	return entry, nil
+}
+
+// DeleteConfiguration deletes a key of the product global key-value store.
+func (i *ProductInteractor) DeleteConfiguration(
+	ctx context.Context,
+	user *entity.User,
+	opts DeleteProductConfigurationOpts,
+) error {
+	if err := i.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageProductConfiguration); err != nil {
+		return err
+	}
+
+	store, err := i.getGlobalConfigurationStore(ctx, opts.ProductID)
+	if err != nil {
+		return err
+	}
+
+	if err := i.natsService.DeleteConfiguration(ctx, store, opts.Key); err != nil {
+		i.registerProductActivity(
+			i.userActivity.RegisterDeleteProductConfigurationAction,
+			user, opts.ProductID, opts.Key, ErrDeletingProductConfiguration.Error(),
+		)
+
+		return fmt.Errorf("%w: %w", ErrDeletingProductConfiguration, err)
+	}
+
+	i.registerProductActivity(
+		i.userActivity.RegisterDeleteProductConfigurationAction,
+		user, opts.ProductID, opts.Key, opts.Comment,
+	)
+
+	return nil
+}
+
+func (i *ProductInteractor) getGlobalConfigurationStore(
+	ctx context.Context,
+	productID string,
+) (entity.ConfigurationStore, error) {
+	product, err := i.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return entity.ConfigurationStore{}, err
+	}
+
+	if product.KeyValueStore == "" {
+		return entity.ConfigurationStore{}, ErrProductWithoutKeyValueStore
+	}
+
+	return entity.ConfigurationStore{ProductID: product.ID, Scope: entity.ConfigurationScopeGlobal}, nil
+}
+
+// registerProductActivity registers an action over a resource of the product, such as a configuration key
+// or an object store, logging any error.
+func (i *ProductInteractor) registerProductActivity(
+	register func(userID, productID, resource, comment string) error,
+	user *entity.User,
+	productID, resource, comment string,
+) {
+	if err := register(user.Email, productID, resource, comment); err != nil {
+		i.logger.Error(err, "Error registering user activity",
+			"productID", productID,
+			"resource", resource,
+			"comment", comment,
+		)
+	}
+}
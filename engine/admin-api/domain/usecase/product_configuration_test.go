@@ -0,0 +1,179 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *productSuite) TestGetConfiguration() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	store := entity.ConfigurationStore{ProductID: product.ID, Scope: entity.ConfigurationScopeGlobal}
+	expectedConfiguration := []*entity.ConfigurationEntry{
+		{Key: "key1", Value: "value1", Scope: entity.ConfigurationScopeGlobal, Revision: 1},
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActViewProduct).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.natsService.EXPECT().GetConfiguration(ctx, store).Return(expectedConfiguration, nil)
+
+	configuration, err := s.productInteractor.GetConfiguration(ctx, user, product.ID)
+	s.Require().NoError(err)
+	s.Equal(expectedConfiguration, configuration)
+}
+
+func (s *productSuite) TestGetConfiguration_FailsIfProductHasNoKeyValueStore() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	product.KeyValueStore = ""
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActViewProduct).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+
+	_, err := s.productInteractor.GetConfiguration(ctx, user, product.ID)
+	s.ErrorIs(err, usecase.ErrProductWithoutKeyValueStore)
+}
+
+func (s *productSuite) TestSetConfiguration() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	store := entity.ConfigurationStore{ProductID: product.ID, Scope: entity.ConfigurationScopeGlobal}
+	opts := usecase.SetProductConfigurationOpts{
+		ProductID: product.ID,
+		Key:       "key1",
+		Value:     "value1",
+		Comment:   "testing",
+	}
+	expectedEntry := &entity.ConfigurationEntry{
+		Key:      "key1",
+		Value:    "value1",
+		Scope:    entity.ConfigurationScopeGlobal,
+		Revision: 2,
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageProductConfiguration).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.natsService.EXPECT().SetConfiguration(ctx, store, opts.Key, opts.Value).Return(expectedEntry, nil)
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(entity.UserActivity{
+		UserID: user.Email,
+		Type:   entity.UserActivityTypeSetProductConfig,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: product.ID},
+			{Key: "KEY", Value: opts.Key},
+			{Key: "COMMENT", Value: opts.Comment},
+		},
+	})).Return(nil)
+
+	entry, err := s.productInteractor.SetConfiguration(ctx, user, opts)
+	s.Require().NoError(err)
+	s.Equal(expectedEntry, entry)
+}
+
+func (s *productSuite) TestSetConfiguration_FailsIfUserHasNotPermission() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	grantError := errors.New("grant error")
+
+	s.accessControl.EXPECT().
+		CheckProductGrants(user, "test-product", auth.ActManageProductConfiguration).
+		Return(grantError)
+
+	_, err := s.productInteractor.SetConfiguration(ctx, user, usecase.SetProductConfigurationOpts{
+		ProductID: "test-product",
+		Key:       "key1",
+	})
+	s.ErrorIs(err, grantError)
+}
+
+func (s *productSuite) TestSetConfiguration_ErrorSettingKey() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	store := entity.ConfigurationStore{ProductID: product.ID, Scope: entity.ConfigurationScopeGlobal}
+	natsErr := errors.New("nats error")
+	opts := usecase.SetProductConfigurationOpts{ProductID: product.ID, Key: "key1", Value: "value1"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageProductConfiguration).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.natsService.EXPECT().SetConfiguration(ctx, store, opts.Key, opts.Value).Return(nil, natsErr)
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(entity.UserActivity{
+		UserID: user.Email,
+		Type:   entity.UserActivityTypeSetProductConfig,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: product.ID},
+			{Key: "KEY", Value: opts.Key},
+			{Key: "COMMENT", Value: usecase.ErrSettingProductConfiguration.Error()},
+		},
+	})).Return(nil)
+
+	_, err := s.productInteractor.SetConfiguration(ctx, user, opts)
+	s.ErrorIs(err, usecase.ErrSettingProductConfiguration)
+	s.ErrorIs(err, natsErr)
+}
+
+func (s *productSuite) TestDeleteConfiguration() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	store := entity.ConfigurationStore{ProductID: product.ID, Scope: entity.ConfigurationScopeGlobal}
+	opts := usecase.DeleteProductConfigurationOpts{ProductID: product.ID, Key: "key1", Comment: "testing"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageProductConfiguration).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.natsService.EXPECT().DeleteConfiguration(ctx, store, opts.Key).Return(nil)
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(entity.UserActivity{
+		UserID: user.Email,
+		Type:   entity.UserActivityTypeDeleteProductConfig,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: product.ID},
+			{Key: "KEY", Value: opts.Key},
+			{Key: "COMMENT", Value: opts.Comment},
+		},
+	})).Return(nil)
+
+	err := s.productInteractor.DeleteConfiguration(ctx, user, opts)
+	s.Require().NoError(err)
+}
+
+func (s *productSuite) TestDeleteConfiguration_ErrorDeletingKey() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	store := entity.ConfigurationStore{ProductID: product.ID, Scope: entity.ConfigurationScopeGlobal}
+	natsErr := errors.New("key not found")
+	opts := usecase.DeleteProductConfigurationOpts{ProductID: product.ID, Key: "key1"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageProductConfiguration).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.natsService.EXPECT().DeleteConfiguration(ctx, store, opts.Key).Return(natsErr)
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(entity.UserActivity{
+		UserID: user.Email,
+		Type:   entity.UserActivityTypeDeleteProductConfig,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: product.ID},
+			{Key: "KEY", Value: opts.Key},
+			{Key: "COMMENT", Value: usecase.ErrDeletingProductConfiguration.Error()},
+		},
+	})).Return(nil)
+
+	err := s.productInteractor.DeleteConfiguration(ctx, user, opts)
+	s.ErrorIs(err, usecase.ErrDeletingProductConfiguration)
+	s.ErrorIs(err, natsErr)
+}
@@ -8,12 +8,14 @@ import (
 	"strings"
 
 	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/config"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/repository"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/service"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
 	"github.com/konstellation-io/kai/engine/admin-api/pkg/compensator"
 	"github.com/sethvargo/go-password/password"
+	"github.com/spf13/viper"
 )
 
 var (
@@ -38,6 +40,7 @@ type ProductInteractor struct {
 	userRegistry      service.UserRegistry
 	passwordGenerator password.PasswordGenerator
 	predictionRepo    repository.PredictionRepository
+	versionService    service.VersionService
 }
 
 type ProductInteractorOpts struct {
@@ -52,6 +55,7 @@ type ProductInteractorOpts struct {
 	UserRegistry         service.UserRegistry
 	PasswordGenerator    password.PasswordGenerator
 	PredictionRepository repository.PredictionRepository
+	VersionService       service.VersionService
 }
 
 // NewProductInteractor creates a new ProductInteractor.
@@ -68,6 +72,7 @@ func NewProductInteractor(ps *ProductInteractorOpts) *ProductInteractor {
 		ps.UserRegistry,
 		ps.PasswordGenerator,
 		ps.PredictionRepository,
+		ps.VersionService,
 	}
 }
 
@@ -191,6 +196,15 @@ func (i *ProductInteractor) createProductResources(
 	newProduct.MinioConfiguration = minioConfiguration
 	newProduct.ServiceAccount = serviceAccount
 
+	err = i.versionService.CreateProductNamespace(ctx, newProduct)
+	if err != nil {
+		return nil, fmt.Errorf("creating product namespace: %w", err)
+	}
+
+	compensations.AddCompensation(func() error {
+		return i.versionService.DeleteProductNamespace(context.Background(), newProduct.ID)
+	})
+
 	err = i.createDatabaseIndexes(ctx, newProduct.Name)
 	if err != nil {
 		return nil, err
@@ -250,6 +264,64 @@ func (i *ProductInteractor) createDatabaseIndexes(ctx context.Context, productID
 	return nil
 }
 
+// UpdateQuota sets the resources that the running versions of the product can request, applying them
+// to the product namespace too. A nil quota removes every limit.
+func (i *ProductInteractor) UpdateQuota(
+	ctx context.Context,
+	user *entity.User,
+	productID string,
+	quota *entity.ProductQuota,
+) (*entity.Product, error) {
+	if err := i.accessControl.CheckRoleGrants(user, auth.ActManageProductQuotas); err != nil {
+		return nil, err
+	}
+
+	if quota != nil {
+		if err := quota.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	product, err := i.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	product.Quota = quota
+
+	if err := i.versionService.UpdateProductNamespace(ctx, product); err != nil {
+		return nil, fmt.Errorf("updating product namespace quota: %w", err)
+	}
+
+	if err := i.productRepo.Update(ctx, product); err != nil {
+		return nil, fmt.Errorf("updating product quota: %w", err)
+	}
+
+	i.logger.Info("Product quota updated", "productID", productID, "userEmail", user.Email)
+
+	return product, nil
+}
+
+// EnsureProductNamespaces creates the namespaces of the existing products, so the products created before the
+// namespace isolation was enabled get one with the quota in their settings. Namespaces already created are kept,
+// setting their resource quota to the product one.
+func (i *ProductInteractor) EnsureProductNamespaces(ctx context.Context) error {
+	products, err := i.productRepo.FindAll(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("getting products: %w", err)
+	}
+
+	var errs error
+
+	for _, product := range products {
+		if err := i.versionService.CreateProductNamespace(ctx, product); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("creating product %q namespace: %w", product.ID, err))
+		}
+	}
+
+	return errs
+}
+
 // GetByID return a Product by its ID.
 func (i *ProductInteractor) GetByID(ctx context.Context, user *entity.User, productID string) (*entity.Product, error) {
 	if err := i.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
@@ -285,7 +357,23 @@ func (i *ProductInteractor) buildProductFromParams(user *entity.User, name, desc
 		Name:        strings.TrimSpace(name),
 		Description: strings.TrimSpace(description),
 		Owner:       user.ID,
+		Quota:       i.getDefaultQuota(),
+	}
+}
+
+// getDefaultQuota returns the configured quota for new products, nil when there is none.
+func (i *ProductInteractor) getDefaultQuota() *entity.ProductQuota {
+	quota := &entity.ProductQuota{
+		CPURequests:    viper.GetString(config.ProductResourceQuotaCPUKey),
+		MemoryRequests: viper.GetString(config.ProductResourceQuotaMemoryKey),
+		MaxPods:        viper.GetInt32(config.ProductResourceQuotaPodsKey),
 	}
+
+	if quota.CPURequests == "" && quota.MemoryRequests == "" && quota.MaxPods == 0 {
+		return nil
+	}
+
+	return quota
 }
 
 func (i *ProductInteractor) executeCompensations(compensations *compensator.Compensator) {
@@ -12,6 +12,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
 	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/config"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/repository"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
@@ -19,6 +20,7 @@ import (
 	"github.com/konstellation-io/kai/engine/admin-api/mocks"
 	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
 	"github.com/sethvargo/go-password/password"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -46,6 +48,7 @@ type productSuite struct {
 	passwordGenerator password.PasswordGenerator
 	natsService       *mocks.MockNatsManagerService
 	predictionRepo    *mocks.MockPredictionRepo
+	versionService    *mocks.MockVersionService
 }
 
 func TestProductSuite(t *testing.T) {
@@ -66,6 +69,7 @@ func (s *productSuite) SetupSuite() {
 	s.passwordGenerator = password.NewMockGenerator(_testPassword, nil)
 	s.natsService = mocks.NewMockNatsManagerService(ctrl)
 	s.predictionRepo = mocks.NewMockPredictionRepo(s.T())
+	s.versionService = mocks.NewMockVersionService(ctrl)
 
 	userActivity := usecase.NewUserActivityInteractor(
 		s.logger,
@@ -85,6 +89,7 @@ func (s *productSuite) SetupSuite() {
 		PasswordGenerator:    s.passwordGenerator,
 		NatsService:          s.natsService,
 		PredictionRepository: s.predictionRepo,
+		VersionService:       s.versionService,
 	}
 	s.productInteractor = usecase.NewProductInteractor(&productInteractorOpts)
 }
@@ -126,6 +131,7 @@ func (s *productSuite) TestCreateProduct() {
 	s.predictionRepo.EXPECT().
 		CreateUser(ctx, productID, expectedProduct.ServiceAccount.Username, expectedProduct.ServiceAccount.Password).
 		Return(nil).Times(1)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, expectedProduct).Return(nil)
 	s.productRepo.EXPECT().Create(ctx, expectedProduct).Return(expectedProduct, nil)
 	s.userRegistry.EXPECT().AddProductGrants(ctx, user.Email, productID, auth.GetDefaultMaintainerGrants()).Return(nil)
 
@@ -283,6 +289,7 @@ func (s *productSuite) TestCreateProduct_ErrorCreatingVersionRepoIndexes() {
 	s.predictionRepo.EXPECT().
 		CreateUser(ctx, productID, expectedProduct.ServiceAccount.Username, expectedProduct.ServiceAccount.Password).
 		Return(nil).Times(1)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, gomock.Any()).Return(nil)
 
 	s.versionRepo.EXPECT().CreateIndexes(ctx, productID).Return(expectedError)
 
@@ -296,6 +303,7 @@ func (s *productSuite) TestCreateProduct_ErrorCreatingVersionRepoIndexes() {
 	s.objectStorage.EXPECT().DeleteBucketPolicy(ctx, _testBucketPolicy).Return(nil).Times(1)
 	s.objectStorage.EXPECT().DeleteBucket(ctx, productID).Return(nil).Times(1)
 	s.predictionRepo.EXPECT().DeleteUser(ctx, productID).Return(nil).Times(1)
+	s.versionService.EXPECT().DeleteProductNamespace(ctx, productID).Return(nil).Times(1)
 
 	_, err := s.productInteractor.CreateProduct(ctx, user, productName, productDescription)
 	s.Require().ErrorIs(err, expectedError)
@@ -334,6 +342,7 @@ func (s *productSuite) TestCreateProduct_ErrorCreatingProcessRegistryRepoIndexes
 	s.predictionRepo.EXPECT().
 		CreateUser(ctx, expectedProduct.ID, expectedProduct.ServiceAccount.Username, expectedProduct.ServiceAccount.Password).
 		Return(nil).Times(1)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, gomock.Any()).Return(nil)
 
 	s.versionRepo.EXPECT().CreateIndexes(ctx, expectedProduct.ID).Return(nil)
 	s.processRepo.EXPECT().CreateIndexes(ctx, expectedProduct.ID).Return(expectedError)
@@ -348,6 +357,7 @@ func (s *productSuite) TestCreateProduct_ErrorCreatingProcessRegistryRepoIndexes
 	s.objectStorage.EXPECT().DeleteBucketPolicy(ctx, _testBucketPolicy).Return(nil).Times(1)
 	s.objectStorage.EXPECT().DeleteBucket(ctx, productID).Return(nil).Times(1)
 	s.predictionRepo.EXPECT().DeleteUser(ctx, productID).Return(nil).Times(1)
+	s.versionService.EXPECT().DeleteProductNamespace(ctx, productID).Return(nil).Times(1)
 
 	_, err := s.productInteractor.CreateProduct(ctx, user, productName, productDescription)
 	s.Require().ErrorIs(err, expectedError)
@@ -399,6 +409,7 @@ func (s *productSuite) TestCreateProduct_FailsIfCreateProductFails() {
 	s.predictionRepo.EXPECT().
 		CreateUser(ctx, productID, newProduct.ServiceAccount.Username, newProduct.ServiceAccount.Password).
 		Return(nil).Times(1)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, gomock.Any()).Return(nil)
 
 	s.versionRepo.EXPECT().CreateIndexes(ctx, productID).Return(nil)
 	s.processRepo.EXPECT().CreateIndexes(ctx, productID).Return(nil)
@@ -414,6 +425,7 @@ func (s *productSuite) TestCreateProduct_FailsIfCreateProductFails() {
 	s.objectStorage.EXPECT().DeleteBucketPolicy(ctx, _testBucketPolicy).Return(nil).Times(1)
 	s.objectStorage.EXPECT().DeleteBucket(ctx, productID).Return(nil).Times(1)
 	s.predictionRepo.EXPECT().DeleteUser(ctx, productID).Return(nil).Times(1)
+	s.versionService.EXPECT().DeleteProductNamespace(ctx, productID).Return(nil).Times(1)
 	s.productRepo.EXPECT().DeleteDatabase(ctx, productID).Return(nil).Times(1)
 
 	_, err := s.productInteractor.CreateProduct(ctx, user, productName, productDescription)
@@ -422,6 +434,151 @@ func (s *productSuite) TestCreateProduct_FailsIfCreateProductFails() {
 	s.NoError(testhelpers.WaitOrTimeout(&wg, _wgTimeout))
 }
 
+func (s *productSuite) TestCreateProduct_ErrorCreatingProductNamespace() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	productID := "test-product"
+	productName := "test-product"
+	productDescription := "This is a product description"
+
+	wg := sync.WaitGroup{}
+
+	wg.Add(1)
+
+	expectedError := errors.New("namespace error")
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActCreateProduct).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, productID).Return(nil, usecase.ErrProductNotFound)
+	s.productRepo.EXPECT().GetByName(ctx, productName).Return(nil, usecase.ErrProductNotFound)
+	s.natsService.EXPECT().CreateGlobalKeyValueStore(ctx, productID).Return(_testKvStore, nil)
+	s.objectStorage.EXPECT().CreateBucket(ctx, productID).Return(nil)
+	s.objectStorage.EXPECT().CreateBucketPolicy(ctx, productID).Times(1).Return(_testBucketPolicy, nil)
+	s.userRegistry.EXPECT().CreateGroupWithPolicy(ctx, productID, _testBucketPolicy).Times(1).Return(nil)
+	s.userRegistry.EXPECT().CreateUserWithinGroup(ctx, productID, _testPassword, productID).Times(1).Return(nil)
+	s.predictionRepo.EXPECT().CreateUser(ctx, productID, productID, _testPassword).Return(nil).Times(1)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, gomock.Any()).Return(expectedError)
+
+	// Compensations
+	s.natsService.EXPECT().DeleteGlobalKeyValueStore(ctx, productID).DoAndReturn(func(_, _ any) error {
+		wg.Done()
+		return nil
+	}).Times(1)
+	s.userRegistry.EXPECT().DeleteUser(ctx, productID).Return(nil).Times(1)
+	s.userRegistry.EXPECT().DeleteGroup(ctx, productID).Return(nil).Times(1)
+	s.objectStorage.EXPECT().DeleteBucketPolicy(ctx, _testBucketPolicy).Return(nil).Times(1)
+	s.objectStorage.EXPECT().DeleteBucket(ctx, productID).Return(nil).Times(1)
+	s.predictionRepo.EXPECT().DeleteUser(ctx, productID).Return(nil).Times(1)
+
+	_, err := s.productInteractor.CreateProduct(ctx, user, productName, productDescription)
+	s.Require().ErrorIs(err, expectedError)
+
+	s.NoError(testhelpers.WaitOrTimeout(&wg, _wgTimeout))
+}
+
+func (s *productSuite) TestCreateProduct_WithDefaultQuota() {
+	ctx := context.Background()
+
+	viper.Set(config.ProductResourceQuotaCPUKey, "4")
+	viper.Set(config.ProductResourceQuotaMemoryKey, "8Gi")
+	viper.Set(config.ProductResourceQuotaPodsKey, 20)
+
+	s.T().Cleanup(func() {
+		viper.Set(config.ProductResourceQuotaCPUKey, "")
+		viper.Set(config.ProductResourceQuotaMemoryKey, "")
+		viper.Set(config.ProductResourceQuotaPodsKey, 0)
+	})
+
+	user := testhelpers.NewUserBuilder().Build()
+	productID := "test-product"
+	productName := "test-product"
+	productDescription := "This is a product description"
+
+	expectedQuota := &entity.ProductQuota{CPURequests: "4", MemoryRequests: "8Gi", MaxPods: 20}
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActCreateProduct).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, productID).Return(nil, usecase.ErrProductNotFound)
+	s.productRepo.EXPECT().GetByName(ctx, productName).Return(nil, usecase.ErrProductNotFound)
+	s.natsService.EXPECT().CreateGlobalKeyValueStore(ctx, productID).Return(_testKvStore, nil)
+	s.objectStorage.EXPECT().CreateBucket(ctx, productID).Return(nil)
+	s.objectStorage.EXPECT().CreateBucketPolicy(ctx, productID).Return(_testBucketPolicy, nil)
+	s.userRegistry.EXPECT().CreateGroupWithPolicy(ctx, productID, _testBucketPolicy).Return(nil)
+	s.userRegistry.EXPECT().CreateUserWithinGroup(ctx, productID, _testPassword, productID).Return(nil)
+	s.predictionRepo.EXPECT().CreateUser(ctx, productID, productID, _testPassword).Return(nil)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, product *entity.Product) error {
+			s.Equal(expectedQuota, product.Quota)
+			return nil
+		})
+	s.versionRepo.EXPECT().CreateIndexes(ctx, productID).Return(nil)
+	s.processRepo.EXPECT().CreateIndexes(ctx, productID).Return(nil)
+	s.productRepo.EXPECT().Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, product *entity.Product) (*entity.Product, error) {
+			return product, nil
+		})
+	s.userRegistry.EXPECT().AddProductGrants(ctx, user.Email, productID, auth.GetDefaultMaintainerGrants()).Return(nil)
+
+	product, err := s.productInteractor.CreateProduct(ctx, user, productName, productDescription)
+	s.Require().NoError(err)
+	s.Equal(expectedQuota, product.Quota)
+}
+
+func (s *productSuite) TestUpdateQuota() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	quota := &entity.ProductQuota{MaxStartedVersions: 2, CPURequests: "4", MemoryRequests: "8Gi"}
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageProductQuotas).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.versionService.EXPECT().UpdateProductNamespace(ctx, product).Return(nil)
+	s.productRepo.EXPECT().Update(ctx, product).Return(nil)
+
+	updatedProduct, err := s.productInteractor.UpdateQuota(ctx, user, product.ID, quota)
+	s.Require().NoError(err)
+	s.Equal(quota, updatedProduct.Quota)
+}
+
+func (s *productSuite) TestUpdateQuota_FailsIfNamespaceCannotBeUpdated() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	quota := &entity.ProductQuota{CPURequests: "4"}
+	namespaceErr := errors.New("namespace error")
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageProductQuotas).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.versionService.EXPECT().UpdateProductNamespace(ctx, product).Return(namespaceErr)
+
+	_, err := s.productInteractor.UpdateQuota(ctx, user, product.ID, quota)
+	s.Require().ErrorIs(err, namespaceErr)
+}
+
+func (s *productSuite) TestUpdateQuota_FailsIfUserHasNotPermission() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	grantError := errors.New("grant error")
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageProductQuotas).Return(grantError)
+
+	_, err := s.productInteractor.UpdateQuota(ctx, user, "test-product", &entity.ProductQuota{})
+	s.Require().ErrorIs(err, grantError)
+}
+
+func (s *productSuite) TestUpdateQuota_FailsIfQuotaIsInvalid() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+
+	s.accessControl.EXPECT().CheckRoleGrants(user, auth.ActManageProductQuotas).Return(nil)
+
+	_, err := s.productInteractor.UpdateQuota(ctx, user, "test-product", &entity.ProductQuota{CPURequests: "lots"})
+	s.Require().ErrorIs(err, entity.ErrInvalidProductQuota)
+}
+
 func (s *productSuite) TestGetByID() {
 	ctx := context.Background()
 
@@ -445,6 +602,38 @@ func (s *productSuite) TestGetByID() {
 	s.Require().Equal(expected, actual)
 }
 
+func (s *productSuite) TestEnsureProductNamespaces() {
+	ctx := context.Background()
+
+	productWithQuota := testhelpers.NewProductBuilder().
+		WithID("product-with-quota").
+		WithQuota(&entity.ProductQuota{CPURequests: "2"}).
+		Build()
+	productWithoutQuota := testhelpers.NewProductBuilder().WithID("product-without-quota").Build()
+
+	s.productRepo.EXPECT().FindAll(ctx, nil).Return([]*entity.Product{productWithQuota, productWithoutQuota}, nil)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, productWithQuota).Return(nil)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, productWithoutQuota).Return(nil)
+
+	err := s.productInteractor.EnsureProductNamespaces(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *productSuite) TestEnsureProductNamespaces_ContinuesOnError() {
+	ctx := context.Background()
+
+	failingProduct := testhelpers.NewProductBuilder().WithID("failing-product").Build()
+	product := testhelpers.NewProductBuilder().WithID("product").Build()
+	namespaceErr := errors.New("namespace error")
+
+	s.productRepo.EXPECT().FindAll(ctx, nil).Return([]*entity.Product{failingProduct, product}, nil)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, failingProduct).Return(namespaceErr)
+	s.versionService.EXPECT().CreateProductNamespace(ctx, product).Return(nil)
+
+	err := s.productInteractor.EnsureProductNamespaces(ctx)
+	s.Require().ErrorIs(err, namespaceErr)
+}
+
 func (s *productSuite) TestFindAll() {
 	ctx := context.Background()
 
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/repository"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+var (
+	ErrCreatingProductObjectStore = errors.New("error creating product object store")
+	ErrDeletingProductObjectStore = errors.New("error deleting product object store")
+	ErrProductObjectStoreInUse    = errors.New("error product object store is used by a started version")
+)
+
+type ProductObjectStoreOpts struct {
+	ProductID string
+	Name      string
+	Comment   string
+}
+
+// GetObjectStores returns the object stores shared by every version of the product.
+func (i *ProductInteractor) GetObjectStores(
+	ctx context.Context,
+	user *entity.User,
+	productID string,
+) ([]*entity.ProductObjectStore, error) {
+	if err := i.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
+		return nil, err
+	}
+
+	if _, err := i.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	names, err := i.natsService.GetProductObjectStores(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	objectStores := make([]*entity.ProductObjectStore, 0, len(names))
+	for _, name := range names {
+		objectStores = append(objectStores, entity.NewProductObjectStore(productID, name))
+	}
+
+	return objectStores, nil
+}
+
+// CreateObjectStore creates an object store shared by every version of the product. Versions whose processes
+// declare a product scoped object store with the same name read and write its objects.
+func (i *ProductInteractor) CreateObjectStore(
+	ctx context.Context,
+	user *entity.User,
+	opts ProductObjectStoreOpts,
+) (*entity.ProductObjectStore, error) {
+	if err := i.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageProductObjectStores); err != nil {
+		return nil, err
+	}
+
+	if _, err := i.productRepo.GetByID(ctx, opts.ProductID); err != nil {
+		return nil, err
+	}
+
+	if _, err := i.natsService.CreateProductObjectStore(ctx, opts.ProductID, opts.Name); err != nil {
+		i.registerProductActivity(
+			i.userActivity.RegisterCreateProductObjectStoreAction,
+			user, opts.ProductID, opts.Name, ErrCreatingProductObjectStore.Error(),
+		)
+
+		return nil, fmt.Errorf("%w: %w", ErrCreatingProductObjectStore, err)
+	}
+
+	i.registerProductActivity(
+		i.userActivity.RegisterCreateProductObjectStoreAction,
+		user, opts.ProductID, opts.Name, opts.Comment,
+	)
+
+	return entity.NewProductObjectStore(opts.ProductID, opts.Name), nil
+}
+
+// DeleteObjectStore deletes an object store shared by every version of the product, with all its objects.
+// Object stores used by started versions can't be deleted.
+func (i *ProductInteractor) DeleteObjectStore(
+	ctx context.Context,
+	user *entity.User,
+	opts ProductObjectStoreOpts,
+) error {
+	if err := i.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageProductObjectStores); err != nil {
+		return err
+	}
+
+	if err := i.checkObjectStoreNotInUse(ctx, opts.ProductID, opts.Name); err != nil {
+		i.registerProductActivity(
+			i.userActivity.RegisterDeleteProductObjectStoreAction,
+			user, opts.ProductID, opts.Name, err.Error(),
+		)
+
+		return err
+	}
+
+	if err := i.natsService.DeleteProductObjectStore(ctx, opts.ProductID, opts.Name); err != nil {
+		i.registerProductActivity(
+			i.userActivity.RegisterDeleteProductObjectStoreAction,
+			user, opts.ProductID, opts.Name, ErrDeletingProductObjectStore.Error(),
+		)
+
+		return fmt.Errorf("%w: %w", ErrDeletingProductObjectStore, err)
+	}
+
+	i.registerProductActivity(
+		i.userActivity.RegisterDeleteProductObjectStoreAction,
+		user, opts.ProductID, opts.Name, opts.Comment,
+	)
+
+	return nil
+}
+
+func (i *ProductInteractor) checkObjectStoreNotInUse(ctx context.Context, productID, name string) error {
+	startedVersions, err := i.versionRepo.SearchByProduct(ctx, productID, &repository.ListVersionsFilter{
+		Status: entity.VersionStatusStarted,
+	})
+	if err != nil {
+		return fmt.Errorf("searching started versions: %w", err)
+	}
+
+	for _, version := range startedVersions {
+		for _, workflow := range version.Workflows {
+			for _, process := range workflow.Processes {
+				objectStore := process.ObjectStore
+				if objectStore != nil && objectStore.Scope == entity.ObjectStoreScopeProduct && objectStore.Name == name {
+					return fmt.Errorf("%w: version %q", ErrProductObjectStoreInUse, version.Tag)
+				}
+			}
+		}
+	}
+
+	return nil
+}
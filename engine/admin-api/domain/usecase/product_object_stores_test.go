@@ -0,0 +1,150 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/repository"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *productSuite) expectObjectStoreActivity(
+	user *entity.User,
+	activityType entity.UserActivityType,
+	productID, name, comment string,
+) {
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(entity.UserActivity{
+		UserID: user.Email,
+		Type:   activityType,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "OBJECT_STORE", Value: name},
+			{Key: "COMMENT", Value: comment},
+		},
+	})).Return(nil)
+}
+
+func (s *productSuite) TestGetObjectStores() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActViewProduct).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.natsService.EXPECT().GetProductObjectStores(ctx, product.ID).Return([]string{"models"}, nil)
+
+	objectStores, err := s.productInteractor.GetObjectStores(ctx, user, product.ID)
+	s.Require().NoError(err)
+	s.Equal([]*entity.ProductObjectStore{
+		{Name: "models", Bucket: entity.GetProductObjectStoreName(product.ID, "models")},
+	}, objectStores)
+}
+
+func (s *productSuite) TestCreateObjectStore() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	opts := usecase.ProductObjectStoreOpts{ProductID: product.ID, Name: "models", Comment: "testing"}
+	bucket := entity.GetProductObjectStoreName(product.ID, "models")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageProductObjectStores).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.natsService.EXPECT().CreateProductObjectStore(ctx, product.ID, opts.Name).Return(bucket, nil)
+	s.expectObjectStoreActivity(user, entity.UserActivityTypeCreateObjectStore, product.ID, opts.Name, opts.Comment)
+
+	objectStore, err := s.productInteractor.CreateObjectStore(ctx, user, opts)
+	s.Require().NoError(err)
+	s.Equal(&entity.ProductObjectStore{Name: "models", Bucket: bucket}, objectStore)
+}
+
+func (s *productSuite) TestCreateObjectStore_FailsIfUserHasNotPermission() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	grantError := errors.New("grant error")
+
+	s.accessControl.EXPECT().
+		CheckProductGrants(user, "test-product", auth.ActManageProductObjectStores).
+		Return(grantError)
+
+	_, err := s.productInteractor.CreateObjectStore(ctx, user, usecase.ProductObjectStoreOpts{
+		ProductID: "test-product",
+		Name:      "models",
+	})
+	s.ErrorIs(err, grantError)
+}
+
+func (s *productSuite) TestCreateObjectStore_ErrorCreating() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	opts := usecase.ProductObjectStoreOpts{ProductID: product.ID, Name: "models"}
+	natsErr := errors.New("object store already exists")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageProductObjectStores).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.natsService.EXPECT().CreateProductObjectStore(ctx, product.ID, opts.Name).Return("", natsErr)
+	s.expectObjectStoreActivity(
+		user, entity.UserActivityTypeCreateObjectStore, product.ID, opts.Name,
+		usecase.ErrCreatingProductObjectStore.Error(),
+	)
+
+	_, err := s.productInteractor.CreateObjectStore(ctx, user, opts)
+	s.ErrorIs(err, usecase.ErrCreatingProductObjectStore)
+	s.ErrorIs(err, natsErr)
+}
+
+func (s *productSuite) TestDeleteObjectStore() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	opts := usecase.ProductObjectStoreOpts{ProductID: product.ID, Name: "models", Comment: "testing"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageProductObjectStores).Return(nil)
+	s.versionRepo.EXPECT().
+		SearchByProduct(ctx, product.ID, &repository.ListVersionsFilter{Status: entity.VersionStatusStarted}).
+		Return([]*entity.Version{testhelpers.NewVersionBuilder().Build()}, nil)
+	s.natsService.EXPECT().DeleteProductObjectStore(ctx, product.ID, opts.Name).Return(nil)
+	s.expectObjectStoreActivity(user, entity.UserActivityTypeDeleteObjectStore, product.ID, opts.Name, opts.Comment)
+
+	err := s.productInteractor.DeleteObjectStore(ctx, user, opts)
+	s.Require().NoError(err)
+}
+
+func (s *productSuite) TestDeleteObjectStore_FailsIfUsedByStartedVersion() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().Build()
+	opts := usecase.ProductObjectStoreOpts{ProductID: product.ID, Name: "models"}
+	startedVersion := testhelpers.NewVersionBuilder().
+		WithWorkflows([]entity.Workflow{
+			testhelpers.NewWorkflowBuilder().
+				WithProcesses([]entity.Process{
+					testhelpers.NewProcessBuilder().
+						WithObjectStore(&entity.ProcessObjectStore{Name: "models", Scope: entity.ObjectStoreScopeProduct}).
+						Build(),
+				}).
+				Build(),
+		}).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageProductObjectStores).Return(nil)
+	s.versionRepo.EXPECT().
+		SearchByProduct(ctx, product.ID, &repository.ListVersionsFilter{Status: entity.VersionStatusStarted}).
+		Return([]*entity.Version{startedVersion}, nil)
+	s.userActivityRepo.EXPECT().Create(gomock.Any()).Return(nil)
+
+	err := s.productInteractor.DeleteObjectStore(ctx, user, opts)
+	s.ErrorIs(err, usecase.ErrProductObjectStoreInUse)
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+var (
+	ErrUploadingObject = errors.New("error uploading object")
+	ErrDeletingObject  = errors.New("error deleting object")
+)
+
+type UploadObjectOpts struct {
+	ProductID   string
+	ObjectStore string
+	Name        string
+	Content     io.Reader
+	Comment     string
+}
+
+type DeleteObjectOpts struct {
+	ProductID   string
+	ObjectStore string
+	Name        string
+	Comment     string
+}
+
+// ListObjects returns the objects stored in one of the object stores of the product, either a version object
+// store or one shared by every version.
+func (i *ProductInteractor) ListObjects(
+	ctx context.Context,
+	user *entity.User,
+	productID, objectStore string,
+) ([]*entity.ObjectInfo, error) {
+	if err := i.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
+		return nil, err
+	}
+
+	return i.natsService.ListObjects(ctx, productID, objectStore)
+}
+
+// DownloadObject writes the content of an object stored in one of the object stores of the product to w.
+func (i *ProductInteractor) DownloadObject(
+	ctx context.Context,
+	user *entity.User,
+	productID, objectStore, name string,
+	w io.Writer,
+) error {
+	if err := i.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
+		return err
+	}
+
+	return i.natsService.DownloadObject(ctx, productID, objectStore, name, w)
+}
+
+// UploadObject stores an object in one of the object stores of the product, replacing it if it already exists.
+func (i *ProductInteractor) UploadObject(
+	ctx context.Context,
+	user *entity.User,
+	opts UploadObjectOpts,
+) (*entity.ObjectInfo, error) {
+	if err := i.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageObjects); err != nil {
+		return nil, err
+	}
+
+	object, err := i.natsService.UploadObject(ctx, opts.ProductID, opts.ObjectStore, opts.Name, opts.Content)
+	if err != nil {
+		i.registerObjectActivity(
+			i.userActivity.RegisterUploadObjectAction,
+			user, opts.ProductID, opts.ObjectStore, opts.Name, ErrUploadingObject.Error(),
+		)
+
+		return nil, fmt.Errorf("%w: %w", ErrUploadingObject, err)
+	}
+
+	i.registerObjectActivity(
+		i.userActivity.RegisterUploadObjectAction,
+		user, opts.ProductID, opts.ObjectStore, opts.Name, opts.Comment,
+	)
+
+	return object, nil
+}
+
+// DeleteObject deletes an object from one of the object stores of the product.
+func (i *ProductInteractor) DeleteObject(
+	ctx context.Context,
+	user *entity.User,
+	opts DeleteObjectOpts,
+) error {
+	if err := i.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageObjects); err != nil {
+		return err
+	}
+
+	if err := i.natsService.DeleteObject(ctx, opts.ProductID, opts.ObjectStore, opts.Name); err != nil {
+		i.registerObjectActivity(
+			i.userActivity.RegisterDeleteObjectAction,
+			user, opts.ProductID, opts.ObjectStore, opts.Name, ErrDeletingObject.Error(),
+		)
+
+		return fmt.Errorf("%w: %w", ErrDeletingObject, err)
+	}
+
+	i.registerObjectActivity(
+		i.userActivity.RegisterDeleteObjectAction,
+		user, opts.ProductID, opts.ObjectStore, opts.Name, opts.Comment,
+	)
+
+	return nil
+}
+
+// registerObjectActivity registers an action over an object of one of the product object stores,
+// logging any error.
+func (i *ProductInteractor) registerObjectActivity(
+	register func(userID, productID, objectStore, name, comment string) error,
+	user *entity.User,
+	productID, objectStore, name, comment string,
+) {
+	if err := register(user.Email, productID, objectStore, name, comment); err != nil {
+		i.logger.Error(err, "Error registering user activity",
+			"productID", productID,
+			"objectStore", objectStore,
+			"object", name,
+			"comment", comment,
+		)
+	}
+}
@@ -0,0 +1,193 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+const _testObjectStore = "object-store_product-name_models"
+
+func (s *productSuite) TestListObjects() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	expectedObjects := []*entity.ObjectInfo{
+		{Name: "model.bin", Size: 10, Digest: "SHA-256=digest", Modified: time.Now()},
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, "test-product", auth.ActViewProduct).Return(nil)
+	s.natsService.EXPECT().ListObjects(ctx, "test-product", _testObjectStore).Return(expectedObjects, nil)
+
+	objects, err := s.productInteractor.ListObjects(ctx, user, "test-product", _testObjectStore)
+	s.Require().NoError(err)
+	s.Equal(expectedObjects, objects)
+}
+
+func (s *productSuite) TestListObjects_FailsIfUserHasNotPermission() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	grantError := errors.New("grant error")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, "test-product", auth.ActViewProduct).Return(grantError)
+
+	_, err := s.productInteractor.ListObjects(ctx, user, "test-product", _testObjectStore)
+	s.ErrorIs(err, grantError)
+}
+
+func (s *productSuite) TestDownloadObject() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	content := &bytes.Buffer{}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, "test-product", auth.ActViewProduct).Return(nil)
+	s.natsService.EXPECT().DownloadObject(ctx, "test-product", _testObjectStore, "model.bin", content).Return(nil)
+
+	err := s.productInteractor.DownloadObject(ctx, user, "test-product", _testObjectStore, "model.bin", content)
+	s.Require().NoError(err)
+}
+
+func (s *productSuite) TestUploadObject() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	opts := usecase.UploadObjectOpts{
+		ProductID:   "test-product",
+		ObjectStore: _testObjectStore,
+		Name:        "model.bin",
+		Content:     strings.NewReader("content"),
+		Comment:     "testing",
+	}
+	expectedObject := &entity.ObjectInfo{Name: "model.bin", Size: 7}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, opts.ProductID, auth.ActManageObjects).Return(nil)
+	s.natsService.EXPECT().
+		UploadObject(ctx, opts.ProductID, opts.ObjectStore, opts.Name, opts.Content).
+		Return(expectedObject, nil)
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(entity.UserActivity{
+		UserID: user.Email,
+		Type:   entity.UserActivityTypeUploadObject,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: opts.ProductID},
+			{Key: "OBJECT_STORE", Value: opts.ObjectStore},
+			{Key: "OBJECT", Value: opts.Name},
+			{Key: "COMMENT", Value: opts.Comment},
+		},
+	})).Return(nil)
+
+	object, err := s.productInteractor.UploadObject(ctx, user, opts)
+	s.Require().NoError(err)
+	s.Equal(expectedObject, object)
+}
+
+func (s *productSuite) TestUploadObject_FailsIfUserHasNotPermission() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	grantError := errors.New("grant error")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, "test-product", auth.ActManageObjects).Return(grantError)
+
+	_, err := s.productInteractor.UploadObject(ctx, user, usecase.UploadObjectOpts{
+		ProductID:   "test-product",
+		ObjectStore: _testObjectStore,
+		Name:        "model.bin",
+	})
+	s.ErrorIs(err, grantError)
+}
+
+func (s *productSuite) TestUploadObject_ErrorUploading() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	natsErr := errors.New("nats error")
+	opts := usecase.UploadObjectOpts{
+		ProductID:   "test-product",
+		ObjectStore: _testObjectStore,
+		Name:        "model.bin",
+		Content:     strings.NewReader("content"),
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, opts.ProductID, auth.ActManageObjects).Return(nil)
+	s.natsService.EXPECT().
+		UploadObject(ctx, opts.ProductID, opts.ObjectStore, opts.Name, opts.Content).
+		Return(nil, natsErr)
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(entity.UserActivity{
+		UserID: user.Email,
+		Type:   entity.UserActivityTypeUploadObject,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: opts.ProductID},
+			{Key: "OBJECT_STORE", Value: opts.ObjectStore},
+			{Key: "OBJECT", Value: opts.Name},
+			{Key: "COMMENT", Value: usecase.ErrUploadingObject.Error()},
+		},
+	})).Return(nil)
+
+	_, err := s.productInteractor.UploadObject(ctx, user, opts)
+	s.ErrorIs(err, usecase.ErrUploadingObject)
+	s.ErrorIs(err, natsErr)
+}
+
+func (s *productSuite) TestDeleteObject() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	opts := usecase.DeleteObjectOpts{
+		ProductID:   "test-product",
+		ObjectStore: _testObjectStore,
+		Name:        "model.bin",
+		Comment:     "testing",
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, opts.ProductID, auth.ActManageObjects).Return(nil)
+	s.natsService.EXPECT().DeleteObject(ctx, opts.ProductID, opts.ObjectStore, opts.Name).Return(nil)
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(entity.UserActivity{
+		UserID: user.Email,
+		Type:   entity.UserActivityTypeDeleteObject,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: opts.ProductID},
+			{Key: "OBJECT_STORE", Value: opts.ObjectStore},
+			{Key: "OBJECT", Value: opts.Name},
+			{Key: "COMMENT", Value: opts.Comment},
+		},
+	})).Return(nil)
+
+	err := s.productInteractor.DeleteObject(ctx, user, opts)
+	s.Require().NoError(err)
+}
+
+func (s *productSuite) TestDeleteObject_ErrorDeleting() {
+	ctx := context.Background()
+
+	user := testhelpers.NewUserBuilder().Build()
+	natsErr := errors.New("object not found")
+	opts := usecase.DeleteObjectOpts{ProductID: "test-product", ObjectStore: _testObjectStore, Name: "model.bin"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, opts.ProductID, auth.ActManageObjects).Return(nil)
+	s.natsService.EXPECT().DeleteObject(ctx, opts.ProductID, opts.ObjectStore, opts.Name).Return(natsErr)
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(entity.UserActivity{
+		UserID: user.Email,
+		Type:   entity.UserActivityTypeDeleteObject,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: opts.ProductID},
+			{Key: "OBJECT_STORE", Value: opts.ObjectStore},
+			{Key: "OBJECT", Value: opts.Name},
+			{Key: "COMMENT", Value: usecase.ErrDeletingObject.Error()},
+		},
+	})).Return(nil)
+
+	err := s.productInteractor.DeleteObject(ctx, user, opts)
+	s.ErrorIs(err, usecase.ErrDeletingObject)
+	s.ErrorIs(err, natsErr)
+}
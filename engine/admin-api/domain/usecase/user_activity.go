@@ -5,6 +5,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +26,27 @@ type UserActivityInteracter interface {
 	RegisterStopAction(userID, productID string, version *entity.Version, comment string) error
 	RegisterPublishAction(userID, productID string, version *entity.Version, comment string) error
 	RegisterUnpublishAction(userID, productID string, version *entity.Version, comment string) error
+	RegisterUpdateProcessImageAction(userID, productID string, version *entity.Version, patch *entity.VersionPatch, comment string) error
+	RegisterScaleProcessAction(userID, productID string, version *entity.Version, scaling *entity.ProcessScaling, comment string) error
+	RegisterRunWorkflowAction(userID, productID string, version *entity.Version, workflow, comment string) error
+	RegisterReplayDeadLettersAction(
+		userID, productID string, version *entity.Version, workflow string, sequences []uint64, comment string,
+	) error
+	RegisterPurgeDeadLettersAction(
+		userID, productID string, version *entity.Version, workflow string, sequences []uint64, comment string,
+	) error
+	RegisterInjectMessageAction(userID, productID string, version *entity.Version, subject, comment string) error
+	RegisterStartShadowAction(userID, productID string, version *entity.Version, publishedVersion, comment string) error
+	RegisterStopShadowAction(userID, productID string, version *entity.Version, publishedVersion, comment string) error
+	RegisterRollbackConfigurationAction(
+		userID string, store entity.ConfigurationStore, key string, revision uint64, comment string,
+	) error
+	RegisterSetProductConfigurationAction(userID, productID, key, comment string) error
+	RegisterDeleteProductConfigurationAction(userID, productID, key, comment string) error
+	RegisterCreateProductObjectStoreAction(userID, productID, name, comment string) error
+	RegisterDeleteProductObjectStoreAction(userID, productID, name, comment string) error
+	RegisterUploadObjectAction(userID, productID, objectStore, name, comment string) error
+	RegisterDeleteObjectAction(userID, productID, objectStore, name, comment string) error
 	RegisterUpdateProductGrants(userID string, targetUserID string, product string, productGrants []auth.Action, comment string) error
 }
 
@@ -162,6 +184,283 @@ func (i *UserActivityInteractor) RegisterUnpublishAction(
 		})
 }
 
+func (i *UserActivityInteractor) RegisterUpdateProcessImageAction(
+	userID,
+	productID string,
+	version *entity.Version,
+	patch *entity.VersionPatch,
+	comment string,
+) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeUpdateProcessImage,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "VERSION_TAG", Value: version.Tag},
+			{Key: "WORKFLOW_NAME", Value: patch.Workflow},
+			{Key: "PROCESS_NAME", Value: patch.Process},
+			{Key: "PREVIOUS_IMAGE", Value: patch.PreviousImage},
+			{Key: "IMAGE", Value: patch.Image},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+func (i *UserActivityInteractor) RegisterScaleProcessAction(
+	userID,
+	productID string,
+	version *entity.Version,
+	scaling *entity.ProcessScaling,
+	comment string,
+) error {
+	vars := []*entity.UserActivityVar{
+		{Key: "PRODUCT_ID", Value: productID},
+		{Key: "VERSION_TAG", Value: version.Tag},
+		{Key: "WORKFLOW_NAME", Value: scaling.Workflow},
+		{Key: "PROCESS_NAME", Value: scaling.Process},
+		{Key: "COMMENT", Value: comment},
+	}
+
+	if scaling.Replicas != nil {
+		vars = append(vars, &entity.UserActivityVar{Key: "REPLICAS", Value: strconv.Itoa(int(*scaling.Replicas))})
+	}
+
+	if scaling.Autoscaling != nil {
+		vars = append(vars,
+			&entity.UserActivityVar{Key: "MIN_REPLICAS", Value: strconv.Itoa(int(scaling.Autoscaling.MinReplicas))},
+			&entity.UserActivityVar{Key: "MAX_REPLICAS", Value: strconv.Itoa(int(scaling.Autoscaling.MaxReplicas))},
+		)
+	}
+
+	return i.create(userID, entity.UserActivityTypeScaleProcess, vars)
+}
+
+func (i *UserActivityInteractor) RegisterRunWorkflowAction(
+	userID,
+	productID string,
+	version *entity.Version,
+	workflow,
+	comment string,
+) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeRunWorkflow,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "VERSION_TAG", Value: version.Tag},
+			{Key: "WORKFLOW_NAME", Value: workflow},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+func (i *UserActivityInteractor) RegisterReplayDeadLettersAction(
+	userID,
+	productID string,
+	version *entity.Version,
+	workflow string,
+	sequences []uint64,
+	comment string,
+) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeReplayDeadLetters,
+		getDeadLettersActivityVars(productID, version, workflow, sequences, comment),
+	)
+}
+
+func (i *UserActivityInteractor) RegisterPurgeDeadLettersAction(
+	userID,
+	productID string,
+	version *entity.Version,
+	workflow string,
+	sequences []uint64,
+	comment string,
+) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypePurgeDeadLetters,
+		getDeadLettersActivityVars(productID, version, workflow, sequences, comment),
+	)
+}
+
+func (i *UserActivityInteractor) RegisterInjectMessageAction(
+	userID,
+	productID string,
+	version *entity.Version,
+	subject,
+	comment string,
+) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeInjectMessage,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "VERSION_TAG", Value: version.Tag},
+			{Key: "SUBJECT", Value: subject},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+func (i *UserActivityInteractor) RegisterStartShadowAction(
+	userID,
+	productID string,
+	version *entity.Version,
+	publishedVersion,
+	comment string,
+) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeStartShadow,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "VERSION_TAG", Value: version.Tag},
+			{Key: "PUBLISHED_VERSION_TAG", Value: publishedVersion},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+func (i *UserActivityInteractor) RegisterStopShadowAction(
+	userID,
+	productID string,
+	version *entity.Version,
+	publishedVersion,
+	comment string,
+) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeStopShadow,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "VERSION_TAG", Value: version.Tag},
+			{Key: "PUBLISHED_VERSION_TAG", Value: publishedVersion},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+func (i *UserActivityInteractor) RegisterRollbackConfigurationAction(
+	userID string,
+	store entity.ConfigurationStore,
+	key string,
+	revision uint64,
+	comment string,
+) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeRollbackConfig,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: store.ProductID},
+			{Key: "VERSION_TAG", Value: store.VersionTag},
+			{Key: "WORKFLOW_NAME", Value: store.Workflow},
+			{Key: "PROCESS_NAME", Value: store.Process},
+			{Key: "SCOPE", Value: store.Scope.String()},
+			{Key: "KEY", Value: key},
+			{Key: "REVISION", Value: strconv.FormatUint(revision, 10)},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+// RegisterSetProductConfigurationAction registers a change of a global product configuration key.
+// The value is not registered, as it may contain credentials.
+func (i *UserActivityInteractor) RegisterSetProductConfigurationAction(userID, productID, key, comment string) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeSetProductConfig,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "KEY", Value: key},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+// RegisterDeleteProductConfigurationAction registers the deletion of a global product configuration key.
+func (i *UserActivityInteractor) RegisterDeleteProductConfigurationAction(userID, productID, key, comment string) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeDeleteProductConfig,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "KEY", Value: key},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+// RegisterCreateProductObjectStoreAction registers the creation of an object store shared by the product versions.
+func (i *UserActivityInteractor) RegisterCreateProductObjectStoreAction(userID, productID, name, comment string) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeCreateObjectStore,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "OBJECT_STORE", Value: name},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+// RegisterDeleteProductObjectStoreAction registers the deletion of an object store shared by the product versions.
+func (i *UserActivityInteractor) RegisterDeleteProductObjectStoreAction(userID, productID, name, comment string) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeDeleteObjectStore,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "OBJECT_STORE", Value: name},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+// RegisterUploadObjectAction registers the upload of an object to one of the product object stores.
+func (i *UserActivityInteractor) RegisterUploadObjectAction(userID, productID, objectStore, name, comment string) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeUploadObject,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "OBJECT_STORE", Value: objectStore},
+			{Key: "OBJECT", Value: name},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+// RegisterDeleteObjectAction registers the deletion of an object from one of the product object stores.
+func (i *UserActivityInteractor) RegisterDeleteObjectAction(userID, productID, objectStore, name, comment string) error {
+	return i.create(
+		userID,
+		entity.UserActivityTypeDeleteObject,
+		[]*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "OBJECT_STORE", Value: objectStore},
+			{Key: "OBJECT", Value: name},
+			{Key: "COMMENT", Value: comment},
+		})
+}
+
+// getDeadLettersActivityVars returns the vars of a dead-letter messages action. No sequences means all the messages.
+func getDeadLettersActivityVars(
+	productID string,
+	version *entity.Version,
+	workflow string,
+	sequences []uint64,
+	comment string,
+) []*entity.UserActivityVar {
+	messages := "ALL"
+
+	if len(sequences) > 0 {
+		sequencesStr := make([]string, 0, len(sequences))
+		for _, sequence := range sequences {
+			sequencesStr = append(sequencesStr, strconv.FormatUint(sequence, 10))
+		}
+
+		messages = strings.Join(sequencesStr, ",")
+	}
+
+	return []*entity.UserActivityVar{
+		{Key: "PRODUCT_ID", Value: productID},
+		{Key: "VERSION_TAG", Value: version.Tag},
+		{Key: "WORKFLOW_NAME", Value: workflow},
+		{Key: "MESSAGES", Value: messages},
+		{Key: "COMMENT", Value: comment},
+	}
+}
+
 func (i *UserActivityInteractor) RegisterUpdateProductGrants(
 	userID string,
 	targetUserID string,
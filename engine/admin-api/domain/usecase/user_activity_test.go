@@ -297,6 +297,89 @@ func (s *userActivitySuite) TestRegisterUnpublishAction() {
 	s.Assert().NoError(err)
 }
 
+func (s *userActivitySuite) TestRegisterReplayDeadLettersAction() {
+	const (
+		userID    = "test-user"
+		productID = "test-product"
+		workflow  = "test-workflow"
+		comment   = "This is a test comment"
+	)
+
+	version := testhelpers.NewVersionBuilder().Build()
+
+	expectedUserActivity := entity.UserActivity{
+		UserID: userID,
+		Type:   entity.UserActivityTypeReplayDeadLetters,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "VERSION_TAG", Value: version.Tag},
+			{Key: "WORKFLOW_NAME", Value: workflow},
+			{Key: "MESSAGES", Value: "1,3"},
+			{Key: "COMMENT", Value: comment},
+		},
+	}
+
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(expectedUserActivity)).Return(nil)
+
+	err := s.userActivity.RegisterReplayDeadLettersAction(userID, productID, version, workflow, []uint64{1, 3}, comment)
+	s.Assert().NoError(err)
+}
+
+func (s *userActivitySuite) TestRegisterInjectMessageAction() {
+	const (
+		userID    = "test-user"
+		productID = "test-product"
+		subject   = "test-stream.test-process"
+		comment   = "This is a test comment"
+	)
+
+	version := testhelpers.NewVersionBuilder().Build()
+
+	expectedUserActivity := entity.UserActivity{
+		UserID: userID,
+		Type:   entity.UserActivityTypeInjectMessage,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "VERSION_TAG", Value: version.Tag},
+			{Key: "SUBJECT", Value: subject},
+			{Key: "COMMENT", Value: comment},
+		},
+	}
+
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(expectedUserActivity)).Return(nil)
+
+	err := s.userActivity.RegisterInjectMessageAction(userID, productID, version, subject, comment)
+	s.Assert().NoError(err)
+}
+
+func (s *userActivitySuite) TestRegisterPurgeDeadLettersAction_AllMessages() {
+	const (
+		userID    = "test-user"
+		productID = "test-product"
+		workflow  = "test-workflow"
+		comment   = "This is a test comment"
+	)
+
+	version := testhelpers.NewVersionBuilder().Build()
+
+	expectedUserActivity := entity.UserActivity{
+		UserID: userID,
+		Type:   entity.UserActivityTypePurgeDeadLetters,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "VERSION_TAG", Value: version.Tag},
+			{Key: "WORKFLOW_NAME", Value: workflow},
+			{Key: "MESSAGES", Value: "ALL"},
+			{Key: "COMMENT", Value: comment},
+		},
+	}
+
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(expectedUserActivity)).Return(nil)
+
+	err := s.userActivity.RegisterPurgeDeadLettersAction(userID, productID, version, workflow, nil, comment)
+	s.Assert().NoError(err)
+}
+
 func (s *userActivitySuite) TestRegisterUpdateProductGrants() {
 	const (
 		userID       = "test-user"
@@ -352,3 +435,133 @@ func (m userActivityMatcher) Matches(actual interface{}) bool {
 		m.expectedUserActivity.Type == actualUserActivity.Type &&
 		reflect.DeepEqual(m.expectedUserActivity.Vars, actualUserActivity.Vars)
 }
+
+func (s *userActivitySuite) TestRegisterRollbackConfigurationAction() {
+	const (
+		userID  = "test-user"
+		comment = "This is a test comment"
+	)
+
+	store := entity.ConfigurationStore{
+		ProductID:  "test-product",
+		VersionTag: "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    "test-process",
+		Scope:      entity.ConfigurationScopeProcess,
+	}
+
+	expectedUserActivity := entity.UserActivity{
+		UserID: userID,
+		Type:   entity.UserActivityTypeRollbackConfig,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: store.ProductID},
+			{Key: "VERSION_TAG", Value: store.VersionTag},
+			{Key: "WORKFLOW_NAME", Value: store.Workflow},
+			{Key: "PROCESS_NAME", Value: store.Process},
+			{Key: "SCOPE", Value: "PROCESS"},
+			{Key: "KEY", Value: "key1"},
+			{Key: "REVISION", Value: "3"},
+			{Key: "COMMENT", Value: comment},
+		},
+	}
+
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(expectedUserActivity)).Return(nil)
+
+	err := s.userActivity.RegisterRollbackConfigurationAction(userID, store, "key1", 3, comment)
+	s.Assert().NoError(err)
+}
+
+func (s *userActivitySuite) TestRegisterSetProductConfigurationAction() {
+	const (
+		userID    = "test-user"
+		productID = "test-product"
+		comment   = "This is a test comment"
+	)
+
+	expectedUserActivity := entity.UserActivity{
+		UserID: userID,
+		Type:   entity.UserActivityTypeSetProductConfig,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "KEY", Value: "key1"},
+			{Key: "COMMENT", Value: comment},
+		},
+	}
+
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(expectedUserActivity)).Return(nil)
+
+	err := s.userActivity.RegisterSetProductConfigurationAction(userID, productID, "key1", comment)
+	s.Assert().NoError(err)
+}
+
+func (s *userActivitySuite) TestRegisterDeleteProductConfigurationAction() {
+	const (
+		userID    = "test-user"
+		productID = "test-product"
+		comment   = "This is a test comment"
+	)
+
+	expectedUserActivity := entity.UserActivity{
+		UserID: userID,
+		Type:   entity.UserActivityTypeDeleteProductConfig,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "KEY", Value: "key1"},
+			{Key: "COMMENT", Value: comment},
+		},
+	}
+
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(expectedUserActivity)).Return(nil)
+
+	err := s.userActivity.RegisterDeleteProductConfigurationAction(userID, productID, "key1", comment)
+	s.Assert().NoError(err)
+}
+
+func (s *userActivitySuite) TestRegisterCreateProductObjectStoreAction() {
+	const (
+		userID    = "test-user"
+		productID = "test-product"
+		comment   = "This is a test comment"
+	)
+
+	expectedUserActivity := entity.UserActivity{
+		UserID: userID,
+		Type:   entity.UserActivityTypeCreateObjectStore,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "OBJECT_STORE", Value: "models"},
+			{Key: "COMMENT", Value: comment},
+		},
+	}
+
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(expectedUserActivity)).Return(nil)
+
+	err := s.userActivity.RegisterCreateProductObjectStoreAction(userID, productID, "models", comment)
+	s.Assert().NoError(err)
+}
+
+func (s *userActivitySuite) TestRegisterUploadObjectAction() {
+	const (
+		userID    = "test-user"
+		productID = "test-product"
+		comment   = "This is a test comment"
+	)
+
+	expectedUserActivity := entity.UserActivity{
+		UserID: userID,
+		Type:   entity.UserActivityTypeUploadObject,
+		Vars: []*entity.UserActivityVar{
+			{Key: "PRODUCT_ID", Value: productID},
+			{Key: "OBJECT_STORE", Value: "object-store_test-product_models"},
+			{Key: "OBJECT", Value: "model.bin"},
+			{Key: "COMMENT", Value: comment},
+		},
+	}
+
+	s.userActivityRepo.EXPECT().Create(newUserActivityMatcher(expectedUserActivity)).Return(nil)
+
+	err := s.userActivity.RegisterUploadObjectAction(
+		userID, productID, "object-store_test-product_models", "model.bin", comment,
+	)
+	s.Assert().NoError(err)
+}
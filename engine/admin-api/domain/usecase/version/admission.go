@@ -0,0 +1,33 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// checkAdmissionPolicies rejects the version if it violates any enforced admission policy.
+// Violations of policies in dry-run mode are only logged.
+func (h *Handler) checkAdmissionPolicies(ctx context.Context, productID string, version *entity.Version) error {
+	policies, err := h.admissionPolicyRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("getting admission policies: %w", err)
+	}
+
+	report := entity.EvaluateAdmissionPolicies(policies, version)
+
+	for _, warning := range report.Warnings {
+		h.logger.Info("Version violates dry-run admission policy",
+			"productID", productID,
+			"versionTag", version.Tag,
+			"violation", warning.String(),
+		)
+	}
+
+	if !report.IsAdmitted() {
+		return entity.AdmissionDeniedError{Violations: report.Violations}
+	}
+
+	return nil
+}
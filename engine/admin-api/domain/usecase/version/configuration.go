@@ -0,0 +1,183 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+type RollbackConfigurationOpts struct {
+	Store    entity.ConfigurationStore
+	Key      string
+	Revision uint64
+	Comment  string
+}
+
+// GetProcessConfiguration returns the configuration a process of a running version sees, with each key resolved
+// to the most specific scope defining it.
+func (h *Handler) GetProcessConfiguration(
+	ctx context.Context,
+	user *entity.User,
+	productID, versionTag, workflowName, processName string,
+) ([]*entity.ConfigurationEntry, error) {
+	if err := h.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
+		return nil, err
+	}
+
+	vers, err := h.getRunningProcessVersion(ctx, productID, versionTag, workflowName, processName)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration, err := h.natsManagerService.GetProcessConfiguration(ctx, productID, vers.Tag, workflowName, processName)
+	if err != nil {
+		return nil, fmt.Errorf("getting process configuration: %w", err)
+	}
+
+	return configuration, nil
+}
+
+// WatchProcessConfiguration returns a channel receiving the configuration changes of a process of a running version
+// until the context is done.
+func (h *Handler) WatchProcessConfiguration(
+	ctx context.Context,
+	user *entity.User,
+	productID, versionTag, workflowName, processName string,
+) (<-chan *entity.ConfigurationEntry, error) {
+	if err := h.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
+		return nil, err
+	}
+
+	vers, err := h.getRunningProcessVersion(ctx, productID, versionTag, workflowName, processName)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.natsManagerService.WatchProcessConfiguration(ctx, productID, vers.Tag, workflowName, processName)
+}
+
+// GetConfigurationHistory returns the revisions of a configuration key kept by the key-value store of its scope,
+// oldest first.
+func (h *Handler) GetConfigurationHistory(
+	ctx context.Context,
+	user *entity.User,
+	store entity.ConfigurationStore,
+	key string,
+) ([]*entity.ConfigurationRevision, error) {
+	if err := h.accessControl.CheckProductGrants(user, store.ProductID, auth.ActViewProduct); err != nil {
+		return nil, err
+	}
+
+	if err := h.checkConfigurationStore(ctx, store); err != nil {
+		return nil, err
+	}
+
+	history, err := h.natsManagerService.GetConfigurationHistory(ctx, store, key)
+	if err != nil {
+		return nil, fmt.Errorf("getting configuration history: %w", err)
+	}
+
+	return history, nil
+}
+
+// RollbackConfiguration sets a configuration key back to the value of a previous revision. The rollback only
+// changes the running configuration, the configuration of the version stays as defined in its KRT file.
+func (h *Handler) RollbackConfiguration(
+	ctx context.Context,
+	user *entity.User,
+	opts RollbackConfigurationOpts,
+) (*entity.ConfigurationRevision, error) {
+	registerActionFailed := func(incomingErr error) {
+		err := h.userActivityInteractor.RegisterRollbackConfigurationAction(
+			user.Email, opts.Store, opts.Key, opts.Revision, incomingErr.Error(),
+		)
+		if err != nil {
+			h.logger.Error(err, "Error registering user activity",
+				"productID", opts.Store.ProductID,
+				"versionTag", opts.Store.VersionTag,
+				"error", incomingErr.Error(),
+			)
+		}
+	}
+
+	if err := h.accessControl.CheckProductGrants(user, opts.Store.ProductID, auth.ActManageVersion); err != nil {
+		registerActionFailed(ErrUserNotAuthorized)
+		return nil, err
+	}
+
+	if err := h.checkConfigurationStore(ctx, opts.Store); err != nil {
+		registerActionFailed(err)
+		return nil, err
+	}
+
+	revision, err := h.natsManagerService.RollbackConfiguration(ctx, opts.Store, opts.Key, opts.Revision)
+	if err != nil {
+		registerActionFailed(ErrRollingBackConfiguration)
+		return nil, fmt.Errorf("%w: %w", ErrRollingBackConfiguration, err)
+	}
+
+	err = h.userActivityInteractor.RegisterRollbackConfigurationAction(
+		user.Email, opts.Store, opts.Key, opts.Revision, opts.Comment,
+	)
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", opts.Store.ProductID,
+			"versionTag", opts.Store.VersionTag,
+			"comment", opts.Comment,
+		)
+	}
+
+	return revision, nil
+}
+
+// checkConfigurationStore checks the key-value store of the scope exists. Except for the global one,
+// key-value stores only exist while the version is running.
+func (h *Handler) checkConfigurationStore(ctx context.Context, store entity.ConfigurationStore) error {
+	var err error
+
+	switch store.Scope {
+	case entity.ConfigurationScopeGlobal:
+		return nil
+	case entity.ConfigurationScopeVersion:
+		_, err = h.getRunningVersion(ctx, store.ProductID, store.VersionTag)
+	case entity.ConfigurationScopeWorkflow:
+		_, err = h.getRunningWorkflowVersion(ctx, store.ProductID, store.VersionTag, store.Workflow)
+	case entity.ConfigurationScopeProcess:
+		_, err = h.getRunningProcessVersion(ctx, store.ProductID, store.VersionTag, store.Workflow, store.Process)
+	default:
+		return ErrInvalidConfigurationScope
+	}
+
+	return err
+}
+
+func (h *Handler) getRunningVersion(ctx context.Context, productID, versionTag string) (*entity.Version, error) {
+	vers, err := h.versionRepo.GetByTag(ctx, productID, versionTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if !vers.CanBePatched() {
+		return nil, ErrVersionIsNotStarted
+	}
+
+	return vers, nil
+}
+
+func (h *Handler) getRunningProcessVersion(
+	ctx context.Context,
+	productID, versionTag, workflowName, processName string,
+) (*entity.Version, error) {
+	vers, err := h.getRunningWorkflowVersion(ctx, productID, versionTag, workflowName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := vers.GetProcess(workflowName, processName); !found {
+		return nil, ErrProcessNotFound
+	}
+
+	return vers, nil
+}
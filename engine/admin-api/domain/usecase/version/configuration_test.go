@@ -0,0 +1,236 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *versionSuite) TestGetProcessConfiguration_OK() {
+	// GIVEN a valid user and a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	workflow := vers.Workflows[0]
+	process := workflow.Processes[0]
+	expectedConfiguration := []*entity.ConfigurationEntry{
+		{Key: "key1", Value: "value1", Scope: entity.ConfigurationScopeProcess, Revision: 2},
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		GetProcessConfiguration(ctx, _productID, _versionTag, workflow.Name, process.Name).
+		Return(expectedConfiguration, nil)
+
+	// WHEN getting the process configuration
+	configuration, err := s.handler.GetProcessConfiguration(
+		ctx, user, _productID, _versionTag, workflow.Name, process.Name,
+	)
+	s.Require().NoError(err)
+
+	// THEN the resolved configuration is returned
+	s.Equal(expectedConfiguration, configuration)
+}
+
+func (s *versionSuite) TestGetProcessConfiguration_ErrorProcessNotFound() {
+	// GIVEN a valid user and a started version without the process
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+
+	// WHEN getting the configuration of an unknown process
+	_, err := s.handler.GetProcessConfiguration(ctx, user, _productID, _versionTag, vers.Workflows[0].Name, "unknown")
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrProcessNotFound)
+}
+
+func (s *versionSuite) TestWatchProcessConfiguration_OK() {
+	// GIVEN a valid user and a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	workflow := vers.Workflows[0]
+	process := workflow.Processes[0]
+	updates := make(chan *entity.ConfigurationEntry)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		WatchProcessConfiguration(ctx, _productID, _versionTag, workflow.Name, process.Name).
+		Return(updates, nil)
+
+	// WHEN watching the process configuration
+	ch, err := s.handler.WatchProcessConfiguration(ctx, user, _productID, _versionTag, workflow.Name, process.Name)
+	s.Require().NoError(err)
+
+	// THEN the channel of configuration changes is returned
+	s.Equal((<-chan *entity.ConfigurationEntry)(updates), ch)
+}
+
+func (s *versionSuite) TestGetConfigurationHistory_GlobalScope() {
+	// GIVEN a valid user and the global configuration, which doesn't depend on any version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	store := entity.ConfigurationStore{ProductID: _productID, Scope: entity.ConfigurationScopeGlobal}
+	expectedHistory := []*entity.ConfigurationRevision{
+		{Key: "key1", Value: "old", Revision: 1},
+		{Key: "key1", Value: "new", Revision: 2},
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.natsManagerService.EXPECT().GetConfigurationHistory(ctx, store, "key1").Return(expectedHistory, nil)
+
+	// WHEN getting the key history
+	history, err := s.handler.GetConfigurationHistory(ctx, user, store, "key1")
+	s.Require().NoError(err)
+
+	// THEN the key revisions are returned
+	s.Equal(expectedHistory, history)
+}
+
+func (s *versionSuite) TestGetConfigurationHistory_ErrorVersionNotStarted() {
+	// GIVEN a valid user and a stopped version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStopped).
+		Build()
+	store := entity.ConfigurationStore{
+		ProductID:  _productID,
+		VersionTag: _versionTag,
+		Scope:      entity.ConfigurationScopeVersion,
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+
+	// WHEN getting the key history of the version scope
+	_, err := s.handler.GetConfigurationHistory(ctx, user, store, "key1")
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrVersionIsNotStarted)
+}
+
+func (s *versionSuite) TestGetConfigurationHistory_ErrorInvalidScope() {
+	// GIVEN a valid user and an unknown scope
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	store := entity.ConfigurationStore{ProductID: _productID, Scope: "unknown"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+
+	// WHEN getting the key history
+	_, err := s.handler.GetConfigurationHistory(ctx, user, store, "key1")
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrInvalidConfigurationScope)
+}
+
+func (s *versionSuite) TestRollbackConfiguration_OK() {
+	// GIVEN a valid user and a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	opts := version.RollbackConfigurationOpts{
+		Store: entity.ConfigurationStore{
+			ProductID:  _productID,
+			VersionTag: _versionTag,
+			Workflow:   vers.Workflows[0].Name,
+			Scope:      entity.ConfigurationScopeWorkflow,
+		},
+		Key:      "key1",
+		Revision: 1,
+		Comment:  "testing",
+	}
+	expectedRevision := &entity.ConfigurationRevision{Key: "key1", Value: "old", Revision: 3}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		RollbackConfiguration(ctx, opts.Store, opts.Key, opts.Revision).
+		Return(expectedRevision, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterRollbackConfigurationAction(user.Email, opts.Store, opts.Key, opts.Revision, opts.Comment).
+		Return(nil)
+
+	// WHEN rolling back the key
+	revision, err := s.handler.RollbackConfiguration(ctx, user, opts)
+	s.Require().NoError(err)
+
+	// THEN the new revision is returned
+	s.Equal(expectedRevision, revision)
+}
+
+func (s *versionSuite) TestRollbackConfiguration_ErrorUserNotAuthorized() {
+	// GIVEN an unauthorized user
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	authErr := errors.New("unauthorized")
+	opts := version.RollbackConfigurationOpts{
+		Store:    entity.ConfigurationStore{ProductID: _productID, Scope: entity.ConfigurationScopeGlobal},
+		Key:      "key1",
+		Revision: 1,
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(authErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterRollbackConfigurationAction(user.Email, opts.Store, opts.Key, opts.Revision,
+			version.ErrUserNotAuthorized.Error()).
+		Return(nil)
+
+	// WHEN rolling back the key
+	_, err := s.handler.RollbackConfiguration(ctx, user, opts)
+
+	// THEN an error is returned
+	s.ErrorIs(err, authErr)
+}
+
+func (s *versionSuite) TestRollbackConfiguration_ErrorRollingBack() {
+	// GIVEN a valid user but nats-manager fails to roll back the key
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	natsErr := errors.New("revision not found")
+	opts := version.RollbackConfigurationOpts{
+		Store:    entity.ConfigurationStore{ProductID: _productID, Scope: entity.ConfigurationScopeGlobal},
+		Key:      "key1",
+		Revision: 1,
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.natsManagerService.EXPECT().RollbackConfiguration(ctx, opts.Store, opts.Key, opts.Revision).Return(nil, natsErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterRollbackConfigurationAction(user.Email, opts.Store, opts.Key, opts.Revision,
+			version.ErrRollingBackConfiguration.Error()).
+		Return(nil)
+
+	// WHEN rolling back the key
+	_, err := s.handler.RollbackConfiguration(ctx, user, opts)
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrRollingBackConfiguration)
+	s.ErrorIs(err, natsErr)
+}
@@ -55,6 +55,11 @@ func (h *Handler) Create(
 		)
 	}
 
+	krtExtensions, err := parseKrtExtensions(tmpKrtFile.Name())
+	if err != nil {
+		return nil, ParsingKRTFileError(err)
+	}
+
 	_, err = h.versionRepo.GetByTag(ctx, productID, krtYml.Version)
 	if err != nil && !errors.Is(err, ErrVersionNotFound) {
 		return nil, fmt.Errorf("error version repo GetByTag: %w", err)
@@ -62,10 +67,24 @@ func (h *Handler) Create(
 		return nil, ErrVersionDuplicated
 	}
 
+	newVersion := h.mapKrtToVersion(krtYml)
+
+	if err := h.applyKrtExtensions(newVersion, krtExtensions); err != nil {
+		return nil, NewErrInvalidKRT("invalid KRT file", err)
+	}
+
+	if err := newVersion.ValidateWorkflowGraphs(); err != nil {
+		return nil, NewErrInvalidKRT("invalid workflow subscriptions", err)
+	}
+
+	if err := h.checkAdmissionPolicies(ctx, productID, newVersion); err != nil {
+		return nil, err
+	}
+
 	versionCreated, err := h.versionRepo.Create(
 		user.Email,
 		productID,
-		h.mapKrtToVersion(krtYml),
+		newVersion,
 	)
 	if err != nil {
 		return nil, err
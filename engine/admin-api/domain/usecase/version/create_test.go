@@ -7,6 +7,8 @@ import (
 	"errors"
 	"os"
 
+	"github.com/golang/mock/gomock"
+
 	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
@@ -29,6 +31,7 @@ func (s *versionSuite) TestCreateVersion() {
 	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageVersion).Return(nil)
 	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, product.ID, expectedVersion.Tag).Return(nil, version.ErrVersionNotFound)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
 	s.versionRepo.EXPECT().Create(user.Email, product.ID, expectedVersion).Return(expectedVersion, nil)
 	s.userActivityInteractor.EXPECT().RegisterCreateAction(user.Email, product.ID, expectedVersion).Return(nil)
 
@@ -103,6 +106,7 @@ func (s *versionSuite) TestCreateVersion_FailsIfThereIsAnErrorCreatingInRepo() {
 	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageVersion).Return(nil)
 	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, product.ID, newVersion.Tag).Return(nil, version.ErrVersionNotFound)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
 	s.versionRepo.EXPECT().Create(user.Email, product.ID, newVersion).Return(nil, expectedError)
 
 	_, err = s.handler.Create(ctx, user, product.ID, file)
@@ -132,6 +136,156 @@ func (s *versionSuite) TestCreateVersion_FailsIfVersionTagIsDuplicated() {
 	s.Require().ErrorIs(err, version.ErrVersionDuplicated)
 }
 
+func (s *versionSuite) TestCreateVersion_FailsIfSubscriptionsAreInvalid() {
+	var (
+		ctx     = context.Background()
+		user    = testhelpers.NewUserBuilder().Build()
+		product = testhelpers.NewProductBuilder().Build()
+	)
+
+	file, err := os.Open("./testdata/invalid_subscriptions_krt.yaml")
+	s.Require().NoError(err)
+
+	defer file.Close()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, product.ID, "v1.0.0").Return(nil, version.ErrVersionNotFound)
+
+	_, err = s.handler.Create(ctx, user, product.ID, file)
+
+	var krtErr version.KRTValidationError
+
+	s.Require().ErrorAs(err, &krtErr)
+	s.ErrorIs(krtErr.GetErrors(), entity.ErrUnknownSubscription)
+	s.ErrorContains(err, `workflow "go-classificator", process "email-classificator"`)
+}
+
+func (s *versionSuite) TestCreateVersion_WithKrtExtensions() {
+	var (
+		ctx             = context.Background()
+		user            = testhelpers.NewUserBuilder().Build()
+		expectedVersion = getClassificatorVersion()
+		product         = testhelpers.NewProductBuilder().Build()
+	)
+
+	expectedVersion.Workflows[0].Processes[0].Probes = &entity.ProcessProbes{
+		Readiness: &entity.ProcessProbe{
+			Type:          entity.ProbeTypeGRPC,
+			PeriodSeconds: 5,
+		},
+		Startup: &entity.ProcessProbe{
+			Type:             entity.ProbeTypeExec,
+			Command:          []string{"cat", "/tmp/ready"},
+			FailureThreshold: 30,
+		},
+	}
+	expectedVersion.Workflows[0].Processes[0].MaxDeliver = 10
+	expectedVersion.Workflows[0].StreamSettings = &entity.WorkflowStreamSettings{
+		Retention:     entity.StreamRetentionLimits,
+		MaxAgeSeconds: 86400,
+		Replicas:      3,
+		Storage:       entity.StreamStorageFile,
+	}
+	expectedVersion.KeyValueStore = &entity.KeyValueStoreSettings{History: 5}
+	expectedVersion.Workflows[0].KeyValueStore = &entity.KeyValueStoreSettings{TTLSeconds: 3600}
+	expectedVersion.Workflows[0].Processes[1].ObjectStore.Settings = &entity.ObjectStoreSettings{
+		TTLSeconds: 600,
+		Storage:    entity.StreamStorageMemory,
+	}
+	expectedVersion.Workflows[0].Processes[1].KeyValueStore = &entity.KeyValueStoreSettings{
+		MaxBytes: 1048576,
+		Replicas: 3,
+	}
+	expectedVersion.Workflows[0].Processes[2].Autoscaling = &entity.ProcessAutoscaling{
+		MinReplicas:         1,
+		MaxReplicas:         4,
+		CPUTargetPercentage: 70,
+	}
+
+	file, err := os.Open("./testdata/classificator_extended_krt.yaml")
+	s.Require().NoError(err)
+
+	defer file.Close()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, product.ID, expectedVersion.Tag).Return(nil, version.ErrVersionNotFound)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
+	s.versionRepo.EXPECT().Create(user.Email, product.ID, expectedVersion).Return(expectedVersion, nil)
+	s.userActivityInteractor.EXPECT().RegisterCreateAction(user.Email, product.ID, expectedVersion).Return(nil)
+
+	createdVersion, err := s.handler.Create(ctx, user, product.ID, file)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(expectedVersion, createdVersion)
+}
+
+func (s *versionSuite) TestCreateVersion_WithBatchWorkflow() {
+	var (
+		ctx     = context.Background()
+		user    = testhelpers.NewUserBuilder().Build()
+		product = testhelpers.NewProductBuilder().Build()
+	)
+
+	file, err := os.Open("./testdata/batch_krt.yaml")
+	s.Require().NoError(err)
+
+	defer file.Close()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, product.ID, "v1.0.0").Return(nil, version.ErrVersionNotFound)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
+	s.versionRepo.EXPECT().Create(user.Email, product.ID, gomock.Any()).
+		DoAndReturn(func(_, _ string, vers *entity.Version) (*entity.Version, error) {
+			return vers, nil
+		})
+	s.userActivityInteractor.EXPECT().RegisterCreateAction(user.Email, product.ID, gomock.Any()).Return(nil)
+
+	createdVersion, err := s.handler.Create(ctx, user, product.ID, file)
+	s.Require().NoError(err)
+
+	s.Require().True(createdVersion.Workflows[0].IsBatch())
+	s.Equal(&entity.WorkflowJob{
+		Schedule:                "0 2 * * *",
+		BackoffLimit:            2,
+		ActiveDeadlineSeconds:   3600,
+		TTLSecondsAfterFinished: 600,
+	}, createdVersion.Workflows[0].Job)
+}
+
+func (s *versionSuite) TestCreateVersion_FailsIfKrtExtensionsAreInvalid() {
+	var (
+		ctx     = context.Background()
+		user    = testhelpers.NewUserBuilder().Build()
+		product = testhelpers.NewProductBuilder().Build()
+	)
+
+	file, err := os.Open("./testdata/invalid_extensions_krt.yaml")
+	s.Require().NoError(err)
+
+	defer file.Close()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, product.ID, "v1.0.0").Return(nil, version.ErrVersionNotFound)
+
+	_, err = s.handler.Create(ctx, user, product.ID, file)
+
+	var krtErr version.KRTValidationError
+
+	s.Require().ErrorAs(err, &krtErr)
+	s.ErrorIs(krtErr.GetErrors(), entity.ErrMissingProbeCommand)
+	s.ErrorContains(err, `workflow "go-classificator", process "entrypoint"`)
+	s.ErrorIs(krtErr.GetErrors(), entity.ErrInvalidStreamRetention)
+	s.ErrorIs(krtErr.GetErrors(), entity.ErrInvalidJobSchedule)
+	s.ErrorIs(krtErr.GetErrors(), entity.ErrInvalidKeyValueStoreHistory)
+	s.ErrorContains(err, `workflow "go-classificator", process "etl"`)
+	s.ErrorIs(krtErr.GetErrors(), entity.ErrInvalidProcessAutoscaling)
+	s.ErrorContains(err, `workflow "go-classificator", process "email-classificator"`)
+}
+
 func getClassificatorVersion() *entity.Version {
 	return &entity.Version{
 		Tag:         "v1.0.0",
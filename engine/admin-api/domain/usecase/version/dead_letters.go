@@ -0,0 +1,141 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+type DeadLetterMessagesOpts struct {
+	ProductID    string
+	VersionTag   string
+	WorkflowName string
+	// Sequences of the dead-letter messages to handle. All the messages are handled when empty.
+	Sequences []uint64
+	Comment   string
+}
+
+// GetDeadLetterMessages returns the messages of a running workflow that its processes could not handle.
+func (h *Handler) GetDeadLetterMessages(
+	ctx context.Context,
+	user *entity.User,
+	productID, versionTag, workflowName string,
+) ([]*entity.DeadLetterMessage, error) {
+	if err := h.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
+		return nil, err
+	}
+
+	vers, err := h.getRunningWorkflowVersion(ctx, productID, versionTag, workflowName)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := h.natsManagerService.GetDeadLetterMessages(ctx, productID, vers.Tag, workflowName)
+	if err != nil {
+		return nil, fmt.Errorf("getting dead-letter messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+// ReplayDeadLetterMessages publishes dead-letter messages of a running workflow again to their original subject,
+// for the consumer that failed them only, and returns the number of replayed messages.
+func (h *Handler) ReplayDeadLetterMessages(
+	ctx context.Context,
+	user *entity.User,
+	opts DeadLetterMessagesOpts,
+) (int, error) {
+	return h.handleDeadLetterMessages(ctx, user, opts,
+		h.natsManagerService.ReplayDeadLetterMessages,
+		h.userActivityInteractor.RegisterReplayDeadLettersAction,
+		ErrReplayingDeadLetters,
+	)
+}
+
+// PurgeDeadLetterMessages removes dead-letter messages of a running workflow without replaying them and returns
+// the number of removed messages.
+func (h *Handler) PurgeDeadLetterMessages(
+	ctx context.Context,
+	user *entity.User,
+	opts DeadLetterMessagesOpts,
+) (int, error) {
+	return h.handleDeadLetterMessages(ctx, user, opts,
+		h.natsManagerService.PurgeDeadLetterMessages,
+		h.userActivityInteractor.RegisterPurgeDeadLettersAction,
+		ErrPurgingDeadLetters,
+	)
+}
+
+type deadLettersActionFunc func(ctx context.Context, product, versionTag, workflow string, sequences []uint64) (int, error)
+
+type registerDeadLettersActionFunc func(
+	userID, productID string, version *entity.Version, workflow string, sequences []uint64, comment string,
+) error
+
+func (h *Handler) handleDeadLetterMessages(
+	ctx context.Context,
+	user *entity.User,
+	opts DeadLetterMessagesOpts,
+	action deadLettersActionFunc,
+	registerAction registerDeadLettersActionFunc,
+	actionErr error,
+) (int, error) {
+	registerActionFailed := func(vers *entity.Version, incomingErr error) {
+		err := registerAction(user.Email, opts.ProductID, vers, opts.WorkflowName, opts.Sequences, incomingErr.Error())
+		if err != nil {
+			h.logger.Error(err, "Error registering user activity",
+				"productID", opts.ProductID,
+				"versionTag", vers.Tag,
+				"error", incomingErr.Error(),
+			)
+		}
+	}
+
+	if err := h.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageVersion); err != nil {
+		registerActionFailed(&entity.Version{Tag: opts.VersionTag}, ErrUserNotAuthorized)
+		return 0, err
+	}
+
+	vers, err := h.getRunningWorkflowVersion(ctx, opts.ProductID, opts.VersionTag, opts.WorkflowName)
+	if err != nil {
+		registerActionFailed(&entity.Version{Tag: opts.VersionTag}, err)
+		return 0, err
+	}
+
+	count, err := action(ctx, opts.ProductID, vers.Tag, opts.WorkflowName, opts.Sequences)
+	if err != nil {
+		registerActionFailed(vers, actionErr)
+		return count, fmt.Errorf("%w: %w", actionErr, err)
+	}
+
+	err = registerAction(user.Email, opts.ProductID, vers, opts.WorkflowName, opts.Sequences, opts.Comment)
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", opts.ProductID,
+			"versionTag", vers.Tag,
+			"comment", opts.Comment,
+		)
+	}
+
+	return count, nil
+}
+
+// getRunningWorkflowVersion returns the version if it is running and has the given workflow,
+// as workflow dead-letter streams only exist while the version is running.
+func (h *Handler) getRunningWorkflowVersion(
+	ctx context.Context,
+	productID, versionTag, workflowName string,
+) (*entity.Version, error) {
+	vers, err := h.getRunningVersion(ctx, productID, versionTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := vers.GetWorkflow(workflowName); !found {
+		return nil, ErrWorkflowNotFound
+	}
+
+	return vers, nil
+}
@@ -0,0 +1,204 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *versionSuite) TestGetDeadLetterMessages_OK() {
+	// GIVEN a valid user and a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	expectedMessages := []*entity.DeadLetterMessage{
+		{Sequence: 1, Subject: "test-subject", Data: []byte("test-data")},
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		GetDeadLetterMessages(ctx, _productID, _versionTag, _patchedWorkflow).
+		Return(expectedMessages, nil)
+
+	// WHEN getting the workflow dead-letter messages
+	messages, err := s.handler.GetDeadLetterMessages(ctx, user, _productID, _versionTag, _patchedWorkflow)
+	s.Require().NoError(err)
+
+	// THEN the messages are returned
+	s.Equal(expectedMessages, messages)
+}
+
+func (s *versionSuite) TestGetDeadLetterMessages_ErrorVersionNotStarted() {
+	// GIVEN a valid user and a created version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusCreated).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+
+	// WHEN getting the workflow dead-letter messages
+	_, err := s.handler.GetDeadLetterMessages(ctx, user, _productID, _versionTag, _patchedWorkflow)
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrVersionIsNotStarted)
+}
+
+func (s *versionSuite) TestReplayDeadLetterMessages_OK() {
+	// GIVEN a valid user and a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	sequences := []uint64{1, 2}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		ReplayDeadLetterMessages(ctx, _productID, _versionTag, _patchedWorkflow, sequences).
+		Return(2, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterReplayDeadLettersAction(user.Email, _productID, vers, _patchedWorkflow, sequences, "testing").
+		Return(nil)
+
+	// WHEN replaying the dead-letter messages
+	replayed, err := s.handler.ReplayDeadLetterMessages(ctx, user, version.DeadLetterMessagesOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		Sequences:    sequences,
+		Comment:      "testing",
+	})
+	s.Require().NoError(err)
+
+	// THEN the number of replayed messages is returned
+	s.Equal(2, replayed)
+}
+
+func (s *versionSuite) TestReplayDeadLetterMessages_ErrorUserNotAuthorized() {
+	// GIVEN an unauthorized user
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	authErr := errors.New("unauthorized")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(authErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterReplayDeadLettersAction(user.Email, _productID, &entity.Version{Tag: _versionTag}, _patchedWorkflow,
+			nil, version.ErrUserNotAuthorized.Error()).
+		Return(nil)
+
+	// WHEN replaying the dead-letter messages
+	_, err := s.handler.ReplayDeadLetterMessages(ctx, user, version.DeadLetterMessagesOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, authErr)
+}
+
+func (s *versionSuite) TestReplayDeadLetterMessages_ErrorWorkflowNotFound() {
+	// GIVEN a valid user and a started version without the workflow
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterReplayDeadLettersAction(user.Email, _productID, &entity.Version{Tag: _versionTag}, "unknown",
+			nil, version.ErrWorkflowNotFound.Error()).
+		Return(nil)
+
+	// WHEN replaying the dead-letter messages of an unknown workflow
+	_, err := s.handler.ReplayDeadLetterMessages(ctx, user, version.DeadLetterMessagesOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: "unknown",
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrWorkflowNotFound)
+}
+
+func (s *versionSuite) TestPurgeDeadLetterMessages_OK() {
+	// GIVEN a valid user and a published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusPublished).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		PurgeDeadLetterMessages(ctx, _productID, _versionTag, _patchedWorkflow, nil).
+		Return(5, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterPurgeDeadLettersAction(user.Email, _productID, vers, _patchedWorkflow, nil, "testing").
+		Return(nil)
+
+	// WHEN purging all the dead-letter messages
+	purged, err := s.handler.PurgeDeadLetterMessages(ctx, user, version.DeadLetterMessagesOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		Comment:      "testing",
+	})
+	s.Require().NoError(err)
+
+	// THEN the number of removed messages is returned
+	s.Equal(5, purged)
+}
+
+func (s *versionSuite) TestPurgeDeadLetterMessages_ErrorPurging() {
+	// GIVEN a valid user and a started version but nats-manager fails to purge the messages
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	natsErr := errors.New("nats error")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		PurgeDeadLetterMessages(ctx, _productID, _versionTag, _patchedWorkflow, nil).
+		Return(0, natsErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterPurgeDeadLettersAction(user.Email, _productID, vers, _patchedWorkflow, nil,
+			version.ErrPurgingDeadLetters.Error()).
+		Return(nil)
+
+	// WHEN purging the dead-letter messages
+	_, err := s.handler.PurgeDeadLetterMessages(ctx, user, version.DeadLetterMessagesOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrPurgingDeadLetters)
+	s.ErrorIs(err, natsErr)
+}
@@ -0,0 +1,347 @@
+package version
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/config"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/repository"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/spf13/viper"
+)
+
+var (
+	ErrDrainingVersion     = errors.New("error draining version")
+	ErrInvalidDrainTimeout = errors.New("error drain timeout cannot be negative")
+)
+
+type DrainOpts struct {
+	ProductID  string
+	VersionTag string
+	Comment    string
+	// Timeout bounds the wait for the in-flight messages, the configured drain timeout is used when zero.
+	Timeout time.Duration
+}
+
+// DrainAndStop stops the given version once the messages already in its streams are processed. The version
+// triggers are scaled to zero so no new traffic enters the version, then the pending messages of its consumers
+// are polled until there are none left or the timeout expires. Only then the version resources are removed.
+func (h *Handler) DrainAndStop(
+	ctx context.Context,
+	user *entity.User,
+	opts DrainOpts,
+) (*entity.Version, chan *entity.Version, error) {
+	if err := h.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageVersion); err != nil {
+		v := &entity.Version{Tag: opts.VersionTag}
+		h.registerStopActionFailed(user.Email, opts.ProductID, v, ErrUserNotAuthorized)
+
+		return nil, nil, err
+	}
+
+	if opts.Timeout < 0 {
+		return nil, nil, ErrInvalidDrainTimeout
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = viper.GetDuration(config.VersionDrainTimeoutKey)
+	}
+
+	h.logger.Info("Draining version",
+		"userEmail", user.Email,
+		"versionTag", opts.VersionTag,
+		"productID", opts.ProductID,
+		"timeout", timeout.String(),
+	)
+
+	vers, err := h.versionRepo.GetByTag(ctx, opts.ProductID, opts.VersionTag)
+	if err != nil {
+		v := &entity.Version{Tag: opts.VersionTag}
+		h.registerStopActionFailed(user.Email, opts.ProductID, v, ErrVersionNotFound)
+
+		return nil, nil, err
+	}
+
+	if !vers.CanBeDrained() {
+		h.registerStopActionFailed(user.Email, opts.ProductID, vers, ErrVersionCannotBeDrained)
+		return nil, nil, ErrVersionCannotBeDrained
+	}
+
+	streamStats, err := h.natsManagerService.GetVersionStreamStats(ctx, opts.ProductID, vers)
+	if err != nil {
+		h.registerStopActionFailed(user.Email, opts.ProductID, vers, ErrDrainingVersion)
+		return nil, nil, fmt.Errorf("%w: getting stream stats: %w", ErrDrainingVersion, err)
+	}
+
+	err = h.stopTriggers(ctx, opts.ProductID, vers)
+	if err != nil {
+		h.registerStopActionFailed(user.Email, opts.ProductID, vers, ErrDrainingVersion)
+		return nil, nil, fmt.Errorf("%w: %w", ErrDrainingVersion, err)
+	}
+
+	now := time.Now()
+	vers.Status = entity.VersionStatusDraining
+	vers.Drain = &entity.VersionDrain{
+		Date:            now,
+		Deadline:        now.Add(timeout),
+		PendingMessages: entity.GetPendingMessages(streamStats),
+		Author:          user.Email,
+		Comment:         opts.Comment,
+	}
+
+	if err := h.versionRepo.Update(opts.ProductID, vers); err != nil {
+		h.logger.Error(err, "Error updating version status",
+			"productID", opts.ProductID,
+			"versionTag", vers.Tag,
+			"newStatus", entity.VersionStatusDraining,
+		)
+	}
+
+	notifyStatusCh := make(chan *entity.Version, 1)
+
+	h.startDrain(opts.ProductID, vers, notifyStatusCh)
+
+	return vers, notifyStatusCh, nil
+}
+
+// ResumeDrains restarts the drains of the versions left draining when the admin API was stopped. Drains whose
+// deadline has already passed stop the version right away.
+func (h *Handler) ResumeDrains(ctx context.Context) error {
+	products, err := h.productRepo.FindAll(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("listing products: %w", err)
+	}
+
+	for _, product := range products {
+		versions, err := h.versionRepo.SearchByProduct(ctx, product.ID, &repository.ListVersionsFilter{
+			Status: entity.VersionStatusDraining,
+		})
+		if err != nil {
+			return fmt.Errorf("listing draining versions of product %q: %w", product.ID, err)
+		}
+
+		for _, vers := range versions {
+			if vers.Drain == nil {
+				vers.Drain = &entity.VersionDrain{Date: time.Now(), Deadline: time.Now()}
+			}
+
+			h.logger.Info("Resuming version drain",
+				"productID", product.ID,
+				"versionTag", vers.Tag,
+				"deadline", vers.Drain.Deadline,
+			)
+
+			notifyStatusCh := make(chan *entity.Version, 1)
+
+			h.startDrain(product.ID, vers, notifyStatusCh)
+
+			// Nobody is subscribed to the resumed drains, so their notifications are discarded.
+			go discardNotifications(notifyStatusCh)
+		}
+	}
+
+	return nil
+}
+
+// stopTriggers scales the version triggers to zero replicas. The replicas of the version processes are kept
+// as they are, so the triggers are deployed again when the version is restarted.
+func (h *Handler) stopTriggers(ctx context.Context, productID string, vers *entity.Version) error {
+	replicas := int32(0)
+
+	for _, workflow := range vers.Workflows {
+		for _, process := range workflow.Processes {
+			if process.Type != entity.ProcessTypeTrigger {
+				continue
+			}
+
+			err := h.k8sService.ScaleProcess(ctx, productID, vers.Tag, &entity.ProcessScaling{
+				Workflow: workflow.Name,
+				Process:  process.Name,
+				Replicas: &replicas,
+			})
+			if err != nil {
+				return fmt.Errorf("stopping trigger %q of workflow %q: %w", process.Name, workflow.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func discardNotifications(notifyStatusCh chan *entity.Version) {
+	for range notifyStatusCh { //nolint:revive // The notifications are only read to unblock the drain.
+	}
+}
+
+// startDrain registers the drain of the version before running it, so a stop requested right after the drain
+// started can cancel it.
+func (h *Handler) startDrain(productID string, vers *entity.Version, notifyStatusCh chan *entity.Version) {
+	drainKey := getDrainKey(productID, vers.Tag)
+
+	drainCtx, cancelDrain := context.WithDeadline(context.Background(), vers.Drain.Deadline)
+	h.drains.start(drainKey, cancelDrain)
+
+	go h.drainAndStop(drainCtx, cancelDrain, productID, vers, notifyStatusCh)
+}
+
+func (h *Handler) drainAndStop(
+	drainCtx context.Context,
+	cancelDrain context.CancelFunc,
+	productID string,
+	vers *entity.Version,
+	notifyStatusCh chan *entity.Version,
+) {
+	drainKey := getDrainKey(productID, vers.Tag)
+	defer h.drains.end(drainKey)
+
+	h.waitForPendingMessages(drainCtx, productID, vers, notifyStatusCh)
+	cancelDrain()
+
+	if !h.drains.finish(drainKey) {
+		// The version is being stopped by hand, the drain is abandoned.
+		close(notifyStatusCh)
+		return
+	}
+
+	userEmail, comment := vers.Drain.Author, vers.Drain.Comment
+
+	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration(config.VersionStatusTimeoutKey))
+	defer cancel()
+
+	if err := h.deleteResourcesAndSetStopping(ctx, userEmail, productID, vers); err != nil {
+		h.handleVersionServiceActionError(ctx, productID, vers, notifyStatusCh, err)
+		close(notifyStatusCh)
+
+		return
+	}
+
+	notifyStatusCh <- vers
+
+	h.stopAndNotify(userEmail, productID, comment, vers, notifyStatusCh)
+}
+
+// waitForPendingMessages polls the version streams until their consumers have no pending messages or the drain
+// deadline is reached. The version drain is saved and notified whenever the pending messages change.
+func (h *Handler) waitForPendingMessages(
+	ctx context.Context,
+	productID string,
+	vers *entity.Version,
+	notifyStatusCh chan *entity.Version,
+) {
+	ticker := time.NewTicker(viper.GetDuration(config.VersionDrainPollIntervalKey))
+	defer ticker.Stop()
+
+	for vers.Drain.PendingMessages > 0 {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return
+			}
+
+			h.logger.Info("Version drain timed out",
+				"productID", productID,
+				"versionTag", vers.Tag,
+				"pendingMessages", vers.Drain.PendingMessages,
+			)
+
+			vers.Drain.TimedOut = true
+			h.updateDrain(productID, vers)
+
+			return
+		case <-ticker.C:
+		}
+
+		streamStats, err := h.natsManagerService.GetVersionStreamStats(ctx, productID, vers)
+		if err != nil {
+			h.logger.Error(err, "Error getting version stream stats", "productID", productID, "versionTag", vers.Tag)
+			continue
+		}
+
+		pendingMessages := entity.GetPendingMessages(streamStats)
+		if pendingMessages == vers.Drain.PendingMessages {
+			continue
+		}
+
+		vers.Drain.PendingMessages = pendingMessages
+		h.updateDrain(productID, vers)
+
+		notifyStatusCh <- vers
+	}
+}
+
+func (h *Handler) updateDrain(productID string, vers *entity.Version) {
+	if err := h.versionRepo.Update(productID, vers); err != nil {
+		h.logger.Error(err, "Error updating version drain",
+			"productID", productID,
+			"versionTag", vers.Tag,
+			"pendingMessages", vers.Drain.PendingMessages,
+		)
+	}
+}
+
+func getDrainKey(productID, versionTag string) string {
+	return fmt.Sprintf("%s/%s", productID, versionTag)
+}
+
+// drainRegistry keeps the drains in progress, so a draining version can be stopped by hand without waiting for
+// its drain. A drain that is already stopping its version is kept with a nil cancel function.
+type drainRegistry struct {
+	mu     sync.Mutex
+	drains map[string]context.CancelFunc
+}
+
+func newDrainRegistry() *drainRegistry {
+	return &drainRegistry{drains: make(map[string]context.CancelFunc)}
+}
+
+func (r *drainRegistry) start(key string, cancel context.CancelFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.drains[key] = cancel
+}
+
+// finish tells if the drain can go on stopping its version, that is, if it was not cancelled.
+func (r *drainRegistry) finish(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.drains[key]; !ok {
+		return false
+	}
+
+	r.drains[key] = nil
+
+	return true
+}
+
+// cancel abandons the drain of the given version. It returns false when the drain is already stopping the version.
+func (r *drainRegistry) cancel(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cancel, ok := r.drains[key]
+	if !ok {
+		return true
+	}
+
+	if cancel == nil {
+		return false
+	}
+
+	cancel()
+	delete(r.drains, key)
+
+	return true
+}
+
+func (r *drainRegistry) end(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.drains, key)
+}
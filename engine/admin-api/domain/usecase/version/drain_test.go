@@ -0,0 +1,288 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/adapter/config"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/repository"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+	"github.com/spf13/viper"
+)
+
+func (s *versionSuite) getDrainVersion() *entity.Version {
+	return testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		WithWorkflows([]entity.Workflow{
+			testhelpers.NewWorkflowBuilder().WithProcesses([]entity.Process{
+				testhelpers.NewProcessBuilder().WithName("entrypoint").WithType(entity.ProcessTypeTrigger).Build(),
+				testhelpers.NewProcessBuilder().WithName("task").Build(),
+			}).Build(),
+		}).
+		Build()
+}
+
+func getStreamStatsWithPending(pending int64) []*entity.WorkflowStreamStats {
+	return []*entity.WorkflowStreamStats{
+		{Workflow: "test-workflow-name", Consumers: entity.ConsumerStats{Pending: pending}},
+	}
+}
+
+func (s *versionSuite) expectStop(ctx interface{}, vers *entity.Version) {
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return([]*entity.Version{vers}, nil)
+	s.natsManagerService.EXPECT().RevokeVersionCredentials(ctx, _productID, _versionTag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteStreams(ctx, _productID, _versionTag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteObjectStores(ctx, _productID, _versionTag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteVersionKeyValueStores(ctx, _productID, vers).Return(nil)
+	s.versionRepo.EXPECT().SetStatus(ctx, _productID, vers.Tag, entity.VersionStatusStopping).Return(nil)
+	s.versionService.EXPECT().Stop(ctx, _productID, vers).Return(nil)
+	s.versionRepo.EXPECT().SetStatus(ctx, _productID, vers.Tag, entity.VersionStatusStopped).Return(nil)
+}
+
+func (s *versionSuite) TestDrainAndStop() {
+	// GIVEN a started version with pending messages that get processed while draining
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := s.getDrainVersion()
+	opts := version.DrainOpts{ProductID: _productID, VersionTag: _versionTag, Comment: "testing"}
+	stoppedTrigger := int32(0)
+
+	viper.Set(config.VersionDrainTimeoutKey, time.Minute)
+	viper.Set(config.VersionDrainPollIntervalKey, time.Millisecond)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().GetVersionStreamStats(ctx, _productID, vers).Return(getStreamStatsWithPending(2), nil)
+	s.versionService.EXPECT().ScaleProcess(ctx, _productID, _versionTag, &entity.ProcessScaling{
+		Workflow: "test-workflow-name",
+		Process:  "entrypoint",
+		Replicas: &stoppedTrigger,
+	}).Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, vers).Return(nil).Times(2)
+
+	s.natsManagerService.EXPECT().GetVersionStreamStats(gomock.Any(), _productID, vers).
+		Return(getStreamStatsWithPending(0), nil)
+	s.expectStop(gomock.Any(), vers)
+	s.userActivityInteractor.EXPECT().RegisterStopAction(user.Email, _productID, vers, opts.Comment).Return(nil)
+
+	// WHEN draining the version
+	_, notifyChn, err := s.handler.DrainAndStop(ctx, user, opts)
+	s.Require().NoError(err)
+
+	var stoppedVer *entity.Version
+	for notifiedVersion := range notifyChn {
+		stoppedVer = notifiedVersion
+	}
+
+	// THEN the version is stopped once the pending messages are processed
+	s.Require().NotNil(stoppedVer)
+	s.Equal(entity.VersionStatusStopped, stoppedVer.Status)
+	s.Zero(stoppedVer.Drain.PendingMessages)
+	s.False(stoppedVer.Drain.TimedOut)
+}
+
+func (s *versionSuite) TestDrainAndStop_TimesOut() {
+	// GIVEN a started version whose pending messages are not processed before the drain timeout
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := s.getDrainVersion()
+	opts := version.DrainOpts{
+		ProductID:  _productID,
+		VersionTag: _versionTag,
+		Comment:    "testing",
+		Timeout:    10 * time.Millisecond,
+	}
+
+	viper.Set(config.VersionDrainPollIntervalKey, time.Millisecond)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().GetVersionStreamStats(gomock.Any(), _productID, vers).
+		Return(getStreamStatsWithPending(5), nil).
+		MinTimes(1)
+	s.versionService.EXPECT().ScaleProcess(ctx, _productID, _versionTag, gomock.Any()).Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, vers).Return(nil).Times(2)
+
+	s.expectStop(gomock.Any(), vers)
+	s.userActivityInteractor.EXPECT().RegisterStopAction(user.Email, _productID, vers, opts.Comment).Return(nil)
+
+	// WHEN draining the version
+	_, notifyChn, err := s.handler.DrainAndStop(ctx, user, opts)
+	s.Require().NoError(err)
+
+	var stoppedVer *entity.Version
+	for notifiedVersion := range notifyChn {
+		stoppedVer = notifiedVersion
+	}
+
+	// THEN the version is stopped anyway and the drain is marked as timed out
+	s.Require().NotNil(stoppedVer)
+	s.Equal(entity.VersionStatusStopped, stoppedVer.Status)
+	s.Equal(int64(5), stoppedVer.Drain.PendingMessages)
+	s.True(stoppedVer.Drain.TimedOut)
+}
+
+func (s *versionSuite) TestDrainAndStop_ErrorStoppingTriggers() {
+	// GIVEN a started version whose triggers cannot be scaled down
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := s.getDrainVersion()
+	opts := version.DrainOpts{ProductID: _productID, VersionTag: _versionTag, Comment: "testing"}
+	scaleErr := errors.New("scale error")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().GetVersionStreamStats(ctx, _productID, vers).Return(getStreamStatsWithPending(2), nil)
+	s.versionService.EXPECT().ScaleProcess(ctx, _productID, _versionTag, gomock.Any()).Return(scaleErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterStopAction(user.Email, _productID, vers, version.ErrDrainingVersion.Error()).
+		Return(nil)
+
+	// WHEN draining the version
+	_, _, err := s.handler.DrainAndStop(ctx, user, opts)
+
+	// THEN an error is returned and the version is left started
+	s.ErrorIs(err, version.ErrDrainingVersion)
+	s.ErrorIs(err, scaleErr)
+	s.Equal(entity.VersionStatusStarted, vers.Status)
+}
+
+func (s *versionSuite) TestDrainAndStop_ErrorVersionCannotBeDrained() {
+	// GIVEN a published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := s.getDrainVersion()
+	vers.Status = entity.VersionStatusPublished
+	opts := version.DrainOpts{ProductID: _productID, VersionTag: _versionTag, Comment: "testing"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterStopAction(user.Email, _productID, vers, version.ErrVersionCannotBeDrained.Error()).
+		Return(nil)
+
+	// WHEN draining the version
+	_, _, err := s.handler.DrainAndStop(ctx, user, opts)
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrVersionCannotBeDrained)
+}
+
+func (s *versionSuite) TestDrainAndStop_ErrorInvalidTimeout() {
+	// GIVEN a negative drain timeout
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	opts := version.DrainOpts{ProductID: _productID, VersionTag: _versionTag, Timeout: -time.Second}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+
+	// WHEN draining the version
+	_, _, err := s.handler.DrainAndStop(ctx, user, opts)
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrInvalidDrainTimeout)
+}
+
+func (s *versionSuite) TestDrainAndStop_CancelledByStop() {
+	// GIVEN a version draining messages that are never processed
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := s.getDrainVersion()
+	opts := version.DrainOpts{ProductID: _productID, VersionTag: _versionTag, Comment: "draining"}
+
+	viper.Set(config.VersionDrainTimeoutKey, time.Minute)
+	viper.Set(config.VersionDrainPollIntervalKey, time.Millisecond)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil).Times(2)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil).Times(2)
+	s.natsManagerService.EXPECT().GetVersionStreamStats(gomock.Any(), _productID, vers).
+		Return(getStreamStatsWithPending(2), nil).
+		AnyTimes()
+	s.versionService.EXPECT().ScaleProcess(ctx, _productID, _versionTag, gomock.Any()).Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, vers).Return(nil)
+
+	s.expectStop(gomock.Any(), vers)
+	s.userActivityInteractor.EXPECT().RegisterStopAction(user.Email, _productID, vers, "stopping").Return(nil)
+
+	_, drainNotifyChn, err := s.handler.DrainAndStop(ctx, user, opts)
+	s.Require().NoError(err)
+
+	// WHEN stopping the draining version
+	_, stopNotifyChn, err := s.handler.Stop(ctx, user, _productID, _versionTag, "stopping")
+	s.Require().NoError(err)
+
+	// THEN the drain is abandoned and the version is stopped only once
+	for range drainNotifyChn {
+	}
+
+	var stoppedVer *entity.Version
+	for notifiedVersion := range stopNotifyChn {
+		stoppedVer = notifiedVersion
+	}
+
+	s.Require().NotNil(stoppedVer)
+	s.Equal(entity.VersionStatusStopped, stoppedVer.Status)
+}
+
+func (s *versionSuite) TestResumeDrains() {
+	// GIVEN a version left draining with its drain deadline already passed
+	ctx := context.Background()
+	product := testhelpers.NewProductBuilder().WithID(_productID).Build()
+	vers := s.getDrainVersion()
+	vers.Status = entity.VersionStatusDraining
+	vers.Drain = &entity.VersionDrain{
+		Date:            time.Now().Add(-time.Hour),
+		Deadline:        time.Now().Add(-time.Minute),
+		PendingMessages: 3,
+		Author:          "drainer@test.com",
+		Comment:         "draining",
+	}
+	stopped := make(chan struct{})
+
+	s.productRepo.EXPECT().FindAll(ctx, nil).Return([]*entity.Product{product}, nil)
+	s.versionRepo.EXPECT().
+		SearchByProduct(ctx, _productID, &repository.ListVersionsFilter{Status: entity.VersionStatusDraining}).
+		Return([]*entity.Version{vers}, nil)
+	s.versionRepo.EXPECT().Update(_productID, vers).Return(nil)
+	s.expectStop(gomock.Any(), vers)
+	s.userActivityInteractor.EXPECT().RegisterStopAction("drainer@test.com", _productID, vers, "draining").
+		DoAndReturn(func(_, _ string, _ *entity.Version, _ string) error {
+			close(stopped)
+			return nil
+		})
+
+	// WHEN resuming the drains
+	err := s.handler.ResumeDrains(ctx)
+	s.Require().NoError(err)
+
+	// THEN the version is stopped by the drain author
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		s.Fail("version not stopped")
+	}
+
+	s.True(vers.Drain.TimedOut)
+}
+
+func (s *versionSuite) TestResumeDrains_ErrorListingProducts() {
+	// GIVEN the products cannot be listed
+	ctx := context.Background()
+	listErr := errors.New("list error")
+
+	s.productRepo.EXPECT().FindAll(ctx, nil).Return(nil, listErr)
+
+	// WHEN resuming the drains
+	err := s.handler.ResumeDrains(ctx)
+
+	// THEN an error is returned
+	s.ErrorIs(err, listErr)
+}
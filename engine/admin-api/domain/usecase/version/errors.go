@@ -21,12 +21,26 @@ var (
 	ErrVersionDuplicated          = errors.New("error version duplicated")
 	ErrUserNotAuthorized          = errors.New("error user not authorized")
 	ErrVersionCannotBeStarted     = errors.New("error version cannot be started, status must be 'created', 'stopped', 'publishing' or 'error'")
-	ErrVersionCannotBeStopped     = errors.New("error version cannot be stopped, status must be 'started'")
+	ErrVersionCannotBeStopped     = errors.New("error version cannot be stopped, status must be 'started' or 'draining'")
+	ErrVersionCannotBeDrained     = errors.New("error version cannot be drained, status must be 'started'")
+	ErrVersionAlreadyStopping     = errors.New("error version drain is already stopping the version")
 	ErrVersionIsNotStarted        = errors.New("error publishing version, status must be 'started'")
 	ErrVersionCannotBeUnpublished = errors.New("error unpublishing version, status must be 'published'")
 	ErrDeletingNATSResources      = errors.New("error deleting NATS resources")
 	ErrStoppingVersion            = errors.New("error stopping version")
 	ErrUnpublishingVersion        = errors.New("error unpublishing version")
+	ErrVersionCannotBePatched     = errors.New("error version cannot be patched, status must be 'started' or 'published'")
+	ErrProcessNotFound            = errors.New("error process not found in version")
+	ErrUpdatingProcessImage       = errors.New("error updating process image")
+	ErrScalingProcess             = errors.New("error scaling process")
+	ErrWorkflowNotFound           = errors.New("error workflow not found in version")
+	ErrWorkflowIsNotBatch         = errors.New("error workflow cannot be run on demand, it has no job settings")
+	ErrRunningWorkflow            = errors.New("error running workflow")
+	ErrReplayingDeadLetters       = errors.New("error replaying dead-letter messages")
+	ErrPurgingDeadLetters         = errors.New("error purging dead-letter messages")
+	ErrInjectingMessage           = errors.New("error injecting message")
+	ErrInvalidConfigurationScope  = errors.New("error invalid configuration scope")
+	ErrRollingBackConfiguration   = errors.New("error rolling back configuration")
 )
 
 func ParsingKRTFileError(err error) error {
@@ -24,6 +24,11 @@ func (h *Handler) GetByTag(ctx context.Context, user *entity.User, productID, ta
 		return nil, err
 	}
 
+	if version.CanBePatched() {
+		h.setProcessesStatus(ctx, productID, version)
+		h.setStreamStats(ctx, productID, version)
+	}
+
 	if version.Status != entity.VersionStatusPublished {
 		return version, err
 	}
@@ -37,3 +42,27 @@ func (h *Handler) GetByTag(ctx context.Context, user *entity.User, productID, ta
 
 	return version, nil
 }
+
+// setProcessesStatus adds the k8s status of the processes to a running version. The status is only informative,
+// so the version is returned even if it cannot be retrieved.
+func (h *Handler) setProcessesStatus(ctx context.Context, productID string, version *entity.Version) {
+	reports, err := h.k8sService.GetProcessStatus(ctx, productID, version.Tag)
+	if err != nil {
+		h.logger.Error(err, "Error getting processes status", "productID", productID, "versionTag", version.Tag)
+		return
+	}
+
+	version.SetProcessesStatus(reports)
+}
+
+// setStreamStats adds the stats of the NATS streams to a running version. As the processes status, they are only
+// informative and the version is returned even if they cannot be retrieved.
+func (h *Handler) setStreamStats(ctx context.Context, productID string, version *entity.Version) {
+	stats, err := h.natsManagerService.GetVersionStreamStats(ctx, productID, version)
+	if err != nil {
+		h.logger.Error(err, "Error getting stream stats", "productID", productID, "versionTag", version.Tag)
+		return
+	}
+
+	version.SetStreamStats(stats)
+}
@@ -109,6 +109,8 @@ func (s *versionSuite) TestGetByTag_PublishedVersion_PublishedTriggers() {
 	s.productRepo.EXPECT().GetByID(ctx, productID).Return(&entity.Product{}, nil)
 	s.accessControl.EXPECT().CheckProductGrants(user, productID, auth.ActViewProduct).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, productID, testVersion.Tag).Return(testVersion, nil)
+	s.versionService.EXPECT().GetProcessStatus(ctx, productID, testVersion.Tag).Return(nil, nil)
+	s.natsManagerService.EXPECT().GetVersionStreamStats(ctx, productID, testVersion).Return(nil, nil)
 	s.versionService.EXPECT().GetPublishedTriggers(ctx, productID).Return(expectedPublishedTriggers, nil)
 
 	// WHEN
@@ -135,6 +137,8 @@ func (s *versionSuite) TestGetByTag_PublishedVersion_ErrorGettingPublishedTrigge
 	s.productRepo.EXPECT().GetByID(ctx, productID).Return(&entity.Product{}, nil)
 	s.accessControl.EXPECT().CheckProductGrants(user, productID, auth.ActViewProduct).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, productID, testVersion.Tag).Return(testVersion, nil)
+	s.versionService.EXPECT().GetProcessStatus(ctx, productID, testVersion.Tag).Return(nil, nil)
+	s.natsManagerService.EXPECT().GetVersionStreamStats(ctx, productID, testVersion).Return(nil, nil)
 	s.versionService.EXPECT().GetPublishedTriggers(ctx, productID).Return(nil, expectedErr)
 
 	// WHEN
@@ -143,3 +147,136 @@ func (s *versionSuite) TestGetByTag_PublishedVersion_ErrorGettingPublishedTrigge
 	// THEN
 	s.ErrorIs(err, expectedErr)
 }
+
+func (s *versionSuite) TestGetByTag_StartedVersion_ProcessesStatus() {
+	// GIVEN a started version with a crash looping process
+	var (
+		ctx         = context.Background()
+		user        = testhelpers.NewUserBuilder().Build()
+		productID   = "product-1"
+		testVersion = testhelpers.NewVersionBuilder().
+				WithTag("test-tag").
+				WithStatus(entity.VersionStatusStarted).
+				Build()
+		workflow = testVersion.Workflows[0]
+		runtime  = &entity.ProcessRuntimeStatus{
+			DesiredReplicas:       1,
+			Restarts:              5,
+			LastTerminationReason: "CrashLoopBackOff",
+		}
+	)
+
+	s.productRepo.EXPECT().GetByID(ctx, productID).Return(&entity.Product{}, nil)
+	s.accessControl.EXPECT().CheckProductGrants(user, productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, productID, testVersion.Tag).Return(testVersion, nil)
+	s.versionService.EXPECT().GetProcessStatus(ctx, productID, testVersion.Tag).Return([]*entity.ProcessStatusReport{
+		{
+			Workflow: workflow.Name,
+			Process:  workflow.Processes[0].Name,
+			Status:   entity.ProcessStatusStarting,
+			Runtime:  runtime,
+		},
+	}, nil)
+	s.natsManagerService.EXPECT().GetVersionStreamStats(ctx, productID, testVersion).Return(nil, nil)
+
+	// WHEN
+	actual, err := s.handler.GetByTag(ctx, user, productID, testVersion.Tag)
+
+	// THEN the process includes its runtime status
+	s.Require().NoError(err)
+	s.Equal(entity.ProcessStatusStarting, actual.Workflows[0].Processes[0].Status)
+	s.Equal(runtime, actual.Workflows[0].Processes[0].Runtime)
+}
+
+func (s *versionSuite) TestGetByTag_StartedVersion_ErrorGettingProcessesStatus() {
+	// GIVEN a started version whose processes status cannot be retrieved
+	var (
+		ctx         = context.Background()
+		user        = testhelpers.NewUserBuilder().Build()
+		productID   = "product-1"
+		testVersion = testhelpers.NewVersionBuilder().
+				WithTag("test-tag").
+				WithStatus(entity.VersionStatusStarted).
+				Build()
+	)
+
+	s.productRepo.EXPECT().GetByID(ctx, productID).Return(&entity.Product{}, nil)
+	s.accessControl.EXPECT().CheckProductGrants(user, productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, productID, testVersion.Tag).Return(testVersion, nil)
+	s.versionService.EXPECT().GetProcessStatus(ctx, productID, testVersion.Tag).Return(nil, errors.New("k8s error"))
+	s.natsManagerService.EXPECT().GetVersionStreamStats(ctx, productID, testVersion).Return(nil, nil)
+
+	// WHEN
+	actual, err := s.handler.GetByTag(ctx, user, productID, testVersion.Tag)
+
+	// THEN the version is still returned
+	s.Require().NoError(err)
+	s.Equal(testVersion, actual)
+}
+
+func (s *versionSuite) TestGetByTag_StartedVersion_StreamStats() {
+	// GIVEN a started version with messages pending in its workflow stream
+	var (
+		ctx         = context.Background()
+		user        = testhelpers.NewUserBuilder().Build()
+		productID   = "product-1"
+		testVersion = testhelpers.NewVersionBuilder().
+				WithTag("test-tag").
+				WithStatus(entity.VersionStatusStarted).
+				Build()
+		workflow     = testVersion.Workflows[0]
+		processStats = &entity.ProcessStreamStats{
+			Process:   workflow.Processes[0].Name,
+			Messages:  5,
+			Consumers: entity.ConsumerStats{Pending: 2},
+		}
+		workflowStats = &entity.WorkflowStreamStats{
+			Workflow:  workflow.Name,
+			Messages:  5,
+			Consumers: entity.ConsumerStats{Pending: 2},
+			Processes: []*entity.ProcessStreamStats{processStats},
+		}
+	)
+
+	s.productRepo.EXPECT().GetByID(ctx, productID).Return(&entity.Product{}, nil)
+	s.accessControl.EXPECT().CheckProductGrants(user, productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, productID, testVersion.Tag).Return(testVersion, nil)
+	s.versionService.EXPECT().GetProcessStatus(ctx, productID, testVersion.Tag).Return(nil, nil)
+	s.natsManagerService.EXPECT().
+		GetVersionStreamStats(ctx, productID, testVersion).
+		Return([]*entity.WorkflowStreamStats{workflowStats}, nil)
+
+	// WHEN
+	actual, err := s.handler.GetByTag(ctx, user, productID, testVersion.Tag)
+
+	// THEN the workflow and its processes include their stream stats
+	s.Require().NoError(err)
+	s.Equal(workflowStats, actual.Workflows[0].StreamStats)
+	s.Equal(processStats, actual.Workflows[0].Processes[0].StreamStats)
+}
+
+func (s *versionSuite) TestGetByTag_StartedVersion_ErrorGettingStreamStats() {
+	// GIVEN a started version whose stream stats cannot be retrieved
+	var (
+		ctx         = context.Background()
+		user        = testhelpers.NewUserBuilder().Build()
+		productID   = "product-1"
+		testVersion = testhelpers.NewVersionBuilder().
+				WithTag("test-tag").
+				WithStatus(entity.VersionStatusStarted).
+				Build()
+	)
+
+	s.productRepo.EXPECT().GetByID(ctx, productID).Return(&entity.Product{}, nil)
+	s.accessControl.EXPECT().CheckProductGrants(user, productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, productID, testVersion.Tag).Return(testVersion, nil)
+	s.versionService.EXPECT().GetProcessStatus(ctx, productID, testVersion.Tag).Return(nil, nil)
+	s.natsManagerService.EXPECT().GetVersionStreamStats(ctx, productID, testVersion).Return(nil, errors.New("nats error"))
+
+	// WHEN
+	actual, err := s.handler.GetByTag(ctx, user, productID, testVersion.Tag)
+
+	// THEN the version is still returned
+	s.Require().NoError(err)
+	s.Equal(testVersion, actual)
+}
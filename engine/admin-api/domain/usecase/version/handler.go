@@ -17,6 +17,8 @@ type Handler struct {
 	natsManagerService     service.NatsManagerService
 	userActivityInteractor usecase.UserActivityInteracter
 	accessControl          auth.AccessControl
+	admissionPolicyRepo    repository.AdmissionPolicyRepo
+	drains                 *drainRegistry
 }
 
 type HandlerParams struct {
@@ -27,6 +29,7 @@ type HandlerParams struct {
 	NatsManagerService     service.NatsManagerService
 	UserActivityInteractor usecase.UserActivityInteracter
 	AccessControl          auth.AccessControl
+	AdmissionPolicyRepo    repository.AdmissionPolicyRepo
 }
 
 // NewHandler creates a new interactor.
@@ -39,5 +42,7 @@ func NewHandler(params *HandlerParams) *Handler {
 		params.NatsManagerService,
 		params.UserActivityInteractor,
 		params.AccessControl,
+		params.AdmissionPolicyRepo,
+		newDrainRegistry(),
 	}
 }
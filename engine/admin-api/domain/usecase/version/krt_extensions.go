@@ -0,0 +1,363 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"gopkg.in/yaml.v3"
+)
+
+// krtExtensions holds the krt file settings not supported by the krt library yet. They are read from the same
+// file and matched by position with the workflows and processes parsed by the library.
+type krtExtensions struct {
+	KeyValueStore *krtKeyValueStoreSettings `yaml:"keyValueStore"`
+	Workflows     []krtWorkflowExtensions   `yaml:"workflows"`
+}
+
+type krtWorkflowExtensions struct {
+	Name          string                    `yaml:"name"`
+	Stream        *krtStreamSettings        `yaml:"stream"`
+	KeyValueStore *krtKeyValueStoreSettings `yaml:"keyValueStore"`
+	Job           *krtJob                   `yaml:"job"`
+	Processes     []krtProcessExtensions    `yaml:"processes"`
+}
+
+type krtJob struct {
+	Schedule                string `yaml:"schedule"`
+	BackoffLimit            int32  `yaml:"backoffLimit"`
+	ActiveDeadlineSeconds   int64  `yaml:"activeDeadlineSeconds"`
+	TTLSecondsAfterFinished int32  `yaml:"ttlSecondsAfterFinished"`
+}
+
+type krtStreamSettings struct {
+	Retention     string `yaml:"retention"`
+	MaxAgeSeconds int64  `yaml:"maxAgeSeconds"`
+	MaxBytes      int64  `yaml:"maxBytes"`
+	MaxMsgSize    int32  `yaml:"maxMsgSize"`
+	Replicas      int32  `yaml:"replicas"`
+	Storage       string `yaml:"storage"`
+}
+
+type krtProcessExtensions struct {
+	Name          string                    `yaml:"name"`
+	Probes        *krtProbes                `yaml:"probes"`
+	MaxDeliver    int32                     `yaml:"maxDeliver"`
+	ObjectStore   *krtObjectStoreExtensions `yaml:"objectStore"`
+	KeyValueStore *krtKeyValueStoreSettings `yaml:"keyValueStore"`
+	Autoscaling   *krtAutoscaling           `yaml:"autoscaling"`
+}
+
+type krtAutoscaling struct {
+	MinReplicas                   int32 `yaml:"minReplicas"`
+	MaxReplicas                   int32 `yaml:"maxReplicas"`
+	CPUTargetPercentage           int32 `yaml:"cpuTargetPercentage"`
+	MemoryTargetPercentage        int32 `yaml:"memoryTargetPercentage"`
+	ScaleUpStabilizationSeconds   int32 `yaml:"scaleUpStabilizationSeconds"`
+	ScaleDownStabilizationSeconds int32 `yaml:"scaleDownStabilizationSeconds"`
+	ConsumerLagTarget             int32 `yaml:"consumerLagTarget"`
+}
+
+type krtObjectStoreExtensions struct {
+	Settings *krtObjectStoreSettings `yaml:"settings"`
+}
+
+type krtObjectStoreSettings struct {
+	TTLSeconds int64  `yaml:"ttlSeconds"`
+	MaxBytes   int64  `yaml:"maxBytes"`
+	Replicas   int32  `yaml:"replicas"`
+	Storage    string `yaml:"storage"`
+}
+
+type krtKeyValueStoreSettings struct {
+	TTLSeconds int64  `yaml:"ttlSeconds"`
+	MaxBytes   int64  `yaml:"maxBytes"`
+	History    int32  `yaml:"history"`
+	Replicas   int32  `yaml:"replicas"`
+	Storage    string `yaml:"storage"`
+}
+
+type krtProbes struct {
+	Liveness  *krtProbe `yaml:"liveness"`
+	Readiness *krtProbe `yaml:"readiness"`
+	Startup   *krtProbe `yaml:"startup"`
+}
+
+type krtProbe struct {
+	Type                string   `yaml:"type"`
+	Path                string   `yaml:"path"`
+	Port                int      `yaml:"port"`
+	Command             []string `yaml:"command"`
+	GRPCService         string   `yaml:"grpcService"`
+	InitialDelaySeconds int32    `yaml:"initialDelaySeconds"`
+	PeriodSeconds       int32    `yaml:"periodSeconds"`
+	TimeoutSeconds      int32    `yaml:"timeoutSeconds"`
+	SuccessThreshold    int32    `yaml:"successThreshold"`
+	FailureThreshold    int32    `yaml:"failureThreshold"`
+}
+
+func parseKrtExtensions(krtFilePath string) (*krtExtensions, error) {
+	krtFile, err := os.ReadFile(krtFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading krt file: %w", err)
+	}
+
+	extensions := &krtExtensions{}
+	if err := yaml.Unmarshal(krtFile, extensions); err != nil {
+		return nil, err
+	}
+
+	return extensions, nil
+}
+
+// applyKrtExtensions sets the extension settings to the version mapped from the krt file.
+func (h *Handler) applyKrtExtensions(version *entity.Version, extensions *krtExtensions) error {
+	var errs error
+
+	keyValueStore, err := h.mapKrtKeyValueStoreSettingsToVersion(extensions.KeyValueStore)
+	if err != nil {
+		errs = errors.Join(errs, fmt.Errorf("version key-value store: %w", err))
+	}
+
+	version.KeyValueStore = keyValueStore
+
+	for i, workflowExtensions := range extensions.Workflows {
+		if i >= len(version.Workflows) {
+			break
+		}
+
+		workflow := &version.Workflows[i]
+
+		if err := h.applyKrtWorkflowExtensions(workflow, workflowExtensions); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("workflow %q: %w", workflow.Name, err))
+		}
+
+		for j, processExtensions := range workflowExtensions.Processes {
+			if j >= len(workflow.Processes) {
+				break
+			}
+
+			process := &workflow.Processes[j]
+
+			if err := h.applyKrtProcessExtensions(process, processExtensions); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("workflow %q, process %q: %w", workflow.Name, process.Name, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+func (h *Handler) applyKrtWorkflowExtensions(workflow *entity.Workflow, extensions krtWorkflowExtensions) error {
+	streamSettings, streamErr := h.mapKrtStreamSettingsToVersion(extensions.Stream)
+	keyValueStore, keyValueStoreErr := h.mapKrtKeyValueStoreSettingsToVersion(extensions.KeyValueStore)
+	job, jobErr := h.mapKrtJobToVersion(extensions.Job)
+
+	if keyValueStoreErr != nil {
+		keyValueStoreErr = fmt.Errorf("key-value store: %w", keyValueStoreErr)
+	}
+
+	if err := errors.Join(streamErr, keyValueStoreErr, jobErr); err != nil {
+		return err
+	}
+
+	workflow.StreamSettings = streamSettings
+	workflow.KeyValueStore = keyValueStore
+	workflow.Job = job
+
+	return nil
+}
+
+func (h *Handler) mapKrtJobToVersion(krtJob *krtJob) (*entity.WorkflowJob, error) {
+	if krtJob == nil {
+		return nil, nil
+	}
+
+	job := &entity.WorkflowJob{
+		Schedule:                krtJob.Schedule,
+		BackoffLimit:            krtJob.BackoffLimit,
+		ActiveDeadlineSeconds:   krtJob.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: krtJob.TTLSecondsAfterFinished,
+	}
+
+	if err := job.Validate(); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (h *Handler) mapKrtStreamSettingsToVersion(krtSettings *krtStreamSettings) (*entity.WorkflowStreamSettings, error) {
+	if krtSettings == nil {
+		return nil, nil
+	}
+
+	settings := &entity.WorkflowStreamSettings{
+		Retention:     entity.StreamRetention(krtSettings.Retention),
+		MaxAgeSeconds: krtSettings.MaxAgeSeconds,
+		MaxBytes:      krtSettings.MaxBytes,
+		MaxMsgSize:    krtSettings.MaxMsgSize,
+		Replicas:      krtSettings.Replicas,
+		Storage:       entity.StreamStorage(krtSettings.Storage),
+	}
+
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (h *Handler) applyKrtProcessExtensions(process *entity.Process, extensions krtProcessExtensions) error {
+	probes, err := h.mapKrtProbesToVersion(extensions.Probes)
+	if err != nil {
+		return err
+	}
+
+	if extensions.MaxDeliver < 0 {
+		return entity.ErrInvalidMaxDeliver
+	}
+
+	keyValueStore, err := h.mapKrtKeyValueStoreSettingsToVersion(extensions.KeyValueStore)
+	if err != nil {
+		return fmt.Errorf("key-value store: %w", err)
+	}
+
+	autoscaling, err := h.mapKrtAutoscalingToVersion(extensions.Autoscaling)
+	if err != nil {
+		return err
+	}
+
+	if extensions.ObjectStore != nil && process.ObjectStore != nil {
+		objectStoreSettings, err := h.mapKrtObjectStoreSettingsToVersion(process.ObjectStore, extensions.ObjectStore.Settings)
+		if err != nil {
+			return fmt.Errorf("object store: %w", err)
+		}
+
+		process.ObjectStore.Settings = objectStoreSettings
+	}
+
+	process.Probes = probes
+	process.MaxDeliver = extensions.MaxDeliver
+	process.KeyValueStore = keyValueStore
+	process.Autoscaling = autoscaling
+
+	return nil
+}
+
+func (h *Handler) mapKrtAutoscalingToVersion(krtAutoscaling *krtAutoscaling) (*entity.ProcessAutoscaling, error) {
+	if krtAutoscaling == nil {
+		return nil, nil
+	}
+
+	autoscaling := &entity.ProcessAutoscaling{
+		MinReplicas:                   krtAutoscaling.MinReplicas,
+		MaxReplicas:                   krtAutoscaling.MaxReplicas,
+		CPUTargetPercentage:           krtAutoscaling.CPUTargetPercentage,
+		MemoryTargetPercentage:        krtAutoscaling.MemoryTargetPercentage,
+		ScaleUpStabilizationSeconds:   krtAutoscaling.ScaleUpStabilizationSeconds,
+		ScaleDownStabilizationSeconds: krtAutoscaling.ScaleDownStabilizationSeconds,
+		ConsumerLagTarget:             krtAutoscaling.ConsumerLagTarget,
+	}
+
+	if err := autoscaling.Validate(); err != nil {
+		return nil, err
+	}
+
+	return autoscaling, nil
+}
+
+func (h *Handler) mapKrtObjectStoreSettingsToVersion(
+	objectStore *entity.ProcessObjectStore,
+	krtSettings *krtObjectStoreSettings,
+) (*entity.ObjectStoreSettings, error) {
+	if krtSettings == nil {
+		return nil, nil
+	}
+
+	// Product object stores are shared by every version, so their settings can't be changed from a version.
+	if objectStore.Scope == entity.ObjectStoreScopeProduct {
+		return nil, entity.ErrProductObjectStoreSettings
+	}
+
+	settings := &entity.ObjectStoreSettings{
+		TTLSeconds: krtSettings.TTLSeconds,
+		MaxBytes:   krtSettings.MaxBytes,
+		Replicas:   krtSettings.Replicas,
+		Storage:    entity.StreamStorage(krtSettings.Storage),
+	}
+
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (h *Handler) mapKrtKeyValueStoreSettingsToVersion(
+	krtSettings *krtKeyValueStoreSettings,
+) (*entity.KeyValueStoreSettings, error) {
+	if krtSettings == nil {
+		return nil, nil
+	}
+
+	settings := &entity.KeyValueStoreSettings{
+		TTLSeconds: krtSettings.TTLSeconds,
+		MaxBytes:   krtSettings.MaxBytes,
+		History:    krtSettings.History,
+		Replicas:   krtSettings.Replicas,
+		Storage:    entity.StreamStorage(krtSettings.Storage),
+	}
+
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (h *Handler) mapKrtProbesToVersion(krtProbes *krtProbes) (*entity.ProcessProbes, error) {
+	if krtProbes == nil {
+		return nil, nil
+	}
+
+	liveness, livenessErr := h.mapKrtProbeToVersion(krtProbes.Liveness)
+	readiness, readinessErr := h.mapKrtProbeToVersion(krtProbes.Readiness)
+	startup, startupErr := h.mapKrtProbeToVersion(krtProbes.Startup)
+
+	if err := errors.Join(livenessErr, readinessErr, startupErr); err != nil {
+		return nil, err
+	}
+
+	return &entity.ProcessProbes{
+		Liveness:  liveness,
+		Readiness: readiness,
+		Startup:   startup,
+	}, nil
+}
+
+func (h *Handler) mapKrtProbeToVersion(krtProbe *krtProbe) (*entity.ProcessProbe, error) {
+	if krtProbe == nil {
+		return nil, nil
+	}
+
+	probe := &entity.ProcessProbe{
+		Type:                entity.ProbeType(krtProbe.Type),
+		Path:                krtProbe.Path,
+		Port:                krtProbe.Port,
+		Command:             krtProbe.Command,
+		GRPCService:         krtProbe.GRPCService,
+		InitialDelaySeconds: krtProbe.InitialDelaySeconds,
+		PeriodSeconds:       krtProbe.PeriodSeconds,
+		TimeoutSeconds:      krtProbe.TimeoutSeconds,
+		SuccessThreshold:    krtProbe.SuccessThreshold,
+		FailureThreshold:    krtProbe.FailureThreshold,
+	}
+
+	if err := probe.Validate(); err != nil {
+		return nil, err
+	}
+
+	return probe, nil
+}
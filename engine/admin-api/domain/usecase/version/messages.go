@@ -0,0 +1,105 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+type PeekProcessMessagesOpts struct {
+	ProductID    string
+	VersionTag   string
+	WorkflowName string
+	ProcessName  string
+	Count        int
+}
+
+type InjectMessageOpts struct {
+	ProductID  string
+	VersionTag string
+	Subject    string
+	Headers    map[string]string
+	Data       []byte
+	Comment    string
+}
+
+// PeekProcessMessages returns the last messages published by a process of a running version, newest first.
+func (h *Handler) PeekProcessMessages(
+	ctx context.Context,
+	user *entity.User,
+	opts PeekProcessMessagesOpts,
+) ([]*entity.StreamMessage, error) {
+	if err := h.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActDebugVersionMessages); err != nil {
+		return nil, err
+	}
+
+	vers, err := h.getRunningWorkflowVersion(ctx, opts.ProductID, opts.VersionTag, opts.WorkflowName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := vers.GetProcess(opts.WorkflowName, opts.ProcessName); !found {
+		return nil, ErrProcessNotFound
+	}
+
+	messages, err := h.natsManagerService.GetProcessMessages(
+		ctx, opts.ProductID, vers.Tag, opts.WorkflowName, opts.ProcessName, opts.Count,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("getting process messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+// InjectMessage publishes a message to a subject of a running version, so it can be debugged without
+// connecting to NATS.
+func (h *Handler) InjectMessage(ctx context.Context, user *entity.User, opts InjectMessageOpts) error {
+	registerActionFailed := func(vers *entity.Version, incomingErr error) {
+		err := h.userActivityInteractor.RegisterInjectMessageAction(
+			user.Email, opts.ProductID, vers, opts.Subject, incomingErr.Error(),
+		)
+		if err != nil {
+			h.logger.Error(err, "Error registering user activity",
+				"productID", opts.ProductID,
+				"versionTag", vers.Tag,
+				"error", incomingErr.Error(),
+			)
+		}
+	}
+
+	if err := h.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActDebugVersionMessages); err != nil {
+		registerActionFailed(&entity.Version{Tag: opts.VersionTag}, ErrUserNotAuthorized)
+		return err
+	}
+
+	vers, err := h.versionRepo.GetByTag(ctx, opts.ProductID, opts.VersionTag)
+	if err != nil {
+		registerActionFailed(&entity.Version{Tag: opts.VersionTag}, err)
+		return err
+	}
+
+	if !vers.CanBePatched() {
+		registerActionFailed(vers, ErrVersionIsNotStarted)
+		return ErrVersionIsNotStarted
+	}
+
+	err = h.natsManagerService.PublishMessage(ctx, opts.ProductID, vers.Tag, opts.Subject, opts.Headers, opts.Data)
+	if err != nil {
+		registerActionFailed(vers, ErrInjectingMessage)
+		return fmt.Errorf("%w: %w", ErrInjectingMessage, err)
+	}
+
+	err = h.userActivityInteractor.RegisterInjectMessageAction(user.Email, opts.ProductID, vers, opts.Subject, opts.Comment)
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", opts.ProductID,
+			"versionTag", vers.Tag,
+			"comment", opts.Comment,
+		)
+	}
+
+	return nil
+}
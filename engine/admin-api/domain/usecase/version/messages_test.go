@@ -0,0 +1,182 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *versionSuite) TestPeekProcessMessages_OK() {
+	// GIVEN a valid user and a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	workflow := vers.Workflows[0]
+	process := workflow.Processes[0]
+	expectedMessages := []*entity.StreamMessage{
+		{Sequence: 1, Subject: "test-subject", Data: []byte(`{"key":"value"}`)},
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActDebugVersionMessages).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		GetProcessMessages(ctx, _productID, _versionTag, workflow.Name, process.Name, 10).
+		Return(expectedMessages, nil)
+
+	// WHEN peeking the process messages
+	messages, err := s.handler.PeekProcessMessages(ctx, user, version.PeekProcessMessagesOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: workflow.Name,
+		ProcessName:  process.Name,
+		Count:        10,
+	})
+	s.Require().NoError(err)
+
+	// THEN the messages are returned
+	s.Equal(expectedMessages, messages)
+}
+
+func (s *versionSuite) TestPeekProcessMessages_ErrorUserNotAuthorized() {
+	// GIVEN a user without grants to debug version messages
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	authErr := errors.New("unauthorized")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActDebugVersionMessages).Return(authErr)
+
+	// WHEN peeking the process messages
+	_, err := s.handler.PeekProcessMessages(ctx, user, version.PeekProcessMessagesOpts{
+		ProductID:  _productID,
+		VersionTag: _versionTag,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, authErr)
+}
+
+func (s *versionSuite) TestPeekProcessMessages_ErrorProcessNotFound() {
+	// GIVEN a valid user and a started version without the process
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActDebugVersionMessages).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+
+	// WHEN peeking the messages of an unknown process
+	_, err := s.handler.PeekProcessMessages(ctx, user, version.PeekProcessMessagesOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: vers.Workflows[0].Name,
+		ProcessName:  "unknown",
+		Count:        10,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrProcessNotFound)
+}
+
+func (s *versionSuite) TestInjectMessage_OK() {
+	// GIVEN a valid user and a published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusPublished).
+		Build()
+	opts := version.InjectMessageOpts{
+		ProductID:  _productID,
+		VersionTag: _versionTag,
+		Subject:    "test-stream.test-process",
+		Headers:    map[string]string{"test-header": "test-value"},
+		Data:       []byte("test-data"),
+		Comment:    "testing",
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActDebugVersionMessages).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		PublishMessage(ctx, _productID, _versionTag, opts.Subject, opts.Headers, opts.Data).
+		Return(nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterInjectMessageAction(user.Email, _productID, vers, opts.Subject, opts.Comment).
+		Return(nil)
+
+	// WHEN injecting the message
+	err := s.handler.InjectMessage(ctx, user, opts)
+
+	// THEN the message is published
+	s.Require().NoError(err)
+}
+
+func (s *versionSuite) TestInjectMessage_ErrorVersionNotStarted() {
+	// GIVEN a valid user and a stopped version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStopped).
+		Build()
+	subject := "test-stream.test-process"
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActDebugVersionMessages).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterInjectMessageAction(user.Email, _productID, vers, subject, version.ErrVersionIsNotStarted.Error()).
+		Return(nil)
+
+	// WHEN injecting a message
+	err := s.handler.InjectMessage(ctx, user, version.InjectMessageOpts{
+		ProductID:  _productID,
+		VersionTag: _versionTag,
+		Subject:    subject,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrVersionIsNotStarted)
+}
+
+func (s *versionSuite) TestInjectMessage_ErrorPublishing() {
+	// GIVEN a valid user and a started version but nats-manager rejects the subject
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	subject := "other-stream.test-process"
+	natsErr := errors.New("subject does not belong to the version streams")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActDebugVersionMessages).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().
+		PublishMessage(ctx, _productID, _versionTag, subject, nil, nil).
+		Return(natsErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterInjectMessageAction(user.Email, _productID, vers, subject, version.ErrInjectingMessage.Error()).
+		Return(nil)
+
+	// WHEN injecting a message
+	err := s.handler.InjectMessage(ctx, user, version.InjectMessageOpts{
+		ProductID:  _productID,
+		VersionTag: _versionTag,
+		Subject:    subject,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrInjectingMessage)
+	s.ErrorIs(err, natsErr)
+}
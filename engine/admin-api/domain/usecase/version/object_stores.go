@@ -0,0 +1,44 @@
+package version
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+// GetObjectStores returns the names of the object stores used by the processes of the version, sorted and
+// without duplicates. Their objects can be browsed through the product object operations.
+func (h *Handler) GetObjectStores(
+	ctx context.Context,
+	user *entity.User,
+	productID, versionTag string,
+) ([]string, error) {
+	if err := h.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
+		return nil, err
+	}
+
+	version, err := h.versionRepo.GetByTag(ctx, productID, versionTag)
+	if err != nil {
+		return nil, err
+	}
+
+	streamingResources, err := h.natsManagerService.GetVersionResources(ctx, productID, version)
+	if err != nil {
+		return nil, fmt.Errorf("getting version resources: %w", err)
+	}
+
+	objectStores := make([]string, 0)
+
+	for _, workflow := range streamingResources.ObjectStores.Workflows {
+		for _, objectStore := range workflow.Processes {
+			objectStores = append(objectStores, objectStore)
+		}
+	}
+
+	slices.Sort(objectStores)
+
+	return slices.Compact(objectStores), nil
+}
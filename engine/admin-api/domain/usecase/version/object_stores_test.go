@@ -0,0 +1,82 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *versionSuite) TestGetObjectStores_OK() {
+	// GIVEN a valid user and a version whose processes use workflow and product object stores
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithWorkflows([]entity.Workflow{
+			testhelpers.NewWorkflowBuilder().
+				WithProcesses([]entity.Process{
+					testhelpers.NewProcessBuilder().
+						WithName("process-1").
+						WithObjectStore(&entity.ProcessObjectStore{Name: "models", Scope: entity.ObjectStoreScopeProduct}).
+						Build(),
+					testhelpers.NewProcessBuilder().
+						WithName("process-2").
+						WithObjectStore(&entity.ProcessObjectStore{Name: "models", Scope: entity.ObjectStoreScopeProduct}).
+						Build(),
+					testhelpers.NewProcessBuilder().
+						WithName("process-3").
+						WithObjectStore(&entity.ProcessObjectStore{Name: "cache", Scope: entity.ObjectStoreScopeWorkflow}).
+						Build(),
+					testhelpers.NewProcessBuilder().
+						WithName("process-4").
+						Build(),
+				}).
+				Build(),
+		}).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().GetVersionResources(ctx, _productID, vers).Return(&entity.VersionStreamingResources{
+		ObjectStores: &entity.VersionObjectStores{
+			Workflows: map[string]entity.WorkflowObjectStoresConfig{
+				"test-workflow-name": {
+					Processes: entity.ProcessObjectStoresConfig{
+						"process-1": "object-store_productID_models",
+						"process-2": "object-store_productID_models",
+						"process-3": "productID_v1_0_0_test-workflow-name_cache",
+					},
+				},
+			},
+		},
+	}, nil)
+
+	// WHEN getting the version object stores
+	objectStores, err := s.handler.GetObjectStores(ctx, user, _productID, _versionTag)
+	s.Require().NoError(err)
+
+	// THEN every object store is returned once
+	s.Equal([]string{
+		"object-store_productID_models",
+		"productID_v1_0_0_test-workflow-name_cache",
+	}, objectStores)
+}
+
+func (s *versionSuite) TestGetObjectStores_ErrorUserNotAuthorized() {
+	// GIVEN an unauthorized user
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	authErr := errors.New("unauthorized")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(authErr)
+
+	// WHEN getting the version object stores
+	_, err := s.handler.GetObjectStores(ctx, user, _productID, _versionTag)
+
+	// THEN an error is returned
+	s.ErrorIs(err, authErr)
+}
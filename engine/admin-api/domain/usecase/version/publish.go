@@ -50,12 +50,15 @@ func (h *Handler) Publish(ctx context.Context, user *entity.User, opts PublishOp
 
 	compensations := compensator.New()
 
+	var previouslyPublishedVersion string
+
 	if product.HasVersionPublished() {
 		if !opts.Force {
 			return nil, ErrProductAlreadyPublished
 		}
 
 		publishedVersion := *product.PublishedVersion
+		previouslyPublishedVersion = publishedVersion
 
 		err := h.versionRepo.SetStatus(ctx, product.ID, publishedVersion, entity.VersionStatusStarted)
 		if err != nil {
@@ -73,6 +76,11 @@ func (h *Handler) Publish(ctx context.Context, user *entity.User, opts PublishOp
 		return nil, err
 	}
 
+	// The published version and the versions shadowing it, including the new published one, stop sharing traffic.
+	if previouslyPublishedVersion != "" {
+		h.stopShadowsOf(ctx, user.Email, product.ID, previouslyPublishedVersion)
+	}
+
 	return urls, nil
 }
 
@@ -269,6 +269,7 @@ func (s *versionSuite) TestPublish_AnotherVersionPublished_Forced() {
 
 	s.productRepo.EXPECT().Update(ctx, product).Return(nil)
 	s.userActivityInteractor.EXPECT().RegisterPublishAction(user.Email, product.ID, vers, "publishing").Return(nil)
+	s.versionRepo.EXPECT().SearchByProduct(ctx, product.ID, nil).Return([]*entity.Version{oldVersion, vers}, nil)
 
 	// WHEN publish the version with the param Force set to true
 	actualURLs, err := s.handler.Publish(ctx, user, version.PublishOpts{
@@ -284,6 +285,50 @@ func (s *versionSuite) TestPublish_AnotherVersionPublished_Forced() {
 	s.Equal(entity.VersionStatusPublished, vers.Status)
 }
 
+func (s *versionSuite) TestPublish_ShadowingVersion_Forced() {
+	// GIVEN a started version shadowing the published version
+	var (
+		ctx                 = context.Background()
+		user                = testhelpers.NewUserBuilder().Build()
+		published, shadower = s.getShadowVersions()
+		product             = testhelpers.NewProductBuilder().
+					WithPublishedVersion(&published.Tag).
+					Build()
+		expectedURLs = map[string]string{
+			"test-trigger": "test-url",
+		}
+	)
+
+	shadower.Shadow = &entity.VersionShadow{PublishedVersion: published.Tag, Author: user.Email}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, product.ID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, product.ID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, product.ID, shadower.Tag).Return(shadower, nil)
+	s.versionRepo.EXPECT().SetStatus(ctx, product.ID, published.Tag, entity.VersionStatusStarted).Return(nil)
+	s.versionService.EXPECT().Publish(ctx, product.ID, shadower.Tag).Return(expectedURLs, nil)
+	s.versionRepo.EXPECT().Update(product.ID, shadower).Return(nil).Times(2)
+	s.productRepo.EXPECT().Update(ctx, product).Return(nil)
+	s.userActivityInteractor.EXPECT().RegisterPublishAction(user.Email, product.ID, shadower, "publishing").Return(nil)
+	s.versionRepo.EXPECT().SearchByProduct(ctx, product.ID, nil).Return([]*entity.Version{published, shadower}, nil)
+	s.natsManagerService.EXPECT().StopShadow(ctx, product.ID, published.Tag, shadower.Tag).Return(nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterStopShadowAction(user.Email, product.ID, shadower, published.Tag, gomock.Any()).
+		Return(nil)
+
+	// WHEN publishing the shadowing version
+	_, err := s.handler.Publish(ctx, user, version.PublishOpts{
+		ProductID:  product.ID,
+		VersionTag: shadower.Tag,
+		Comment:    "publishing",
+		Force:      true,
+	})
+	s.Require().NoError(err)
+
+	// THEN the new published version no longer shadows the previous one
+	s.Equal(entity.VersionStatusPublished, shadower.Status)
+	s.Nil(shadower.Shadow)
+}
+
 func (s *versionSuite) TestPublish_AnotherVersionPublished_Forced_RegisterActionError() {
 	// GIVEN a valid user and a non started version
 	var (
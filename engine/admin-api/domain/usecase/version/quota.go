@@ -0,0 +1,77 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// checkProductQuota rejects starting or scaling the version if the resources it requests, added to the
+// ones requested by the running versions of the product, go over the product quota.
+func (h *Handler) checkProductQuota(ctx context.Context, product *entity.Product, version *entity.Version) error {
+	if product.Quota == nil {
+		return nil
+	}
+
+	requested, err := version.GetResourceUsage()
+	if err != nil {
+		return fmt.Errorf("computing resources requested by version %q: %w", version.Tag, err)
+	}
+
+	return h.checkRequestedResources(ctx, product, version, requested)
+}
+
+// checkRollingUpdateQuota rejects a rolling update of the process if the extra replica created before
+// an old one is removed does not fit in the product quota.
+func (h *Handler) checkRollingUpdateQuota(
+	ctx context.Context,
+	product *entity.Product,
+	version *entity.Version,
+	process *entity.Process,
+) error {
+	if product.Quota == nil {
+		return nil
+	}
+
+	requested, err := version.GetResourceUsage()
+	if err != nil {
+		return fmt.Errorf("computing resources requested by version %q: %w", version.Tag, err)
+	}
+
+	surge, err := process.GetReplicaResourceUsage()
+	if err != nil {
+		return fmt.Errorf("computing resources requested by process %q: %w", process.Name, err)
+	}
+
+	return h.checkRequestedResources(ctx, product, version, requested.Add(surge))
+}
+
+func (h *Handler) checkRequestedResources(
+	ctx context.Context,
+	product *entity.Product,
+	version *entity.Version,
+	requested entity.ResourceUsage,
+) error {
+	versions, err := h.versionRepo.SearchByProduct(ctx, product.ID, nil)
+	if err != nil {
+		return fmt.Errorf("getting product versions: %w", err)
+	}
+
+	var inUse entity.ResourceUsage
+
+	for _, v := range versions {
+		if v.Tag == version.Tag || !v.IsRunning() {
+			continue
+		}
+
+		usage, err := v.GetResourceUsage()
+		if err != nil {
+			return fmt.Errorf("computing resources requested by version %q: %w", v.Tag, err)
+		}
+
+		inUse = inUse.Add(usage)
+	}
+
+	return product.Quota.Check(inUse, requested)
+}
@@ -0,0 +1,45 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+// RenderManifests returns the k8s manifests that starting the version would create, without applying them.
+func (h *Handler) RenderManifests(
+	ctx context.Context,
+	user *entity.User,
+	productID, versionTag string,
+) ([]*entity.KubernetesManifest, error) {
+	if err := h.accessControl.CheckProductGrants(user, productID, auth.ActManageVersion); err != nil {
+		return nil, err
+	}
+
+	product, err := h.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := h.versionRepo.GetByTag(ctx, productID, versionTag)
+	if err != nil {
+		return nil, err
+	}
+
+	// nats-manager returns the names of the NATS resources starting the version creates, without creating them.
+	streamingResources, err := h.natsManagerService.GetVersionResources(ctx, productID, version)
+	if err != nil {
+		return nil, fmt.Errorf("getting version resources: %w", err)
+	}
+
+	streamingResources.KeyValueStores.GlobalKeyValueStore = product.KeyValueStore
+
+	manifests, err := h.k8sService.RenderManifests(ctx, product, version, streamingResources)
+	if err != nil {
+		return nil, fmt.Errorf("rendering manifests: %w", err)
+	}
+
+	return manifests, nil
+}
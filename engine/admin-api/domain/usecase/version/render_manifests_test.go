@@ -0,0 +1,149 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *versionSuite) TestRenderManifests_OK() {
+	// GIVEN a valid user and a version with an object store
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithWorkflows([]entity.Workflow{
+			testhelpers.NewWorkflowBuilder().
+				WithProcesses([]entity.Process{
+					testhelpers.NewProcessBuilder().
+						WithObjectStore(&entity.ProcessObjectStore{
+							Name:  "test-object-store",
+							Scope: entity.ObjectStoreScopeWorkflow,
+						}).
+						Build(),
+				}).
+				Build(),
+		}).
+		Build()
+
+	expectedManifests := []*entity.KubernetesManifest{
+		{Kind: "ConfigMap", Name: "test-config", YAML: "kind: ConfigMap"},
+	}
+	expectedResources := &entity.VersionStreamingResources{
+		Streams: &entity.VersionStreams{
+			Workflows: map[string]entity.WorkflowStreamResources{
+				"test-workflow-name": {
+					Stream: "productID_v1_0_0_test-workflow-name",
+					Processes: map[string]entity.ProcessStreamConfig{
+						"test-process-name": {
+							Subject:       "productID_v1_0_0_test-workflow-name.test-process-name",
+							Subscriptions: []string{"productID_v1_0_0_test-workflow-name.other-process"},
+						},
+					},
+				},
+			},
+		},
+		ObjectStores: &entity.VersionObjectStores{
+			Workflows: map[string]entity.WorkflowObjectStoresConfig{
+				"test-workflow-name": {
+					Processes: entity.ProcessObjectStoresConfig{
+						"test-process-name": "productID_v1_0_0_test-workflow-name_test-object-store",
+					},
+				},
+			},
+		},
+		KeyValueStores: &entity.KeyValueStores{
+			GlobalKeyValueStore:  _globalKeyValueStore,
+			VersionKeyValueStore: "key-store_productID_v1_0_0",
+			Workflows: map[string]*entity.WorkflowKeyValueStores{
+				"test-workflow-name": {
+					KeyValueStore: "key-store_productID_v1_0_0_test-workflow-name",
+					Processes: map[string]string{
+						"test-process-name": "key-store_productID_v1_0_0_test-workflow-name_test-process-name",
+					},
+				},
+			},
+		},
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(prod, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().GetVersionResources(ctx, _productID, vers).Return(&entity.VersionStreamingResources{
+		Streams:      expectedResources.Streams,
+		ObjectStores: expectedResources.ObjectStores,
+		KeyValueStores: &entity.KeyValueStores{
+			VersionKeyValueStore: expectedResources.KeyValueStores.VersionKeyValueStore,
+			Workflows:            expectedResources.KeyValueStores.Workflows,
+		},
+	}, nil)
+	s.versionService.EXPECT().RenderManifests(ctx, prod, vers, expectedResources).Return(expectedManifests, nil)
+
+	// WHEN rendering the version manifests
+	manifests, err := s.handler.RenderManifests(ctx, user, _productID, _versionTag)
+	s.Require().NoError(err)
+
+	// THEN the rendered manifests are returned without creating any NATS resource
+	s.Equal(expectedManifests, manifests)
+}
+
+func (s *versionSuite) TestRenderManifests_ErrorGettingVersionResources() {
+	// GIVEN a valid user and version but nats-manager fails to get the version resources
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().Build()
+	natsErr := errors.New("nats error")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(prod, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().GetVersionResources(ctx, _productID, vers).Return(nil, natsErr)
+
+	// WHEN rendering the version manifests
+	_, err := s.handler.RenderManifests(ctx, user, _productID, _versionTag)
+
+	// THEN an error is returned
+	s.ErrorIs(err, natsErr)
+}
+
+func (s *versionSuite) TestRenderManifests_ErrorUserNotAuthorized() {
+	// GIVEN an unauthorized user
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	authErr := errors.New("unauthorized")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(authErr)
+
+	// WHEN rendering the version manifests
+	_, err := s.handler.RenderManifests(ctx, user, _productID, _versionTag)
+
+	// THEN an error is returned
+	s.ErrorIs(err, authErr)
+}
+
+func (s *versionSuite) TestRenderManifests_ErrorRendering() {
+	// GIVEN a valid user and version but k8s-manager fails to render
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().Build()
+	renderErr := errors.New("render error")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(prod, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.natsManagerService.EXPECT().GetVersionResources(ctx, _productID, vers).Return(&entity.VersionStreamingResources{
+		KeyValueStores: &entity.KeyValueStores{},
+	}, nil)
+	s.versionService.EXPECT().RenderManifests(ctx, prod, vers, gomock.Any()).Return(nil, renderErr)
+
+	// WHEN rendering the version manifests
+	_, err := s.handler.RenderManifests(ctx, user, _productID, _versionTag)
+
+	// THEN an error is returned
+	s.ErrorIs(err, renderErr)
+}
@@ -0,0 +1,94 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+type RunWorkflowOpts struct {
+	ProductID    string
+	VersionTag   string
+	WorkflowName string
+	Comment      string
+}
+
+// RunWorkflow triggers a new run of a batch workflow in a running version and returns the created job names.
+func (h *Handler) RunWorkflow(
+	ctx context.Context,
+	user *entity.User,
+	opts RunWorkflowOpts,
+) ([]string, error) {
+	if err := h.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageVersion); err != nil {
+		v := &entity.Version{Tag: opts.VersionTag}
+		h.registerRunWorkflowActionFailed(user.Email, opts, v, ErrUserNotAuthorized)
+
+		return nil, err
+	}
+
+	h.logger.Info("Running workflow",
+		"userEmail", user.Email,
+		"productID", opts.ProductID,
+		"versionTag", opts.VersionTag,
+		"workflow", opts.WorkflowName,
+	)
+
+	vers, err := h.versionRepo.GetByTag(ctx, opts.ProductID, opts.VersionTag)
+	if err != nil {
+		v := &entity.Version{Tag: opts.VersionTag}
+		h.registerRunWorkflowActionFailed(user.Email, opts, v, ErrVersionNotFound)
+
+		return nil, err
+	}
+
+	if !vers.CanBePatched() {
+		h.registerRunWorkflowActionFailed(user.Email, opts, vers, ErrVersionIsNotStarted)
+		return nil, ErrVersionIsNotStarted
+	}
+
+	workflow, found := vers.GetWorkflow(opts.WorkflowName)
+	if !found {
+		h.registerRunWorkflowActionFailed(user.Email, opts, vers, ErrWorkflowNotFound)
+		return nil, ErrWorkflowNotFound
+	}
+
+	if !workflow.IsBatch() {
+		h.registerRunWorkflowActionFailed(user.Email, opts, vers, ErrWorkflowIsNotBatch)
+		return nil, ErrWorkflowIsNotBatch
+	}
+
+	jobs, err := h.k8sService.RunWorkflow(ctx, opts.ProductID, vers.Tag, opts.WorkflowName)
+	if err != nil {
+		h.registerRunWorkflowActionFailed(user.Email, opts, vers, ErrRunningWorkflow)
+		return nil, fmt.Errorf("%w: %w", ErrRunningWorkflow, err)
+	}
+
+	err = h.userActivityInteractor.RegisterRunWorkflowAction(user.Email, opts.ProductID, vers, opts.WorkflowName, opts.Comment)
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", opts.ProductID,
+			"versionTag", vers.Tag,
+			"comment", opts.Comment,
+		)
+	}
+
+	return jobs, nil
+}
+
+func (h *Handler) registerRunWorkflowActionFailed(
+	userEmail string,
+	opts RunWorkflowOpts,
+	vers *entity.Version,
+	incomingErr error,
+) {
+	err := h.userActivityInteractor.RegisterRunWorkflowAction(userEmail, opts.ProductID, vers, opts.WorkflowName, incomingErr.Error())
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", opts.ProductID,
+			"versionTag", vers.Tag,
+			"error", incomingErr.Error(),
+		)
+	}
+}
@@ -0,0 +1,182 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *versionSuite) TestRunWorkflow_OK() {
+	// GIVEN a valid user and a started version with a batch workflow
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		WithWorkflows([]entity.Workflow{
+			testhelpers.NewWorkflowBuilder().WithJob(&entity.WorkflowJob{}).Build(),
+		}).
+		Build()
+	expectedJobs := []string{"test-job"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.versionService.EXPECT().RunWorkflow(ctx, _productID, _versionTag, _patchedWorkflow).Return(expectedJobs, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterRunWorkflowAction(user.Email, _productID, vers, _patchedWorkflow, "testing").
+		Return(nil)
+
+	// WHEN running the workflow
+	jobs, err := s.handler.RunWorkflow(ctx, user, version.RunWorkflowOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		Comment:      "testing",
+	})
+	s.Require().NoError(err)
+
+	// THEN the created jobs are returned
+	s.Equal(expectedJobs, jobs)
+}
+
+func (s *versionSuite) TestRunWorkflow_ErrorUserNotAuthorized() {
+	// GIVEN an unauthorized user
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	authErr := errors.New("unauthorized")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(authErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterRunWorkflowAction(user.Email, _productID, &entity.Version{Tag: _versionTag}, _patchedWorkflow,
+			version.ErrUserNotAuthorized.Error()).
+		Return(nil)
+
+	// WHEN running the workflow
+	_, err := s.handler.RunWorkflow(ctx, user, version.RunWorkflowOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, authErr)
+}
+
+func (s *versionSuite) TestRunWorkflow_ErrorVersionNotStarted() {
+	// GIVEN a valid user and a stopped version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStopped).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterRunWorkflowAction(user.Email, _productID, vers, _patchedWorkflow, version.ErrVersionIsNotStarted.Error()).
+		Return(nil)
+
+	// WHEN running the workflow
+	_, err := s.handler.RunWorkflow(ctx, user, version.RunWorkflowOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrVersionIsNotStarted)
+}
+
+func (s *versionSuite) TestRunWorkflow_ErrorWorkflowIsNotBatch() {
+	// GIVEN a valid user and a started version with a training workflow without job settings
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		WithWorkflows([]entity.Workflow{
+			testhelpers.NewWorkflowBuilder().WithType(entity.WorkflowTypeTraining).Build(),
+		}).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterRunWorkflowAction(user.Email, _productID, vers, _patchedWorkflow, version.ErrWorkflowIsNotBatch.Error()).
+		Return(nil)
+
+	// WHEN running the workflow
+	_, err := s.handler.RunWorkflow(ctx, user, version.RunWorkflowOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrWorkflowIsNotBatch)
+}
+
+func (s *versionSuite) TestRunWorkflow_ErrorWorkflowNotFound() {
+	// GIVEN a valid user and a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterRunWorkflowAction(user.Email, _productID, vers, "unknown", version.ErrWorkflowNotFound.Error()).
+		Return(nil)
+
+	// WHEN running an unknown workflow
+	_, err := s.handler.RunWorkflow(ctx, user, version.RunWorkflowOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: "unknown",
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrWorkflowNotFound)
+}
+
+func (s *versionSuite) TestRunWorkflow_ErrorRunningInK8s() {
+	// GIVEN a valid user and a started version with a batch workflow
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		WithWorkflows([]entity.Workflow{
+			testhelpers.NewWorkflowBuilder().WithJob(&entity.WorkflowJob{}).Build(),
+		}).
+		Build()
+	k8sErr := errors.New("k8s error")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.versionService.EXPECT().RunWorkflow(ctx, _productID, _versionTag, _patchedWorkflow).Return(nil, k8sErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterRunWorkflowAction(user.Email, _productID, vers, _patchedWorkflow, version.ErrRunningWorkflow.Error()).
+		Return(nil)
+
+	// WHEN running the workflow
+	_, err := s.handler.RunWorkflow(ctx, user, version.RunWorkflowOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+	})
+
+	// THEN the k8s error is returned
+	s.ErrorIs(err, version.ErrRunningWorkflow)
+	s.ErrorIs(err, k8sErr)
+}
@@ -0,0 +1,140 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+type ScaleProcessOpts struct {
+	ProductID    string
+	VersionTag   string
+	WorkflowName string
+	ProcessName  string
+	Replicas     *int32
+	Autoscaling  *entity.ProcessAutoscaling
+	Comment      string
+}
+
+// ScaleProcess changes the replicas or the autoscaling bounds of a process in a running version without restarting it.
+func (h *Handler) ScaleProcess(
+	ctx context.Context,
+	user *entity.User,
+	opts ScaleProcessOpts,
+) (*entity.Version, error) {
+	scaling := &entity.ProcessScaling{
+		Workflow:    opts.WorkflowName,
+		Process:     opts.ProcessName,
+		Replicas:    opts.Replicas,
+		Autoscaling: opts.Autoscaling,
+	}
+
+	if err := h.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageVersion); err != nil {
+		v := &entity.Version{Tag: opts.VersionTag}
+		h.registerScaleProcessActionFailed(user.Email, opts.ProductID, v, scaling, ErrUserNotAuthorized)
+
+		return nil, err
+	}
+
+	if err := scaling.Validate(); err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("Scaling process",
+		"userEmail", user.Email,
+		"productID", opts.ProductID,
+		"versionTag", opts.VersionTag,
+		"workflow", opts.WorkflowName,
+		"process", opts.ProcessName,
+	)
+
+	vers, err := h.versionRepo.GetByTag(ctx, opts.ProductID, opts.VersionTag)
+	if err != nil {
+		v := &entity.Version{Tag: opts.VersionTag}
+		h.registerScaleProcessActionFailed(user.Email, opts.ProductID, v, scaling, ErrVersionNotFound)
+
+		return nil, err
+	}
+
+	if !vers.CanBePatched() {
+		h.registerScaleProcessActionFailed(user.Email, opts.ProductID, vers, scaling, ErrVersionCannotBePatched)
+		return nil, ErrVersionCannotBePatched
+	}
+
+	process, found := vers.GetProcess(opts.WorkflowName, opts.ProcessName)
+	if !found {
+		h.registerScaleProcessActionFailed(user.Email, opts.ProductID, vers, scaling, ErrProcessNotFound)
+		return nil, ErrProcessNotFound
+	}
+
+	product, err := h.productRepo.GetByID(ctx, opts.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousProcess := *process
+
+	if opts.Replicas != nil {
+		process.Replicas = *opts.Replicas
+	}
+
+	if opts.Autoscaling != nil {
+		process.Autoscaling = opts.Autoscaling
+	}
+
+	if err := h.checkProductQuota(ctx, product, vers); err != nil {
+		*process = previousProcess
+		h.registerScaleProcessActionFailed(user.Email, opts.ProductID, vers, scaling, err)
+
+		return nil, err
+	}
+
+	if err := h.checkAdmissionPolicies(ctx, opts.ProductID, vers); err != nil {
+		*process = previousProcess
+		h.registerScaleProcessActionFailed(user.Email, opts.ProductID, vers, scaling, err)
+
+		return nil, err
+	}
+
+	err = h.k8sService.ScaleProcess(ctx, opts.ProductID, vers.Tag, scaling)
+	if err != nil {
+		*process = previousProcess
+		h.registerScaleProcessActionFailed(user.Email, opts.ProductID, vers, scaling, ErrScalingProcess)
+
+		return nil, fmt.Errorf("%w: %w", ErrScalingProcess, err)
+	}
+
+	err = h.versionRepo.Update(opts.ProductID, vers)
+	if err != nil {
+		return nil, fmt.Errorf("saving process scaling: %w", err)
+	}
+
+	err = h.userActivityInteractor.RegisterScaleProcessAction(user.Email, opts.ProductID, vers, scaling, opts.Comment)
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", opts.ProductID,
+			"versionTag", vers.Tag,
+			"comment", opts.Comment,
+		)
+	}
+
+	return vers, nil
+}
+
+func (h *Handler) registerScaleProcessActionFailed(
+	userEmail, productID string,
+	vers *entity.Version,
+	scaling *entity.ProcessScaling,
+	incomingErr error,
+) {
+	err := h.userActivityInteractor.RegisterScaleProcessAction(userEmail, productID, vers, scaling, incomingErr.Error())
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", productID,
+			"versionTag", vers.Tag,
+			"error", incomingErr.Error(),
+		)
+	}
+}
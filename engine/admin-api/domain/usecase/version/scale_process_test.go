@@ -0,0 +1,216 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *versionSuite) TestScaleProcess_OK() {
+	// GIVEN a valid user and a published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusPublished).
+		Build()
+
+	replicas := int32(3)
+	autoscaling := &entity.ProcessAutoscaling{MinReplicas: 2, MaxReplicas: 6}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(testhelpers.NewProductBuilder().Build(), nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
+	s.versionService.EXPECT().ScaleProcess(ctx, _productID, _versionTag, &entity.ProcessScaling{
+		Workflow:    _patchedWorkflow,
+		Process:     _patchedProcess,
+		Replicas:    &replicas,
+		Autoscaling: autoscaling,
+	}).Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, vers).Return(nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterScaleProcessAction(user.Email, _productID, vers, gomock.Any(), "testing").
+		Return(nil)
+
+	// WHEN scaling the process
+	scaledVersion, err := s.handler.ScaleProcess(ctx, user, version.ScaleProcessOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		ProcessName:  _patchedProcess,
+		Replicas:     &replicas,
+		Autoscaling:  autoscaling,
+		Comment:      "testing",
+	})
+	s.Require().NoError(err)
+
+	// THEN the process scaling settings are updated
+	s.Equal(replicas, scaledVersion.Workflows[0].Processes[0].Replicas)
+	s.Equal(autoscaling, scaledVersion.Workflows[0].Processes[0].Autoscaling)
+}
+
+func (s *versionSuite) TestScaleProcess_ErrorNothingToScale() {
+	// GIVEN a valid user and no scaling settings
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+
+	// WHEN scaling the process
+	_, err := s.handler.ScaleProcess(ctx, user, version.ScaleProcessOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		ProcessName:  _patchedProcess,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, entity.ErrNothingToScale)
+}
+
+func (s *versionSuite) TestScaleProcess_ErrorInvalidVersionStatus() {
+	// GIVEN a valid user and a created version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusCreated).
+		Build()
+	replicas := int32(3)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterScaleProcessAction(user.Email, _productID, vers, gomock.Any(), version.ErrVersionCannotBePatched.Error()).
+		Return(nil)
+
+	// WHEN scaling the process
+	_, err := s.handler.ScaleProcess(ctx, user, version.ScaleProcessOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		ProcessName:  _patchedProcess,
+		Replicas:     &replicas,
+	})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrVersionCannotBePatched)
+}
+
+func (s *versionSuite) TestScaleProcess_ErrorScalingInK8s() {
+	// GIVEN a valid user and a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	replicas := int32(3)
+	k8sErr := errors.New("k8s error")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(testhelpers.NewProductBuilder().Build(), nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
+	s.versionService.EXPECT().ScaleProcess(ctx, _productID, _versionTag, gomock.Any()).Return(k8sErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterScaleProcessAction(user.Email, _productID, vers, gomock.Any(), version.ErrScalingProcess.Error()).
+		Return(nil)
+
+	// WHEN scaling the process
+	_, err := s.handler.ScaleProcess(ctx, user, version.ScaleProcessOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		ProcessName:  _patchedProcess,
+		Replicas:     &replicas,
+	})
+
+	// THEN an error is returned and the version is not modified
+	s.ErrorIs(err, k8sErr)
+	s.Equal(int32(1), vers.Workflows[0].Processes[0].Replicas)
+}
+
+func (s *versionSuite) TestScaleProcess_ErrorProductQuotaExceeded() {
+	// GIVEN a product with a replicas quota and a started version using part of it
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().
+		WithID(_productID).
+		WithQuota(&entity.ProductQuota{MaxReplicas: 2}).
+		Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	replicas := int32(3)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(product, nil)
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return([]*entity.Version{vers}, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterScaleProcessAction(user.Email, _productID, vers, gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	// WHEN scaling the process over the quota
+	_, err := s.handler.ScaleProcess(ctx, user, version.ScaleProcessOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		ProcessName:  _patchedProcess,
+		Replicas:     &replicas,
+	})
+
+	// THEN the scaling is rejected and the version is not modified
+	s.ErrorIs(err, entity.ErrProductQuotaExceeded)
+	s.Equal(int32(1), vers.Workflows[0].Processes[0].Replicas)
+}
+
+func (s *versionSuite) TestScaleProcess_ErrorAdmissionPolicyViolated() {
+	// GIVEN an enforced policy capping the replicas of the processes
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	replicas := int32(3)
+	policies := []*entity.AdmissionPolicy{
+		{
+			Name:   "max-replicas",
+			Type:   entity.AdmissionPolicyTypeMaxReplicas,
+			Mode:   entity.AdmissionPolicyModeEnforce,
+			Params: entity.AdmissionPolicyParams{MaxReplicas: 2},
+		},
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(testhelpers.NewProductBuilder().Build(), nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(policies, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterScaleProcessAction(user.Email, _productID, vers, gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	// WHEN scaling the process over the policy limit
+	_, err := s.handler.ScaleProcess(ctx, user, version.ScaleProcessOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		ProcessName:  _patchedProcess,
+		Replicas:     &replicas,
+	})
+
+	// THEN the scaling is rejected and the version is not modified
+	s.ErrorIs(err, entity.ErrVersionNotAdmitted)
+	s.Equal(int32(1), vers.Workflows[0].Processes[0].Replicas)
+}
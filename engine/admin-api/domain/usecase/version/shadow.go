@@ -0,0 +1,232 @@
+package version
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+var (
+	ErrProductHasNoPublishedVersion = errors.New("error product has no published version to shadow")
+	ErrVersionCannotBeShadowed      = errors.New("error version cannot shadow the published version, status must be 'started'")
+	ErrVersionAlreadyShadowing      = errors.New("error version is already shadowing the published version")
+	ErrVersionIsNotShadowing        = errors.New("error version is not shadowing the published version")
+	ErrNoShadowTriggers             = errors.New("error version has no triggers in common with the published version")
+	ErrStartingShadow               = errors.New("error starting shadow traffic")
+	ErrStoppingShadow               = errors.New("error stopping shadow traffic")
+)
+
+type ShadowOpts struct {
+	ProductID  string
+	VersionTag string
+	Comment    string
+}
+
+// StartShadow mirrors the messages entering the triggers of the published version into the given started
+// version, so it can be validated on real traffic. Responses are still given by the published version only,
+// the outputs of the shadow version are kept in its own streams.
+func (h *Handler) StartShadow(ctx context.Context, user *entity.User, opts ShadowOpts) (*entity.Version, error) {
+	registerActionFailed := func(vers *entity.Version, publishedVersion string, incomingErr error) {
+		err := h.userActivityInteractor.RegisterStartShadowAction(
+			user.Email, opts.ProductID, vers, publishedVersion, incomingErr.Error(),
+		)
+		if err != nil {
+			h.logger.Error(err, "Error registering user activity",
+				"productID", opts.ProductID,
+				"versionTag", vers.Tag,
+				"error", incomingErr.Error(),
+			)
+		}
+	}
+
+	if err := h.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageVersion); err != nil {
+		registerActionFailed(&entity.Version{Tag: opts.VersionTag}, "", ErrUserNotAuthorized)
+		return nil, err
+	}
+
+	product, err := h.productRepo.GetByID(ctx, opts.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !product.HasVersionPublished() {
+		registerActionFailed(&entity.Version{Tag: opts.VersionTag}, "", ErrProductHasNoPublishedVersion)
+		return nil, ErrProductHasNoPublishedVersion
+	}
+
+	publishedTag := *product.PublishedVersion
+
+	vers, err := h.versionRepo.GetByTag(ctx, opts.ProductID, opts.VersionTag)
+	if err != nil {
+		registerActionFailed(&entity.Version{Tag: opts.VersionTag}, publishedTag, ErrVersionNotFound)
+		return nil, err
+	}
+
+	if !vers.CanBeShadowed() {
+		registerActionFailed(vers, publishedTag, ErrVersionCannotBeShadowed)
+		return nil, ErrVersionCannotBeShadowed
+	}
+
+	if vers.Shadow != nil {
+		registerActionFailed(vers, publishedTag, ErrVersionAlreadyShadowing)
+		return nil, ErrVersionAlreadyShadowing
+	}
+
+	publishedVersion, err := h.versionRepo.GetByTag(ctx, opts.ProductID, publishedTag)
+	if err != nil {
+		return nil, fmt.Errorf("getting published version: %w", err)
+	}
+
+	shadowWorkflows := vers.GetShadowWorkflows(publishedVersion)
+	if len(shadowWorkflows) == 0 {
+		registerActionFailed(vers, publishedTag, ErrNoShadowTriggers)
+		return nil, ErrNoShadowTriggers
+	}
+
+	h.logger.Info("Starting shadow traffic",
+		"userEmail", user.Email,
+		"productID", opts.ProductID,
+		"versionTag", vers.Tag,
+		"publishedVersionTag", publishedTag,
+	)
+
+	err = h.natsManagerService.StartShadow(ctx, opts.ProductID, publishedTag, vers.Tag, shadowWorkflows)
+	if err != nil {
+		registerActionFailed(vers, publishedTag, ErrStartingShadow)
+		return nil, fmt.Errorf("%w: %w", ErrStartingShadow, err)
+	}
+
+	vers.Shadow = &entity.VersionShadow{
+		PublishedVersion: publishedTag,
+		Author:           user.Email,
+		Date:             time.Now(),
+	}
+
+	err = h.versionRepo.Update(opts.ProductID, vers)
+	if err != nil {
+		return nil, fmt.Errorf("saving version shadow: %w", err)
+	}
+
+	err = h.userActivityInteractor.RegisterStartShadowAction(user.Email, opts.ProductID, vers, publishedTag, opts.Comment)
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", opts.ProductID,
+			"versionTag", vers.Tag,
+			"comment", opts.Comment,
+		)
+	}
+
+	return vers, nil
+}
+
+// StopShadow stops mirroring the published version traffic into the given version.
+func (h *Handler) StopShadow(ctx context.Context, user *entity.User, opts ShadowOpts) (*entity.Version, error) {
+	registerActionFailed := func(vers *entity.Version, publishedVersion string, incomingErr error) {
+		err := h.userActivityInteractor.RegisterStopShadowAction(
+			user.Email, opts.ProductID, vers, publishedVersion, incomingErr.Error(),
+		)
+		if err != nil {
+			h.logger.Error(err, "Error registering user activity",
+				"productID", opts.ProductID,
+				"versionTag", vers.Tag,
+				"error", incomingErr.Error(),
+			)
+		}
+	}
+
+	if err := h.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageVersion); err != nil {
+		registerActionFailed(&entity.Version{Tag: opts.VersionTag}, "", ErrUserNotAuthorized)
+		return nil, err
+	}
+
+	vers, err := h.versionRepo.GetByTag(ctx, opts.ProductID, opts.VersionTag)
+	if err != nil {
+		registerActionFailed(&entity.Version{Tag: opts.VersionTag}, "", ErrVersionNotFound)
+		return nil, err
+	}
+
+	if vers.Shadow == nil {
+		registerActionFailed(vers, "", ErrVersionIsNotShadowing)
+		return nil, ErrVersionIsNotShadowing
+	}
+
+	publishedTag := vers.Shadow.PublishedVersion
+
+	err = h.natsManagerService.StopShadow(ctx, opts.ProductID, publishedTag, vers.Tag)
+	if err != nil {
+		registerActionFailed(vers, publishedTag, ErrStoppingShadow)
+		return nil, fmt.Errorf("%w: %w", ErrStoppingShadow, err)
+	}
+
+	vers.Shadow = nil
+
+	err = h.versionRepo.Update(opts.ProductID, vers)
+	if err != nil {
+		return nil, fmt.Errorf("removing version shadow: %w", err)
+	}
+
+	err = h.userActivityInteractor.RegisterStopShadowAction(user.Email, opts.ProductID, vers, publishedTag, opts.Comment)
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", opts.ProductID,
+			"versionTag", vers.Tag,
+			"comment", opts.Comment,
+		)
+	}
+
+	return vers, nil
+}
+
+// stopShadowsOf stops mirroring the traffic of a version that is no longer published into the versions
+// shadowing it. Errors are only logged, so the versions can still stop their shadow on demand.
+func (h *Handler) stopShadowsOf(ctx context.Context, userEmail, productID, publishedTag string) {
+	versions, err := h.versionRepo.SearchByProduct(ctx, productID, nil)
+	if err != nil {
+		h.logger.Error(err, "Error getting versions shadowing the published version",
+			"productID", productID,
+			"publishedVersionTag", publishedTag,
+		)
+
+		return
+	}
+
+	comment := fmt.Sprintf("version %q is no longer published", publishedTag)
+
+	for _, vers := range versions {
+		if vers.Shadow == nil || vers.Shadow.PublishedVersion != publishedTag {
+			continue
+		}
+
+		h.logger.Info("Stopping shadow traffic",
+			"productID", productID,
+			"versionTag", vers.Tag,
+			"publishedVersionTag", publishedTag,
+		)
+
+		err := h.natsManagerService.StopShadow(ctx, productID, publishedTag, vers.Tag)
+		if err != nil {
+			h.logger.Error(err, "Error stopping shadow traffic", "productID", productID, "versionTag", vers.Tag)
+			continue
+		}
+
+		vers.Shadow = nil
+
+		if err := h.versionRepo.Update(productID, vers); err != nil {
+			h.logger.Error(err, "Error removing version shadow", "productID", productID, "versionTag", vers.Tag)
+			continue
+		}
+
+		err = h.userActivityInteractor.RegisterStopShadowAction(userEmail, productID, vers, publishedTag, comment)
+		if err != nil {
+			h.logger.Error(err, "Error registering user activity",
+				"productID", productID,
+				"versionTag", vers.Tag,
+				"comment", comment,
+			)
+		}
+	}
+}
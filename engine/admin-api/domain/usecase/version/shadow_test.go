@@ -0,0 +1,207 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+const _publishedVersionTag = "v0.9.0"
+
+func (s *versionSuite) getShadowVersions() (published, candidate *entity.Version) {
+	workflow := testhelpers.NewWorkflowBuilder().WithProcesses([]entity.Process{
+		testhelpers.NewProcessBuilder().WithName("entrypoint").WithType(entity.ProcessTypeTrigger).Build(),
+		testhelpers.NewProcessBuilder().WithName("task").Build(),
+	}).Build()
+
+	published = testhelpers.NewVersionBuilder().
+		WithTag(_publishedVersionTag).
+		WithStatus(entity.VersionStatusPublished).
+		WithWorkflows([]entity.Workflow{workflow}).
+		Build()
+	candidate = testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		WithWorkflows([]entity.Workflow{workflow}).
+		Build()
+
+	return published, candidate
+}
+
+func (s *versionSuite) TestStartShadow() {
+	// GIVEN a product with a published version and a started candidate version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	publishedTag := _publishedVersionTag
+	product := testhelpers.NewProductBuilder().WithID(_productID).WithPublishedVersion(&publishedTag).Build()
+	published, candidate := s.getShadowVersions()
+	opts := version.ShadowOpts{ProductID: _productID, VersionTag: _versionTag, Comment: "testing"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(candidate, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _publishedVersionTag).Return(published, nil)
+	s.natsManagerService.EXPECT().
+		StartShadow(ctx, _productID, _publishedVersionTag, _versionTag, []entity.ShadowWorkflow{
+			{Name: candidate.Workflows[0].Name, Triggers: []string{"entrypoint"}},
+		}).
+		Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, candidate).Return(nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterStartShadowAction(user.Email, _productID, candidate, _publishedVersionTag, opts.Comment).
+		Return(nil)
+
+	// WHEN starting the shadow traffic
+	vers, err := s.handler.StartShadow(ctx, user, opts)
+	s.Require().NoError(err)
+
+	// THEN the version records the published version it shadows
+	s.Require().NotNil(vers.Shadow)
+	s.Equal(_publishedVersionTag, vers.Shadow.PublishedVersion)
+	s.Equal(user.Email, vers.Shadow.Author)
+}
+
+func (s *versionSuite) TestStartShadow_ProductWithoutPublishedVersion() {
+	// GIVEN a product without a published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().WithID(_productID).WithPublishedVersion(nil).Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(product, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterStartShadowAction(user.Email, _productID, gomock.Any(), "", version.ErrProductHasNoPublishedVersion.Error()).
+		Return(nil)
+
+	// WHEN starting the shadow traffic
+	_, err := s.handler.StartShadow(ctx, user, version.ShadowOpts{ProductID: _productID, VersionTag: _versionTag})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrProductHasNoPublishedVersion)
+}
+
+func (s *versionSuite) TestStartShadow_VersionNotStarted() {
+	// GIVEN a candidate version that is not started
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	publishedTag := _publishedVersionTag
+	product := testhelpers.NewProductBuilder().WithID(_productID).WithPublishedVersion(&publishedTag).Build()
+	_, candidate := s.getShadowVersions()
+	candidate.Status = entity.VersionStatusStopped
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(candidate, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterStartShadowAction(
+			user.Email, _productID, candidate, _publishedVersionTag, version.ErrVersionCannotBeShadowed.Error(),
+		).
+		Return(nil)
+
+	// WHEN starting the shadow traffic
+	_, err := s.handler.StartShadow(ctx, user, version.ShadowOpts{ProductID: _productID, VersionTag: _versionTag})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrVersionCannotBeShadowed)
+}
+
+func (s *versionSuite) TestStartShadow_NoTriggersInCommon() {
+	// GIVEN a candidate version whose triggers don't exist in the published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	publishedTag := _publishedVersionTag
+	product := testhelpers.NewProductBuilder().WithID(_productID).WithPublishedVersion(&publishedTag).Build()
+	published, candidate := s.getShadowVersions()
+	candidate.Workflows = []entity.Workflow{testhelpers.NewWorkflowBuilder().Build()}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(candidate, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _publishedVersionTag).Return(published, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterStartShadowAction(user.Email, _productID, candidate, _publishedVersionTag, version.ErrNoShadowTriggers.Error()).
+		Return(nil)
+
+	// WHEN starting the shadow traffic
+	_, err := s.handler.StartShadow(ctx, user, version.ShadowOpts{ProductID: _productID, VersionTag: _versionTag})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrNoShadowTriggers)
+}
+
+func (s *versionSuite) TestStartShadow_ErrorInNatsManager() {
+	// GIVEN nats-manager fails mirroring the published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	publishedTag := _publishedVersionTag
+	product := testhelpers.NewProductBuilder().WithID(_productID).WithPublishedVersion(&publishedTag).Build()
+	published, candidate := s.getShadowVersions()
+	natsErr := errors.New("subject transforms not supported")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(candidate, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _publishedVersionTag).Return(published, nil)
+	s.natsManagerService.EXPECT().StartShadow(ctx, _productID, _publishedVersionTag, _versionTag, gomock.Any()).Return(natsErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterStartShadowAction(user.Email, _productID, candidate, _publishedVersionTag, version.ErrStartingShadow.Error()).
+		Return(nil)
+
+	// WHEN starting the shadow traffic
+	_, err := s.handler.StartShadow(ctx, user, version.ShadowOpts{ProductID: _productID, VersionTag: _versionTag})
+
+	// THEN the error is returned and the version isn't updated
+	s.ErrorIs(err, version.ErrStartingShadow)
+	s.ErrorIs(err, natsErr)
+	s.Nil(candidate.Shadow)
+}
+
+func (s *versionSuite) TestStopShadow() {
+	// GIVEN a version shadowing the published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	_, candidate := s.getShadowVersions()
+	candidate.Shadow = &entity.VersionShadow{PublishedVersion: _publishedVersionTag, Author: user.Email}
+	opts := version.ShadowOpts{ProductID: _productID, VersionTag: _versionTag, Comment: "testing"}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(candidate, nil)
+	s.natsManagerService.EXPECT().StopShadow(ctx, _productID, _publishedVersionTag, _versionTag).Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, candidate).Return(nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterStopShadowAction(user.Email, _productID, candidate, _publishedVersionTag, opts.Comment).
+		Return(nil)
+
+	// WHEN stopping the shadow traffic
+	vers, err := s.handler.StopShadow(ctx, user, opts)
+	s.Require().NoError(err)
+
+	// THEN the version no longer shadows the published version
+	s.Nil(vers.Shadow)
+}
+
+func (s *versionSuite) TestStopShadow_VersionNotShadowing() {
+	// GIVEN a version that is not shadowing the published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	_, candidate := s.getShadowVersions()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(candidate, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterStopShadowAction(user.Email, _productID, candidate, "", version.ErrVersionIsNotShadowing.Error()).
+		Return(nil)
+
+	// WHEN stopping the shadow traffic
+	_, err := s.handler.StopShadow(ctx, user, version.ShadowOpts{ProductID: _productID, VersionTag: _versionTag})
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrVersionIsNotShadowing)
+}
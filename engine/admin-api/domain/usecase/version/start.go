@@ -45,6 +45,14 @@ func (h *Handler) Start(
 		}
 	}
 
+	if err := h.checkProductQuota(ctx, product, version); err != nil {
+		return nil, nil, err
+	}
+
+	if err := h.checkAdmissionPolicies(ctx, productID, version); err != nil {
+		return nil, nil, err
+	}
+
 	version.Status = entity.VersionStatusStarting
 
 	err = h.versionRepo.SetStatus(ctx, productID, version.Tag, entity.VersionStatusStarting)
@@ -107,11 +115,20 @@ func (h *Handler) createVersionResources(
 
 	compensations.AddCompensation(h.deleteKeyValueStoresFunc(product.ID, version))
 
+	natsCredentials, err := h.natsManagerService.CreateVersionCredentials(ctx, product.ID, version)
+	if err != nil {
+		return fmt.Errorf("error creating NATS credentials for version %q: %w", version.Tag, err)
+	}
+
+	compensations.AddCompensation(h.revokeCredentialsFunc(product.ID, version))
+
 	versionCfg, err := entity.NewVersionConfig(versionStreamCfg, objectStoreCfg, kvStoreCfg)
 	if err != nil {
 		return err
 	}
 
+	versionCfg.NatsCredentials = natsCredentials
+
 	err = h.updateKeyValueConfigurations(ctx, version, versionCfg)
 	if err != nil {
 		return fmt.Errorf("initializing centralized configuration: %w", err)
@@ -226,6 +243,12 @@ func (h *Handler) deleteKeyValueStoresFunc(productID string, version *entity.Ver
 	}
 }
 
+func (h *Handler) revokeCredentialsFunc(productID string, version *entity.Version) func() error {
+	return func() error {
+		return h.natsManagerService.RevokeVersionCredentials(context.Background(), productID, version.Tag)
+	}
+}
+
 func (h *Handler) stopVersionFunc(productID string, version *entity.Version) func() error {
 	return func() error {
 		return h.k8sService.Stop(context.Background(), productID, version)
@@ -16,6 +16,7 @@ import (
 
 const (
 	_globalKeyValueStore = "test-global-kv-store"
+	_natsCredentials     = "test-nats-credentials"
 	_waitGroupTimeout    = 500 * time.Millisecond
 )
 
@@ -50,7 +51,9 @@ func (s *versionSuite) TestStart_OK() {
 	s.natsManagerService.EXPECT().CreateStreams(gomock.Any(), _productID, vers).Return(versionStreamResources.Streams, nil)
 	s.natsManagerService.EXPECT().CreateObjectStores(gomock.Any(), _productID, vers).Return(versionStreamResources.ObjectStores, nil)
 	s.natsManagerService.EXPECT().CreateVersionKeyValueStores(gomock.Any(), _productID, vers).Return(keyValueStoreResources, nil)
+	s.natsManagerService.EXPECT().CreateVersionCredentials(gomock.Any(), _productID, vers).Return(_natsCredentials, nil)
 	s.natsManagerService.EXPECT().UpdateKeyValueConfiguration(gomock.Any(), configurationsToUpdate).Return(nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
 	s.versionRepo.EXPECT().SetStatus(ctx, _productID, vers.Tag, entity.VersionStatusStarting).Return(nil)
 
 	// goroutine calls
@@ -71,6 +74,112 @@ func (s *versionSuite) TestStart_OK() {
 	s.Equal(entity.VersionStatusStarted, startedVersion.Status)
 }
 
+func (s *versionSuite) TestStart_ErrorProductQuotaExceeded() {
+	// GIVEN a product with a quota and a running version using most of it
+	var (
+		ctx     = context.Background()
+		user    = testhelpers.NewUserBuilder().Build()
+		product = testhelpers.NewProductBuilder().
+			WithID(_productID).
+			WithQuota(&entity.ProductQuota{
+				MaxStartedVersions: 2,
+				CPURequests:        "1",
+				MaxReplicas:        3,
+			}).
+			Build()
+		processWithLimits = entity.Process{
+			Name:     "test-process",
+			Replicas: 2,
+			ResourceLimits: &entity.ProcessResourceLimits{
+				CPU: &entity.ResourceLimit{Request: "300m", Limit: "500m"},
+			},
+		}
+		workflows = []entity.Workflow{{Name: "test-workflow", Processes: []entity.Process{processWithLimits}}}
+
+		runningVersion = testhelpers.NewVersionBuilder().
+				WithTag("v0.9.0").
+				WithStatus(entity.VersionStatusPublished).
+				WithWorkflows(workflows).
+				Build()
+		stoppedVersion = testhelpers.NewVersionBuilder().
+				WithTag("v0.8.0").
+				WithStatus(entity.VersionStatusStopped).
+				WithWorkflows(workflows).
+				Build()
+		vers = testhelpers.NewVersionBuilder().
+			WithTag(_versionTag).
+			WithStatus(entity.VersionStatusCreated).
+			WithWorkflows(workflows).
+			Build()
+	)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(product, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).
+		Return([]*entity.Version{runningVersion, stoppedVersion, vers}, nil)
+
+	// WHEN starting the version
+	_, _, err := s.handler.Start(ctx, user, _productID, _versionTag, "testing")
+
+	// THEN the start is rejected with the exceeded resources
+	s.Require().ErrorIs(err, entity.ErrProductQuotaExceeded)
+
+	var quotaErr entity.ProductQuotaExceededError
+
+	s.Require().ErrorAs(err, &quotaErr)
+	s.Equal([]entity.QuotaExcess{
+		{Resource: "cpu requests", InUse: "600m", Requested: "600m", Limit: "1000m"},
+		{Resource: "replicas", InUse: "2", Requested: "2", Limit: "3"},
+	}, quotaErr.Exceeded)
+}
+
+func (s *versionSuite) TestStart_ErrorAdmissionPolicyViolated() {
+	// GIVEN an enforced policy and a dry-run policy that the version violates
+	var (
+		ctx  = context.Background()
+		user = testhelpers.NewUserBuilder().Build()
+		vers = testhelpers.NewVersionBuilder().
+			WithTag(_versionTag).
+			WithStatus(entity.VersionStatusCreated).
+			Build()
+		policies = []*entity.AdmissionPolicy{
+			{
+				Name:   "trusted-registries",
+				Type:   entity.AdmissionPolicyTypeAllowedRegistries,
+				Mode:   entity.AdmissionPolicyModeEnforce,
+				Params: entity.AdmissionPolicyParams{Registries: []string{"registry.kai.local"}},
+			},
+			{
+				Name:   "staging-registries",
+				Type:   entity.AdmissionPolicyTypeAllowedRegistries,
+				Mode:   entity.AdmissionPolicyModeDryRun,
+				Params: entity.AdmissionPolicyParams{Registries: []string{"registry.staging.local"}},
+			},
+		}
+	)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(prod, nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(policies, nil)
+
+	// WHEN starting the version
+	_, _, err := s.handler.Start(ctx, user, _productID, _versionTag, "testing")
+
+	// THEN the start is rejected only with the violations of the enforced policy
+	s.Require().ErrorIs(err, entity.ErrVersionNotAdmitted)
+
+	var admissionErr entity.AdmissionDeniedError
+
+	s.Require().ErrorAs(err, &admissionErr)
+	s.Require().NotEmpty(admissionErr.Violations)
+
+	for _, violation := range admissionErr.Violations {
+		s.Equal("trusted-registries", violation.Policy)
+	}
+}
+
 func (s *versionSuite) TestStart_ErrorUserNotAuthorized() {
 	// GIVEN an unauthorized user and a version
 	ctx := context.Background()
@@ -165,6 +274,7 @@ func (s *versionSuite) TestStart_ErrorCreatingStreams() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.productRepo.EXPECT().GetByID(ctx, _productID).Times(1).Return(prod, nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
 	s.versionRepo.EXPECT().SetStatus(ctx, _productID, _versionTag, entity.VersionStatusStarting).Return(nil)
 
 	s.natsManagerService.EXPECT().CreateStreams(gomock.Any(), _productID, vers).Return(nil, expectedError)
@@ -197,6 +307,7 @@ func (s *versionSuite) TestStart_ErrorCreatingObjectStore() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.productRepo.EXPECT().GetByID(ctx, _productID).Times(1).Return(prod, nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
 	s.versionRepo.EXPECT().SetStatus(ctx, _productID, _versionTag, entity.VersionStatusStarting).Return(nil)
 
 	s.natsManagerService.EXPECT().CreateStreams(gomock.Any(), _productID, vers).Return(nil, nil)
@@ -231,6 +342,7 @@ func (s *versionSuite) TestStart_ErrorCreatingKeyValueStores() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
 	s.productRepo.EXPECT().GetByID(ctx, _productID).Times(1).Return(prod, nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
 	s.versionRepo.EXPECT().SetStatus(ctx, _productID, _versionTag, entity.VersionStatusStarting).Return(nil)
 
 	s.natsManagerService.EXPECT().CreateStreams(gomock.Any(), _productID, vers).Return(nil, nil)
@@ -250,6 +362,50 @@ func (s *versionSuite) TestStart_ErrorCreatingKeyValueStores() {
 	s.Contains(failedVersion.Error, expectedError.Error())
 }
 
+func (s *versionSuite) TestStart_ErrorCreatingCredentials() {
+	// GIVEN a valid user and version
+	var (
+		ctx  = context.Background()
+		user = testhelpers.NewUserBuilder().Build()
+		vers = testhelpers.NewVersionBuilder().
+			WithTag(_versionTag).
+			WithStatus(entity.VersionStatusCreated).
+			Build()
+
+		expectedError   = errors.New("error creating credentials")
+		errStrMatcher   = newStringContainsMatcher(expectedError.Error())
+		streamResources = s.getVersionStreamingResources(vers)
+	)
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Times(1).Return(prod, nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
+	s.versionRepo.EXPECT().SetStatus(ctx, _productID, vers.Tag, entity.VersionStatusStarting).Return(nil)
+
+	s.natsManagerService.EXPECT().CreateStreams(gomock.Any(), _productID, vers).Return(streamResources.Streams, nil)
+	s.natsManagerService.EXPECT().CreateObjectStores(gomock.Any(), _productID, vers).Return(streamResources.ObjectStores, nil)
+	s.natsManagerService.EXPECT().CreateVersionKeyValueStores(gomock.Any(), _productID, vers).Return(streamResources.KeyValueStores, nil)
+	s.natsManagerService.EXPECT().CreateVersionCredentials(gomock.Any(), _productID, vers).Return("", expectedError)
+
+	s.natsManagerService.EXPECT().DeleteObjectStores(gomock.Any(), _productID, vers.Tag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteStreams(gomock.Any(), _productID, vers.Tag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteVersionKeyValueStores(gomock.Any(), _productID, vers).Return(nil)
+
+	s.versionRepo.EXPECT().SetErrorStatusWithError(gomock.Any(), _productID, vers.Tag, errStrMatcher).Return(nil)
+
+	// WHEN starting the version
+	_, notifyCh, err := s.handler.Start(ctx, user, _productID, _versionTag, "testing")
+	s.Require().NoError(err)
+
+	// THEN the version is not started on k8s and the created resources are deleted
+	failedVersion, ok := <-notifyCh
+	s.Require().True(ok)
+
+	s.Equal(entity.VersionStatusError, failedVersion.Status)
+	s.Contains(failedVersion.Error, expectedError.Error())
+}
+
 func (s *versionSuite) TestStart_ErrorVersionServiceStart() {
 	// GIVEN a valid user and version
 	var (
@@ -268,11 +424,13 @@ func (s *versionSuite) TestStart_ErrorVersionServiceStart() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
 	s.productRepo.EXPECT().GetByID(ctx, _productID).Times(1).Return(prod, nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
 	s.versionRepo.EXPECT().SetStatus(ctx, _productID, vers.Tag, entity.VersionStatusStarting).Return(nil)
 
 	s.natsManagerService.EXPECT().CreateStreams(gomock.Any(), _productID, vers).Return(streamResources.Streams, nil)
 	s.natsManagerService.EXPECT().CreateObjectStores(gomock.Any(), _productID, vers).Return(streamResources.ObjectStores, nil)
 	s.natsManagerService.EXPECT().CreateVersionKeyValueStores(gomock.Any(), _productID, vers).Return(streamResources.KeyValueStores, nil)
+	s.natsManagerService.EXPECT().CreateVersionCredentials(gomock.Any(), _productID, vers).Return(_natsCredentials, nil)
 
 	s.versionService.EXPECT().Start(gomock.Any(), prod, vers, streamResources).
 		Return(expectedError)
@@ -280,6 +438,7 @@ func (s *versionSuite) TestStart_ErrorVersionServiceStart() {
 	s.natsManagerService.EXPECT().DeleteObjectStores(gomock.Any(), _productID, vers.Tag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteStreams(gomock.Any(), _productID, vers.Tag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteVersionKeyValueStores(gomock.Any(), _productID, vers).Return(nil)
+	s.natsManagerService.EXPECT().RevokeVersionCredentials(gomock.Any(), _productID, vers.Tag).Return(nil)
 
 	s.versionRepo.EXPECT().SetErrorStatusWithError(gomock.Any(), _productID, vers.Tag, errStrMatcher).Return(nil)
 
@@ -318,11 +477,13 @@ func (s *versionSuite) TestStart_ErrorRegisteringUserActivity() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
 	s.productRepo.EXPECT().GetByID(ctx, _productID).Times(1).Return(prod, nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
 	s.versionRepo.EXPECT().SetStatus(ctx, _productID, vers.Tag, entity.VersionStatusStarting).Return(nil)
 
 	s.natsManagerService.EXPECT().CreateStreams(gomock.Any(), _productID, vers).Return(streamResources.Streams, nil)
 	s.natsManagerService.EXPECT().CreateObjectStores(gomock.Any(), _productID, vers).Return(streamResources.ObjectStores, nil)
 	s.natsManagerService.EXPECT().CreateVersionKeyValueStores(gomock.Any(), _productID, vers).Return(streamResources.KeyValueStores, nil)
+	s.natsManagerService.EXPECT().CreateVersionCredentials(gomock.Any(), _productID, vers).Return(_natsCredentials, nil)
 	s.versionRepo.EXPECT().SetStatus(gomock.Any(), _productID, vers.Tag, entity.VersionStatusStarted).Return(nil)
 	s.versionService.EXPECT().Start(gomock.Any(), prod, vers, streamResources).
 		Return(nil)
@@ -332,6 +493,7 @@ func (s *versionSuite) TestStart_ErrorRegisteringUserActivity() {
 	s.natsManagerService.EXPECT().DeleteObjectStores(gomock.Any(), _productID, vers.Tag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteStreams(gomock.Any(), _productID, vers.Tag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteVersionKeyValueStores(gomock.Any(), _productID, vers).Return(nil)
+	s.natsManagerService.EXPECT().RevokeVersionCredentials(gomock.Any(), _productID, vers.Tag).Return(nil)
 	s.versionService.EXPECT().Stop(gomock.Any(), _productID, vers).Return(nil)
 	s.versionRepo.EXPECT().SetErrorStatusWithError(gomock.Any(), _productID, vers.Tag, errStrMatcher).Return(nil)
 
@@ -371,7 +533,8 @@ func (s *versionSuite) getVersionStreamingResources(vers *entity.Version) *entit
 	}
 
 	return &entity.VersionStreamingResources{
-		KeyValueStores: versionKeyValueStores,
+		KeyValueStores:  versionKeyValueStores,
+		NatsCredentials: _natsCredentials,
 		Streams: &entity.VersionStreams{
 			Workflows: map[string]entity.WorkflowStreamResources{
 				workflow.Name: {
@@ -10,7 +10,7 @@ import (
 	"github.com/spf13/viper"
 )
 
-// Stop removes the resources of the given Version.
+// Stop removes the resources of the given Version. The drain of a draining version is cancelled.
 func (h *Handler) Stop(
 	ctx context.Context,
 	user *entity.User,
@@ -40,12 +40,47 @@ func (h *Handler) Stop(
 		return nil, nil, ErrVersionCannotBeStopped
 	}
 
-	err = h.deleteNatsResources(ctx, productID, vers)
-	if err != nil {
-		h.registerStopActionFailed(user.Email, productID, vers, ErrDeletingNATSResources)
+	if vers.Status == entity.VersionStatusDraining && !h.drains.cancel(getDrainKey(productID, vers.Tag)) {
+		h.registerStopActionFailed(user.Email, productID, vers, ErrVersionAlreadyStopping)
+		return nil, nil, ErrVersionAlreadyStopping
+	}
+
+	if err := h.deleteResourcesAndSetStopping(ctx, user.Email, productID, vers); err != nil {
 		return nil, nil, err
 	}
 
+	notifyStatusCh := make(chan *entity.Version, 1)
+
+	go h.stopAndNotify(user.Email, productID, comment, vers, notifyStatusCh)
+
+	return vers, notifyStatusCh, nil
+}
+
+// deleteResourcesAndSetStopping deletes the NATS resources of the version, so its processes stop consuming
+// messages, and marks the version as stopping.
+func (h *Handler) deleteResourcesAndSetStopping(
+	ctx context.Context,
+	userEmail, productID string,
+	vers *entity.Version,
+) error {
+	// Versions shadowing this one would mirror streams that are about to be deleted.
+	h.stopShadowsOf(ctx, userEmail, productID, vers.Tag)
+
+	err := h.deleteNatsResources(ctx, productID, vers)
+	if err != nil {
+		h.registerStopActionFailed(userEmail, productID, vers, ErrDeletingNATSResources)
+		return err
+	}
+
+	if vers.Shadow != nil {
+		// The shadow traffic ends along with the version streams.
+		vers.Shadow = nil
+
+		if err := h.versionRepo.Update(productID, vers); err != nil {
+			h.logger.Error(err, "Error removing version shadow", "productID", productID, "versionTag", vers.Tag)
+		}
+	}
+
 	vers.Status = entity.VersionStatusStopping
 
 	err = h.versionRepo.SetStatus(ctx, productID, vers.Tag, entity.VersionStatusStopping)
@@ -58,11 +93,7 @@ func (h *Handler) Stop(
 		)
 	}
 
-	notifyStatusCh := make(chan *entity.Version, 1)
-
-	go h.stopAndNotify(user.Email, productID, comment, vers, notifyStatusCh)
-
-	return vers, notifyStatusCh, nil
+	return nil
 }
 
 func (h *Handler) deleteNatsResources(ctx context.Context, productID string, vers *entity.Version) error {
@@ -81,6 +112,12 @@ func (h *Handler) deleteNatsResources(ctx context.Context, productID string, ver
 		return fmt.Errorf("error deleting key value stores for version %q: %w", vers.Tag, err)
 	}
 
+	// The version resources are gone, so failing to revoke its credentials must not prevent stopping it.
+	err = h.natsManagerService.RevokeVersionCredentials(ctx, productID, vers.Tag)
+	if err != nil {
+		h.logger.Error(err, "Error revoking version NATS credentials", "productID", productID, "versionTag", vers.Tag)
+	}
+
 	return nil
 }
 
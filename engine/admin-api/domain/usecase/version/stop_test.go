@@ -26,6 +26,8 @@ func (s *versionSuite) TestStop_OK() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
 
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return(nil, nil)
+	s.natsManagerService.EXPECT().RevokeVersionCredentials(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteStreams(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteObjectStores(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteVersionKeyValueStores(ctx, _productID, vers).Return(nil)
@@ -49,6 +51,40 @@ func (s *versionSuite) TestStop_OK() {
 	s.Equal(entity.VersionStatusStopped, versionStatus.Status)
 }
 
+func (s *versionSuite) TestStop_RemovesShadow() {
+	// GIVEN a version shadowing the published version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	vers.Shadow = &entity.VersionShadow{PublishedVersion: "v0.9.0", Author: user.Email}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return(nil, nil)
+	s.natsManagerService.EXPECT().RevokeVersionCredentials(ctx, _productID, _versionTag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteStreams(ctx, _productID, _versionTag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteObjectStores(ctx, _productID, _versionTag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteVersionKeyValueStores(ctx, _productID, vers).Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, vers).Return(nil)
+	s.versionRepo.EXPECT().SetStatus(ctx, _productID, vers.Tag, entity.VersionStatusStopping).Return(nil)
+
+	s.versionService.EXPECT().Stop(gomock.Any(), _productID, vers).Return(nil)
+	s.versionRepo.EXPECT().SetStatus(gomock.Any(), _productID, vers.Tag, entity.VersionStatusStopped).Return(nil)
+	s.userActivityInteractor.EXPECT().RegisterStopAction(user.Email, _productID, vers, "testing").Return(nil)
+
+	// WHEN stopping the version
+	stoppingVer, notifyChn, err := s.handler.Stop(ctx, user, _productID, _versionTag, "testing")
+	s.Require().NoError(err)
+	<-notifyChn
+
+	// THEN the version no longer shadows the published version
+	s.Nil(stoppingVer.Shadow)
+}
+
 func (s *versionSuite) TestStop_ErrorUserNotAuthorized() {
 	// GIVEN an unauthorized user and a version
 	ctx := context.Background()
@@ -109,6 +145,39 @@ func (s *versionSuite) TestStop_ErrorInvalidVersionStatus() {
 	s.ErrorIs(err, version.ErrVersionCannotBeStopped)
 }
 
+func (s *versionSuite) TestStop_ErrorRevokingCredentials() {
+	// GIVEN a valid user and a version whose credentials can't be revoked
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return(nil, nil)
+	s.natsManagerService.EXPECT().DeleteStreams(ctx, _productID, _versionTag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteObjectStores(ctx, _productID, _versionTag).Return(nil)
+	s.natsManagerService.EXPECT().DeleteVersionKeyValueStores(ctx, _productID, vers).Return(nil)
+	s.natsManagerService.EXPECT().RevokeVersionCredentials(ctx, _productID, _versionTag).Return(fmt.Errorf("error revoking"))
+	s.versionRepo.EXPECT().SetStatus(ctx, _productID, vers.Tag, entity.VersionStatusStopping).Return(nil)
+
+	s.versionService.EXPECT().Stop(gomock.Any(), _productID, vers).Return(nil)
+	s.versionRepo.EXPECT().SetStatus(gomock.Any(), _productID, vers.Tag, entity.VersionStatusStopped).Return(nil)
+	s.userActivityInteractor.EXPECT().RegisterStopAction(user.Email, _productID, vers, "testing").Return(nil)
+
+	// WHEN stopping the version
+	_, notifyChn, err := s.handler.Stop(ctx, user, _productID, _versionTag, "testing")
+
+	// THEN the version is stopped anyway
+	s.Require().NoError(err)
+
+	versionStatus := <-notifyChn
+	s.Equal(entity.VersionStatusStopped, versionStatus.Status)
+}
+
 func (s *versionSuite) TestDeleteNatsResources_ErrorDeletingStreams() {
 	// GIVEN a valid user and a version
 	ctx := context.Background()
@@ -122,6 +191,7 @@ func (s *versionSuite) TestDeleteNatsResources_ErrorDeletingStreams() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
 
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return(nil, nil)
 	s.natsManagerService.EXPECT().DeleteStreams(ctx, _productID, _versionTag).Return(fmt.Errorf("error deleting streams"))
 	s.userActivityInteractor.EXPECT().RegisterStopAction(user.Email, _productID, versionMatcher, version.ErrDeletingNATSResources.Error()).Return(nil)
 
@@ -145,6 +215,7 @@ func (s *versionSuite) TestDeleteNatsResources_ErrorDeletingObjectStores() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
 
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return(nil, nil)
 	s.natsManagerService.EXPECT().DeleteStreams(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteObjectStores(ctx, _productID, _versionTag).Return(fmt.Errorf("error deleting object stores"))
 	s.userActivityInteractor.EXPECT().RegisterStopAction(user.Email, _productID, versionMatcher, version.ErrDeletingNATSResources.Error()).Return(nil)
@@ -172,6 +243,8 @@ func (s *versionSuite) TestStop_CheckNonBlockingErrorLogging() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
 
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return(nil, nil)
+	s.natsManagerService.EXPECT().RevokeVersionCredentials(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteStreams(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteObjectStores(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteVersionKeyValueStores(ctx, _productID, vers).Return(nil)
@@ -256,6 +329,8 @@ func (s *versionSuite) TestStopAndNotify_ErrorVersionServiceStop() {
 	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
 	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
 
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return(nil, nil)
+	s.natsManagerService.EXPECT().RevokeVersionCredentials(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteStreams(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteObjectStores(ctx, _productID, _versionTag).Return(nil)
 	s.natsManagerService.EXPECT().DeleteVersionKeyValueStores(ctx, _productID, vers).Return(nil)
@@ -75,6 +75,7 @@ type versionSuite struct {
 	natsManagerService     *mocks.MockNatsManagerService
 	userActivityInteractor *mocks.MockUserActivityInteracter
 	accessControl          *mocks.MockAccessControl
+	admissionPolicyRepo    *mocks.MockAdmissionPolicyRepo
 
 	observedLogs *observer.ObservedLogs
 }
@@ -101,6 +102,7 @@ func (s *versionSuite) SetupSuite() {
 	s.natsManagerService = mocks.NewMockNatsManagerService(s.ctrl)
 	s.userActivityInteractor = mocks.NewMockUserActivityInteracter(s.ctrl)
 	s.accessControl = mocks.NewMockAccessControl(s.ctrl)
+	s.admissionPolicyRepo = mocks.NewMockAdmissionPolicyRepo(s.ctrl)
 
 	s.handler = version.NewHandler(&version.HandlerParams{
 		Logger:                 logger,
@@ -110,6 +112,7 @@ func (s *versionSuite) SetupSuite() {
 		NatsManagerService:     s.natsManagerService,
 		UserActivityInteractor: s.userActivityInteractor,
 		AccessControl:          s.accessControl,
+		AdmissionPolicyRepo:    s.admissionPolicyRepo,
 	})
 }
 
@@ -64,6 +64,8 @@ func (h *Handler) Unpublish(
 		)
 	}
 
+	h.stopShadowsOf(ctx, user.Email, productID, vers.Tag)
+
 	err = h.userActivityInteractor.RegisterUnpublishAction(user.Email, productID, vers, comment)
 	if err != nil {
 		h.logger.Error(err, "Error registering user activity",
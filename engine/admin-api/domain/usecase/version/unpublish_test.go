@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/golang/mock/gomock"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
@@ -32,6 +33,7 @@ func (s *versionSuite) TestUnpublish_OK() {
 	s.versionService.EXPECT().Unpublish(ctx, _productID, vers).Return(nil)
 	s.versionRepo.EXPECT().Update(_productID, vers).Return(nil)
 	s.productRepo.EXPECT().Update(ctx, product).Return(nil)
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return([]*entity.Version{vers}, nil)
 	s.userActivityInteractor.EXPECT().RegisterUnpublishAction(user.Email, _productID, vers, "unpublishing").Return(nil)
 
 	// WHEN unpublishing the version
@@ -45,6 +47,41 @@ func (s *versionSuite) TestUnpublish_OK() {
 	s.False(product.HasVersionPublished()) // product is a pointer, so it's updated
 }
 
+func (s *versionSuite) TestUnpublish_StopsShadowTraffic() {
+	// GIVEN a published version shadowed by a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	published, candidate := s.getShadowVersions()
+	candidate.Shadow = &entity.VersionShadow{PublishedVersion: _publishedVersionTag, Author: user.Email}
+	product := testhelpers.NewProductBuilder().
+		WithPublishedVersion(&published.Tag).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _publishedVersionTag).Return(published, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(product, nil)
+
+	s.versionService.EXPECT().Unpublish(ctx, _productID, published).Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, published).Return(nil)
+	s.productRepo.EXPECT().Update(ctx, product).Return(nil)
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return([]*entity.Version{published, candidate}, nil)
+	s.natsManagerService.EXPECT().StopShadow(ctx, _productID, _publishedVersionTag, _versionTag).Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, candidate).Return(nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterStopShadowAction(user.Email, _productID, candidate, _publishedVersionTag, gomock.Any()).
+		Return(nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterUnpublishAction(user.Email, _productID, published, "unpublishing").
+		Return(nil)
+
+	// WHEN unpublishing the version
+	_, err := s.handler.Unpublish(ctx, user, _productID, _publishedVersionTag, "unpublishing")
+	s.Require().NoError(err)
+
+	// THEN the candidate version no longer shadows it
+	s.Nil(candidate.Shadow)
+}
+
 func (s *versionSuite) TestUnpublish_ErrorUserNotAuthorized() {
 	// GIVEN an unauthorized user and a published version
 	ctx := context.Background()
@@ -165,6 +202,7 @@ func (s *versionSuite) TestUnpublish_CheckNonBlockingErrorLogging() {
 	s.versionService.EXPECT().Unpublish(ctx, _productID, vers).Return(nil)
 	s.versionRepo.EXPECT().Update(_productID, vers).Return(setStatusErr)
 	s.productRepo.EXPECT().Update(ctx, product).Return(updateProductErr)
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return([]*entity.Version{vers}, nil)
 	s.userActivityInteractor.EXPECT().RegisterUnpublishAction(user.Email, _productID, vers, "unpublishing").Return(registerActionErr)
 
 	// WHEN unpublishing the version
@@ -0,0 +1,132 @@
+package version
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+type UpdateProcessImageOpts struct {
+	ProductID    string
+	VersionTag   string
+	WorkflowName string
+	ProcessName  string
+	Image        string
+	Comment      string
+}
+
+// UpdateProcessImage rolls a process of a running version to a new image without creating a new version.
+func (h *Handler) UpdateProcessImage(
+	ctx context.Context,
+	user *entity.User,
+	opts UpdateProcessImageOpts,
+) (*entity.Version, error) {
+	patch := &entity.VersionPatch{
+		Workflow: opts.WorkflowName,
+		Process:  opts.ProcessName,
+		Image:    opts.Image,
+		Author:   user.Email,
+	}
+
+	if err := h.accessControl.CheckProductGrants(user, opts.ProductID, auth.ActManageVersion); err != nil {
+		v := &entity.Version{Tag: opts.VersionTag}
+		h.registerUpdateProcessImageActionFailed(user.Email, opts.ProductID, v, patch, ErrUserNotAuthorized)
+
+		return nil, err
+	}
+
+	h.logger.Info("Updating process image",
+		"userEmail", user.Email,
+		"productID", opts.ProductID,
+		"versionTag", opts.VersionTag,
+		"workflow", opts.WorkflowName,
+		"process", opts.ProcessName,
+		"image", opts.Image,
+	)
+
+	vers, err := h.versionRepo.GetByTag(ctx, opts.ProductID, opts.VersionTag)
+	if err != nil {
+		v := &entity.Version{Tag: opts.VersionTag}
+		h.registerUpdateProcessImageActionFailed(user.Email, opts.ProductID, v, patch, ErrVersionNotFound)
+
+		return nil, err
+	}
+
+	if !vers.CanBePatched() {
+		h.registerUpdateProcessImageActionFailed(user.Email, opts.ProductID, vers, patch, ErrVersionCannotBePatched)
+		return nil, ErrVersionCannotBePatched
+	}
+
+	process, found := vers.GetProcess(opts.WorkflowName, opts.ProcessName)
+	if !found {
+		h.registerUpdateProcessImageActionFailed(user.Email, opts.ProductID, vers, patch, ErrProcessNotFound)
+		return nil, ErrProcessNotFound
+	}
+
+	patch.PreviousImage = process.Image
+
+	product, err := h.productRepo.GetByID(ctx, opts.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.checkRollingUpdateQuota(ctx, product, vers, process); err != nil {
+		h.registerUpdateProcessImageActionFailed(user.Email, opts.ProductID, vers, patch, err)
+		return nil, err
+	}
+
+	process.Image = opts.Image
+
+	if err := h.checkAdmissionPolicies(ctx, opts.ProductID, vers); err != nil {
+		process.Image = patch.PreviousImage
+		h.registerUpdateProcessImageActionFailed(user.Email, opts.ProductID, vers, patch, err)
+
+		return nil, err
+	}
+
+	err = h.k8sService.UpdateProcessImage(ctx, opts.ProductID, vers.Tag, patch)
+	if err != nil {
+		process.Image = patch.PreviousImage
+		h.registerUpdateProcessImageActionFailed(user.Email, opts.ProductID, vers, patch, ErrUpdatingProcessImage)
+
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingProcessImage, err)
+	}
+
+	patch.Date = time.Now()
+	vers.Patches = append(vers.Patches, *patch)
+
+	err = h.versionRepo.Update(opts.ProductID, vers)
+	if err != nil {
+		return nil, fmt.Errorf("saving version patch: %w", err)
+	}
+
+	err = h.userActivityInteractor.RegisterUpdateProcessImageAction(user.Email, opts.ProductID, vers, patch, opts.Comment)
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", opts.ProductID,
+			"versionTag", vers.Tag,
+			"comment", opts.Comment,
+		)
+	}
+
+	return vers, nil
+}
+
+func (h *Handler) registerUpdateProcessImageActionFailed(
+	userEmail, productID string,
+	vers *entity.Version,
+	patch *entity.VersionPatch,
+	incomingErr error,
+) {
+	err := h.userActivityInteractor.RegisterUpdateProcessImageAction(userEmail, productID, vers, patch, incomingErr.Error())
+	if err != nil {
+		h.logger.Error(err, "Error registering user activity",
+			"productID", productID,
+			"versionTag", vers.Tag,
+			"error", incomingErr.Error(),
+		)
+	}
+}
@@ -0,0 +1,226 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+const (
+	_patchedWorkflow = "test-workflow-name"
+	_patchedProcess  = "test-process-name"
+	_patchedImage    = "test-process-image@hotfix"
+)
+
+func (s *versionSuite) getUpdateProcessImageOpts() version.UpdateProcessImageOpts {
+	return version.UpdateProcessImageOpts{
+		ProductID:    _productID,
+		VersionTag:   _versionTag,
+		WorkflowName: _patchedWorkflow,
+		ProcessName:  _patchedProcess,
+		Image:        _patchedImage,
+		Comment:      "testing",
+	}
+}
+
+func (s *versionSuite) TestUpdateProcessImage_OK() {
+	// GIVEN a valid user and a started version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	previousImage := vers.Workflows[0].Processes[0].Image
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(testhelpers.NewProductBuilder().Build(), nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
+	s.versionService.EXPECT().UpdateProcessImage(ctx, _productID, _versionTag, gomock.Any()).Return(nil)
+	s.versionRepo.EXPECT().Update(_productID, vers).Return(nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterUpdateProcessImageAction(user.Email, _productID, vers, gomock.Any(), "testing").
+		Return(nil)
+
+	// WHEN updating the process image
+	updatedVersion, err := s.handler.UpdateProcessImage(ctx, user, s.getUpdateProcessImageOpts())
+	s.Require().NoError(err)
+
+	// THEN the process image is updated and the patch is recorded
+	s.Equal(_patchedImage, updatedVersion.Workflows[0].Processes[0].Image)
+	s.Require().Len(updatedVersion.Patches, 1)
+	s.Equal(previousImage, updatedVersion.Patches[0].PreviousImage)
+	s.Equal(_patchedImage, updatedVersion.Patches[0].Image)
+	s.Equal(user.Email, updatedVersion.Patches[0].Author)
+}
+
+func (s *versionSuite) TestUpdateProcessImage_ErrorUserNotAuthorized() {
+	// GIVEN an unauthorized user
+	ctx := context.Background()
+	badUser := testhelpers.NewUserBuilder().Build()
+	versionMatcher := newVersionMatcher(&entity.Version{Tag: _versionTag})
+
+	s.accessControl.EXPECT().CheckProductGrants(badUser, _productID, auth.ActManageVersion).Return(
+		fmt.Errorf("git good"),
+	)
+	s.userActivityInteractor.EXPECT().
+		RegisterUpdateProcessImageAction(badUser.Email, _productID, versionMatcher, gomock.Any(), version.ErrUserNotAuthorized.Error()).
+		Return(nil)
+
+	// WHEN updating the process image
+	_, err := s.handler.UpdateProcessImage(ctx, badUser, s.getUpdateProcessImageOpts())
+
+	// THEN an error is returned
+	s.Error(err)
+}
+
+func (s *versionSuite) TestUpdateProcessImage_ErrorInvalidVersionStatus() {
+	// GIVEN a valid user and a stopped version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStopped).
+		Build()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterUpdateProcessImageAction(user.Email, _productID, vers, gomock.Any(), version.ErrVersionCannotBePatched.Error()).
+		Return(nil)
+
+	// WHEN updating the process image
+	_, err := s.handler.UpdateProcessImage(ctx, user, s.getUpdateProcessImageOpts())
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrVersionCannotBePatched)
+}
+
+func (s *versionSuite) TestUpdateProcessImage_ErrorProcessNotFound() {
+	// GIVEN a valid user and a published version without the given process
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusPublished).
+		Build()
+
+	opts := s.getUpdateProcessImageOpts()
+	opts.ProcessName = "unknown-process"
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterUpdateProcessImageAction(user.Email, _productID, vers, gomock.Any(), version.ErrProcessNotFound.Error()).
+		Return(nil)
+
+	// WHEN updating the process image
+	_, err := s.handler.UpdateProcessImage(ctx, user, opts)
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrProcessNotFound)
+}
+
+func (s *versionSuite) TestUpdateProcessImage_ErrorRolledBack() {
+	// GIVEN a valid user and a started version whose rolling update fails
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	previousImage := vers.Workflows[0].Processes[0].Image
+	rollbackErr := errors.New("process image update failed and was rolled back")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(testhelpers.NewProductBuilder().Build(), nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(nil, nil)
+	s.versionService.EXPECT().UpdateProcessImage(ctx, _productID, _versionTag, gomock.Any()).Return(rollbackErr)
+	s.userActivityInteractor.EXPECT().
+		RegisterUpdateProcessImageAction(user.Email, _productID, vers, gomock.Any(), version.ErrUpdatingProcessImage.Error()).
+		Return(nil)
+
+	// WHEN updating the process image
+	_, err := s.handler.UpdateProcessImage(ctx, user, s.getUpdateProcessImageOpts())
+
+	// THEN an error is returned and the version keeps its previous image
+	s.ErrorIs(err, version.ErrUpdatingProcessImage)
+	s.ErrorIs(err, rollbackErr)
+	s.Equal(previousImage, vers.Workflows[0].Processes[0].Image)
+	s.Empty(vers.Patches)
+}
+
+func (s *versionSuite) TestUpdateProcessImage_ErrorProductQuotaExceeded() {
+	// GIVEN a product whose replicas quota leaves no room for the rolling update surge
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	product := testhelpers.NewProductBuilder().
+		WithID(_productID).
+		WithQuota(&entity.ProductQuota{MaxReplicas: 1}).
+		Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	previousImage := vers.Workflows[0].Processes[0].Image
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(product, nil)
+	s.versionRepo.EXPECT().SearchByProduct(ctx, _productID, nil).Return([]*entity.Version{vers}, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterUpdateProcessImageAction(user.Email, _productID, vers, gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	// WHEN updating the process image
+	_, err := s.handler.UpdateProcessImage(ctx, user, s.getUpdateProcessImageOpts())
+
+	// THEN the update is rejected and the version keeps its previous image
+	s.ErrorIs(err, entity.ErrProductQuotaExceeded)
+	s.Equal(previousImage, vers.Workflows[0].Processes[0].Image)
+}
+
+func (s *versionSuite) TestUpdateProcessImage_ErrorAdmissionPolicyViolated() {
+	// GIVEN an enforced policy that only allows images from a trusted registry
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := testhelpers.NewVersionBuilder().
+		WithTag(_versionTag).
+		WithStatus(entity.VersionStatusStarted).
+		Build()
+	previousImage := vers.Workflows[0].Processes[0].Image
+	policies := []*entity.AdmissionPolicy{
+		{
+			Name:   "trusted-registries",
+			Type:   entity.AdmissionPolicyTypeAllowedRegistries,
+			Mode:   entity.AdmissionPolicyModeEnforce,
+			Params: entity.AdmissionPolicyParams{Registries: []string{"registry.kai.local"}},
+		},
+	}
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActManageVersion).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, _versionTag).Return(vers, nil)
+	s.productRepo.EXPECT().GetByID(ctx, _productID).Return(testhelpers.NewProductBuilder().Build(), nil)
+	s.admissionPolicyRepo.EXPECT().FindAll(ctx).Return(policies, nil)
+	s.userActivityInteractor.EXPECT().
+		RegisterUpdateProcessImageAction(user.Email, _productID, vers, gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	// WHEN updating the process to an image from an untrusted registry
+	_, err := s.handler.UpdateProcessImage(ctx, user, s.getUpdateProcessImageOpts())
+
+	// THEN the update is rejected and the version keeps its previous image
+	s.ErrorIs(err, entity.ErrVersionNotAdmitted)
+	s.Equal(previousImage, vers.Workflows[0].Processes[0].Image)
+	s.Empty(vers.Patches)
+}
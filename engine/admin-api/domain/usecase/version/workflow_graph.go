@@ -0,0 +1,31 @@
+package version
+
+import (
+	"context"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+)
+
+// GetWorkflowGraph returns the processes of a version workflow and their subscriptions to visualize them.
+func (h *Handler) GetWorkflowGraph(
+	ctx context.Context,
+	user *entity.User,
+	productID, versionTag, workflowName string,
+) (*entity.WorkflowGraph, error) {
+	if err := h.accessControl.CheckProductGrants(user, productID, auth.ActViewProduct); err != nil {
+		return nil, err
+	}
+
+	vers, err := h.versionRepo.GetByTag(ctx, productID, versionTag)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, found := vers.GetWorkflow(workflowName)
+	if !found {
+		return nil, ErrWorkflowNotFound
+	}
+
+	return entity.NewWorkflowGraph(workflow), nil
+}
@@ -0,0 +1,64 @@
+//go:build unit
+
+package version_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/service/auth"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
+	"github.com/konstellation-io/kai/engine/admin-api/testhelpers"
+)
+
+func (s *versionSuite) TestGetWorkflowGraph_OK() {
+	// GIVEN a valid user and a version
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := getClassificatorVersion()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, vers.Tag).Return(vers, nil)
+
+	// WHEN getting the workflow graph
+	graph, err := s.handler.GetWorkflowGraph(ctx, user, _productID, vers.Tag, "go-classificator")
+	s.Require().NoError(err)
+
+	// THEN the graph has a node for each process and an edge for each subscription
+	s.Len(graph.Nodes, 4)
+	s.Equal(entity.WorkflowGraphNode{Process: "entrypoint", Type: entity.ProcessTypeTrigger}, graph.Nodes[0])
+	s.Contains(graph.Edges, entity.WorkflowGraphEdge{From: "entrypoint", To: "etl"})
+	s.Contains(graph.Edges, entity.WorkflowGraphEdge{From: "exitpoint", To: "entrypoint"})
+}
+
+func (s *versionSuite) TestGetWorkflowGraph_ErrorUserNotAuthorized() {
+	// GIVEN an unauthorized user
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	authErr := errors.New("unauthorized")
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(authErr)
+
+	// WHEN getting the workflow graph
+	_, err := s.handler.GetWorkflowGraph(ctx, user, _productID, _versionTag, "go-classificator")
+
+	// THEN an error is returned
+	s.ErrorIs(err, authErr)
+}
+
+func (s *versionSuite) TestGetWorkflowGraph_ErrorWorkflowNotFound() {
+	// GIVEN a valid user and a version without the workflow
+	ctx := context.Background()
+	user := testhelpers.NewUserBuilder().Build()
+	vers := getClassificatorVersion()
+
+	s.accessControl.EXPECT().CheckProductGrants(user, _productID, auth.ActViewProduct).Return(nil)
+	s.versionRepo.EXPECT().GetByTag(ctx, _productID, vers.Tag).Return(vers, nil)
+
+	// WHEN getting the graph of an unknown workflow
+	_, err := s.handler.GetWorkflowGraph(ctx, user, _productID, vers.Tag, "unknown")
+
+	// THEN an error is returned
+	s.ErrorIs(err, version.ErrWorkflowNotFound)
+}
@@ -24,7 +24,9 @@ import (
 	"github.com/konstellation-io/kai/engine/admin-api/delivery/http"
 	"github.com/konstellation-io/kai/engine/admin-api/delivery/http/controller"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/admission"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/logs"
+	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/natsgc"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/process"
 	"github.com/konstellation-io/kai/engine/admin-api/domain/usecase/version"
 	"github.com/minio/minio-go/v7"
@@ -58,22 +60,27 @@ func main() {
 
 	defer db.Disconnect()
 
-	graphqlController := initGraphqlController(logger, mongodbClient)
+	graphqlController, objectStoreController := initControllers(logger, mongodbClient)
 
 	app := http.NewApp(
 		logger,
 		graphqlController,
+		objectStoreController,
 	)
 
 	app.Start()
 }
 
 //nolint:funlen // Future refactor
-func initGraphqlController(logger logr.Logger, mongodbClient *mongo.Client) *controller.GraphQLController {
+func initControllers(
+	logger logr.Logger,
+	mongodbClient *mongo.Client,
+) (*controller.GraphQLController, *controller.ObjectStoreController) {
 	productRepo := mongodb.NewProductRepoMongoDB(logger, mongodbClient)
 	userActivityRepo := mongodb.NewUserActivityRepoMongoDB(logger, mongodbClient)
 	versionMongoRepo := versionrepository.New(logger, mongodbClient)
 	processRepo := processrepository.New(logger, mongodbClient)
+	admissionPolicyRepo := mongodb.NewAdmissionPolicyRepoMongoDB(logger, mongodbClient)
 	logsService := loki.NewClient()
 
 	ccK8sManager, err := grpc.Dial(
@@ -159,8 +166,13 @@ func initGraphqlController(logger logr.Logger, mongodbClient *mongo.Client) *con
 		UserRegistry:         keycloakUserRegistry,
 		PasswordGenerator:    passwordGenerator,
 		PredictionRepository: predictionRepo,
+		VersionService:       k8sService,
 	})
 
+	if err := productInteractor.EnsureProductNamespaces(context.Background()); err != nil {
+		logger.Error(err, "Error creating namespaces of existing products")
+	}
+
 	userHandler := usecase.NewUserHandler(
 		logger,
 		accessControl,
@@ -177,6 +189,30 @@ func initGraphqlController(logger logr.Logger, mongodbClient *mongo.Client) *con
 			NatsManagerService:     natsManagerService,
 			UserActivityInteractor: userActivityInteractor,
 			AccessControl:          accessControl,
+			AdmissionPolicyRepo:    admissionPolicyRepo,
+		},
+	)
+
+	if err := versionInteractor.ResumeDrains(context.Background()); err != nil {
+		logger.Error(err, "Error resuming version drains")
+	}
+
+	admissionHandler := admission.NewHandler(
+		&admission.HandlerParams{
+			Logger:              logger,
+			AdmissionPolicyRepo: admissionPolicyRepo,
+			VersionRepo:         versionMongoRepo,
+			AccessControl:       accessControl,
+		},
+	)
+
+	natsGCHandler := natsgc.NewHandler(
+		&natsgc.HandlerParams{
+			Logger:             logger,
+			NatsManagerService: natsManagerService,
+			ProductRepo:        productRepo,
+			VersionRepo:        versionMongoRepo,
+			AccessControl:      accessControl,
 		},
 	)
 
@@ -194,7 +230,7 @@ func initGraphqlController(logger logr.Logger, mongodbClient *mongo.Client) *con
 
 	logsUseCase := logs.NewLogsInteractor(logsService)
 
-	return controller.NewGraphQLController(
+	graphqlController := controller.NewGraphQLController(
 		controller.Params{
 			Logger:                 logger,
 			ProductInteractor:      productInteractor,
@@ -203,8 +239,12 @@ func initGraphqlController(logger logr.Logger, mongodbClient *mongo.Client) *con
 			VersionInteractor:      versionInteractor,
 			ProcessHandler:         processHandler,
 			LogsUsecase:            logsUseCase,
+			AdmissionHandler:       admissionHandler,
+			NatsGCHandler:          natsGCHandler,
 		},
 	)
+
+	return graphqlController, controller.NewObjectStoreController(logger, productInteractor)
 }
 
 func ensureKAIBucketExists(
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	natspb "github.com/konstellation-io/kai/engine/admin-api/adapter/service/proto/natspb"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockNatsManagerServiceClient is a mock of NatsManagerServiceClient interface.
@@ -76,6 +77,26 @@ func (mr *MockNatsManagerServiceClientMockRecorder) CreateObjectStores(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateObjectStores", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).CreateObjectStores), varargs...)
 }
 
+// CreateProductObjectStore mocks base method.
+func (m *MockNatsManagerServiceClient) CreateProductObjectStore(ctx context.Context, in *natspb.CreateProductObjectStoreRequest, opts ...grpc.CallOption) (*natspb.CreateProductObjectStoreResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateProductObjectStore", varargs...)
+	ret0, _ := ret[0].(*natspb.CreateProductObjectStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductObjectStore indicates an expected call of CreateProductObjectStore.
+func (mr *MockNatsManagerServiceClientMockRecorder) CreateProductObjectStore(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductObjectStore", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).CreateProductObjectStore), varargs...)
+}
+
 // CreateStreams mocks base method.
 func (m *MockNatsManagerServiceClient) CreateStreams(ctx context.Context, in *natspb.CreateStreamsRequest, opts ...grpc.CallOption) (*natspb.CreateStreamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +117,26 @@ func (mr *MockNatsManagerServiceClientMockRecorder) CreateStreams(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreams", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).CreateStreams), varargs...)
 }
 
+// CreateVersionCredentials mocks base method.
+func (m *MockNatsManagerServiceClient) CreateVersionCredentials(ctx context.Context, in *natspb.CreateVersionCredentialsRequest, opts ...grpc.CallOption) (*natspb.CreateVersionCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVersionCredentials", varargs...)
+	ret0, _ := ret[0].(*natspb.CreateVersionCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVersionCredentials indicates an expected call of CreateVersionCredentials.
+func (mr *MockNatsManagerServiceClientMockRecorder) CreateVersionCredentials(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionCredentials", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).CreateVersionCredentials), varargs...)
+}
+
 // CreateVersionKeyValueStores mocks base method.
 func (m *MockNatsManagerServiceClient) CreateVersionKeyValueStores(ctx context.Context, in *natspb.CreateVersionKeyValueStoresRequest, opts ...grpc.CallOption) (*natspb.CreateVersionKeyValueStoresResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +157,26 @@ func (mr *MockNatsManagerServiceClientMockRecorder) CreateVersionKeyValueStores(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionKeyValueStores", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).CreateVersionKeyValueStores), varargs...)
 }
 
+// DeleteConfiguration mocks base method.
+func (m *MockNatsManagerServiceClient) DeleteConfiguration(ctx context.Context, in *natspb.DeleteConfigurationRequest, opts ...grpc.CallOption) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConfiguration", varargs...)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfiguration indicates an expected call of DeleteConfiguration.
+func (mr *MockNatsManagerServiceClientMockRecorder) DeleteConfiguration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfiguration", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).DeleteConfiguration), varargs...)
+}
+
 // DeleteGlobalKeyValueStore mocks base method.
 func (m *MockNatsManagerServiceClient) DeleteGlobalKeyValueStore(ctx context.Context, in *natspb.DeleteGlobalKeyValueStoreRequest, opts ...grpc.CallOption) (*natspb.DeleteResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +197,26 @@ func (mr *MockNatsManagerServiceClientMockRecorder) DeleteGlobalKeyValueStore(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalKeyValueStore", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).DeleteGlobalKeyValueStore), varargs...)
 }
 
+// DeleteObject mocks base method.
+func (m *MockNatsManagerServiceClient) DeleteObject(ctx context.Context, in *natspb.DeleteObjectRequest, opts ...grpc.CallOption) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteObject", varargs...)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockNatsManagerServiceClientMockRecorder) DeleteObject(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).DeleteObject), varargs...)
+}
+
 // DeleteObjectStores mocks base method.
 func (m *MockNatsManagerServiceClient) DeleteObjectStores(ctx context.Context, in *natspb.DeleteObjectStoresRequest, opts ...grpc.CallOption) (*natspb.DeleteResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +237,46 @@ func (mr *MockNatsManagerServiceClientMockRecorder) DeleteObjectStores(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectStores", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).DeleteObjectStores), varargs...)
 }
 
+// DeleteProductObjectStore mocks base method.
+func (m *MockNatsManagerServiceClient) DeleteProductObjectStore(ctx context.Context, in *natspb.DeleteProductObjectStoreRequest, opts ...grpc.CallOption) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteProductObjectStore", varargs...)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductObjectStore indicates an expected call of DeleteProductObjectStore.
+func (mr *MockNatsManagerServiceClientMockRecorder) DeleteProductObjectStore(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductObjectStore", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).DeleteProductObjectStore), varargs...)
+}
+
+// DeleteResources mocks base method.
+func (m *MockNatsManagerServiceClient) DeleteResources(ctx context.Context, in *natspb.DeleteResourcesRequest, opts ...grpc.CallOption) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteResources", varargs...)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResources indicates an expected call of DeleteResources.
+func (mr *MockNatsManagerServiceClientMockRecorder) DeleteResources(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResources", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).DeleteResources), varargs...)
+}
+
 // DeleteStreams mocks base method.
 func (m *MockNatsManagerServiceClient) DeleteStreams(ctx context.Context, in *natspb.DeleteStreamsRequest, opts ...grpc.CallOption) (*natspb.DeleteResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,204 +317,1444 @@ func (mr *MockNatsManagerServiceClientMockRecorder) DeleteVersionKeyValueStores(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersionKeyValueStores", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).DeleteVersionKeyValueStores), varargs...)
 }
 
-// UpdateKeyValueConfiguration mocks base method.
-func (m *MockNatsManagerServiceClient) UpdateKeyValueConfiguration(ctx context.Context, in *natspb.UpdateKeyValueConfigurationRequest, opts ...grpc.CallOption) (*natspb.UpdateKeyValueConfigurationResponse, error) {
+// GetConfiguration mocks base method.
+func (m *MockNatsManagerServiceClient) GetConfiguration(ctx context.Context, in *natspb.GetConfigurationRequest, opts ...grpc.CallOption) (*natspb.GetConfigurationResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateKeyValueConfiguration", varargs...)
-	ret0, _ := ret[0].(*natspb.UpdateKeyValueConfigurationResponse)
+	ret := m.ctrl.Call(m, "GetConfiguration", varargs...)
+	ret0, _ := ret[0].(*natspb.GetConfigurationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateKeyValueConfiguration indicates an expected call of UpdateKeyValueConfiguration.
-func (mr *MockNatsManagerServiceClientMockRecorder) UpdateKeyValueConfiguration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetConfiguration indicates an expected call of GetConfiguration.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetConfiguration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyValueConfiguration", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).UpdateKeyValueConfiguration), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfiguration", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetConfiguration), varargs...)
 }
 
-// MockNatsManagerServiceServer is a mock of NatsManagerServiceServer interface.
-type MockNatsManagerServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockNatsManagerServiceServerMockRecorder
+// GetConfigurationHistory mocks base method.
+func (m *MockNatsManagerServiceClient) GetConfigurationHistory(ctx context.Context, in *natspb.GetConfigurationHistoryRequest, opts ...grpc.CallOption) (*natspb.GetConfigurationHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConfigurationHistory", varargs...)
+	ret0, _ := ret[0].(*natspb.GetConfigurationHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockNatsManagerServiceServerMockRecorder is the mock recorder for MockNatsManagerServiceServer.
-type MockNatsManagerServiceServerMockRecorder struct {
-	mock *MockNatsManagerServiceServer
+// GetConfigurationHistory indicates an expected call of GetConfigurationHistory.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetConfigurationHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigurationHistory", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetConfigurationHistory), varargs...)
 }
 
-// NewMockNatsManagerServiceServer creates a new mock instance.
-func NewMockNatsManagerServiceServer(ctrl *gomock.Controller) *MockNatsManagerServiceServer {
-	mock := &MockNatsManagerServiceServer{ctrl: ctrl}
-	mock.recorder = &MockNatsManagerServiceServerMockRecorder{mock}
-	return mock
+// GetDeadLetterMessages mocks base method.
+func (m *MockNatsManagerServiceClient) GetDeadLetterMessages(ctx context.Context, in *natspb.GetDeadLetterMessagesRequest, opts ...grpc.CallOption) (*natspb.GetDeadLetterMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeadLetterMessages", varargs...)
+	ret0, _ := ret[0].(*natspb.GetDeadLetterMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockNatsManagerServiceServer) EXPECT() *MockNatsManagerServiceServerMockRecorder {
-	return m.recorder
+// GetDeadLetterMessages indicates an expected call of GetDeadLetterMessages.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetDeadLetterMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessages", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetDeadLetterMessages), varargs...)
 }
 
-// CreateGlobalKeyValueStore mocks base method.
-func (m *MockNatsManagerServiceServer) CreateGlobalKeyValueStore(arg0 context.Context, arg1 *natspb.CreateGlobalKeyValueStoreRequest) (*natspb.CreateGlobalKeyValueStoreResponse, error) {
+// GetObject mocks base method.
+func (m *MockNatsManagerServiceClient) GetObject(ctx context.Context, in *natspb.GetObjectRequest, opts ...grpc.CallOption) (natspb.NatsManagerService_GetObjectClient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateGlobalKeyValueStore", arg0, arg1)
-	ret0, _ := ret[0].(*natspb.CreateGlobalKeyValueStoreResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObject", varargs...)
+	ret0, _ := ret[0].(natspb.NatsManagerService_GetObjectClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateGlobalKeyValueStore indicates an expected call of CreateGlobalKeyValueStore.
-func (mr *MockNatsManagerServiceServerMockRecorder) CreateGlobalKeyValueStore(arg0, arg1 interface{}) *gomock.Call {
+// GetObject indicates an expected call of GetObject.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetObject(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalKeyValueStore", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateGlobalKeyValueStore), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetObject), varargs...)
 }
 
-// CreateObjectStores mocks base method.
-func (m *MockNatsManagerServiceServer) CreateObjectStores(arg0 context.Context, arg1 *natspb.CreateObjectStoresRequest) (*natspb.CreateObjectStoresResponse, error) {
+// GetProcessConfiguration mocks base method.
+func (m *MockNatsManagerServiceClient) GetProcessConfiguration(ctx context.Context, in *natspb.GetProcessConfigurationRequest, opts ...grpc.CallOption) (*natspb.GetProcessConfigurationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateObjectStores", arg0, arg1)
-	ret0, _ := ret[0].(*natspb.CreateObjectStoresResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProcessConfiguration", varargs...)
+	ret0, _ := ret[0].(*natspb.GetProcessConfigurationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateObjectStores indicates an expected call of CreateObjectStores.
-func (mr *MockNatsManagerServiceServerMockRecorder) CreateObjectStores(arg0, arg1 interface{}) *gomock.Call {
+// GetProcessConfiguration indicates an expected call of GetProcessConfiguration.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetProcessConfiguration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateObjectStores", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateObjectStores), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessConfiguration", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetProcessConfiguration), varargs...)
 }
 
-// CreateStreams mocks base method.
-func (m *MockNatsManagerServiceServer) CreateStreams(arg0 context.Context, arg1 *natspb.CreateStreamsRequest) (*natspb.CreateStreamsResponse, error) {
+// GetProcessConsumerLag mocks base method.
+func (m *MockNatsManagerServiceClient) GetProcessConsumerLag(ctx context.Context, in *natspb.GetProcessConsumerLagRequest, opts ...grpc.CallOption) (*natspb.GetProcessConsumerLagResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateStreams", arg0, arg1)
-	ret0, _ := ret[0].(*natspb.CreateStreamsResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProcessConsumerLag", varargs...)
+	ret0, _ := ret[0].(*natspb.GetProcessConsumerLagResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateStreams indicates an expected call of CreateStreams.
-func (mr *MockNatsManagerServiceServerMockRecorder) CreateStreams(arg0, arg1 interface{}) *gomock.Call {
+// GetProcessConsumerLag indicates an expected call of GetProcessConsumerLag.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetProcessConsumerLag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreams", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateStreams), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessConsumerLag", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetProcessConsumerLag), varargs...)
 }
 
-// CreateVersionKeyValueStores mocks base method.
-func (m *MockNatsManagerServiceServer) CreateVersionKeyValueStores(arg0 context.Context, arg1 *natspb.CreateVersionKeyValueStoresRequest) (*natspb.CreateVersionKeyValueStoresResponse, error) {
+// GetProcessMessages mocks base method.
+func (m *MockNatsManagerServiceClient) GetProcessMessages(ctx context.Context, in *natspb.GetProcessMessagesRequest, opts ...grpc.CallOption) (*natspb.GetProcessMessagesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVersionKeyValueStores", arg0, arg1)
-	ret0, _ := ret[0].(*natspb.CreateVersionKeyValueStoresResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProcessMessages", varargs...)
+	ret0, _ := ret[0].(*natspb.GetProcessMessagesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVersionKeyValueStores indicates an expected call of CreateVersionKeyValueStores.
-func (mr *MockNatsManagerServiceServerMockRecorder) CreateVersionKeyValueStores(arg0, arg1 interface{}) *gomock.Call {
+// GetProcessMessages indicates an expected call of GetProcessMessages.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetProcessMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionKeyValueStores", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateVersionKeyValueStores), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessMessages", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetProcessMessages), varargs...)
 }
 
-// DeleteGlobalKeyValueStore mocks base method.
-func (m *MockNatsManagerServiceServer) DeleteGlobalKeyValueStore(arg0 context.Context, arg1 *natspb.DeleteGlobalKeyValueStoreRequest) (*natspb.DeleteResponse, error) {
+// GetProductObjectStores mocks base method.
+func (m *MockNatsManagerServiceClient) GetProductObjectStores(ctx context.Context, in *natspb.GetProductObjectStoresRequest, opts ...grpc.CallOption) (*natspb.GetProductObjectStoresResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGlobalKeyValueStore", arg0, arg1)
-	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProductObjectStores", varargs...)
+	ret0, _ := ret[0].(*natspb.GetProductObjectStoresResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteGlobalKeyValueStore indicates an expected call of DeleteGlobalKeyValueStore.
-func (mr *MockNatsManagerServiceServerMockRecorder) DeleteGlobalKeyValueStore(arg0, arg1 interface{}) *gomock.Call {
+// GetProductObjectStores indicates an expected call of GetProductObjectStores.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetProductObjectStores(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalKeyValueStore", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteGlobalKeyValueStore), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductObjectStores", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetProductObjectStores), varargs...)
 }
 
-// DeleteObjectStores mocks base method.
-func (m *MockNatsManagerServiceServer) DeleteObjectStores(arg0 context.Context, arg1 *natspb.DeleteObjectStoresRequest) (*natspb.DeleteResponse, error) {
+// GetVersionResources mocks base method.
+func (m *MockNatsManagerServiceClient) GetVersionResources(ctx context.Context, in *natspb.GetVersionResourcesRequest, opts ...grpc.CallOption) (*natspb.GetVersionResourcesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteObjectStores", arg0, arg1)
-	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVersionResources", varargs...)
+	ret0, _ := ret[0].(*natspb.GetVersionResourcesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteObjectStores indicates an expected call of DeleteObjectStores.
-func (mr *MockNatsManagerServiceServerMockRecorder) DeleteObjectStores(arg0, arg1 interface{}) *gomock.Call {
+// GetVersionResources indicates an expected call of GetVersionResources.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetVersionResources(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectStores", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteObjectStores), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionResources", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetVersionResources), varargs...)
 }
 
-// DeleteStreams mocks base method.
-func (m *MockNatsManagerServiceServer) DeleteStreams(arg0 context.Context, arg1 *natspb.DeleteStreamsRequest) (*natspb.DeleteResponse, error) {
+// GetVersionStreamStats mocks base method.
+func (m *MockNatsManagerServiceClient) GetVersionStreamStats(ctx context.Context, in *natspb.GetVersionStreamStatsRequest, opts ...grpc.CallOption) (*natspb.GetVersionStreamStatsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteStreams", arg0, arg1)
-	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVersionStreamStats", varargs...)
+	ret0, _ := ret[0].(*natspb.GetVersionStreamStatsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteStreams indicates an expected call of DeleteStreams.
-func (mr *MockNatsManagerServiceServerMockRecorder) DeleteStreams(arg0, arg1 interface{}) *gomock.Call {
+// GetVersionStreamStats indicates an expected call of GetVersionStreamStats.
+func (mr *MockNatsManagerServiceClientMockRecorder) GetVersionStreamStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStreams", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteStreams), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionStreamStats", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).GetVersionStreamStats), varargs...)
 }
 
-// DeleteVersionKeyValueStores mocks base method.
-func (m *MockNatsManagerServiceServer) DeleteVersionKeyValueStores(arg0 context.Context, arg1 *natspb.DeleteVersionKeyValueStoresRequest) (*natspb.DeleteResponse, error) {
+// ListObjects mocks base method.
+func (m *MockNatsManagerServiceClient) ListObjects(ctx context.Context, in *natspb.ListObjectsRequest, opts ...grpc.CallOption) (*natspb.ListObjectsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVersionKeyValueStores", arg0, arg1)
-	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjects", varargs...)
+	ret0, _ := ret[0].(*natspb.ListObjectsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteVersionKeyValueStores indicates an expected call of DeleteVersionKeyValueStores.
-func (mr *MockNatsManagerServiceServerMockRecorder) DeleteVersionKeyValueStores(arg0, arg1 interface{}) *gomock.Call {
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockNatsManagerServiceClientMockRecorder) ListObjects(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersionKeyValueStores", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteVersionKeyValueStores), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).ListObjects), varargs...)
 }
 
-// UpdateKeyValueConfiguration mocks base method.
-func (m *MockNatsManagerServiceServer) UpdateKeyValueConfiguration(arg0 context.Context, arg1 *natspb.UpdateKeyValueConfigurationRequest) (*natspb.UpdateKeyValueConfigurationResponse, error) {
+// ListResources mocks base method.
+func (m *MockNatsManagerServiceClient) ListResources(ctx context.Context, in *natspb.ListResourcesRequest, opts ...grpc.CallOption) (*natspb.ListResourcesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateKeyValueConfiguration", arg0, arg1)
-	ret0, _ := ret[0].(*natspb.UpdateKeyValueConfigurationResponse)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResources", varargs...)
+	ret0, _ := ret[0].(*natspb.ListResourcesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateKeyValueConfiguration indicates an expected call of UpdateKeyValueConfiguration.
-func (mr *MockNatsManagerServiceServerMockRecorder) UpdateKeyValueConfiguration(arg0, arg1 interface{}) *gomock.Call {
+// ListResources indicates an expected call of ListResources.
+func (mr *MockNatsManagerServiceClientMockRecorder) ListResources(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyValueConfiguration", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).UpdateKeyValueConfiguration), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).ListResources), varargs...)
 }
 
-// mustEmbedUnimplementedNatsManagerServiceServer mocks base method.
-func (m *MockNatsManagerServiceServer) mustEmbedUnimplementedNatsManagerServiceServer() {
+// PublishMessage mocks base method.
+func (m *MockNatsManagerServiceClient) PublishMessage(ctx context.Context, in *natspb.PublishMessageRequest, opts ...grpc.CallOption) (*natspb.PublishMessageResponse, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedNatsManagerServiceServer")
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishMessage", varargs...)
+	ret0, _ := ret[0].(*natspb.PublishMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// mustEmbedUnimplementedNatsManagerServiceServer indicates an expected call of mustEmbedUnimplementedNatsManagerServiceServer.
-func (mr *MockNatsManagerServiceServerMockRecorder) mustEmbedUnimplementedNatsManagerServiceServer() *gomock.Call {
+// PublishMessage indicates an expected call of PublishMessage.
+func (mr *MockNatsManagerServiceClientMockRecorder) PublishMessage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedNatsManagerServiceServer", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).mustEmbedUnimplementedNatsManagerServiceServer))
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMessage", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).PublishMessage), varargs...)
 }
 
-// MockUnsafeNatsManagerServiceServer is a mock of UnsafeNatsManagerServiceServer interface.
-type MockUnsafeNatsManagerServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockUnsafeNatsManagerServiceServerMockRecorder
+// PurgeDeadLetterMessages mocks base method.
+func (m *MockNatsManagerServiceClient) PurgeDeadLetterMessages(ctx context.Context, in *natspb.PurgeDeadLetterMessagesRequest, opts ...grpc.CallOption) (*natspb.PurgeDeadLetterMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeDeadLetterMessages", varargs...)
+	ret0, _ := ret[0].(*natspb.PurgeDeadLetterMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockUnsafeNatsManagerServiceServerMockRecorder is the mock recorder for MockUnsafeNatsManagerServiceServer.
-type MockUnsafeNatsManagerServiceServerMockRecorder struct {
+// PurgeDeadLetterMessages indicates an expected call of PurgeDeadLetterMessages.
+func (mr *MockNatsManagerServiceClientMockRecorder) PurgeDeadLetterMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetterMessages", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).PurgeDeadLetterMessages), varargs...)
+}
+
+// PutObject mocks base method.
+func (m *MockNatsManagerServiceClient) PutObject(ctx context.Context, opts ...grpc.CallOption) (natspb.NatsManagerService_PutObjectClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutObject", varargs...)
+	ret0, _ := ret[0].(natspb.NatsManagerService_PutObjectClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutObject indicates an expected call of PutObject.
+func (mr *MockNatsManagerServiceClientMockRecorder) PutObject(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).PutObject), varargs...)
+}
+
+// ReplayDeadLetterMessages mocks base method.
+func (m *MockNatsManagerServiceClient) ReplayDeadLetterMessages(ctx context.Context, in *natspb.ReplayDeadLetterMessagesRequest, opts ...grpc.CallOption) (*natspb.ReplayDeadLetterMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplayDeadLetterMessages", varargs...)
+	ret0, _ := ret[0].(*natspb.ReplayDeadLetterMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetterMessages indicates an expected call of ReplayDeadLetterMessages.
+func (mr *MockNatsManagerServiceClientMockRecorder) ReplayDeadLetterMessages(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetterMessages", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).ReplayDeadLetterMessages), varargs...)
+}
+
+// RevokeVersionCredentials mocks base method.
+func (m *MockNatsManagerServiceClient) RevokeVersionCredentials(ctx context.Context, in *natspb.RevokeVersionCredentialsRequest, opts ...grpc.CallOption) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeVersionCredentials", varargs...)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeVersionCredentials indicates an expected call of RevokeVersionCredentials.
+func (mr *MockNatsManagerServiceClientMockRecorder) RevokeVersionCredentials(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeVersionCredentials", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).RevokeVersionCredentials), varargs...)
+}
+
+// RollbackConfiguration mocks base method.
+func (m *MockNatsManagerServiceClient) RollbackConfiguration(ctx context.Context, in *natspb.RollbackConfigurationRequest, opts ...grpc.CallOption) (*natspb.RollbackConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackConfiguration", varargs...)
+	ret0, _ := ret[0].(*natspb.RollbackConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackConfiguration indicates an expected call of RollbackConfiguration.
+func (mr *MockNatsManagerServiceClientMockRecorder) RollbackConfiguration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackConfiguration", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).RollbackConfiguration), varargs...)
+}
+
+// SetConfiguration mocks base method.
+func (m *MockNatsManagerServiceClient) SetConfiguration(ctx context.Context, in *natspb.SetConfigurationRequest, opts ...grpc.CallOption) (*natspb.SetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetConfiguration", varargs...)
+	ret0, _ := ret[0].(*natspb.SetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConfiguration indicates an expected call of SetConfiguration.
+func (mr *MockNatsManagerServiceClientMockRecorder) SetConfiguration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfiguration", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).SetConfiguration), varargs...)
+}
+
+// StartShadow mocks base method.
+func (m *MockNatsManagerServiceClient) StartShadow(ctx context.Context, in *natspb.StartShadowRequest, opts ...grpc.CallOption) (*natspb.StartShadowResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartShadow", varargs...)
+	ret0, _ := ret[0].(*natspb.StartShadowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartShadow indicates an expected call of StartShadow.
+func (mr *MockNatsManagerServiceClientMockRecorder) StartShadow(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShadow", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).StartShadow), varargs...)
+}
+
+// StopShadow mocks base method.
+func (m *MockNatsManagerServiceClient) StopShadow(ctx context.Context, in *natspb.StopShadowRequest, opts ...grpc.CallOption) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopShadow", varargs...)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopShadow indicates an expected call of StopShadow.
+func (mr *MockNatsManagerServiceClientMockRecorder) StopShadow(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopShadow", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).StopShadow), varargs...)
+}
+
+// UpdateKeyValueConfiguration mocks base method.
+func (m *MockNatsManagerServiceClient) UpdateKeyValueConfiguration(ctx context.Context, in *natspb.UpdateKeyValueConfigurationRequest, opts ...grpc.CallOption) (*natspb.UpdateKeyValueConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateKeyValueConfiguration", varargs...)
+	ret0, _ := ret[0].(*natspb.UpdateKeyValueConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateKeyValueConfiguration indicates an expected call of UpdateKeyValueConfiguration.
+func (mr *MockNatsManagerServiceClientMockRecorder) UpdateKeyValueConfiguration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyValueConfiguration", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).UpdateKeyValueConfiguration), varargs...)
+}
+
+// WatchProcessConfiguration mocks base method.
+func (m *MockNatsManagerServiceClient) WatchProcessConfiguration(ctx context.Context, in *natspb.WatchProcessConfigurationRequest, opts ...grpc.CallOption) (natspb.NatsManagerService_WatchProcessConfigurationClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchProcessConfiguration", varargs...)
+	ret0, _ := ret[0].(natspb.NatsManagerService_WatchProcessConfigurationClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchProcessConfiguration indicates an expected call of WatchProcessConfiguration.
+func (mr *MockNatsManagerServiceClientMockRecorder) WatchProcessConfiguration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchProcessConfiguration", reflect.TypeOf((*MockNatsManagerServiceClient)(nil).WatchProcessConfiguration), varargs...)
+}
+
+// MockNatsManagerService_GetObjectClient is a mock of NatsManagerService_GetObjectClient interface.
+type MockNatsManagerService_GetObjectClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNatsManagerService_GetObjectClientMockRecorder
+}
+
+// MockNatsManagerService_GetObjectClientMockRecorder is the mock recorder for MockNatsManagerService_GetObjectClient.
+type MockNatsManagerService_GetObjectClientMockRecorder struct {
+	mock *MockNatsManagerService_GetObjectClient
+}
+
+// NewMockNatsManagerService_GetObjectClient creates a new mock instance.
+func NewMockNatsManagerService_GetObjectClient(ctrl *gomock.Controller) *MockNatsManagerService_GetObjectClient {
+	mock := &MockNatsManagerService_GetObjectClient{ctrl: ctrl}
+	mock.recorder = &MockNatsManagerService_GetObjectClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNatsManagerService_GetObjectClient) EXPECT() *MockNatsManagerService_GetObjectClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockNatsManagerService_GetObjectClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockNatsManagerService_GetObjectClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockNatsManagerService_GetObjectClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockNatsManagerService_GetObjectClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockNatsManagerService_GetObjectClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNatsManagerService_GetObjectClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockNatsManagerService_GetObjectClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockNatsManagerService_GetObjectClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockNatsManagerService_GetObjectClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockNatsManagerService_GetObjectClient) Recv() (*natspb.ObjectChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*natspb.ObjectChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockNatsManagerService_GetObjectClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockNatsManagerService_GetObjectClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockNatsManagerService_GetObjectClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockNatsManagerService_GetObjectClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNatsManagerService_GetObjectClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockNatsManagerService_GetObjectClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockNatsManagerService_GetObjectClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNatsManagerService_GetObjectClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockNatsManagerService_GetObjectClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockNatsManagerService_GetObjectClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockNatsManagerService_GetObjectClient)(nil).Trailer))
+}
+
+// MockNatsManagerService_PutObjectClient is a mock of NatsManagerService_PutObjectClient interface.
+type MockNatsManagerService_PutObjectClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNatsManagerService_PutObjectClientMockRecorder
+}
+
+// MockNatsManagerService_PutObjectClientMockRecorder is the mock recorder for MockNatsManagerService_PutObjectClient.
+type MockNatsManagerService_PutObjectClientMockRecorder struct {
+	mock *MockNatsManagerService_PutObjectClient
+}
+
+// NewMockNatsManagerService_PutObjectClient creates a new mock instance.
+func NewMockNatsManagerService_PutObjectClient(ctrl *gomock.Controller) *MockNatsManagerService_PutObjectClient {
+	mock := &MockNatsManagerService_PutObjectClient{ctrl: ctrl}
+	mock.recorder = &MockNatsManagerService_PutObjectClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNatsManagerService_PutObjectClient) EXPECT() *MockNatsManagerService_PutObjectClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method.
+func (m *MockNatsManagerService_PutObjectClient) CloseAndRecv() (*natspb.PutObjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*natspb.PutObjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockNatsManagerService_PutObjectClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockNatsManagerService_PutObjectClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method.
+func (m *MockNatsManagerService_PutObjectClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockNatsManagerService_PutObjectClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockNatsManagerService_PutObjectClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockNatsManagerService_PutObjectClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockNatsManagerService_PutObjectClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNatsManagerService_PutObjectClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockNatsManagerService_PutObjectClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockNatsManagerService_PutObjectClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockNatsManagerService_PutObjectClient)(nil).Header))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockNatsManagerService_PutObjectClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockNatsManagerService_PutObjectClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNatsManagerService_PutObjectClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockNatsManagerService_PutObjectClient) Send(arg0 *natspb.PutObjectRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockNatsManagerService_PutObjectClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockNatsManagerService_PutObjectClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockNatsManagerService_PutObjectClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockNatsManagerService_PutObjectClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNatsManagerService_PutObjectClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockNatsManagerService_PutObjectClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockNatsManagerService_PutObjectClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockNatsManagerService_PutObjectClient)(nil).Trailer))
+}
+
+// MockNatsManagerService_WatchProcessConfigurationClient is a mock of NatsManagerService_WatchProcessConfigurationClient interface.
+type MockNatsManagerService_WatchProcessConfigurationClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNatsManagerService_WatchProcessConfigurationClientMockRecorder
+}
+
+// MockNatsManagerService_WatchProcessConfigurationClientMockRecorder is the mock recorder for MockNatsManagerService_WatchProcessConfigurationClient.
+type MockNatsManagerService_WatchProcessConfigurationClientMockRecorder struct {
+	mock *MockNatsManagerService_WatchProcessConfigurationClient
+}
+
+// NewMockNatsManagerService_WatchProcessConfigurationClient creates a new mock instance.
+func NewMockNatsManagerService_WatchProcessConfigurationClient(ctrl *gomock.Controller) *MockNatsManagerService_WatchProcessConfigurationClient {
+	mock := &MockNatsManagerService_WatchProcessConfigurationClient{ctrl: ctrl}
+	mock.recorder = &MockNatsManagerService_WatchProcessConfigurationClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNatsManagerService_WatchProcessConfigurationClient) EXPECT() *MockNatsManagerService_WatchProcessConfigurationClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockNatsManagerService_WatchProcessConfigurationClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockNatsManagerService_WatchProcessConfigurationClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockNatsManagerService_WatchProcessConfigurationClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationClient) Recv() (*natspb.ConfigurationEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*natspb.ConfigurationEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockNatsManagerService_WatchProcessConfigurationClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockNatsManagerService_WatchProcessConfigurationClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockNatsManagerService_WatchProcessConfigurationClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockNatsManagerService_WatchProcessConfigurationClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockNatsManagerService_WatchProcessConfigurationClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockNatsManagerService_WatchProcessConfigurationClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationClient)(nil).Trailer))
+}
+
+// MockNatsManagerServiceServer is a mock of NatsManagerServiceServer interface.
+type MockNatsManagerServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNatsManagerServiceServerMockRecorder
+}
+
+// MockNatsManagerServiceServerMockRecorder is the mock recorder for MockNatsManagerServiceServer.
+type MockNatsManagerServiceServerMockRecorder struct {
+	mock *MockNatsManagerServiceServer
+}
+
+// NewMockNatsManagerServiceServer creates a new mock instance.
+func NewMockNatsManagerServiceServer(ctrl *gomock.Controller) *MockNatsManagerServiceServer {
+	mock := &MockNatsManagerServiceServer{ctrl: ctrl}
+	mock.recorder = &MockNatsManagerServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNatsManagerServiceServer) EXPECT() *MockNatsManagerServiceServerMockRecorder {
+	return m.recorder
+}
+
+// CreateGlobalKeyValueStore mocks base method.
+func (m *MockNatsManagerServiceServer) CreateGlobalKeyValueStore(arg0 context.Context, arg1 *natspb.CreateGlobalKeyValueStoreRequest) (*natspb.CreateGlobalKeyValueStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGlobalKeyValueStore", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.CreateGlobalKeyValueStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGlobalKeyValueStore indicates an expected call of CreateGlobalKeyValueStore.
+func (mr *MockNatsManagerServiceServerMockRecorder) CreateGlobalKeyValueStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalKeyValueStore", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateGlobalKeyValueStore), arg0, arg1)
+}
+
+// CreateObjectStores mocks base method.
+func (m *MockNatsManagerServiceServer) CreateObjectStores(arg0 context.Context, arg1 *natspb.CreateObjectStoresRequest) (*natspb.CreateObjectStoresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateObjectStores", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.CreateObjectStoresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateObjectStores indicates an expected call of CreateObjectStores.
+func (mr *MockNatsManagerServiceServerMockRecorder) CreateObjectStores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateObjectStores", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateObjectStores), arg0, arg1)
+}
+
+// CreateProductObjectStore mocks base method.
+func (m *MockNatsManagerServiceServer) CreateProductObjectStore(arg0 context.Context, arg1 *natspb.CreateProductObjectStoreRequest) (*natspb.CreateProductObjectStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductObjectStore", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.CreateProductObjectStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductObjectStore indicates an expected call of CreateProductObjectStore.
+func (mr *MockNatsManagerServiceServerMockRecorder) CreateProductObjectStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductObjectStore", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateProductObjectStore), arg0, arg1)
+}
+
+// CreateStreams mocks base method.
+func (m *MockNatsManagerServiceServer) CreateStreams(arg0 context.Context, arg1 *natspb.CreateStreamsRequest) (*natspb.CreateStreamsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStreams", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.CreateStreamsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStreams indicates an expected call of CreateStreams.
+func (mr *MockNatsManagerServiceServerMockRecorder) CreateStreams(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreams", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateStreams), arg0, arg1)
+}
+
+// CreateVersionCredentials mocks base method.
+func (m *MockNatsManagerServiceServer) CreateVersionCredentials(arg0 context.Context, arg1 *natspb.CreateVersionCredentialsRequest) (*natspb.CreateVersionCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVersionCredentials", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.CreateVersionCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVersionCredentials indicates an expected call of CreateVersionCredentials.
+func (mr *MockNatsManagerServiceServerMockRecorder) CreateVersionCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionCredentials", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateVersionCredentials), arg0, arg1)
+}
+
+// CreateVersionKeyValueStores mocks base method.
+func (m *MockNatsManagerServiceServer) CreateVersionKeyValueStores(arg0 context.Context, arg1 *natspb.CreateVersionKeyValueStoresRequest) (*natspb.CreateVersionKeyValueStoresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVersionKeyValueStores", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.CreateVersionKeyValueStoresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVersionKeyValueStores indicates an expected call of CreateVersionKeyValueStores.
+func (mr *MockNatsManagerServiceServerMockRecorder) CreateVersionKeyValueStores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionKeyValueStores", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).CreateVersionKeyValueStores), arg0, arg1)
+}
+
+// DeleteConfiguration mocks base method.
+func (m *MockNatsManagerServiceServer) DeleteConfiguration(arg0 context.Context, arg1 *natspb.DeleteConfigurationRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfiguration indicates an expected call of DeleteConfiguration.
+func (mr *MockNatsManagerServiceServerMockRecorder) DeleteConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfiguration", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteConfiguration), arg0, arg1)
+}
+
+// DeleteGlobalKeyValueStore mocks base method.
+func (m *MockNatsManagerServiceServer) DeleteGlobalKeyValueStore(arg0 context.Context, arg1 *natspb.DeleteGlobalKeyValueStoreRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGlobalKeyValueStore", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGlobalKeyValueStore indicates an expected call of DeleteGlobalKeyValueStore.
+func (mr *MockNatsManagerServiceServerMockRecorder) DeleteGlobalKeyValueStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalKeyValueStore", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteGlobalKeyValueStore), arg0, arg1)
+}
+
+// DeleteObject mocks base method.
+func (m *MockNatsManagerServiceServer) DeleteObject(arg0 context.Context, arg1 *natspb.DeleteObjectRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockNatsManagerServiceServerMockRecorder) DeleteObject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteObject), arg0, arg1)
+}
+
+// DeleteObjectStores mocks base method.
+func (m *MockNatsManagerServiceServer) DeleteObjectStores(arg0 context.Context, arg1 *natspb.DeleteObjectStoresRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectStores", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteObjectStores indicates an expected call of DeleteObjectStores.
+func (mr *MockNatsManagerServiceServerMockRecorder) DeleteObjectStores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectStores", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteObjectStores), arg0, arg1)
+}
+
+// DeleteProductObjectStore mocks base method.
+func (m *MockNatsManagerServiceServer) DeleteProductObjectStore(arg0 context.Context, arg1 *natspb.DeleteProductObjectStoreRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductObjectStore", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductObjectStore indicates an expected call of DeleteProductObjectStore.
+func (mr *MockNatsManagerServiceServerMockRecorder) DeleteProductObjectStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductObjectStore", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteProductObjectStore), arg0, arg1)
+}
+
+// DeleteResources mocks base method.
+func (m *MockNatsManagerServiceServer) DeleteResources(arg0 context.Context, arg1 *natspb.DeleteResourcesRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResources", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResources indicates an expected call of DeleteResources.
+func (mr *MockNatsManagerServiceServerMockRecorder) DeleteResources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResources", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteResources), arg0, arg1)
+}
+
+// DeleteStreams mocks base method.
+func (m *MockNatsManagerServiceServer) DeleteStreams(arg0 context.Context, arg1 *natspb.DeleteStreamsRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStreams", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStreams indicates an expected call of DeleteStreams.
+func (mr *MockNatsManagerServiceServerMockRecorder) DeleteStreams(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStreams", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteStreams), arg0, arg1)
+}
+
+// DeleteVersionKeyValueStores mocks base method.
+func (m *MockNatsManagerServiceServer) DeleteVersionKeyValueStores(arg0 context.Context, arg1 *natspb.DeleteVersionKeyValueStoresRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVersionKeyValueStores", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVersionKeyValueStores indicates an expected call of DeleteVersionKeyValueStores.
+func (mr *MockNatsManagerServiceServerMockRecorder) DeleteVersionKeyValueStores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersionKeyValueStores", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).DeleteVersionKeyValueStores), arg0, arg1)
+}
+
+// GetConfiguration mocks base method.
+func (m *MockNatsManagerServiceServer) GetConfiguration(arg0 context.Context, arg1 *natspb.GetConfigurationRequest) (*natspb.GetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.GetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfiguration indicates an expected call of GetConfiguration.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfiguration", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetConfiguration), arg0, arg1)
+}
+
+// GetConfigurationHistory mocks base method.
+func (m *MockNatsManagerServiceServer) GetConfigurationHistory(arg0 context.Context, arg1 *natspb.GetConfigurationHistoryRequest) (*natspb.GetConfigurationHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigurationHistory", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.GetConfigurationHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigurationHistory indicates an expected call of GetConfigurationHistory.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetConfigurationHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigurationHistory", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetConfigurationHistory), arg0, arg1)
+}
+
+// GetDeadLetterMessages mocks base method.
+func (m *MockNatsManagerServiceServer) GetDeadLetterMessages(arg0 context.Context, arg1 *natspb.GetDeadLetterMessagesRequest) (*natspb.GetDeadLetterMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterMessages", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.GetDeadLetterMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterMessages indicates an expected call of GetDeadLetterMessages.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetDeadLetterMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessages", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetDeadLetterMessages), arg0, arg1)
+}
+
+// GetObject mocks base method.
+func (m *MockNatsManagerServiceServer) GetObject(arg0 *natspb.GetObjectRequest, arg1 natspb.NatsManagerService_GetObjectServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetObject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetObject), arg0, arg1)
+}
+
+// GetProcessConfiguration mocks base method.
+func (m *MockNatsManagerServiceServer) GetProcessConfiguration(arg0 context.Context, arg1 *natspb.GetProcessConfigurationRequest) (*natspb.GetProcessConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.GetProcessConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessConfiguration indicates an expected call of GetProcessConfiguration.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetProcessConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessConfiguration", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetProcessConfiguration), arg0, arg1)
+}
+
+// GetProcessConsumerLag mocks base method.
+func (m *MockNatsManagerServiceServer) GetProcessConsumerLag(arg0 context.Context, arg1 *natspb.GetProcessConsumerLagRequest) (*natspb.GetProcessConsumerLagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessConsumerLag", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.GetProcessConsumerLagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessConsumerLag indicates an expected call of GetProcessConsumerLag.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetProcessConsumerLag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessConsumerLag", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetProcessConsumerLag), arg0, arg1)
+}
+
+// GetProcessMessages mocks base method.
+func (m *MockNatsManagerServiceServer) GetProcessMessages(arg0 context.Context, arg1 *natspb.GetProcessMessagesRequest) (*natspb.GetProcessMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessMessages", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.GetProcessMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessMessages indicates an expected call of GetProcessMessages.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetProcessMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessMessages", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetProcessMessages), arg0, arg1)
+}
+
+// GetProductObjectStores mocks base method.
+func (m *MockNatsManagerServiceServer) GetProductObjectStores(arg0 context.Context, arg1 *natspb.GetProductObjectStoresRequest) (*natspb.GetProductObjectStoresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductObjectStores", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.GetProductObjectStoresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductObjectStores indicates an expected call of GetProductObjectStores.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetProductObjectStores(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductObjectStores", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetProductObjectStores), arg0, arg1)
+}
+
+// GetVersionResources mocks base method.
+func (m *MockNatsManagerServiceServer) GetVersionResources(arg0 context.Context, arg1 *natspb.GetVersionResourcesRequest) (*natspb.GetVersionResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionResources", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.GetVersionResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersionResources indicates an expected call of GetVersionResources.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetVersionResources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionResources", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetVersionResources), arg0, arg1)
+}
+
+// GetVersionStreamStats mocks base method.
+func (m *MockNatsManagerServiceServer) GetVersionStreamStats(arg0 context.Context, arg1 *natspb.GetVersionStreamStatsRequest) (*natspb.GetVersionStreamStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionStreamStats", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.GetVersionStreamStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersionStreamStats indicates an expected call of GetVersionStreamStats.
+func (mr *MockNatsManagerServiceServerMockRecorder) GetVersionStreamStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionStreamStats", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).GetVersionStreamStats), arg0, arg1)
+}
+
+// ListObjects mocks base method.
+func (m *MockNatsManagerServiceServer) ListObjects(arg0 context.Context, arg1 *natspb.ListObjectsRequest) (*natspb.ListObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.ListObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockNatsManagerServiceServerMockRecorder) ListObjects(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).ListObjects), arg0, arg1)
+}
+
+// ListResources mocks base method.
+func (m *MockNatsManagerServiceServer) ListResources(arg0 context.Context, arg1 *natspb.ListResourcesRequest) (*natspb.ListResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.ListResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockNatsManagerServiceServerMockRecorder) ListResources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).ListResources), arg0, arg1)
+}
+
+// PublishMessage mocks base method.
+func (m *MockNatsManagerServiceServer) PublishMessage(arg0 context.Context, arg1 *natspb.PublishMessageRequest) (*natspb.PublishMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMessage", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.PublishMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishMessage indicates an expected call of PublishMessage.
+func (mr *MockNatsManagerServiceServerMockRecorder) PublishMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMessage", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).PublishMessage), arg0, arg1)
+}
+
+// PurgeDeadLetterMessages mocks base method.
+func (m *MockNatsManagerServiceServer) PurgeDeadLetterMessages(arg0 context.Context, arg1 *natspb.PurgeDeadLetterMessagesRequest) (*natspb.PurgeDeadLetterMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetterMessages", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.PurgeDeadLetterMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetterMessages indicates an expected call of PurgeDeadLetterMessages.
+func (mr *MockNatsManagerServiceServerMockRecorder) PurgeDeadLetterMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetterMessages", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).PurgeDeadLetterMessages), arg0, arg1)
+}
+
+// PutObject mocks base method.
+func (m *MockNatsManagerServiceServer) PutObject(arg0 natspb.NatsManagerService_PutObjectServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObject", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutObject indicates an expected call of PutObject.
+func (mr *MockNatsManagerServiceServerMockRecorder) PutObject(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).PutObject), arg0)
+}
+
+// ReplayDeadLetterMessages mocks base method.
+func (m *MockNatsManagerServiceServer) ReplayDeadLetterMessages(arg0 context.Context, arg1 *natspb.ReplayDeadLetterMessagesRequest) (*natspb.ReplayDeadLetterMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetterMessages", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.ReplayDeadLetterMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetterMessages indicates an expected call of ReplayDeadLetterMessages.
+func (mr *MockNatsManagerServiceServerMockRecorder) ReplayDeadLetterMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetterMessages", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).ReplayDeadLetterMessages), arg0, arg1)
+}
+
+// RevokeVersionCredentials mocks base method.
+func (m *MockNatsManagerServiceServer) RevokeVersionCredentials(arg0 context.Context, arg1 *natspb.RevokeVersionCredentialsRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeVersionCredentials", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeVersionCredentials indicates an expected call of RevokeVersionCredentials.
+func (mr *MockNatsManagerServiceServerMockRecorder) RevokeVersionCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeVersionCredentials", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).RevokeVersionCredentials), arg0, arg1)
+}
+
+// RollbackConfiguration mocks base method.
+func (m *MockNatsManagerServiceServer) RollbackConfiguration(arg0 context.Context, arg1 *natspb.RollbackConfigurationRequest) (*natspb.RollbackConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.RollbackConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackConfiguration indicates an expected call of RollbackConfiguration.
+func (mr *MockNatsManagerServiceServerMockRecorder) RollbackConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackConfiguration", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).RollbackConfiguration), arg0, arg1)
+}
+
+// SetConfiguration mocks base method.
+func (m *MockNatsManagerServiceServer) SetConfiguration(arg0 context.Context, arg1 *natspb.SetConfigurationRequest) (*natspb.SetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.SetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConfiguration indicates an expected call of SetConfiguration.
+func (mr *MockNatsManagerServiceServerMockRecorder) SetConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfiguration", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).SetConfiguration), arg0, arg1)
+}
+
+// StartShadow mocks base method.
+func (m *MockNatsManagerServiceServer) StartShadow(arg0 context.Context, arg1 *natspb.StartShadowRequest) (*natspb.StartShadowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartShadow", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.StartShadowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartShadow indicates an expected call of StartShadow.
+func (mr *MockNatsManagerServiceServerMockRecorder) StartShadow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShadow", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).StartShadow), arg0, arg1)
+}
+
+// StopShadow mocks base method.
+func (m *MockNatsManagerServiceServer) StopShadow(arg0 context.Context, arg1 *natspb.StopShadowRequest) (*natspb.DeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopShadow", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.DeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopShadow indicates an expected call of StopShadow.
+func (mr *MockNatsManagerServiceServerMockRecorder) StopShadow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopShadow", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).StopShadow), arg0, arg1)
+}
+
+// UpdateKeyValueConfiguration mocks base method.
+func (m *MockNatsManagerServiceServer) UpdateKeyValueConfiguration(arg0 context.Context, arg1 *natspb.UpdateKeyValueConfigurationRequest) (*natspb.UpdateKeyValueConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKeyValueConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*natspb.UpdateKeyValueConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateKeyValueConfiguration indicates an expected call of UpdateKeyValueConfiguration.
+func (mr *MockNatsManagerServiceServerMockRecorder) UpdateKeyValueConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyValueConfiguration", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).UpdateKeyValueConfiguration), arg0, arg1)
+}
+
+// WatchProcessConfiguration mocks base method.
+func (m *MockNatsManagerServiceServer) WatchProcessConfiguration(arg0 *natspb.WatchProcessConfigurationRequest, arg1 natspb.NatsManagerService_WatchProcessConfigurationServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchProcessConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchProcessConfiguration indicates an expected call of WatchProcessConfiguration.
+func (mr *MockNatsManagerServiceServerMockRecorder) WatchProcessConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchProcessConfiguration", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).WatchProcessConfiguration), arg0, arg1)
+}
+
+// mustEmbedUnimplementedNatsManagerServiceServer mocks base method.
+func (m *MockNatsManagerServiceServer) mustEmbedUnimplementedNatsManagerServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedNatsManagerServiceServer")
+}
+
+// mustEmbedUnimplementedNatsManagerServiceServer indicates an expected call of mustEmbedUnimplementedNatsManagerServiceServer.
+func (mr *MockNatsManagerServiceServerMockRecorder) mustEmbedUnimplementedNatsManagerServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedNatsManagerServiceServer", reflect.TypeOf((*MockNatsManagerServiceServer)(nil).mustEmbedUnimplementedNatsManagerServiceServer))
+}
+
+// MockUnsafeNatsManagerServiceServer is a mock of UnsafeNatsManagerServiceServer interface.
+type MockUnsafeNatsManagerServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeNatsManagerServiceServerMockRecorder
+}
+
+// MockUnsafeNatsManagerServiceServerMockRecorder is the mock recorder for MockUnsafeNatsManagerServiceServer.
+type MockUnsafeNatsManagerServiceServerMockRecorder struct {
 	mock *MockUnsafeNatsManagerServiceServer
 }
 
@@ -420,3 +1781,375 @@ func (mr *MockUnsafeNatsManagerServiceServerMockRecorder) mustEmbedUnimplemented
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedNatsManagerServiceServer", reflect.TypeOf((*MockUnsafeNatsManagerServiceServer)(nil).mustEmbedUnimplementedNatsManagerServiceServer))
 }
+
+// MockNatsManagerService_GetObjectServer is a mock of NatsManagerService_GetObjectServer interface.
+type MockNatsManagerService_GetObjectServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNatsManagerService_GetObjectServerMockRecorder
+}
+
+// MockNatsManagerService_GetObjectServerMockRecorder is the mock recorder for MockNatsManagerService_GetObjectServer.
+type MockNatsManagerService_GetObjectServerMockRecorder struct {
+	mock *MockNatsManagerService_GetObjectServer
+}
+
+// NewMockNatsManagerService_GetObjectServer creates a new mock instance.
+func NewMockNatsManagerService_GetObjectServer(ctrl *gomock.Controller) *MockNatsManagerService_GetObjectServer {
+	mock := &MockNatsManagerService_GetObjectServer{ctrl: ctrl}
+	mock.recorder = &MockNatsManagerService_GetObjectServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNatsManagerService_GetObjectServer) EXPECT() *MockNatsManagerService_GetObjectServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockNatsManagerService_GetObjectServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockNatsManagerService_GetObjectServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNatsManagerService_GetObjectServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockNatsManagerService_GetObjectServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockNatsManagerService_GetObjectServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNatsManagerService_GetObjectServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockNatsManagerService_GetObjectServer) Send(arg0 *natspb.ObjectChunk) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockNatsManagerService_GetObjectServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockNatsManagerService_GetObjectServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockNatsManagerService_GetObjectServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockNatsManagerService_GetObjectServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockNatsManagerService_GetObjectServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockNatsManagerService_GetObjectServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockNatsManagerService_GetObjectServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNatsManagerService_GetObjectServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockNatsManagerService_GetObjectServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockNatsManagerService_GetObjectServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNatsManagerService_GetObjectServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockNatsManagerService_GetObjectServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockNatsManagerService_GetObjectServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockNatsManagerService_GetObjectServer)(nil).SetTrailer), arg0)
+}
+
+// MockNatsManagerService_PutObjectServer is a mock of NatsManagerService_PutObjectServer interface.
+type MockNatsManagerService_PutObjectServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNatsManagerService_PutObjectServerMockRecorder
+}
+
+// MockNatsManagerService_PutObjectServerMockRecorder is the mock recorder for MockNatsManagerService_PutObjectServer.
+type MockNatsManagerService_PutObjectServerMockRecorder struct {
+	mock *MockNatsManagerService_PutObjectServer
+}
+
+// NewMockNatsManagerService_PutObjectServer creates a new mock instance.
+func NewMockNatsManagerService_PutObjectServer(ctrl *gomock.Controller) *MockNatsManagerService_PutObjectServer {
+	mock := &MockNatsManagerService_PutObjectServer{ctrl: ctrl}
+	mock.recorder = &MockNatsManagerService_PutObjectServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNatsManagerService_PutObjectServer) EXPECT() *MockNatsManagerService_PutObjectServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockNatsManagerService_PutObjectServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockNatsManagerService_PutObjectServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNatsManagerService_PutObjectServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockNatsManagerService_PutObjectServer) Recv() (*natspb.PutObjectRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*natspb.PutObjectRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockNatsManagerService_PutObjectServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockNatsManagerService_PutObjectServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockNatsManagerService_PutObjectServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockNatsManagerService_PutObjectServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNatsManagerService_PutObjectServer)(nil).RecvMsg), m)
+}
+
+// SendAndClose mocks base method.
+func (m *MockNatsManagerService_PutObjectServer) SendAndClose(arg0 *natspb.PutObjectResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockNatsManagerService_PutObjectServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockNatsManagerService_PutObjectServer)(nil).SendAndClose), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockNatsManagerService_PutObjectServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockNatsManagerService_PutObjectServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockNatsManagerService_PutObjectServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockNatsManagerService_PutObjectServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockNatsManagerService_PutObjectServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNatsManagerService_PutObjectServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockNatsManagerService_PutObjectServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockNatsManagerService_PutObjectServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNatsManagerService_PutObjectServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockNatsManagerService_PutObjectServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockNatsManagerService_PutObjectServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockNatsManagerService_PutObjectServer)(nil).SetTrailer), arg0)
+}
+
+// MockNatsManagerService_WatchProcessConfigurationServer is a mock of NatsManagerService_WatchProcessConfigurationServer interface.
+type MockNatsManagerService_WatchProcessConfigurationServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNatsManagerService_WatchProcessConfigurationServerMockRecorder
+}
+
+// MockNatsManagerService_WatchProcessConfigurationServerMockRecorder is the mock recorder for MockNatsManagerService_WatchProcessConfigurationServer.
+type MockNatsManagerService_WatchProcessConfigurationServerMockRecorder struct {
+	mock *MockNatsManagerService_WatchProcessConfigurationServer
+}
+
+// NewMockNatsManagerService_WatchProcessConfigurationServer creates a new mock instance.
+func NewMockNatsManagerService_WatchProcessConfigurationServer(ctrl *gomock.Controller) *MockNatsManagerService_WatchProcessConfigurationServer {
+	mock := &MockNatsManagerService_WatchProcessConfigurationServer{ctrl: ctrl}
+	mock.recorder = &MockNatsManagerService_WatchProcessConfigurationServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNatsManagerService_WatchProcessConfigurationServer) EXPECT() *MockNatsManagerService_WatchProcessConfigurationServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockNatsManagerService_WatchProcessConfigurationServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockNatsManagerService_WatchProcessConfigurationServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockNatsManagerService_WatchProcessConfigurationServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationServer) Send(arg0 *natspb.ConfigurationEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockNatsManagerService_WatchProcessConfigurationServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockNatsManagerService_WatchProcessConfigurationServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockNatsManagerService_WatchProcessConfigurationServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockNatsManagerService_WatchProcessConfigurationServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockNatsManagerService_WatchProcessConfigurationServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockNatsManagerService_WatchProcessConfigurationServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockNatsManagerService_WatchProcessConfigurationServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockNatsManagerService_WatchProcessConfigurationServer)(nil).SetTrailer), arg0)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admission_policy.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/konstellation-io/kai/engine/admin-api/domain/entity"
+)
+
+// MockAdmissionPolicyRepo is a mock of AdmissionPolicyRepo interface.
+type MockAdmissionPolicyRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdmissionPolicyRepoMockRecorder
+}
+
+// MockAdmissionPolicyRepoMockRecorder is the mock recorder for MockAdmissionPolicyRepo.
+type MockAdmissionPolicyRepoMockRecorder struct {
+	mock *MockAdmissionPolicyRepo
+}
+
+// NewMockAdmissionPolicyRepo creates a new mock instance.
+func NewMockAdmissionPolicyRepo(ctrl *gomock.Controller) *MockAdmissionPolicyRepo {
+	mock := &MockAdmissionPolicyRepo{ctrl: ctrl}
+	mock.recorder = &MockAdmissionPolicyRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdmissionPolicyRepo) EXPECT() *MockAdmissionPolicyRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAdmissionPolicyRepo) Create(ctx context.Context, policy *entity.AdmissionPolicy) (*entity.AdmissionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, policy)
+	ret0, _ := ret[0].(*entity.AdmissionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAdmissionPolicyRepoMockRecorder) Create(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAdmissionPolicyRepo)(nil).Create), ctx, policy)
+}
+
+// Delete mocks base method.
+func (m *MockAdmissionPolicyRepo) Delete(ctx context.Context, policyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, policyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAdmissionPolicyRepoMockRecorder) Delete(ctx, policyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAdmissionPolicyRepo)(nil).Delete), ctx, policyID)
+}
+
+// FindAll mocks base method.
+func (m *MockAdmissionPolicyRepo) FindAll(ctx context.Context) ([]*entity.AdmissionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]*entity.AdmissionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAdmissionPolicyRepoMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAdmissionPolicyRepo)(nil).FindAll), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockAdmissionPolicyRepo) GetByID(ctx context.Context, policyID string) (*entity.AdmissionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, policyID)
+	ret0, _ := ret[0].(*entity.AdmissionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAdmissionPolicyRepoMockRecorder) GetByID(ctx, policyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAdmissionPolicyRepo)(nil).GetByID), ctx, policyID)
+}
+
+// Update mocks base method.
+func (m *MockAdmissionPolicyRepo) Update(ctx context.Context, policy *entity.AdmissionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAdmissionPolicyRepoMockRecorder) Update(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAdmissionPolicyRepo)(nil).Update), ctx, policy)
+}
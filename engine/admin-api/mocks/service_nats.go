@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -65,6 +66,21 @@ func (mr *MockNatsManagerServiceMockRecorder) CreateObjectStores(ctx, product, v
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateObjectStores", reflect.TypeOf((*MockNatsManagerService)(nil).CreateObjectStores), ctx, product, version)
 }
 
+// CreateProductObjectStore mocks base method.
+func (m *MockNatsManagerService) CreateProductObjectStore(ctx context.Context, product, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductObjectStore", ctx, product, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductObjectStore indicates an expected call of CreateProductObjectStore.
+func (mr *MockNatsManagerServiceMockRecorder) CreateProductObjectStore(ctx, product, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductObjectStore", reflect.TypeOf((*MockNatsManagerService)(nil).CreateProductObjectStore), ctx, product, name)
+}
+
 // CreateStreams mocks base method.
 func (m *MockNatsManagerService) CreateStreams(ctx context.Context, product string, version *entity.Version) (*entity.VersionStreams, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +96,21 @@ func (mr *MockNatsManagerServiceMockRecorder) CreateStreams(ctx, product, versio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreams", reflect.TypeOf((*MockNatsManagerService)(nil).CreateStreams), ctx, product, version)
 }
 
+// CreateVersionCredentials mocks base method.
+func (m *MockNatsManagerService) CreateVersionCredentials(ctx context.Context, product string, version *entity.Version) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVersionCredentials", ctx, product, version)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVersionCredentials indicates an expected call of CreateVersionCredentials.
+func (mr *MockNatsManagerServiceMockRecorder) CreateVersionCredentials(ctx, product, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionCredentials", reflect.TypeOf((*MockNatsManagerService)(nil).CreateVersionCredentials), ctx, product, version)
+}
+
 // CreateVersionKeyValueStores mocks base method.
 func (m *MockNatsManagerService) CreateVersionKeyValueStores(ctx context.Context, product string, version *entity.Version) (*entity.KeyValueStores, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +126,20 @@ func (mr *MockNatsManagerServiceMockRecorder) CreateVersionKeyValueStores(ctx, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionKeyValueStores", reflect.TypeOf((*MockNatsManagerService)(nil).CreateVersionKeyValueStores), ctx, product, version)
 }
 
+// DeleteConfiguration mocks base method.
+func (m *MockNatsManagerService) DeleteConfiguration(ctx context.Context, store entity.ConfigurationStore, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfiguration", ctx, store, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConfiguration indicates an expected call of DeleteConfiguration.
+func (mr *MockNatsManagerServiceMockRecorder) DeleteConfiguration(ctx, store, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfiguration", reflect.TypeOf((*MockNatsManagerService)(nil).DeleteConfiguration), ctx, store, key)
+}
+
 // DeleteGlobalKeyValueStore mocks base method.
 func (m *MockNatsManagerService) DeleteGlobalKeyValueStore(ctx context.Context, product string) error {
 	m.ctrl.T.Helper()
@@ -109,6 +154,20 @@ func (mr *MockNatsManagerServiceMockRecorder) DeleteGlobalKeyValueStore(ctx, pro
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalKeyValueStore", reflect.TypeOf((*MockNatsManagerService)(nil).DeleteGlobalKeyValueStore), ctx, product)
 }
 
+// DeleteObject mocks base method.
+func (m *MockNatsManagerService) DeleteObject(ctx context.Context, product, objectStore, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", ctx, product, objectStore, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockNatsManagerServiceMockRecorder) DeleteObject(ctx, product, objectStore, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockNatsManagerService)(nil).DeleteObject), ctx, product, objectStore, name)
+}
+
 // DeleteObjectStores mocks base method.
 func (m *MockNatsManagerService) DeleteObjectStores(ctx context.Context, product, versionTag string) error {
 	m.ctrl.T.Helper()
@@ -123,6 +182,34 @@ func (mr *MockNatsManagerServiceMockRecorder) DeleteObjectStores(ctx, product, v
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectStores", reflect.TypeOf((*MockNatsManagerService)(nil).DeleteObjectStores), ctx, product, versionTag)
 }
 
+// DeleteProductObjectStore mocks base method.
+func (m *MockNatsManagerService) DeleteProductObjectStore(ctx context.Context, product, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductObjectStore", ctx, product, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductObjectStore indicates an expected call of DeleteProductObjectStore.
+func (mr *MockNatsManagerServiceMockRecorder) DeleteProductObjectStore(ctx, product, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductObjectStore", reflect.TypeOf((*MockNatsManagerService)(nil).DeleteProductObjectStore), ctx, product, name)
+}
+
+// DeleteResources mocks base method.
+func (m *MockNatsManagerService) DeleteResources(ctx context.Context, resources []*entity.NatsResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResources", ctx, resources)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResources indicates an expected call of DeleteResources.
+func (mr *MockNatsManagerServiceMockRecorder) DeleteResources(ctx, resources interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResources", reflect.TypeOf((*MockNatsManagerService)(nil).DeleteResources), ctx, resources)
+}
+
 // DeleteStreams mocks base method.
 func (m *MockNatsManagerService) DeleteStreams(ctx context.Context, product, versionTag string) error {
 	m.ctrl.T.Helper()
@@ -151,6 +238,286 @@ func (mr *MockNatsManagerServiceMockRecorder) DeleteVersionKeyValueStores(ctx, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersionKeyValueStores", reflect.TypeOf((*MockNatsManagerService)(nil).DeleteVersionKeyValueStores), ctx, product, version)
 }
 
+// DownloadObject mocks base method.
+func (m *MockNatsManagerService) DownloadObject(ctx context.Context, product, objectStore, name string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadObject", ctx, product, objectStore, name, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadObject indicates an expected call of DownloadObject.
+func (mr *MockNatsManagerServiceMockRecorder) DownloadObject(ctx, product, objectStore, name, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadObject", reflect.TypeOf((*MockNatsManagerService)(nil).DownloadObject), ctx, product, objectStore, name, w)
+}
+
+// GetConfiguration mocks base method.
+func (m *MockNatsManagerService) GetConfiguration(ctx context.Context, store entity.ConfigurationStore) ([]*entity.ConfigurationEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfiguration", ctx, store)
+	ret0, _ := ret[0].([]*entity.ConfigurationEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfiguration indicates an expected call of GetConfiguration.
+func (mr *MockNatsManagerServiceMockRecorder) GetConfiguration(ctx, store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfiguration", reflect.TypeOf((*MockNatsManagerService)(nil).GetConfiguration), ctx, store)
+}
+
+// GetConfigurationHistory mocks base method.
+func (m *MockNatsManagerService) GetConfigurationHistory(ctx context.Context, store entity.ConfigurationStore, key string) ([]*entity.ConfigurationRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigurationHistory", ctx, store, key)
+	ret0, _ := ret[0].([]*entity.ConfigurationRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigurationHistory indicates an expected call of GetConfigurationHistory.
+func (mr *MockNatsManagerServiceMockRecorder) GetConfigurationHistory(ctx, store, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigurationHistory", reflect.TypeOf((*MockNatsManagerService)(nil).GetConfigurationHistory), ctx, store, key)
+}
+
+// GetDeadLetterMessages mocks base method.
+func (m *MockNatsManagerService) GetDeadLetterMessages(ctx context.Context, product, versionTag, workflow string) ([]*entity.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterMessages", ctx, product, versionTag, workflow)
+	ret0, _ := ret[0].([]*entity.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterMessages indicates an expected call of GetDeadLetterMessages.
+func (mr *MockNatsManagerServiceMockRecorder) GetDeadLetterMessages(ctx, product, versionTag, workflow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessages", reflect.TypeOf((*MockNatsManagerService)(nil).GetDeadLetterMessages), ctx, product, versionTag, workflow)
+}
+
+// GetProcessConfiguration mocks base method.
+func (m *MockNatsManagerService) GetProcessConfiguration(ctx context.Context, product, versionTag, workflow, process string) ([]*entity.ConfigurationEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessConfiguration", ctx, product, versionTag, workflow, process)
+	ret0, _ := ret[0].([]*entity.ConfigurationEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessConfiguration indicates an expected call of GetProcessConfiguration.
+func (mr *MockNatsManagerServiceMockRecorder) GetProcessConfiguration(ctx, product, versionTag, workflow, process interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessConfiguration", reflect.TypeOf((*MockNatsManagerService)(nil).GetProcessConfiguration), ctx, product, versionTag, workflow, process)
+}
+
+// GetProcessMessages mocks base method.
+func (m *MockNatsManagerService) GetProcessMessages(ctx context.Context, product, versionTag, workflow, process string, count int) ([]*entity.StreamMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessMessages", ctx, product, versionTag, workflow, process, count)
+	ret0, _ := ret[0].([]*entity.StreamMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessMessages indicates an expected call of GetProcessMessages.
+func (mr *MockNatsManagerServiceMockRecorder) GetProcessMessages(ctx, product, versionTag, workflow, process, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessMessages", reflect.TypeOf((*MockNatsManagerService)(nil).GetProcessMessages), ctx, product, versionTag, workflow, process, count)
+}
+
+// GetProductObjectStores mocks base method.
+func (m *MockNatsManagerService) GetProductObjectStores(ctx context.Context, product string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductObjectStores", ctx, product)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductObjectStores indicates an expected call of GetProductObjectStores.
+func (mr *MockNatsManagerServiceMockRecorder) GetProductObjectStores(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductObjectStores", reflect.TypeOf((*MockNatsManagerService)(nil).GetProductObjectStores), ctx, product)
+}
+
+// GetVersionResources mocks base method.
+func (m *MockNatsManagerService) GetVersionResources(ctx context.Context, product string, version *entity.Version) (*entity.VersionStreamingResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionResources", ctx, product, version)
+	ret0, _ := ret[0].(*entity.VersionStreamingResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersionResources indicates an expected call of GetVersionResources.
+func (mr *MockNatsManagerServiceMockRecorder) GetVersionResources(ctx, product, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionResources", reflect.TypeOf((*MockNatsManagerService)(nil).GetVersionResources), ctx, product, version)
+}
+
+// GetVersionStreamStats mocks base method.
+func (m *MockNatsManagerService) GetVersionStreamStats(ctx context.Context, product string, version *entity.Version) ([]*entity.WorkflowStreamStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionStreamStats", ctx, product, version)
+	ret0, _ := ret[0].([]*entity.WorkflowStreamStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersionStreamStats indicates an expected call of GetVersionStreamStats.
+func (mr *MockNatsManagerServiceMockRecorder) GetVersionStreamStats(ctx, product, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionStreamStats", reflect.TypeOf((*MockNatsManagerService)(nil).GetVersionStreamStats), ctx, product, version)
+}
+
+// ListObjects mocks base method.
+func (m *MockNatsManagerService) ListObjects(ctx context.Context, product, objectStore string) ([]*entity.ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", ctx, product, objectStore)
+	ret0, _ := ret[0].([]*entity.ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockNatsManagerServiceMockRecorder) ListObjects(ctx, product, objectStore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockNatsManagerService)(nil).ListObjects), ctx, product, objectStore)
+}
+
+// ListResources mocks base method.
+func (m *MockNatsManagerService) ListResources(ctx context.Context) ([]*entity.NatsResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", ctx)
+	ret0, _ := ret[0].([]*entity.NatsResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockNatsManagerServiceMockRecorder) ListResources(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockNatsManagerService)(nil).ListResources), ctx)
+}
+
+// PublishMessage mocks base method.
+func (m *MockNatsManagerService) PublishMessage(ctx context.Context, product, versionTag, subject string, headers map[string]string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMessage", ctx, product, versionTag, subject, headers, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishMessage indicates an expected call of PublishMessage.
+func (mr *MockNatsManagerServiceMockRecorder) PublishMessage(ctx, product, versionTag, subject, headers, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMessage", reflect.TypeOf((*MockNatsManagerService)(nil).PublishMessage), ctx, product, versionTag, subject, headers, data)
+}
+
+// PurgeDeadLetterMessages mocks base method.
+func (m *MockNatsManagerService) PurgeDeadLetterMessages(ctx context.Context, product, versionTag, workflow string, sequences []uint64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetterMessages", ctx, product, versionTag, workflow, sequences)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetterMessages indicates an expected call of PurgeDeadLetterMessages.
+func (mr *MockNatsManagerServiceMockRecorder) PurgeDeadLetterMessages(ctx, product, versionTag, workflow, sequences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetterMessages", reflect.TypeOf((*MockNatsManagerService)(nil).PurgeDeadLetterMessages), ctx, product, versionTag, workflow, sequences)
+}
+
+// ReplayDeadLetterMessages mocks base method.
+func (m *MockNatsManagerService) ReplayDeadLetterMessages(ctx context.Context, product, versionTag, workflow string, sequences []uint64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetterMessages", ctx, product, versionTag, workflow, sequences)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetterMessages indicates an expected call of ReplayDeadLetterMessages.
+func (mr *MockNatsManagerServiceMockRecorder) ReplayDeadLetterMessages(ctx, product, versionTag, workflow, sequences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetterMessages", reflect.TypeOf((*MockNatsManagerService)(nil).ReplayDeadLetterMessages), ctx, product, versionTag, workflow, sequences)
+}
+
+// RevokeVersionCredentials mocks base method.
+func (m *MockNatsManagerService) RevokeVersionCredentials(ctx context.Context, product, versionTag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeVersionCredentials", ctx, product, versionTag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeVersionCredentials indicates an expected call of RevokeVersionCredentials.
+func (mr *MockNatsManagerServiceMockRecorder) RevokeVersionCredentials(ctx, product, versionTag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeVersionCredentials", reflect.TypeOf((*MockNatsManagerService)(nil).RevokeVersionCredentials), ctx, product, versionTag)
+}
+
+// RollbackConfiguration mocks base method.
+func (m *MockNatsManagerService) RollbackConfiguration(ctx context.Context, store entity.ConfigurationStore, key string, revision uint64) (*entity.ConfigurationRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackConfiguration", ctx, store, key, revision)
+	ret0, _ := ret[0].(*entity.ConfigurationRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackConfiguration indicates an expected call of RollbackConfiguration.
+func (mr *MockNatsManagerServiceMockRecorder) RollbackConfiguration(ctx, store, key, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackConfiguration", reflect.TypeOf((*MockNatsManagerService)(nil).RollbackConfiguration), ctx, store, key, revision)
+}
+
+// SetConfiguration mocks base method.
+func (m *MockNatsManagerService) SetConfiguration(ctx context.Context, store entity.ConfigurationStore, key, value string) (*entity.ConfigurationEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConfiguration", ctx, store, key, value)
+	ret0, _ := ret[0].(*entity.ConfigurationEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConfiguration indicates an expected call of SetConfiguration.
+func (mr *MockNatsManagerServiceMockRecorder) SetConfiguration(ctx, store, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfiguration", reflect.TypeOf((*MockNatsManagerService)(nil).SetConfiguration), ctx, store, key, value)
+}
+
+// StartShadow mocks base method.
+func (m *MockNatsManagerService) StartShadow(ctx context.Context, product, publishedVersionTag, candidateVersionTag string, workflows []entity.ShadowWorkflow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartShadow", ctx, product, publishedVersionTag, candidateVersionTag, workflows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartShadow indicates an expected call of StartShadow.
+func (mr *MockNatsManagerServiceMockRecorder) StartShadow(ctx, product, publishedVersionTag, candidateVersionTag, workflows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShadow", reflect.TypeOf((*MockNatsManagerService)(nil).StartShadow), ctx, product, publishedVersionTag, candidateVersionTag, workflows)
+}
+
+// StopShadow mocks base method.
+func (m *MockNatsManagerService) StopShadow(ctx context.Context, product, publishedVersionTag, candidateVersionTag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopShadow", ctx, product, publishedVersionTag, candidateVersionTag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopShadow indicates an expected call of StopShadow.
+func (mr *MockNatsManagerServiceMockRecorder) StopShadow(ctx, product, publishedVersionTag, candidateVersionTag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopShadow", reflect.TypeOf((*MockNatsManagerService)(nil).StopShadow), ctx, product, publishedVersionTag, candidateVersionTag)
+}
+
 // UpdateKeyValueConfiguration mocks base method.
 func (m *MockNatsManagerService) UpdateKeyValueConfiguration(ctx context.Context, configurations []entity.KeyValueConfiguration) error {
 	m.ctrl.T.Helper()
@@ -164,3 +531,33 @@ func (mr *MockNatsManagerServiceMockRecorder) UpdateKeyValueConfiguration(ctx, c
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyValueConfiguration", reflect.TypeOf((*MockNatsManagerService)(nil).UpdateKeyValueConfiguration), ctx, configurations)
 }
+
+// UploadObject mocks base method.
+func (m *MockNatsManagerService) UploadObject(ctx context.Context, product, objectStore, name string, r io.Reader) (*entity.ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadObject", ctx, product, objectStore, name, r)
+	ret0, _ := ret[0].(*entity.ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadObject indicates an expected call of UploadObject.
+func (mr *MockNatsManagerServiceMockRecorder) UploadObject(ctx, product, objectStore, name, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadObject", reflect.TypeOf((*MockNatsManagerService)(nil).UploadObject), ctx, product, objectStore, name, r)
+}
+
+// WatchProcessConfiguration mocks base method.
+func (m *MockNatsManagerService) WatchProcessConfiguration(ctx context.Context, product, versionTag, workflow, process string) (<-chan *entity.ConfigurationEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchProcessConfiguration", ctx, product, versionTag, workflow, process)
+	ret0, _ := ret[0].(<-chan *entity.ConfigurationEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchProcessConfiguration indicates an expected call of WatchProcessConfiguration.
+func (mr *MockNatsManagerServiceMockRecorder) WatchProcessConfiguration(ctx, product, versionTag, workflow, process interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchProcessConfiguration", reflect.TypeOf((*MockNatsManagerService)(nil).WatchProcessConfiguration), ctx, product, versionTag, workflow, process)
+}
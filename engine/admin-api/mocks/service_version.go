@@ -35,6 +35,49 @@ func (m *MockVersionService) EXPECT() *MockVersionServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateProductNamespace mocks base method.
+func (m *MockVersionService) CreateProductNamespace(ctx context.Context, product *entity.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductNamespace", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateProductNamespace indicates an expected call of CreateProductNamespace.
+func (mr *MockVersionServiceMockRecorder) CreateProductNamespace(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductNamespace", reflect.TypeOf((*MockVersionService)(nil).CreateProductNamespace), ctx, product)
+}
+
+// DeleteProductNamespace mocks base method.
+func (m *MockVersionService) DeleteProductNamespace(ctx context.Context, productID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductNamespace", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductNamespace indicates an expected call of DeleteProductNamespace.
+func (mr *MockVersionServiceMockRecorder) DeleteProductNamespace(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductNamespace", reflect.TypeOf((*MockVersionService)(nil).DeleteProductNamespace), ctx, productID)
+}
+
+// GetProcessStatus mocks base method.
+func (m *MockVersionService) GetProcessStatus(ctx context.Context, productID, versionTag string) ([]*entity.ProcessStatusReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessStatus", ctx, productID, versionTag)
+	ret0, _ := ret[0].([]*entity.ProcessStatusReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessStatus indicates an expected call of GetProcessStatus.
+func (mr *MockVersionServiceMockRecorder) GetProcessStatus(ctx, productID, versionTag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessStatus", reflect.TypeOf((*MockVersionService)(nil).GetProcessStatus), ctx, productID, versionTag)
+}
+
 // GetPublishedTriggers mocks base method.
 func (m *MockVersionService) GetPublishedTriggers(ctx context.Context, productID string) ([]entity.PublishedTrigger, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +123,50 @@ func (mr *MockVersionServiceMockRecorder) RegisterProcess(ctx, productID, proces
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterProcess", reflect.TypeOf((*MockVersionService)(nil).RegisterProcess), ctx, productID, processID, processImage)
 }
 
+// RenderManifests mocks base method.
+func (m *MockVersionService) RenderManifests(ctx context.Context, product *entity.Product, version *entity.Version, versionConfig *entity.VersionStreamingResources) ([]*entity.KubernetesManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderManifests", ctx, product, version, versionConfig)
+	ret0, _ := ret[0].([]*entity.KubernetesManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderManifests indicates an expected call of RenderManifests.
+func (mr *MockVersionServiceMockRecorder) RenderManifests(ctx, product, version, versionConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderManifests", reflect.TypeOf((*MockVersionService)(nil).RenderManifests), ctx, product, version, versionConfig)
+}
+
+// RunWorkflow mocks base method.
+func (m *MockVersionService) RunWorkflow(ctx context.Context, productID, versionTag, workflow string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunWorkflow", ctx, productID, versionTag, workflow)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunWorkflow indicates an expected call of RunWorkflow.
+func (mr *MockVersionServiceMockRecorder) RunWorkflow(ctx, productID, versionTag, workflow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWorkflow", reflect.TypeOf((*MockVersionService)(nil).RunWorkflow), ctx, productID, versionTag, workflow)
+}
+
+// ScaleProcess mocks base method.
+func (m *MockVersionService) ScaleProcess(ctx context.Context, productID, versionTag string, scaling *entity.ProcessScaling) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleProcess", ctx, productID, versionTag, scaling)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScaleProcess indicates an expected call of ScaleProcess.
+func (mr *MockVersionServiceMockRecorder) ScaleProcess(ctx, productID, versionTag, scaling interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleProcess", reflect.TypeOf((*MockVersionService)(nil).ScaleProcess), ctx, productID, versionTag, scaling)
+}
+
 // Start mocks base method.
 func (m *MockVersionService) Start(ctx context.Context, product *entity.Product, version *entity.Version, versionConfig *entity.VersionStreamingResources) error {
 	m.ctrl.T.Helper()
@@ -122,6 +209,34 @@ func (mr *MockVersionServiceMockRecorder) Unpublish(ctx, productID, version inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpublish", reflect.TypeOf((*MockVersionService)(nil).Unpublish), ctx, productID, version)
 }
 
+// UpdateProcessImage mocks base method.
+func (m *MockVersionService) UpdateProcessImage(ctx context.Context, productID, versionTag string, patch *entity.VersionPatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProcessImage", ctx, productID, versionTag, patch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProcessImage indicates an expected call of UpdateProcessImage.
+func (mr *MockVersionServiceMockRecorder) UpdateProcessImage(ctx, productID, versionTag, patch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProcessImage", reflect.TypeOf((*MockVersionService)(nil).UpdateProcessImage), ctx, productID, versionTag, patch)
+}
+
+// UpdateProductNamespace mocks base method.
+func (m *MockVersionService) UpdateProductNamespace(ctx context.Context, product *entity.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductNamespace", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductNamespace indicates an expected call of UpdateProductNamespace.
+func (mr *MockVersionServiceMockRecorder) UpdateProductNamespace(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductNamespace", reflect.TypeOf((*MockVersionService)(nil).UpdateProductNamespace), ctx, product)
+}
+
 // WatchProcessStatus mocks base method.
 func (m *MockVersionService) WatchProcessStatus(ctx context.Context, productID, versionTag string) (<-chan *entity.Process, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +79,76 @@ func (mr *MockUserActivityInteracterMockRecorder) RegisterCreateProduct(userID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCreateProduct", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterCreateProduct), userID, product)
 }
 
+// RegisterCreateProductObjectStoreAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterCreateProductObjectStoreAction(userID, productID, name, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterCreateProductObjectStoreAction", userID, productID, name, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterCreateProductObjectStoreAction indicates an expected call of RegisterCreateProductObjectStoreAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterCreateProductObjectStoreAction(userID, productID, name, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCreateProductObjectStoreAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterCreateProductObjectStoreAction), userID, productID, name, comment)
+}
+
+// RegisterDeleteObjectAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterDeleteObjectAction(userID, productID, objectStore, name, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDeleteObjectAction", userID, productID, objectStore, name, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterDeleteObjectAction indicates an expected call of RegisterDeleteObjectAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterDeleteObjectAction(userID, productID, objectStore, name, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDeleteObjectAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterDeleteObjectAction), userID, productID, objectStore, name, comment)
+}
+
+// RegisterDeleteProductConfigurationAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterDeleteProductConfigurationAction(userID, productID, key, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDeleteProductConfigurationAction", userID, productID, key, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterDeleteProductConfigurationAction indicates an expected call of RegisterDeleteProductConfigurationAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterDeleteProductConfigurationAction(userID, productID, key, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDeleteProductConfigurationAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterDeleteProductConfigurationAction), userID, productID, key, comment)
+}
+
+// RegisterDeleteProductObjectStoreAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterDeleteProductObjectStoreAction(userID, productID, name, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDeleteProductObjectStoreAction", userID, productID, name, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterDeleteProductObjectStoreAction indicates an expected call of RegisterDeleteProductObjectStoreAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterDeleteProductObjectStoreAction(userID, productID, name, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDeleteProductObjectStoreAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterDeleteProductObjectStoreAction), userID, productID, name, comment)
+}
+
+// RegisterInjectMessageAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterInjectMessageAction(userID, productID string, version *entity.Version, subject, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterInjectMessageAction", userID, productID, version, subject, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterInjectMessageAction indicates an expected call of RegisterInjectMessageAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterInjectMessageAction(userID, productID, version, subject, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterInjectMessageAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterInjectMessageAction), userID, productID, version, subject, comment)
+}
+
 // RegisterPublishAction mocks base method.
 func (m *MockUserActivityInteracter) RegisterPublishAction(userID, productID string, version *entity.Version, comment string) error {
 	m.ctrl.T.Helper()
@@ -93,6 +163,90 @@ func (mr *MockUserActivityInteracterMockRecorder) RegisterPublishAction(userID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterPublishAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterPublishAction), userID, productID, version, comment)
 }
 
+// RegisterPurgeDeadLettersAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterPurgeDeadLettersAction(userID, productID string, version *entity.Version, workflow string, sequences []uint64, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterPurgeDeadLettersAction", userID, productID, version, workflow, sequences, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterPurgeDeadLettersAction indicates an expected call of RegisterPurgeDeadLettersAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterPurgeDeadLettersAction(userID, productID, version, workflow, sequences, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterPurgeDeadLettersAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterPurgeDeadLettersAction), userID, productID, version, workflow, sequences, comment)
+}
+
+// RegisterReplayDeadLettersAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterReplayDeadLettersAction(userID, productID string, version *entity.Version, workflow string, sequences []uint64, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterReplayDeadLettersAction", userID, productID, version, workflow, sequences, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterReplayDeadLettersAction indicates an expected call of RegisterReplayDeadLettersAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterReplayDeadLettersAction(userID, productID, version, workflow, sequences, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterReplayDeadLettersAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterReplayDeadLettersAction), userID, productID, version, workflow, sequences, comment)
+}
+
+// RegisterRollbackConfigurationAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterRollbackConfigurationAction(userID string, store entity.ConfigurationStore, key string, revision uint64, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterRollbackConfigurationAction", userID, store, key, revision, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterRollbackConfigurationAction indicates an expected call of RegisterRollbackConfigurationAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterRollbackConfigurationAction(userID, store, key, revision, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRollbackConfigurationAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterRollbackConfigurationAction), userID, store, key, revision, comment)
+}
+
+// RegisterRunWorkflowAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterRunWorkflowAction(userID, productID string, version *entity.Version, workflow, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterRunWorkflowAction", userID, productID, version, workflow, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterRunWorkflowAction indicates an expected call of RegisterRunWorkflowAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterRunWorkflowAction(userID, productID, version, workflow, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRunWorkflowAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterRunWorkflowAction), userID, productID, version, workflow, comment)
+}
+
+// RegisterScaleProcessAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterScaleProcessAction(userID, productID string, version *entity.Version, scaling *entity.ProcessScaling, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterScaleProcessAction", userID, productID, version, scaling, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterScaleProcessAction indicates an expected call of RegisterScaleProcessAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterScaleProcessAction(userID, productID, version, scaling, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterScaleProcessAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterScaleProcessAction), userID, productID, version, scaling, comment)
+}
+
+// RegisterSetProductConfigurationAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterSetProductConfigurationAction(userID, productID, key, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSetProductConfigurationAction", userID, productID, key, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterSetProductConfigurationAction indicates an expected call of RegisterSetProductConfigurationAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterSetProductConfigurationAction(userID, productID, key, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSetProductConfigurationAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterSetProductConfigurationAction), userID, productID, key, comment)
+}
+
 // RegisterStartAction mocks base method.
 func (m *MockUserActivityInteracter) RegisterStartAction(userID, productID string, version *entity.Version, comment string) error {
 	m.ctrl.T.Helper()
@@ -107,6 +261,20 @@ func (mr *MockUserActivityInteracterMockRecorder) RegisterStartAction(userID, pr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterStartAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterStartAction), userID, productID, version, comment)
 }
 
+// RegisterStartShadowAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterStartShadowAction(userID, productID string, version *entity.Version, publishedVersion, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterStartShadowAction", userID, productID, version, publishedVersion, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterStartShadowAction indicates an expected call of RegisterStartShadowAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterStartShadowAction(userID, productID, version, publishedVersion, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterStartShadowAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterStartShadowAction), userID, productID, version, publishedVersion, comment)
+}
+
 // RegisterStopAction mocks base method.
 func (m *MockUserActivityInteracter) RegisterStopAction(userID, productID string, version *entity.Version, comment string) error {
 	m.ctrl.T.Helper()
@@ -121,6 +289,20 @@ func (mr *MockUserActivityInteracterMockRecorder) RegisterStopAction(userID, pro
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterStopAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterStopAction), userID, productID, version, comment)
 }
 
+// RegisterStopShadowAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterStopShadowAction(userID, productID string, version *entity.Version, publishedVersion, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterStopShadowAction", userID, productID, version, publishedVersion, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterStopShadowAction indicates an expected call of RegisterStopShadowAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterStopShadowAction(userID, productID, version, publishedVersion, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterStopShadowAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterStopShadowAction), userID, productID, version, publishedVersion, comment)
+}
+
 // RegisterUnpublishAction mocks base method.
 func (m *MockUserActivityInteracter) RegisterUnpublishAction(userID, productID string, version *entity.Version, comment string) error {
 	m.ctrl.T.Helper()
@@ -135,6 +317,20 @@ func (mr *MockUserActivityInteracterMockRecorder) RegisterUnpublishAction(userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUnpublishAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterUnpublishAction), userID, productID, version, comment)
 }
 
+// RegisterUpdateProcessImageAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterUpdateProcessImageAction(userID, productID string, version *entity.Version, patch *entity.VersionPatch, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterUpdateProcessImageAction", userID, productID, version, patch, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterUpdateProcessImageAction indicates an expected call of RegisterUpdateProcessImageAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterUpdateProcessImageAction(userID, productID, version, patch, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUpdateProcessImageAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterUpdateProcessImageAction), userID, productID, version, patch, comment)
+}
+
 // RegisterUpdateProductGrants mocks base method.
 func (m *MockUserActivityInteracter) RegisterUpdateProductGrants(userID, targetUserID, product string, productGrants []auth.Action, comment string) error {
 	m.ctrl.T.Helper()
@@ -148,3 +344,17 @@ func (mr *MockUserActivityInteracterMockRecorder) RegisterUpdateProductGrants(us
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUpdateProductGrants", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterUpdateProductGrants), userID, targetUserID, product, productGrants, comment)
 }
+
+// RegisterUploadObjectAction mocks base method.
+func (m *MockUserActivityInteracter) RegisterUploadObjectAction(userID, productID, objectStore, name, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterUploadObjectAction", userID, productID, objectStore, name, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterUploadObjectAction indicates an expected call of RegisterUploadObjectAction.
+func (mr *MockUserActivityInteracterMockRecorder) RegisterUploadObjectAction(userID, productID, objectStore, name, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUploadObjectAction", reflect.TypeOf((*MockUserActivityInteracter)(nil).RegisterUploadObjectAction), userID, productID, objectStore, name, comment)
+}
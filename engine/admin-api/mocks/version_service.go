@@ -37,6 +37,66 @@ func (m *MockVersionServiceClient) EXPECT() *MockVersionServiceClientMockRecorde
 	return m.recorder
 }
 
+// CreateProductNamespace mocks base method.
+func (m *MockVersionServiceClient) CreateProductNamespace(ctx context.Context, in *versionpb.CreateProductNamespaceRequest, opts ...grpc.CallOption) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateProductNamespace", varargs...)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductNamespace indicates an expected call of CreateProductNamespace.
+func (mr *MockVersionServiceClientMockRecorder) CreateProductNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductNamespace", reflect.TypeOf((*MockVersionServiceClient)(nil).CreateProductNamespace), varargs...)
+}
+
+// DeleteProductNamespace mocks base method.
+func (m *MockVersionServiceClient) DeleteProductNamespace(ctx context.Context, in *versionpb.DeleteProductNamespaceRequest, opts ...grpc.CallOption) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteProductNamespace", varargs...)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductNamespace indicates an expected call of DeleteProductNamespace.
+func (mr *MockVersionServiceClientMockRecorder) DeleteProductNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductNamespace", reflect.TypeOf((*MockVersionServiceClient)(nil).DeleteProductNamespace), varargs...)
+}
+
+// GetProcessStatus mocks base method.
+func (m *MockVersionServiceClient) GetProcessStatus(ctx context.Context, in *versionpb.ProcessStatusRequest, opts ...grpc.CallOption) (*versionpb.GetProcessStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProcessStatus", varargs...)
+	ret0, _ := ret[0].(*versionpb.GetProcessStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessStatus indicates an expected call of GetProcessStatus.
+func (mr *MockVersionServiceClientMockRecorder) GetProcessStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessStatus", reflect.TypeOf((*MockVersionServiceClient)(nil).GetProcessStatus), varargs...)
+}
+
 // GetPublishedTriggers mocks base method.
 func (m *MockVersionServiceClient) GetPublishedTriggers(ctx context.Context, in *versionpb.GetPublishedTriggersRequest, opts ...grpc.CallOption) (*versionpb.PublishResponse, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +157,66 @@ func (mr *MockVersionServiceClientMockRecorder) RegisterProcess(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterProcess", reflect.TypeOf((*MockVersionServiceClient)(nil).RegisterProcess), varargs...)
 }
 
+// RenderManifests mocks base method.
+func (m *MockVersionServiceClient) RenderManifests(ctx context.Context, in *versionpb.StartRequest, opts ...grpc.CallOption) (*versionpb.RenderManifestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenderManifests", varargs...)
+	ret0, _ := ret[0].(*versionpb.RenderManifestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderManifests indicates an expected call of RenderManifests.
+func (mr *MockVersionServiceClientMockRecorder) RenderManifests(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderManifests", reflect.TypeOf((*MockVersionServiceClient)(nil).RenderManifests), varargs...)
+}
+
+// RunWorkflow mocks base method.
+func (m *MockVersionServiceClient) RunWorkflow(ctx context.Context, in *versionpb.RunWorkflowRequest, opts ...grpc.CallOption) (*versionpb.RunWorkflowResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunWorkflow", varargs...)
+	ret0, _ := ret[0].(*versionpb.RunWorkflowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunWorkflow indicates an expected call of RunWorkflow.
+func (mr *MockVersionServiceClientMockRecorder) RunWorkflow(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWorkflow", reflect.TypeOf((*MockVersionServiceClient)(nil).RunWorkflow), varargs...)
+}
+
+// ScaleProcess mocks base method.
+func (m *MockVersionServiceClient) ScaleProcess(ctx context.Context, in *versionpb.ScaleProcessRequest, opts ...grpc.CallOption) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScaleProcess", varargs...)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleProcess indicates an expected call of ScaleProcess.
+func (mr *MockVersionServiceClientMockRecorder) ScaleProcess(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleProcess", reflect.TypeOf((*MockVersionServiceClient)(nil).ScaleProcess), varargs...)
+}
+
 // Start mocks base method.
 func (m *MockVersionServiceClient) Start(ctx context.Context, in *versionpb.StartRequest, opts ...grpc.CallOption) (*versionpb.Response, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +277,46 @@ func (mr *MockVersionServiceClientMockRecorder) Unpublish(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpublish", reflect.TypeOf((*MockVersionServiceClient)(nil).Unpublish), varargs...)
 }
 
+// UpdateProcessImage mocks base method.
+func (m *MockVersionServiceClient) UpdateProcessImage(ctx context.Context, in *versionpb.UpdateProcessImageRequest, opts ...grpc.CallOption) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProcessImage", varargs...)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProcessImage indicates an expected call of UpdateProcessImage.
+func (mr *MockVersionServiceClientMockRecorder) UpdateProcessImage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProcessImage", reflect.TypeOf((*MockVersionServiceClient)(nil).UpdateProcessImage), varargs...)
+}
+
+// UpdateProductNamespace mocks base method.
+func (m *MockVersionServiceClient) UpdateProductNamespace(ctx context.Context, in *versionpb.UpdateProductNamespaceRequest, opts ...grpc.CallOption) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateProductNamespace", varargs...)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductNamespace indicates an expected call of UpdateProductNamespace.
+func (mr *MockVersionServiceClientMockRecorder) UpdateProductNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductNamespace", reflect.TypeOf((*MockVersionServiceClient)(nil).UpdateProductNamespace), varargs...)
+}
+
 // WatchProcessStatus mocks base method.
 func (m *MockVersionServiceClient) WatchProcessStatus(ctx context.Context, in *versionpb.ProcessStatusRequest, opts ...grpc.CallOption) (versionpb.VersionService_WatchProcessStatusClient, error) {
 	m.ctrl.T.Helper()
@@ -323,6 +483,51 @@ func (m *MockVersionServiceServer) EXPECT() *MockVersionServiceServerMockRecorde
 	return m.recorder
 }
 
+// CreateProductNamespace mocks base method.
+func (m *MockVersionServiceServer) CreateProductNamespace(arg0 context.Context, arg1 *versionpb.CreateProductNamespaceRequest) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductNamespace indicates an expected call of CreateProductNamespace.
+func (mr *MockVersionServiceServerMockRecorder) CreateProductNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductNamespace", reflect.TypeOf((*MockVersionServiceServer)(nil).CreateProductNamespace), arg0, arg1)
+}
+
+// DeleteProductNamespace mocks base method.
+func (m *MockVersionServiceServer) DeleteProductNamespace(arg0 context.Context, arg1 *versionpb.DeleteProductNamespaceRequest) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductNamespace indicates an expected call of DeleteProductNamespace.
+func (mr *MockVersionServiceServerMockRecorder) DeleteProductNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductNamespace", reflect.TypeOf((*MockVersionServiceServer)(nil).DeleteProductNamespace), arg0, arg1)
+}
+
+// GetProcessStatus mocks base method.
+func (m *MockVersionServiceServer) GetProcessStatus(arg0 context.Context, arg1 *versionpb.ProcessStatusRequest) (*versionpb.GetProcessStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessStatus", arg0, arg1)
+	ret0, _ := ret[0].(*versionpb.GetProcessStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessStatus indicates an expected call of GetProcessStatus.
+func (mr *MockVersionServiceServerMockRecorder) GetProcessStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessStatus", reflect.TypeOf((*MockVersionServiceServer)(nil).GetProcessStatus), arg0, arg1)
+}
+
 // GetPublishedTriggers mocks base method.
 func (m *MockVersionServiceServer) GetPublishedTriggers(arg0 context.Context, arg1 *versionpb.GetPublishedTriggersRequest) (*versionpb.PublishResponse, error) {
 	m.ctrl.T.Helper()
@@ -368,6 +573,51 @@ func (mr *MockVersionServiceServerMockRecorder) RegisterProcess(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterProcess", reflect.TypeOf((*MockVersionServiceServer)(nil).RegisterProcess), arg0, arg1)
 }
 
+// RenderManifests mocks base method.
+func (m *MockVersionServiceServer) RenderManifests(arg0 context.Context, arg1 *versionpb.StartRequest) (*versionpb.RenderManifestsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderManifests", arg0, arg1)
+	ret0, _ := ret[0].(*versionpb.RenderManifestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderManifests indicates an expected call of RenderManifests.
+func (mr *MockVersionServiceServerMockRecorder) RenderManifests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderManifests", reflect.TypeOf((*MockVersionServiceServer)(nil).RenderManifests), arg0, arg1)
+}
+
+// RunWorkflow mocks base method.
+func (m *MockVersionServiceServer) RunWorkflow(arg0 context.Context, arg1 *versionpb.RunWorkflowRequest) (*versionpb.RunWorkflowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunWorkflow", arg0, arg1)
+	ret0, _ := ret[0].(*versionpb.RunWorkflowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunWorkflow indicates an expected call of RunWorkflow.
+func (mr *MockVersionServiceServerMockRecorder) RunWorkflow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWorkflow", reflect.TypeOf((*MockVersionServiceServer)(nil).RunWorkflow), arg0, arg1)
+}
+
+// ScaleProcess mocks base method.
+func (m *MockVersionServiceServer) ScaleProcess(arg0 context.Context, arg1 *versionpb.ScaleProcessRequest) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleProcess", arg0, arg1)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleProcess indicates an expected call of ScaleProcess.
+func (mr *MockVersionServiceServerMockRecorder) ScaleProcess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleProcess", reflect.TypeOf((*MockVersionServiceServer)(nil).ScaleProcess), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockVersionServiceServer) Start(arg0 context.Context, arg1 *versionpb.StartRequest) (*versionpb.Response, error) {
 	m.ctrl.T.Helper()
@@ -413,6 +663,36 @@ func (mr *MockVersionServiceServerMockRecorder) Unpublish(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpublish", reflect.TypeOf((*MockVersionServiceServer)(nil).Unpublish), arg0, arg1)
 }
 
+// UpdateProcessImage mocks base method.
+func (m *MockVersionServiceServer) UpdateProcessImage(arg0 context.Context, arg1 *versionpb.UpdateProcessImageRequest) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProcessImage", arg0, arg1)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProcessImage indicates an expected call of UpdateProcessImage.
+func (mr *MockVersionServiceServerMockRecorder) UpdateProcessImage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProcessImage", reflect.TypeOf((*MockVersionServiceServer)(nil).UpdateProcessImage), arg0, arg1)
+}
+
+// UpdateProductNamespace mocks base method.
+func (m *MockVersionServiceServer) UpdateProductNamespace(arg0 context.Context, arg1 *versionpb.UpdateProductNamespaceRequest) (*versionpb.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*versionpb.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductNamespace indicates an expected call of UpdateProductNamespace.
+func (mr *MockVersionServiceServerMockRecorder) UpdateProductNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductNamespace", reflect.TypeOf((*MockVersionServiceServer)(nil).UpdateProductNamespace), arg0, arg1)
+}
+
 // WatchProcessStatus mocks base method.
 func (m *MockVersionServiceServer) WatchProcessStatus(arg0 *versionpb.ProcessStatusRequest, arg1 versionpb.VersionService_WatchProcessStatusServer) error {
 	m.ctrl.T.Helper()
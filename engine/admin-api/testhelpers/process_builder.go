@@ -33,6 +33,16 @@ func (pb *ProcessBuilder) Build() entity.Process {
 	return pb.process
 }
 
+func (pb *ProcessBuilder) WithName(name string) *ProcessBuilder {
+	pb.process.Name = name
+	return pb
+}
+
+func (pb *ProcessBuilder) WithType(processType entity.ProcessType) *ProcessBuilder {
+	pb.process.Type = processType
+	return pb
+}
+
 func (pb *ProcessBuilder) WithObjectStore(objectStore *entity.ProcessObjectStore) *ProcessBuilder {
 	pb.process.ObjectStore = objectStore
 	return pb
@@ -52,3 +62,18 @@ func (pb *ProcessBuilder) WithConfig(config []entity.ConfigurationVariable) *Pro
 	pb.process.Config = config
 	return pb
 }
+
+func (pb *ProcessBuilder) WithProbes(probes *entity.ProcessProbes) *ProcessBuilder {
+	pb.process.Probes = probes
+	return pb
+}
+
+func (pb *ProcessBuilder) WithMaxDeliver(maxDeliver int32) *ProcessBuilder {
+	pb.process.MaxDeliver = maxDeliver
+	return pb
+}
+
+func (pb *ProcessBuilder) WithKeyValueStore(settings *entity.KeyValueStoreSettings) *ProcessBuilder {
+	pb.process.KeyValueStore = settings
+	return pb
+}
@@ -54,6 +54,11 @@ func (pb *ProductBuilder) WithServiceAccount(serviceAccount entity.ServiceAccoun
 	return pb
 }
 
+func (pb *ProductBuilder) WithQuota(quota *entity.ProductQuota) *ProductBuilder {
+	pb.product.Quota = quota
+	return pb
+}
+
 func (pb *ProductBuilder) Build() *entity.Product {
 	return pb.product
 }
@@ -31,3 +31,23 @@ func (wb *WorkflowBuilder) WithConfig(config []entity.ConfigurationVariable) *Wo
 	wb.workflow.Config = config
 	return wb
 }
+
+func (wb *WorkflowBuilder) WithType(workflowType entity.WorkflowType) *WorkflowBuilder {
+	wb.workflow.Type = workflowType
+	return wb
+}
+
+func (wb *WorkflowBuilder) WithJob(job *entity.WorkflowJob) *WorkflowBuilder {
+	wb.workflow.Job = job
+	return wb
+}
+
+func (wb *WorkflowBuilder) WithStreamSettings(settings *entity.WorkflowStreamSettings) *WorkflowBuilder {
+	wb.workflow.StreamSettings = settings
+	return wb
+}
+
+func (wb *WorkflowBuilder) WithKeyValueStore(settings *entity.KeyValueStoreSettings) *WorkflowBuilder {
+	wb.workflow.KeyValueStore = settings
+	return wb
+}
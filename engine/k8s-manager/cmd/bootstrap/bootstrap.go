@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	"context"
 	"fmt"
 	"net"
 
@@ -9,12 +10,15 @@ import (
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/usecase"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
 	internalgrpc "github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/grpc"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/grpc/proto/natspb"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/grpc/proto/versionpb"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/registry"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/natsmanager"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -59,9 +63,19 @@ func initGrpcServer(logger logr.Logger) (*grpc.Server, error) {
 	stopper := usecase.NewVersionStopper(logger, k8sContainerService)
 	publisher := usecase.NewVersionPublisher(logger, k8sContainerService)
 	unpublisher := usecase.NewVersionUnpublisher(logger, k8sContainerService)
+	updater := usecase.NewVersionUpdater(logger, k8sContainerService)
+	runner := usecase.NewVersionRunner(logger, k8sContainerService)
 	processRegister := usecase.NewProcessRegister(logger, imageBuilder)
+	namespaceManager := usecase.NewProductNamespaceManager(logger, k8sContainerService)
+	renderer := usecase.NewVersionRenderer(logger, k8sContainerService)
 
-	versionService := internalgrpc.NewVersionService(logger, starter, stopper, publisher, unpublisher, processRegister)
+	versionService := internalgrpc.NewVersionService(
+		logger, starter, stopper, publisher, unpublisher, updater, runner, processRegister, namespaceManager, renderer,
+	)
+
+	if err := startQueueScaler(logger, k8sContainerService); err != nil {
+		return nil, err
+	}
 
 	versionpb.RegisterVersionServiceServer(s, versionService)
 	reflection.Register(s)
@@ -69,6 +83,30 @@ func initGrpcServer(logger logr.Logger) (*grpc.Server, error) {
 	return s, nil
 }
 
+// startQueueScaler runs the consumer lag autoscaler when nats-manager is reachable from the configuration.
+func startQueueScaler(logger logr.Logger, containerService *kube.K8sContainerService) error {
+	natsManagerEndpoint := viper.GetString(config.NatsManagerEndpointKey)
+	if natsManagerEndpoint == "" {
+		logger.Info("NATS manager endpoint not configured, consumer lag autoscaling disabled")
+		return nil
+	}
+
+	cc, err := grpc.Dial(natsManagerEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("connecting to nats-manager: %w", err)
+	}
+
+	queueScaler := usecase.NewQueueScaler(
+		logger,
+		containerService,
+		natsmanager.NewClient(natspb.NewNatsManagerServiceClient(cc)),
+	)
+
+	go queueScaler.Run(context.Background(), viper.GetDuration(config.AutoscaleQueueIntervalKey))
+
+	return nil
+}
+
 func startServer(logger logr.Logger, s *grpc.Server) error {
 	port := viper.GetInt(config.ServerPortKey)
 	serverAddress := fmt.Sprintf("0.0.0.0:%d", port)
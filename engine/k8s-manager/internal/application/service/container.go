@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
 )
@@ -12,6 +13,8 @@ type CreateProcessParams struct {
 	Version    string
 	Workflow   string
 	Process    *domain.Process
+	// Job is set for processes of batch workflows, that run to completion instead of as a deployment.
+	Job *domain.WorkflowJob
 }
 
 type CreateNetworkParams struct {
@@ -26,6 +29,40 @@ type PublishNetworkParams struct {
 	Version string
 }
 
+type RenderPublishedNetworkParams struct {
+	Product string
+	Version string
+	// Networks are the trigger networks of the version that the ingress publishes.
+	Networks []CreateNetworkParams
+}
+
+type UpdateProcessImageParams struct {
+	Product  string
+	Version  string
+	Workflow string
+	Process  string
+	Image    string
+}
+
+type ScaleProcessParams struct {
+	Product     string
+	Version     string
+	Workflow    string
+	Process     string
+	Replicas    *int32
+	Autoscaling *domain.ProcessAutoscaling
+}
+
+type CreateProductNamespaceParams struct {
+	Product       string
+	ResourceQuota *domain.ProductResourceQuota
+}
+
+type UpdateProductNamespaceParams struct {
+	Product       string
+	ResourceQuota *domain.ProductResourceQuota
+}
+
 type ContainerStarter interface {
 	CreateProcess(ctx context.Context, params CreateProcessParams) error
 	CreateNetwork(ctx context.Context, params CreateNetworkParams) error
@@ -33,6 +70,14 @@ type ContainerStarter interface {
 	WaitProcesses(ctx context.Context, version *domain.Version) error
 }
 
+// ContainerRenderer renders the objects that ContainerStarter and ContainerPublisher would create, without creating them.
+type ContainerRenderer interface {
+	RenderVersionConfiguration(version *domain.Version) (*domain.Manifest, error)
+	RenderProcess(params CreateProcessParams) ([]*domain.Manifest, error)
+	RenderNetwork(params CreateNetworkParams) (*domain.Manifest, error)
+	RenderPublishedNetwork(params RenderPublishedNetworkParams) (*domain.Manifest, error)
+}
+
 type ContainerStopper interface {
 	DeleteProcesses(ctx context.Context, product, version string) error
 	DeleteConfiguration(ctx context.Context, product, version string) error
@@ -48,6 +93,44 @@ type ContainerUnpublisher interface {
 	UnpublishNetwork(ctx context.Context, product, version string) error
 }
 
+type ContainerUpdater interface {
+	// UpdateProcessImage rolls the process deployment to the given image and returns the previous one.
+	UpdateProcessImage(ctx context.Context, params UpdateProcessImageParams) (string, error)
+	WaitProcesses(ctx context.Context, version *domain.Version) error
+	ScaleProcess(ctx context.Context, params ScaleProcessParams) error
+}
+
+type ContainerScaler interface {
+	// ListQueueScaledProcesses returns the running processes whose replicas follow their consumer lag.
+	ListQueueScaledProcesses(ctx context.Context) ([]*domain.QueueScaledProcess, error)
+	ScaleProcess(ctx context.Context, params ScaleProcessParams) error
+}
+
+type ContainerRunner interface {
+	// RunWorkflow starts a new run of the jobs of a batch workflow and returns the names of the created jobs.
+	RunWorkflow(ctx context.Context, product, version, workflow string) ([]string, error)
+	// WatchProcessStatus sends the status changes of the version processes until the context is done.
+	WatchProcessStatus(ctx context.Context, product, version string, statusCh chan<- *domain.ProcessStatus) error
+	// GetProcessStatus returns the current status of the version processes.
+	GetProcessStatus(ctx context.Context, product, version string) ([]*domain.ProcessStatus, error)
+}
+
+type ContainerNamespaceManager interface {
+	// CreateProductNamespace creates the isolated namespace of a product. It does nothing if namespace isolation is disabled.
+	CreateProductNamespace(ctx context.Context, params CreateProductNamespaceParams) error
+	// UpdateProductNamespace applies a new resource quota to the product namespace.
+	UpdateProductNamespace(ctx context.Context, params UpdateProductNamespaceParams) error
+	DeleteProductNamespace(ctx context.Context, product string) error
+}
+
+// ErrNoProcessConsumers is returned when the process has no durable consumer to read its lag from.
+var ErrNoProcessConsumers = errors.New("process has no durable consumers")
+
+//go:generate mockery --name ConsumerLagService --output ../../../mocks --filename consumer_lag_service_mock.go --structname ConsumerLagServiceMock
+type ConsumerLagService interface {
+	GetProcessConsumerLag(ctx context.Context, product, version, workflow, process string) (uint64, error)
+}
+
 //go:generate mockery --name ImageBuilder --output ../../../mocks --filename image_builder_mock.go --structname ImageBuilderMock
 type ImageBuilder interface {
 	BuildImage(ctx context.Context, productID, processID, processImage string) (string, error)
@@ -56,7 +139,12 @@ type ImageBuilder interface {
 //go:generate mockery --name ContainerService --output ../../../mocks --filename container_service_mock.go --structname ContainerServiceMock
 type ContainerService interface {
 	ContainerStarter
+	ContainerRenderer
 	ContainerStopper
 	ContainerPublisher
 	ContainerUnpublisher
+	ContainerUpdater
+	ContainerScaler
+	ContainerRunner
+	ContainerNamespaceManager
 }
@@ -9,6 +9,10 @@ type VersionStarterService interface {
 	StartVersion(ctx context.Context, version *domain.Version) error
 }
 
+type VersionRendererService interface {
+	RenderVersion(ctx context.Context, version *domain.Version) ([]*domain.Manifest, error)
+}
+
 type VersionStopperService interface {
 	StopVersion(ctx context.Context, params StopParams) error
 }
@@ -22,10 +26,31 @@ type VersionUnpublisherService interface {
 	UnpublishVersion(ctx context.Context, product, version string) error
 }
 
+type VersionUpdaterService interface {
+	UpdateProcessImage(ctx context.Context, params UpdateProcessImageParams) error
+	ScaleProcess(ctx context.Context, params ScaleProcessParams) error
+}
+
+type VersionRunnerService interface {
+	RunWorkflow(ctx context.Context, product, version, workflow string) ([]string, error)
+	WatchProcessStatus(ctx context.Context, product, version string, statusCh chan<- *domain.ProcessStatus) error
+	GetProcessStatus(ctx context.Context, product, version string) ([]*domain.ProcessStatus, error)
+}
+
+type ProductNamespaceService interface {
+	CreateProductNamespace(ctx context.Context, product string, quota *domain.ProductResourceQuota) error
+	UpdateProductNamespace(ctx context.Context, product string, quota *domain.ProductResourceQuota) error
+	DeleteProductNamespace(ctx context.Context, product string) error
+}
+
 //go:generate mockery --name VersionService --output ../../../mocks --filename version_service_mock.go --structname VersionServiceMock
 type VersionService interface {
 	VersionStarterService
+	VersionRendererService
 	VersionStopperService
 	VersionPublisherService
 	VersionUnpublisherService
+	VersionUpdaterService
+	VersionRunnerService
+	ProductNamespaceService
 }
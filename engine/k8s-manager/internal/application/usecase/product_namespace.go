@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"golang.org/x/net/context"
+)
+
+type ProductNamespaceManager struct {
+	logger           logr.Logger
+	containerService service.ContainerNamespaceManager
+}
+
+func NewProductNamespaceManager(logger logr.Logger, containerService service.ContainerNamespaceManager) ProductNamespaceService {
+	return &ProductNamespaceManager{
+		logger:           logger,
+		containerService: containerService,
+	}
+}
+
+func (m *ProductNamespaceManager) CreateProductNamespace(
+	ctx context.Context,
+	product string,
+	quota *domain.ProductResourceQuota,
+) error {
+	m.logger.Info("Creating product namespace", "product", product)
+
+	err := m.containerService.CreateProductNamespace(ctx, service.CreateProductNamespaceParams{
+		Product:       product,
+		ResourceQuota: quota,
+	})
+	if err != nil {
+		return fmt.Errorf("create product %q namespace: %w", product, err)
+	}
+
+	return nil
+}
+
+func (m *ProductNamespaceManager) UpdateProductNamespace(
+	ctx context.Context,
+	product string,
+	quota *domain.ProductResourceQuota,
+) error {
+	m.logger.Info("Updating product namespace", "product", product)
+
+	err := m.containerService.UpdateProductNamespace(ctx, service.UpdateProductNamespaceParams{
+		Product:       product,
+		ResourceQuota: quota,
+	})
+	if err != nil {
+		return fmt.Errorf("update product %q namespace: %w", product, err)
+	}
+
+	return nil
+}
+
+func (m *ProductNamespaceManager) DeleteProductNamespace(ctx context.Context, product string) error {
+	m.logger.Info("Deleting product namespace", "product", product)
+
+	if err := m.containerService.DeleteProductNamespace(ctx, product); err != nil {
+		return fmt.Errorf("delete product %q namespace: %w", product, err)
+	}
+
+	return nil
+}
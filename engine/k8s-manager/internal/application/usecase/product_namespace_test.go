@@ -0,0 +1,90 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/usecase"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateProductNamespace(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	quota := &domain.ProductResourceQuota{CPU: "2", Memory: "4Gi", Pods: 10}
+
+	containerSvc.EXPECT().
+		CreateProductNamespace(mock.Anything, service.CreateProductNamespaceParams{
+			Product:       "test-product",
+			ResourceQuota: quota,
+		}).
+		Return(nil).
+		Once()
+
+	manager := usecase.NewProductNamespaceManager(logger, containerSvc)
+
+	err := manager.CreateProductNamespace(context.Background(), "test-product", quota)
+	require.NoError(t, err)
+}
+
+func TestCreateProductNamespace_Error(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	expectedErr := errors.New("quota exceeded")
+
+	containerSvc.EXPECT().
+		CreateProductNamespace(mock.Anything, mock.Anything).
+		Return(expectedErr).
+		Once()
+
+	manager := usecase.NewProductNamespaceManager(logger, containerSvc)
+
+	err := manager.CreateProductNamespace(context.Background(), "test-product", nil)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestUpdateProductNamespace(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	quota := &domain.ProductResourceQuota{CPU: "4", Memory: "8Gi", Pods: 20}
+
+	containerSvc.EXPECT().
+		UpdateProductNamespace(mock.Anything, service.UpdateProductNamespaceParams{
+			Product:       "test-product",
+			ResourceQuota: quota,
+		}).
+		Return(nil).
+		Once()
+
+	manager := usecase.NewProductNamespaceManager(logger, containerSvc)
+
+	err := manager.UpdateProductNamespace(context.Background(), "test-product", quota)
+	require.NoError(t, err)
+}
+
+func TestDeleteProductNamespace(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	containerSvc.EXPECT().
+		DeleteProductNamespace(mock.Anything, "test-product").
+		Return(nil).
+		Once()
+
+	manager := usecase.NewProductNamespaceManager(logger, containerSvc)
+
+	err := manager.DeleteProductNamespace(context.Background(), "test-product")
+	require.NoError(t, err)
+}
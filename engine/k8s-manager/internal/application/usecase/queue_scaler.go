@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+)
+
+// QueueScaler sets the replicas of the processes autoscaled on their JetStream consumer lag.
+type QueueScaler struct {
+	logger             logr.Logger
+	containerService   service.ContainerScaler
+	consumerLagService service.ConsumerLagService
+
+	// lastBusy keeps when each process last needed its current replicas, to delay scale downs.
+	lastBusy map[string]time.Time
+}
+
+func NewQueueScaler(
+	logger logr.Logger,
+	containerService service.ContainerScaler,
+	consumerLagService service.ConsumerLagService,
+) *QueueScaler {
+	return &QueueScaler{
+		logger:             logger,
+		containerService:   containerService,
+		consumerLagService: consumerLagService,
+		lastBusy:           make(map[string]time.Time),
+	}
+}
+
+// Run scales the processes every interval until the context is done.
+func (q *QueueScaler) Run(ctx context.Context, interval time.Duration) {
+	q.logger.Info("Starting consumer lag autoscaler", "interval", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := q.ScaleProcesses(ctx); err != nil {
+				q.logger.Error(err, "Error scaling processes on consumer lag")
+			}
+		}
+	}
+}
+
+// ScaleProcesses sets the replicas of each queue autoscaled process from its current consumer lag.
+// Scale ups are applied right away, while scale downs wait for the process scale down stabilization window.
+func (q *QueueScaler) ScaleProcesses(ctx context.Context) error {
+	processes, err := q.containerService.ListQueueScaledProcesses(ctx)
+	if err != nil {
+		return fmt.Errorf("listing queue scaled processes: %w", err)
+	}
+
+	runningProcesses := make(map[string]bool, len(processes))
+
+	var scaleErrors error
+
+	for _, process := range processes {
+		runningProcesses[q.processKey(process)] = true
+
+		if err := q.scaleProcess(ctx, process); err != nil {
+			scaleErrors = errors.Join(scaleErrors, err)
+		}
+	}
+
+	for key := range q.lastBusy {
+		if !runningProcesses[key] {
+			delete(q.lastBusy, key)
+		}
+	}
+
+	return scaleErrors
+}
+
+func (q *QueueScaler) scaleProcess(ctx context.Context, process *domain.QueueScaledProcess) error {
+	lag, err := q.consumerLagService.GetProcessConsumerLag(ctx, process.Product, process.Version, process.Workflow, process.Process)
+	if errors.Is(err, service.ErrNoProcessConsumers) {
+		// Without a durable consumer there is no lag to scale on, so the process keeps its replicas.
+		q.logger.V(1).Info("Process has no durable consumers, keeping its replicas",
+			"product", process.Product,
+			"version", process.Version,
+			"workflow", process.Workflow,
+			"process", process.Process,
+		)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting process %q consumer lag: %w", process.Process, err)
+	}
+
+	key := q.processKey(process)
+	desiredReplicas := process.DesiredReplicas(lag)
+
+	lastBusy, ok := q.lastBusy[key]
+	if !ok || desiredReplicas >= process.Replicas {
+		lastBusy = time.Now()
+		q.lastBusy[key] = lastBusy
+	}
+
+	if desiredReplicas == process.Replicas {
+		return nil
+	}
+
+	stabilizationWindow := time.Duration(process.Autoscaling.ScaleDownStabilizationSeconds) * time.Second
+	if desiredReplicas < process.Replicas && time.Since(lastBusy) < stabilizationWindow {
+		return nil
+	}
+
+	q.logger.Info("Scaling process on consumer lag",
+		"product", process.Product,
+		"version", process.Version,
+		"workflow", process.Workflow,
+		"process", process.Process,
+		"lag", lag,
+		"replicas", process.Replicas,
+		"desiredReplicas", desiredReplicas,
+	)
+
+	err = q.containerService.ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:  process.Product,
+		Version:  process.Version,
+		Workflow: process.Workflow,
+		Process:  process.Process,
+		Replicas: &desiredReplicas,
+	})
+	if err != nil {
+		return fmt.Errorf("scale process %q: %w", process.Process, err)
+	}
+
+	return nil
+}
+
+func (q *QueueScaler) processKey(process *domain.QueueScaledProcess) string {
+	return fmt.Sprintf("%s/%s/%s/%s", process.Product, process.Version, process.Workflow, process.Process)
+}
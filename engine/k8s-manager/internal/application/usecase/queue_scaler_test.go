@@ -0,0 +1,194 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/usecase"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+)
+
+func newQueueScaledProcess(replicas int32, scaleDownStabilizationSeconds int32) *domain.QueueScaledProcess {
+	return &domain.QueueScaledProcess{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Replicas: replicas,
+		Autoscaling: domain.ProcessAutoscaling{
+			MinReplicas:                   0,
+			MaxReplicas:                   5,
+			ConsumerLagTarget:             10,
+			ScaleDownStabilizationSeconds: scaleDownStabilizationSeconds,
+		},
+	}
+}
+
+func TestQueueScaler_ScaleProcesses_ScalesUp(t *testing.T) {
+	var (
+		logger         = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc   = mocks.NewContainerServiceMock(t)
+		consumerLagSvc = mocks.NewConsumerLagServiceMock(t)
+		scaler         = usecase.NewQueueScaler(logger, containerSvc, consumerLagSvc)
+		ctx            = context.Background()
+		process        = newQueueScaledProcess(1, 60)
+	)
+
+	containerSvc.EXPECT().ListQueueScaledProcesses(ctx).Return([]*domain.QueueScaledProcess{process}, nil).Once()
+	consumerLagSvc.EXPECT().
+		GetProcessConsumerLag(ctx, process.Product, process.Version, process.Workflow, process.Process).
+		Return(uint64(35), nil).
+		Once()
+	containerSvc.EXPECT().ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:  process.Product,
+		Version:  process.Version,
+		Workflow: process.Workflow,
+		Process:  process.Process,
+		Replicas: pointer.Int32(4),
+	}).Return(nil).Once()
+
+	err := scaler.ScaleProcesses(ctx)
+	assert.NoError(t, err)
+}
+
+func TestQueueScaler_ScaleProcesses_ScalesIdleProcessToZero(t *testing.T) {
+	var (
+		logger         = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc   = mocks.NewContainerServiceMock(t)
+		consumerLagSvc = mocks.NewConsumerLagServiceMock(t)
+		scaler         = usecase.NewQueueScaler(logger, containerSvc, consumerLagSvc)
+		ctx            = context.Background()
+		process        = newQueueScaledProcess(2, 0)
+	)
+
+	containerSvc.EXPECT().ListQueueScaledProcesses(ctx).Return([]*domain.QueueScaledProcess{process}, nil).Once()
+	consumerLagSvc.EXPECT().
+		GetProcessConsumerLag(ctx, process.Product, process.Version, process.Workflow, process.Process).
+		Return(uint64(0), nil).
+		Once()
+	containerSvc.EXPECT().ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:  process.Product,
+		Version:  process.Version,
+		Workflow: process.Workflow,
+		Process:  process.Process,
+		Replicas: pointer.Int32(0),
+	}).Return(nil).Once()
+
+	err := scaler.ScaleProcesses(ctx)
+	assert.NoError(t, err)
+}
+
+func TestQueueScaler_ScaleProcesses_WaitsStabilizationWindowToScaleDown(t *testing.T) {
+	var (
+		logger         = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc   = mocks.NewContainerServiceMock(t)
+		consumerLagSvc = mocks.NewConsumerLagServiceMock(t)
+		scaler         = usecase.NewQueueScaler(logger, containerSvc, consumerLagSvc)
+		ctx            = context.Background()
+		process        = newQueueScaledProcess(2, 300)
+	)
+
+	containerSvc.EXPECT().ListQueueScaledProcesses(ctx).Return([]*domain.QueueScaledProcess{process}, nil).Twice()
+	consumerLagSvc.EXPECT().
+		GetProcessConsumerLag(ctx, process.Product, process.Version, process.Workflow, process.Process).
+		Return(uint64(0), nil).
+		Twice()
+
+	err := scaler.ScaleProcesses(ctx)
+	assert.NoError(t, err)
+
+	err = scaler.ScaleProcesses(ctx)
+	assert.NoError(t, err)
+}
+
+func TestQueueScaler_ScaleProcesses_NothingToScale(t *testing.T) {
+	var (
+		logger         = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc   = mocks.NewContainerServiceMock(t)
+		consumerLagSvc = mocks.NewConsumerLagServiceMock(t)
+		scaler         = usecase.NewQueueScaler(logger, containerSvc, consumerLagSvc)
+		ctx            = context.Background()
+		process        = newQueueScaledProcess(2, 0)
+	)
+
+	containerSvc.EXPECT().ListQueueScaledProcesses(ctx).Return([]*domain.QueueScaledProcess{process}, nil).Once()
+	consumerLagSvc.EXPECT().
+		GetProcessConsumerLag(ctx, process.Product, process.Version, process.Workflow, process.Process).
+		Return(uint64(20), nil).
+		Once()
+
+	err := scaler.ScaleProcesses(ctx)
+	assert.NoError(t, err)
+}
+
+func TestQueueScaler_ScaleProcesses_ErrorGettingConsumerLag(t *testing.T) {
+	var (
+		logger         = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc   = mocks.NewContainerServiceMock(t)
+		consumerLagSvc = mocks.NewConsumerLagServiceMock(t)
+		scaler         = usecase.NewQueueScaler(logger, containerSvc, consumerLagSvc)
+		ctx            = context.Background()
+		failing        = newQueueScaledProcess(1, 0)
+		process        = newQueueScaledProcess(1, 0)
+		expectedErr    = errors.New("nats-manager unavailable")
+	)
+
+	process.Process = "other-process"
+
+	containerSvc.EXPECT().ListQueueScaledProcesses(ctx).Return([]*domain.QueueScaledProcess{failing, process}, nil).Once()
+	consumerLagSvc.EXPECT().
+		GetProcessConsumerLag(ctx, failing.Product, failing.Version, failing.Workflow, failing.Process).
+		Return(uint64(0), expectedErr).
+		Once()
+	consumerLagSvc.EXPECT().
+		GetProcessConsumerLag(ctx, process.Product, process.Version, process.Workflow, process.Process).
+		Return(uint64(10), nil).
+		Once()
+
+	err := scaler.ScaleProcesses(ctx)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestQueueScaler_ScaleProcesses_KeepsReplicasWithoutDurableConsumers(t *testing.T) {
+	var (
+		logger         = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc   = mocks.NewContainerServiceMock(t)
+		consumerLagSvc = mocks.NewConsumerLagServiceMock(t)
+		scaler         = usecase.NewQueueScaler(logger, containerSvc, consumerLagSvc)
+		ctx            = context.Background()
+		process        = newQueueScaledProcess(2, 0)
+	)
+
+	containerSvc.EXPECT().ListQueueScaledProcesses(ctx).Return([]*domain.QueueScaledProcess{process}, nil).Once()
+	consumerLagSvc.EXPECT().
+		GetProcessConsumerLag(ctx, process.Product, process.Version, process.Workflow, process.Process).
+		Return(uint64(0), service.ErrNoProcessConsumers).
+		Once()
+
+	err := scaler.ScaleProcesses(ctx)
+	assert.NoError(t, err)
+}
+
+func TestQueueScaler_ScaleProcesses_ErrorListingProcesses(t *testing.T) {
+	var (
+		logger         = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc   = mocks.NewContainerServiceMock(t)
+		consumerLagSvc = mocks.NewConsumerLagServiceMock(t)
+		scaler         = usecase.NewQueueScaler(logger, containerSvc, consumerLagSvc)
+		ctx            = context.Background()
+		expectedErr    = errors.New("error listing deployments")
+	)
+
+	containerSvc.EXPECT().ListQueueScaledProcesses(ctx).Return(nil, expectedErr).Once()
+
+	err := scaler.ScaleProcesses(ctx)
+	assert.ErrorIs(t, err, expectedErr)
+}
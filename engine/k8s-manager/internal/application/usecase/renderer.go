@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"golang.org/x/net/context"
+)
+
+type VersionRenderer struct {
+	logger           logr.Logger
+	containerService service.ContainerRenderer
+}
+
+func NewVersionRenderer(logger logr.Logger, containerService service.ContainerRenderer) VersionRendererService {
+	return &VersionRenderer{
+		logger,
+		containerService,
+	}
+}
+
+// RenderVersion returns the manifests of every object created when starting and publishing the version,
+// in the same order VersionStarter creates them, without applying anything to the cluster.
+func (r *VersionRenderer) RenderVersion(_ context.Context, version *domain.Version) ([]*domain.Manifest, error) {
+	r.logger.Info("Rendering version manifests", "product", version.Product, "version", version.Tag)
+
+	if err := version.ValidateWorkflows(); err != nil {
+		return nil, err
+	}
+
+	configManifest, err := r.containerService.RenderVersionConfiguration(version)
+	if err != nil {
+		return nil, fmt.Errorf("render version configuration: %w", err)
+	}
+
+	manifests := []*domain.Manifest{configManifest}
+
+	var networks []service.CreateNetworkParams
+
+	for _, workflow := range version.Workflows {
+		for _, process := range workflow.Processes {
+			processManifests, err := r.containerService.RenderProcess(service.CreateProcessParams{
+				ConfigName: configManifest.Name,
+				Product:    version.Product,
+				Version:    version.Tag,
+				Workflow:   workflow.Name,
+				Process:    process,
+				Job:        workflow.Job,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("render process %q: %w", process.Name, err)
+			}
+
+			manifests = append(manifests, processManifests...)
+
+			if process.IsTrigger() && process.Networking != nil {
+				network := service.CreateNetworkParams{
+					Product:  version.Product,
+					Version:  version.Tag,
+					Workflow: workflow.Name,
+					Process:  process,
+				}
+
+				networkManifest, err := r.containerService.RenderNetwork(network)
+				if err != nil {
+					return nil, fmt.Errorf("render network: %w", err)
+				}
+
+				manifests = append(manifests, networkManifest)
+				networks = append(networks, network)
+			}
+		}
+	}
+
+	if len(networks) > 0 {
+		ingressManifest, err := r.containerService.RenderPublishedNetwork(service.RenderPublishedNetworkParams{
+			Product:  version.Product,
+			Version:  version.Tag,
+			Networks: networks,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("render published network: %w", err)
+		}
+
+		manifests = append(manifests, ingressManifest)
+	}
+
+	return manifests, nil
+}
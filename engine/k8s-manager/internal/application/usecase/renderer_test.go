@@ -0,0 +1,157 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/usecase"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
+	"github.com/konstellation-io/kai/engine/k8s-manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderVersion(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	processes := []*domain.Process{
+		testhelpers.NewProcessBuilder().
+			WithName("trigger").
+			WithType(domain.TriggerProcessType).
+			WithNetworking(domain.Networking{
+				SourcePort: 80,
+				TargetPort: 8080,
+				Protocol:   "HTTP",
+			}).
+			Build(),
+		testhelpers.NewProcessBuilder().WithName("task").Build(),
+	}
+	workflows := []*domain.Workflow{
+		testhelpers.NewWorkflowBuilder().WithProcesses(processes).Build(),
+	}
+
+	version := testhelpers.NewVersionBuilder().WithWorkflows(workflows).Build()
+
+	configManifest := &domain.Manifest{Kind: "ConfigMap", Name: "test-config-name"}
+	triggerManifest := &domain.Manifest{Kind: "Deployment", Name: "trigger"}
+	taskManifest := &domain.Manifest{Kind: "Deployment", Name: "task"}
+	autoscalerManifest := &domain.Manifest{Kind: "HorizontalPodAutoscaler", Name: "task"}
+	serviceManifest := &domain.Manifest{Kind: "Service", Name: "trigger"}
+	ingressManifest := &domain.Manifest{Kind: "Ingress", Name: "test-product"}
+
+	containerSvc.EXPECT().RenderVersionConfiguration(version).Return(configManifest, nil).Once()
+
+	for _, p := range []struct {
+		process   *domain.Process
+		manifests []*domain.Manifest
+	}{
+		{processes[0], []*domain.Manifest{triggerManifest}},
+		{processes[1], []*domain.Manifest{taskManifest, autoscalerManifest}},
+	} {
+		containerSvc.EXPECT().
+			RenderProcess(service.CreateProcessParams{
+				ConfigName: configManifest.Name,
+				Product:    version.Product,
+				Version:    version.Tag,
+				Workflow:   workflows[0].Name,
+				Process:    p.process,
+			}).
+			Return(p.manifests, nil).
+			Once()
+	}
+
+	networkParams := service.CreateNetworkParams{
+		Product:  version.Product,
+		Version:  version.Tag,
+		Workflow: workflows[0].Name,
+		Process:  processes[0],
+	}
+
+	containerSvc.EXPECT().RenderNetwork(networkParams).Return(serviceManifest, nil).Once()
+	containerSvc.EXPECT().
+		RenderPublishedNetwork(service.RenderPublishedNetworkParams{
+			Product:  version.Product,
+			Version:  version.Tag,
+			Networks: []service.CreateNetworkParams{networkParams},
+		}).
+		Return(ingressManifest, nil).
+		Once()
+
+	renderer := usecase.NewVersionRenderer(logger, containerSvc)
+
+	manifests, err := renderer.RenderVersion(context.Background(), version)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*domain.Manifest{
+		configManifest,
+		triggerManifest,
+		serviceManifest,
+		taskManifest,
+		autoscalerManifest,
+		ingressManifest,
+	}, manifests)
+}
+
+func TestRenderVersion_WithoutNetworking(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	version := testhelpers.NewVersionBuilder().Build()
+
+	configManifest := &domain.Manifest{Kind: "ConfigMap", Name: "test-config-name"}
+	processManifest := &domain.Manifest{Kind: "Deployment", Name: "test-process"}
+
+	containerSvc.EXPECT().RenderVersionConfiguration(version).Return(configManifest, nil).Once()
+	containerSvc.EXPECT().
+		RenderProcess(service.CreateProcessParams{
+			ConfigName: configManifest.Name,
+			Product:    version.Product,
+			Version:    version.Tag,
+			Workflow:   version.Workflows[0].Name,
+			Process:    version.Workflows[0].Processes[0],
+		}).
+		Return([]*domain.Manifest{processManifest}, nil).
+		Once()
+
+	renderer := usecase.NewVersionRenderer(logger, containerSvc)
+
+	manifests, err := renderer.RenderVersion(context.Background(), version)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*domain.Manifest{configManifest, processManifest}, manifests)
+}
+
+func TestRenderVersion_ErrorRenderingProcess(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	version := testhelpers.NewVersionBuilder().Build()
+	expectedErr := errors.New("render error")
+
+	containerSvc.EXPECT().
+		RenderVersionConfiguration(version).
+		Return(&domain.Manifest{Kind: "ConfigMap", Name: "test-config-name"}, nil).
+		Once()
+	containerSvc.EXPECT().
+		RenderProcess(service.CreateProcessParams{
+			ConfigName: "test-config-name",
+			Product:    version.Product,
+			Version:    version.Tag,
+			Workflow:   version.Workflows[0].Name,
+			Process:    version.Workflows[0].Processes[0],
+		}).
+		Return(nil, expectedErr).
+		Once()
+
+	renderer := usecase.NewVersionRenderer(logger, containerSvc)
+
+	_, err := renderer.RenderVersion(context.Background(), version)
+	assert.ErrorIs(t, err, expectedErr)
+}
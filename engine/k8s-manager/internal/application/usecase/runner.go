@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"golang.org/x/net/context"
+)
+
+type VersionRunner struct {
+	logger           logr.Logger
+	containerService service.ContainerRunner
+}
+
+func NewVersionRunner(logger logr.Logger, containerService service.ContainerRunner) VersionRunnerService {
+	return &VersionRunner{
+		logger:           logger,
+		containerService: containerService,
+	}
+}
+
+// RunWorkflow triggers a new run of a batch workflow and returns the names of the created jobs.
+func (r *VersionRunner) RunWorkflow(ctx context.Context, product, version, workflow string) ([]string, error) {
+	r.logger.Info("Running workflow", "product", product, "version", version, "workflow", workflow)
+
+	jobs, err := r.containerService.RunWorkflow(ctx, product, version, workflow)
+	if err != nil {
+		return nil, fmt.Errorf("run workflow %q: %w", workflow, err)
+	}
+
+	return jobs, nil
+}
+
+func (r *VersionRunner) WatchProcessStatus(
+	ctx context.Context,
+	product, version string,
+	statusCh chan<- *domain.ProcessStatus,
+) error {
+	r.logger.Info("Watching process status", "product", product, "version", version)
+
+	if err := r.containerService.WatchProcessStatus(ctx, product, version, statusCh); err != nil {
+		return fmt.Errorf("watch process status: %w", err)
+	}
+
+	return nil
+}
+
+func (r *VersionRunner) GetProcessStatus(ctx context.Context, product, version string) ([]*domain.ProcessStatus, error) {
+	statuses, err := r.containerService.GetProcessStatus(ctx, product, version)
+	if err != nil {
+		return nil, fmt.Errorf("get process status: %w", err)
+	}
+
+	return statuses, nil
+}
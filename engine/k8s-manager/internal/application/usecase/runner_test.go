@@ -0,0 +1,89 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/usecase"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunWorkflow(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	expectedJobs := []string{"test-product-v1-0-0-training-process-1700000000"}
+
+	containerSvc.EXPECT().
+		RunWorkflow(mock.Anything, "test-product", "v1.0.0", "training").
+		Return(expectedJobs, nil).
+		Once()
+
+	runner := usecase.NewVersionRunner(logger, containerSvc)
+
+	jobs, err := runner.RunWorkflow(context.Background(), "test-product", "v1.0.0", "training")
+	require.NoError(t, err)
+	assert.Equal(t, expectedJobs, jobs)
+}
+
+func TestRunWorkflow_Error(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	expectedErr := errors.New("no jobs")
+
+	containerSvc.EXPECT().
+		RunWorkflow(mock.Anything, "test-product", "v1.0.0", "training").
+		Return(nil, expectedErr).
+		Once()
+
+	runner := usecase.NewVersionRunner(logger, containerSvc)
+
+	_, err := runner.RunWorkflow(context.Background(), "test-product", "v1.0.0", "training")
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestWatchProcessStatus(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	statusCh := make(chan *domain.ProcessStatus)
+
+	containerSvc.EXPECT().
+		WatchProcessStatus(mock.Anything, "test-product", "v1.0.0", mock.Anything).
+		Return(nil).
+		Once()
+
+	runner := usecase.NewVersionRunner(logger, containerSvc)
+
+	err := runner.WatchProcessStatus(context.Background(), "test-product", "v1.0.0", statusCh)
+	assert.NoError(t, err)
+}
+
+func TestGetProcessStatus(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	expectedStatuses := []*domain.ProcessStatus{
+		{Workflow: "test-workflow", Process: "test-process", State: domain.ProcessStateStarted, Restarts: 2},
+	}
+
+	containerSvc.EXPECT().
+		GetProcessStatus(mock.Anything, "test-product", "v1.0.0").
+		Return(expectedStatuses, nil).
+		Once()
+
+	runner := usecase.NewVersionRunner(logger, containerSvc)
+
+	statuses, err := runner.GetProcessStatus(context.Background(), "test-product", "v1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedStatuses, statuses)
+}
@@ -25,6 +25,10 @@ func NewVersionStarter(logger logr.Logger, orchStarter service.ContainerService)
 func (s *VersionStarter) StartVersion(ctx context.Context, version *domain.Version) error {
 	s.logger.Info("Running version starter", "product", version.Product, "version", version.Tag)
 
+	if err := version.ValidateWorkflows(); err != nil {
+		return err
+	}
+
 	compensations := compensator.New()
 
 	if err := s.createVersionResources(ctx, version, compensations); err != nil {
@@ -59,6 +63,7 @@ func (s *VersionStarter) createVersionResources(
 				Version:    version.Tag,
 				Workflow:   workflow.Name,
 				Process:    process,
+				Job:        workflow.Job,
 			})
 			if err != nil {
 				return fmt.Errorf("create process %q: %w", process.Name, err)
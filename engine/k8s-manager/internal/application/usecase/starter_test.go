@@ -41,6 +41,88 @@ func TestStartVersion(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStartVersion_BatchWorkflow(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	job := &domain.WorkflowJob{
+		Schedule:     "*/5 * * * *",
+		BackoffLimit: 3,
+	}
+
+	workflows := []*domain.Workflow{
+		testhelpers.NewWorkflowBuilder().WithType(domain.WorkflowTypeTraining).WithName("training").Build(),
+		testhelpers.NewWorkflowBuilder().WithJob(job).WithName("batch").Build(),
+	}
+
+	version := testhelpers.NewVersionBuilder().WithWorkflows(workflows).Build()
+
+	configName := "test-config-name"
+
+	containerSvc.EXPECT().
+		CreateVersionConfiguration(mock.Anything, version).
+		Return(configName, nil).
+		Once()
+
+	containerSvc.EXPECT().
+		CreateProcess(mock.Anything, service.CreateProcessParams{
+			ConfigName: configName,
+			Product:    version.Product,
+			Version:    version.Tag,
+			Workflow:   "training",
+			Process:    workflows[0].Processes[0],
+		}).
+		Return(nil).
+		Once()
+
+	containerSvc.EXPECT().
+		CreateProcess(mock.Anything, service.CreateProcessParams{
+			ConfigName: configName,
+			Product:    version.Product,
+			Version:    version.Tag,
+			Workflow:   "batch",
+			Process:    workflows[1].Processes[0],
+			Job:        job,
+		}).
+		Return(nil).
+		Once()
+
+	containerSvc.EXPECT().WaitProcesses(mock.Anything, version).Return(nil)
+
+	starter := usecase.NewVersionStarter(logger, containerSvc)
+
+	err := starter.StartVersion(context.Background(), version)
+	assert.NoError(t, err)
+}
+
+func TestStartVersion_ErrorBatchWorkflowWithNetworkedTrigger(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	containerSvc := mocks.NewContainerServiceMock(t)
+
+	trigger := testhelpers.NewProcessBuilder().
+		WithType(domain.TriggerProcessType).
+		WithNetworking(domain.Networking{
+			SourcePort: 80,
+			TargetPort: 8080,
+			Protocol:   "HTTP",
+		}).
+		Build()
+
+	version := testhelpers.NewVersionBuilder().
+		WithWorkflows([]*domain.Workflow{
+			testhelpers.NewWorkflowBuilder().
+				WithJob(&domain.WorkflowJob{}).
+				WithProcesses([]*domain.Process{trigger}).
+				Build(),
+		}).
+		Build()
+
+	starter := usecase.NewVersionStarter(logger, containerSvc)
+
+	err := starter.StartVersion(context.Background(), version)
+	assert.ErrorIs(t, err, domain.ErrBatchWorkflowWithNetworking)
+}
+
 func TestStartVersion_WithMultipleProcesses(t *testing.T) {
 	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
 	containerSvc := mocks.NewContainerServiceMock(t)
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/pkg/compensator"
+	"golang.org/x/net/context"
+)
+
+var (
+	ErrProcessImageRolledBack  = errors.New("process image update failed and was rolled back")
+	ErrNothingToScale          = errors.New("replicas or autoscaling settings are required to scale a process")
+	ErrInvalidAutoscaling      = errors.New("invalid autoscaling settings, max replicas must be greater or equal than min replicas")
+	ErrInvalidQueueAutoscaling = errors.New("invalid autoscaling settings, consumer lag autoscaling requires max replicas")
+)
+
+type UpdateProcessImageParams struct {
+	Product  string
+	Version  string
+	Workflow string
+	Process  string
+	Image    string
+}
+
+type ScaleProcessParams struct {
+	Product     string
+	Version     string
+	Workflow    string
+	Process     string
+	Replicas    *int32
+	Autoscaling *domain.ProcessAutoscaling
+}
+
+type VersionUpdater struct {
+	logger           logr.Logger
+	containerService service.ContainerUpdater
+}
+
+func NewVersionUpdater(logger logr.Logger, containerService service.ContainerUpdater) VersionUpdaterService {
+	return &VersionUpdater{
+		logger:           logger,
+		containerService: containerService,
+	}
+}
+
+// UpdateProcessImage performs a rolling update of a running process to a new image. If the new
+// pods don't become ready, the deployment is rolled back to the previous image.
+func (u *VersionUpdater) UpdateProcessImage(ctx context.Context, params UpdateProcessImageParams) error {
+	u.logger.Info("Updating process image",
+		"product", params.Product,
+		"version", params.Version,
+		"workflow", params.Workflow,
+		"process", params.Process,
+		"image", params.Image,
+	)
+
+	previousImage, err := u.containerService.UpdateProcessImage(ctx, service.UpdateProcessImageParams{
+		Product:  params.Product,
+		Version:  params.Version,
+		Workflow: params.Workflow,
+		Process:  params.Process,
+		Image:    params.Image,
+	})
+	if err != nil {
+		return fmt.Errorf("update process %q image: %w", params.Process, err)
+	}
+
+	compensations := compensator.New()
+	compensations.AddCompensation(u.rollbackProcessImageFunc(params, previousImage))
+
+	err = u.containerService.WaitProcesses(ctx, &domain.Version{
+		Product: params.Product,
+		Tag:     params.Version,
+	})
+	if err != nil {
+		u.logger.Info("Process not ready after image update, rolling back",
+			"process", params.Process,
+			"previousImage", previousImage,
+		)
+
+		if compensationsErrors := compensations.Execute(); compensationsErrors != nil {
+			return errors.Join(
+				fmt.Errorf("wait processes: %w", err),
+				fmt.Errorf("rolling back process image: %w", compensationsErrors),
+			)
+		}
+
+		return errors.Join(ErrProcessImageRolledBack, fmt.Errorf("wait processes: %w", err))
+	}
+
+	return nil
+}
+
+// ScaleProcess changes the replicas or the autoscaling bounds of a running process.
+func (u *VersionUpdater) ScaleProcess(ctx context.Context, params ScaleProcessParams) error {
+	u.logger.Info("Scaling process",
+		"product", params.Product,
+		"version", params.Version,
+		"workflow", params.Workflow,
+		"process", params.Process,
+	)
+
+	if params.Replicas == nil && params.Autoscaling == nil {
+		return ErrNothingToScale
+	}
+
+	if params.Autoscaling != nil && params.Autoscaling.MaxReplicas < params.Autoscaling.MinReplicas {
+		return ErrInvalidAutoscaling
+	}
+
+	if params.Autoscaling.IsQueueBased() && params.Autoscaling.MaxReplicas < 1 {
+		return ErrInvalidQueueAutoscaling
+	}
+
+	err := u.containerService.ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:     params.Product,
+		Version:     params.Version,
+		Workflow:    params.Workflow,
+		Process:     params.Process,
+		Replicas:    params.Replicas,
+		Autoscaling: params.Autoscaling,
+	})
+	if err != nil {
+		return fmt.Errorf("scale process %q: %w", params.Process, err)
+	}
+
+	return nil
+}
+
+func (u *VersionUpdater) rollbackProcessImageFunc(
+	params UpdateProcessImageParams,
+	previousImage string,
+) compensator.Compensation {
+	return func() error {
+		_, err := u.containerService.UpdateProcessImage(context.Background(), service.UpdateProcessImageParams{
+			Product:  params.Product,
+			Version:  params.Version,
+			Workflow: params.Workflow,
+			Process:  params.Process,
+			Image:    previousImage,
+		})
+
+		return err
+	}
+}
@@ -0,0 +1,195 @@
+//go:build unit
+
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/usecase"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateProcessImage(t *testing.T) {
+	var (
+		logger       = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc = mocks.NewContainerServiceMock(t)
+		updater      = usecase.NewVersionUpdater(logger, containerSvc)
+		ctx          = context.Background()
+	)
+
+	params := usecase.UpdateProcessImageParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Image:    "test-image@hotfix",
+	}
+
+	containerSvc.EXPECT().
+		UpdateProcessImage(ctx, service.UpdateProcessImageParams(params)).
+		Return("test-image@previous", nil).
+		Once()
+
+	containerSvc.EXPECT().
+		WaitProcesses(ctx, &domain.Version{Product: params.Product, Tag: params.Version}).
+		Return(nil).
+		Once()
+
+	err := updater.UpdateProcessImage(ctx, params)
+	assert.NoError(t, err)
+}
+
+func TestUpdateProcessImage_ErrorUpdatingImage(t *testing.T) {
+	var (
+		logger       = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc = mocks.NewContainerServiceMock(t)
+		updater      = usecase.NewVersionUpdater(logger, containerSvc)
+		ctx          = context.Background()
+	)
+
+	params := usecase.UpdateProcessImageParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Image:    "test-image@hotfix",
+	}
+
+	expectedErr := errors.New("error updating deployment")
+
+	containerSvc.EXPECT().
+		UpdateProcessImage(ctx, service.UpdateProcessImageParams(params)).
+		Return("", expectedErr).
+		Once()
+
+	err := updater.UpdateProcessImage(ctx, params)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestUpdateProcessImage_RollbackWhenNotReady(t *testing.T) {
+	var (
+		logger       = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc = mocks.NewContainerServiceMock(t)
+		updater      = usecase.NewVersionUpdater(logger, containerSvc)
+		ctx          = context.Background()
+	)
+
+	params := usecase.UpdateProcessImageParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Image:    "test-image@hotfix",
+	}
+
+	rollbackParams := service.UpdateProcessImageParams(params)
+	rollbackParams.Image = "test-image@previous"
+
+	expectedErr := errors.New("timeout waiting processes")
+
+	containerSvc.EXPECT().
+		UpdateProcessImage(ctx, service.UpdateProcessImageParams(params)).
+		Return("test-image@previous", nil).
+		Once()
+
+	containerSvc.EXPECT().
+		WaitProcesses(ctx, &domain.Version{Product: params.Product, Tag: params.Version}).
+		Return(expectedErr).
+		Once()
+
+	containerSvc.EXPECT().
+		UpdateProcessImage(mock.Anything, rollbackParams).
+		Return("test-image@hotfix", nil).
+		Once()
+
+	err := updater.UpdateProcessImage(ctx, params)
+	assert.ErrorIs(t, err, usecase.ErrProcessImageRolledBack)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestScaleProcess(t *testing.T) {
+	var (
+		logger       = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc = mocks.NewContainerServiceMock(t)
+		updater      = usecase.NewVersionUpdater(logger, containerSvc)
+		ctx          = context.Background()
+	)
+
+	replicas := int32(3)
+	params := usecase.ScaleProcessParams{
+		Product:     "test-product",
+		Version:     "v1.0.0",
+		Workflow:    "test-workflow",
+		Process:     "test-process",
+		Replicas:    &replicas,
+		Autoscaling: &domain.ProcessAutoscaling{MinReplicas: 1, MaxReplicas: 5},
+	}
+
+	containerSvc.EXPECT().
+		ScaleProcess(ctx, service.ScaleProcessParams(params)).
+		Return(nil).
+		Once()
+
+	err := updater.ScaleProcess(ctx, params)
+	assert.NoError(t, err)
+}
+
+func TestScaleProcess_NothingToScale(t *testing.T) {
+	var (
+		logger       = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc = mocks.NewContainerServiceMock(t)
+		updater      = usecase.NewVersionUpdater(logger, containerSvc)
+		ctx          = context.Background()
+	)
+
+	err := updater.ScaleProcess(ctx, usecase.ScaleProcessParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+	})
+	assert.ErrorIs(t, err, usecase.ErrNothingToScale)
+}
+
+func TestScaleProcess_InvalidAutoscaling(t *testing.T) {
+	var (
+		logger       = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc = mocks.NewContainerServiceMock(t)
+		updater      = usecase.NewVersionUpdater(logger, containerSvc)
+		ctx          = context.Background()
+	)
+
+	err := updater.ScaleProcess(ctx, usecase.ScaleProcessParams{
+		Product:     "test-product",
+		Version:     "v1.0.0",
+		Workflow:    "test-workflow",
+		Process:     "test-process",
+		Autoscaling: &domain.ProcessAutoscaling{MinReplicas: 5, MaxReplicas: 2},
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidAutoscaling)
+}
+
+func TestScaleProcess_InvalidQueueAutoscaling(t *testing.T) {
+	var (
+		logger       = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		containerSvc = mocks.NewContainerServiceMock(t)
+		updater      = usecase.NewVersionUpdater(logger, containerSvc)
+		ctx          = context.Background()
+	)
+
+	err := updater.ScaleProcess(ctx, usecase.ScaleProcessParams{
+		Product:     "test-product",
+		Version:     "v1.0.0",
+		Workflow:    "test-workflow",
+		Process:     "test-process",
+		Autoscaling: &domain.ProcessAutoscaling{ConsumerLagTarget: 100},
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidQueueAutoscaling)
+}
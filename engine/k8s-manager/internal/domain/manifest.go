@@ -0,0 +1,8 @@
+package domain
+
+// Manifest is a Kubernetes object rendered as YAML, as it would be applied to the cluster.
+type Manifest struct {
+	Kind string
+	Name string
+	YAML string
+}
@@ -16,12 +16,25 @@ type Process struct {
 	Networking     *Networking
 	ResourceLimits *ProcessResourceLimits
 	NodeSelectors  map[string]string
+	Autoscaling    *ProcessAutoscaling
+	Probes         *ProcessProbes
+	// MaxDeliver is how many times a message is delivered to the process before being dead-lettered.
+	// Zero uses the default max deliveries.
+	MaxDeliver int32
 }
 
 func (p *Process) IsTrigger() bool {
 	return p.Type == TriggerProcessType
 }
 
+func (p *Process) IsAutoscaled() bool {
+	return p.Autoscaling != nil || p.Replicas > 1
+}
+
+func (p *Process) IsQueueAutoscaled() bool {
+	return p.Autoscaling.IsQueueBased()
+}
+
 type Networking struct {
 	SourcePort int
 	TargetPort int
@@ -35,6 +48,39 @@ const (
 	NetworkingProtocolGRPC NetworkingProtocol = "GRPC"
 )
 
+// ProcessProbes holds the health checks of a process' app container.
+// Missing probes on networked processes are defaulted from their networking settings.
+type ProcessProbes struct {
+	Liveness  *ProcessProbe
+	Readiness *ProcessProbe
+	Startup   *ProcessProbe
+}
+
+//nolint:maligned // it's a struct
+type ProcessProbe struct {
+	Type        ProbeType
+	Path        string
+	Port        int
+	Command     []string
+	GRPCService string
+
+	InitialDelaySeconds int32
+	PeriodSeconds       int32
+	TimeoutSeconds      int32
+	SuccessThreshold    int32
+	FailureThreshold    int32
+}
+
+type ProbeType int
+
+const (
+	UnknownProbeType ProbeType = iota
+	HTTPProbeType
+	GRPCProbeType
+	ExecProbeType
+	TCPProbeType
+)
+
 type ProcessType int
 
 const (
@@ -57,6 +103,24 @@ func (p ProcessType) ToString() string {
 	}
 }
 
+// ProcessAutoscaling holds the horizontal autoscaling settings of a process.
+// Zero values fall back to the defaults: a single min replica and the global CPU target.
+// When ConsumerLagTarget is set, replicas follow the process' pending messages instead of
+// its resource usage and MinReplicas can be zero to stop idle processes.
+type ProcessAutoscaling struct {
+	MinReplicas                   int32
+	MaxReplicas                   int32
+	CPUTargetPercentage           int32
+	MemoryTargetPercentage        int32
+	ScaleUpStabilizationSeconds   int32
+	ScaleDownStabilizationSeconds int32
+	ConsumerLagTarget             int32
+}
+
+func (a *ProcessAutoscaling) IsQueueBased() bool {
+	return a != nil && a.ConsumerLagTarget > 0
+}
+
 type ResourceLimit struct {
 	Request string
 	Limit   string
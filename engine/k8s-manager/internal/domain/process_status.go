@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+type ProcessState string
+
+const (
+	ProcessStateStarting  ProcessState = "STARTING"
+	ProcessStateStarted   ProcessState = "STARTED"
+	ProcessStateSucceeded ProcessState = "SUCCEEDED"
+	ProcessStateFailed    ProcessState = "FAILED"
+)
+
+// ProcessStatus is the state of a running process. Attempts counts the pods run by processes
+// running as jobs, including retries.
+type ProcessStatus struct {
+	Workflow string
+	Process  string
+	State    ProcessState
+	Attempts int32
+	Message  string
+
+	ReadyReplicas   int32
+	DesiredReplicas int32
+	// Restarts sums the container restarts of all the process pods.
+	Restarts int32
+	// LastTerminationReason is the reason of the last container termination, like OOMKilled or Error,
+	// or CrashLoopBackOff while a container is waiting to be restarted.
+	LastTerminationReason string
+	// Events are the most recent k8s events of the process and its pods, newest first.
+	Events []ProcessEvent
+}
+
+type ProcessEvent struct {
+	Type          string
+	Reason        string
+	Message       string
+	Count         int32
+	LastTimestamp time.Time
+}
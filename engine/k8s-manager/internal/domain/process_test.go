@@ -63,3 +63,70 @@ func TestProcess_IsTrigger(t *testing.T) {
 		})
 	}
 }
+
+func TestProcess_IsAutoscaled(t *testing.T) {
+	testCases := []struct {
+		name         string
+		process      *domain.Process
+		isAutoscaled bool
+	}{
+		{
+			"single replica process",
+			testhelpers.NewProcessBuilder().WithReplicas(1).Build(),
+			false,
+		},
+		{
+			"multiple replicas process",
+			testhelpers.NewProcessBuilder().WithReplicas(3).Build(),
+			true,
+		},
+		{
+			"process with autoscaling settings",
+			testhelpers.NewProcessBuilder().
+				WithReplicas(1).
+				WithAutoscaling(&domain.ProcessAutoscaling{MinReplicas: 1, MaxReplicas: 4}).
+				Build(),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.isAutoscaled, tc.process.IsAutoscaled())
+		})
+	}
+}
+
+func TestProcess_IsQueueAutoscaled(t *testing.T) {
+	testCases := []struct {
+		name              string
+		process           *domain.Process
+		isQueueAutoscaled bool
+	}{
+		{
+			"process without autoscaling settings",
+			testhelpers.NewProcessBuilder().Build(),
+			false,
+		},
+		{
+			"process autoscaled by resources",
+			testhelpers.NewProcessBuilder().
+				WithAutoscaling(&domain.ProcessAutoscaling{MinReplicas: 1, MaxReplicas: 4}).
+				Build(),
+			false,
+		},
+		{
+			"process autoscaled by consumer lag",
+			testhelpers.NewProcessBuilder().
+				WithAutoscaling(&domain.ProcessAutoscaling{MaxReplicas: 4, ConsumerLagTarget: 100}).
+				Build(),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.isQueueAutoscaled, tc.process.IsQueueAutoscaled())
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package domain
+
+// ProductResourceQuota caps the resources a product can request in its namespace.
+// Empty values are not limited.
+type ProductResourceQuota struct {
+	CPU    string
+	Memory string
+	Pods   int32
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "math"
+
+// QueueScaledProcess is a running process whose replicas follow the backlog of its JetStream consumers.
+type QueueScaledProcess struct {
+	Product     string
+	Version     string
+	Workflow    string
+	Process     string
+	Replicas    int32
+	Autoscaling ProcessAutoscaling
+}
+
+// DesiredReplicas returns the replicas needed to keep each replica under the consumer lag target,
+// bounded by the min and max replicas. An idle process scales down to the min replicas, which
+// may be zero, and any pending message wakes it up again.
+func (p *QueueScaledProcess) DesiredReplicas(lag uint64) int32 {
+	desired := math.Ceil(float64(lag) / float64(p.Autoscaling.ConsumerLagTarget))
+	desired = math.Max(desired, float64(p.Autoscaling.MinReplicas))
+
+	if lag > 0 {
+		desired = math.Max(desired, 1)
+	}
+
+	return int32(math.Min(desired, float64(p.Autoscaling.MaxReplicas)))
+}
@@ -0,0 +1,40 @@
+//go:build unit
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueScaledProcess_DesiredReplicas(t *testing.T) {
+	testCases := []struct {
+		name        string
+		minReplicas int32
+		lag         uint64
+		expected    int32
+	}{
+		{"idle process scales to zero", 0, 0, 0},
+		{"idle process keeps min replicas", 2, 0, 2},
+		{"pending messages wake up a scaled to zero process", 0, 1, 1},
+		{"replicas follow lag target", 0, 25, 3},
+		{"replicas are capped by max replicas", 0, 1000, 5},
+		{"replicas don't go below min replicas", 4, 10, 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			process := &domain.QueueScaledProcess{
+				Autoscaling: domain.ProcessAutoscaling{
+					MinReplicas:       tc.minReplicas,
+					MaxReplicas:       5,
+					ConsumerLagTarget: 10,
+				},
+			}
+
+			assert.Equal(t, tc.expected, process.DesiredReplicas(tc.lag))
+		})
+	}
+}
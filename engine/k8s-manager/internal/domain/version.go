@@ -1,5 +1,7 @@
 package domain
 
+import "fmt"
+
 type Version struct {
 	Tag                  string
 	Product              string
@@ -9,6 +11,20 @@ type Version struct {
 	Workflows          []*Workflow
 	MinioConfiguration MinioConfiguration
 	ServiceAccount     ServiceAccount
+	// NatsCredentials restrict the version processes to the version NATS resources. Empty when
+	// NATS authentication is disabled.
+	NatsCredentials string
+}
+
+// ValidateWorkflows checks that the version workflows can be deployed.
+func (v *Version) ValidateWorkflows() error {
+	for _, workflow := range v.Workflows {
+		if err := workflow.Validate(); err != nil {
+			return fmt.Errorf("invalid workflow %q: %w", workflow.Name, err)
+		}
+	}
+
+	return nil
 }
 
 type MinioConfiguration struct {
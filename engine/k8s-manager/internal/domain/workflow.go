@@ -1,5 +1,12 @@
 package domain
 
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrBatchWorkflowWithNetworking = errors.New("batch workflows cannot have networked triggers")
+
 const (
 	WorkflowTypeUnknown  WorkflowType = "unknown"
 	WorkflowTypeTraining WorkflowType = "training"
@@ -14,6 +21,41 @@ type Workflow struct {
 	KeyValueStore string
 	Type          WorkflowType
 	Processes     []*Process
+	Job           *WorkflowJob
+}
+
+// IsBatch tells if the workflow processes run to completion as jobs instead of as long-lived deployments.
+// Only workflows with job settings are batch workflows.
+func (w *Workflow) IsBatch() bool {
+	return w.Job != nil
+}
+
+// Validate checks that batch workflows have no networked triggers, as their jobs cannot serve requests.
+func (w *Workflow) Validate() error {
+	if !w.IsBatch() {
+		return nil
+	}
+
+	for _, process := range w.Processes {
+		if process.IsTrigger() && process.Networking != nil {
+			return fmt.Errorf("%w: process %q", ErrBatchWorkflowWithNetworking, process.Name)
+		}
+	}
+
+	return nil
+}
+
+// WorkflowJob holds how the processes of a batch workflow run. Workflows with a schedule
+// run periodically, otherwise they run once when the version starts and on demand.
+type WorkflowJob struct {
+	Schedule                string
+	BackoffLimit            int32
+	ActiveDeadlineSeconds   int64
+	TTLSecondsAfterFinished int32
+}
+
+func (j *WorkflowJob) IsScheduled() bool {
+	return j.Schedule != ""
 }
 
 type WorkflowType string
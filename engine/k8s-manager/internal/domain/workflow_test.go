@@ -0,0 +1,74 @@
+//go:build unit
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflow_IsBatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		workflow *domain.Workflow
+		isBatch  bool
+	}{
+		{
+			"training workflow without job settings",
+			testhelpers.NewWorkflowBuilder().WithType(domain.WorkflowTypeTraining).Build(),
+			false,
+		},
+		{
+			"workflow with job settings",
+			testhelpers.NewWorkflowBuilder().WithJob(&domain.WorkflowJob{}).Build(),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.isBatch, tc.workflow.IsBatch())
+		})
+	}
+}
+
+func TestWorkflow_Validate(t *testing.T) {
+	networkedTrigger := testhelpers.NewProcessBuilder().
+		WithType(domain.TriggerProcessType).
+		WithNetworking(domain.Networking{SourcePort: 80, TargetPort: 8080, Protocol: "HTTP"}).
+		Build()
+
+	testCases := []struct {
+		name        string
+		workflow    *domain.Workflow
+		expectedErr error
+	}{
+		{
+			"batch workflow",
+			testhelpers.NewWorkflowBuilder().WithJob(&domain.WorkflowJob{}).Build(),
+			nil,
+		},
+		{
+			"networked trigger in a long-lived workflow",
+			testhelpers.NewWorkflowBuilder().WithProcesses([]*domain.Process{networkedTrigger}).Build(),
+			nil,
+		},
+		{
+			"networked trigger in a batch workflow",
+			testhelpers.NewWorkflowBuilder().
+				WithJob(&domain.WorkflowJob{}).
+				WithProcesses([]*domain.Process{networkedTrigger}).
+				Build(),
+			domain.ErrBatchWorkflowWithNetworking,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, tc.workflow.Validate(), tc.expectedErr)
+		})
+	}
+}
@@ -18,7 +18,15 @@ const (
 	BaseDomainNameKey  = "baseDomainName"
 	IsInsideClusterKey = "kubernetes.isInsideCluster"
 
-	NatsEndpointKey = "nats.url"
+	NamespaceIsolationEnabledKey           = "kubernetes.namespaceIsolation.enabled"
+	NamespaceIsolationAllowedNamespacesKey = "kubernetes.namespaceIsolation.allowedNamespaces"
+	NamespaceDefaultCPURequestKey          = "kubernetes.namespaceIsolation.defaults.cpu.request"
+	NamespaceDefaultCPULimitKey            = "kubernetes.namespaceIsolation.defaults.cpu.limit"
+	NamespaceDefaultMemoryRequestKey       = "kubernetes.namespaceIsolation.defaults.memory.request"
+	NamespaceDefaultMemoryLimitKey         = "kubernetes.namespaceIsolation.defaults.memory.limit"
+
+	NatsEndpointKey          = "nats.url"
+	NatsDefaultMaxDeliverKey = "nats.defaultMaxDeliver"
 
 	ImageRegistryURLKey = "registry.url"
 	//nolint:gosec // False positive
@@ -51,6 +59,8 @@ const (
 
 	ProcessTimeoutKey         = "processes.timeout"
 	AutoscaleCPUPercentageKey = "autoescale.cpu.percentage"
+	AutoscaleQueueIntervalKey = "autoescale.queue.interval"
+	NatsManagerEndpointKey    = "services.natsManager.endpoint"
 
 	FluentBitImageKey      = "fluentbit.image"
 	FluentBitTagKey        = "fluentbit.tag"
@@ -77,6 +87,7 @@ const (
 
 	_defaultServerPort     = 50051
 	_defaultRequestTimeout = 5000
+	_defaultMaxDeliver     = 5
 )
 
 //nolint:funlen // Future refactor
@@ -98,9 +109,12 @@ func Init(configFilePath string) error {
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
 	viper.RegisterAlias(NatsEndpointKey, "NATS_URL")
+	viper.RegisterAlias(NatsDefaultMaxDeliverKey, "NATS_DEFAULT_MAX_DELIVER")
 	viper.RegisterAlias(ImageRegistryURLKey, "REGISTRY_URL")
 	viper.RegisterAlias(KubeNamespaceKey, "KUBERNETES_NAMESPACE")
 	viper.RegisterAlias(BaseDomainNameKey, "BASE_DOMAIN_NAME")
+	viper.RegisterAlias(NamespaceIsolationEnabledKey, "NAMESPACE_ISOLATION_ENABLED")
+	viper.RegisterAlias(NamespaceIsolationAllowedNamespacesKey, "NAMESPACE_ISOLATION_ALLOWED_NAMESPACES")
 
 	viper.RegisterAlias(ImageRegistryAuthSecretKey, "REGISTRY_AUTH_SECRET_NAME")
 	viper.RegisterAlias(ImageBuilderNetrcEnabledKey, "IMAGE_BUILDER_NETRC_ENABLED")
@@ -127,6 +141,8 @@ func Init(configFilePath string) error {
 	viper.RegisterAlias(TriggersRequestTimeoutKey, "TRIGGERS_REQUEST_TIMEOUT")
 	viper.RegisterAlias(TriggersB64IngressesAnnotaionsKey, "TRIGGERS_BASE64_INGRESSES_ANNOTATIONS")
 	viper.RegisterAlias(AutoscaleCPUPercentageKey, "AUTOSCALE_CPU_PERCENTAGE")
+	viper.RegisterAlias(AutoscaleQueueIntervalKey, "AUTOSCALE_QUEUE_INTERVAL")
+	viper.RegisterAlias(NatsManagerEndpointKey, "SERVICES_NATS_MANAGER")
 
 	viper.RegisterAlias(FluentBitImageKey, "FLUENTBIT_IMAGE_REPOSITORY")
 	viper.RegisterAlias(FluentBitTagKey, "FLUENTBIT_IMAGE_TAG")
@@ -170,8 +186,14 @@ func setDefaultValues() {
 
 	viper.SetDefault(IsInsideClusterKey, true)
 	viper.SetDefault(KubeNamespaceKey, "kai")
+	viper.SetDefault(NamespaceIsolationEnabledKey, false)
+	viper.SetDefault(NamespaceDefaultCPURequestKey, "100m")
+	viper.SetDefault(NamespaceDefaultCPULimitKey, "500m")
+	viper.SetDefault(NamespaceDefaultMemoryRequestKey, "128Mi")
+	viper.SetDefault(NamespaceDefaultMemoryLimitKey, "256Mi")
 
 	viper.SetDefault(AutoscaleCPUPercentageKey, 80)
+	viper.SetDefault(AutoscaleQueueIntervalKey, 15*time.Second)
 	viper.SetDefault(ProcessTimeoutKey, 5*time.Minute)
 
 	viper.SetDefault(FluentBitImageKey, "fluent/fluent-bit")
@@ -188,6 +210,7 @@ func setDefaultValues() {
 	viper.SetDefault(MeasurementsMetricsIntervalKey, 10)
 
 	viper.SetDefault(PredictionsIndexKey, "predictionsIdx")
+	viper.SetDefault(NatsDefaultMaxDeliverKey, _defaultMaxDeliver)
 
 	userHome, ok := os.LookupEnv("HOME")
 	if ok {
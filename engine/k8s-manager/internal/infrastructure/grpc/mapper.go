@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"time"
+
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/grpc/proto/versionpb"
 )
@@ -19,6 +21,7 @@ func mapRequestToVersion(req *versionpb.StartRequest) *domain.Version {
 			Username: req.ServiceAccount.Username,
 			Password: req.ServiceAccount.Password,
 		},
+		NatsCredentials: req.NatsCredentials,
 	}
 }
 
@@ -32,6 +35,7 @@ func mapReqWorkflowsToWorkflows(reqWorkflows []*versionpb.Workflow) []*domain.Wo
 			KeyValueStore: workflow.KeyValueStore,
 			Processes:     mapReqProcessToProcess(workflow.Processes),
 			Type:          mapReqWorkflowTypeToDomain(workflow.Type),
+			Job:           mapReqWorkflowJobToDomain(workflow.Job),
 		})
 	}
 
@@ -54,6 +58,9 @@ func mapReqProcessToProcess(reqProcesses []*versionpb.Process) []*domain.Process
 			ObjectStore:   process.ObjectStore,
 			Config:        process.Config,
 			NodeSelectors: process.NodeSelectors,
+			Autoscaling:   mapReqAutoscalingToDomain(process.Autoscaling),
+			Probes:        mapReqProbesToDomain(process.Probes),
+			MaxDeliver:    process.MaxDeliver,
 		}
 
 		if process.Networking != nil {
@@ -83,6 +90,70 @@ func mapReqProcessToProcess(reqProcesses []*versionpb.Process) []*domain.Process
 	return processes
 }
 
+func mapReqAutoscalingToDomain(autoscaling *versionpb.ProcessAutoscaling) *domain.ProcessAutoscaling {
+	if autoscaling == nil {
+		return nil
+	}
+
+	return &domain.ProcessAutoscaling{
+		MinReplicas:                   autoscaling.MinReplicas,
+		MaxReplicas:                   autoscaling.MaxReplicas,
+		CPUTargetPercentage:           autoscaling.CpuTargetPercentage,
+		MemoryTargetPercentage:        autoscaling.MemoryTargetPercentage,
+		ScaleUpStabilizationSeconds:   autoscaling.ScaleUpStabilizationSeconds,
+		ScaleDownStabilizationSeconds: autoscaling.ScaleDownStabilizationSeconds,
+		ConsumerLagTarget:             autoscaling.ConsumerLagTarget,
+	}
+}
+
+func mapReqProbesToDomain(probes *versionpb.ProcessProbes) *domain.ProcessProbes {
+	if probes == nil {
+		return nil
+	}
+
+	return &domain.ProcessProbes{
+		Liveness:  mapReqProbeToDomain(probes.Liveness),
+		Readiness: mapReqProbeToDomain(probes.Readiness),
+		Startup:   mapReqProbeToDomain(probes.Startup),
+	}
+}
+
+func mapReqProbeToDomain(probe *versionpb.ProcessProbe) *domain.ProcessProbe {
+	if probe == nil {
+		return nil
+	}
+
+	return &domain.ProcessProbe{
+		Type:                mapReqProbeTypeToDomain(probe.Type),
+		Path:                probe.Path,
+		Port:                int(probe.Port),
+		Command:             probe.Command,
+		GRPCService:         probe.GrpcService,
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		SuccessThreshold:    probe.SuccessThreshold,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+}
+
+func mapReqProbeTypeToDomain(probeType versionpb.ProbeType) domain.ProbeType {
+	switch probeType {
+	case versionpb.ProbeType_ProbeTypeHTTP:
+		return domain.HTTPProbeType
+	case versionpb.ProbeType_ProbeTypeGRPC:
+		return domain.GRPCProbeType
+	case versionpb.ProbeType_ProbeTypeExec:
+		return domain.ExecProbeType
+	case versionpb.ProbeType_ProbeTypeTCP:
+		return domain.TCPProbeType
+	case versionpb.ProbeType_ProbeTypeUnknown:
+		return domain.UnknownProbeType
+	default:
+		return domain.UnknownProbeType
+	}
+}
+
 func mapReqWorkflowTypeToDomain(workflowType versionpb.WorkflowType) domain.WorkflowType {
 	switch workflowType {
 	case versionpb.WorkflowType_WorkflowTypeTraining:
@@ -112,3 +183,75 @@ func mapReqProcessTypeTpProcessType(processType versionpb.ProcessType) domain.Pr
 		return domain.UnknownProcessType
 	}
 }
+
+func mapReqWorkflowJobToDomain(job *versionpb.WorkflowJob) *domain.WorkflowJob {
+	if job == nil {
+		return nil
+	}
+
+	return &domain.WorkflowJob{
+		Schedule:                job.Schedule,
+		BackoffLimit:            job.BackoffLimit,
+		ActiveDeadlineSeconds:   job.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: job.TtlSecondsAfterFinished,
+	}
+}
+
+func mapProcessStatusToResponse(status *domain.ProcessStatus) *versionpb.ProcessStatusResponse {
+	return &versionpb.ProcessStatusResponse{
+		ProcessId: status.Process,
+		Name:      status.Process,
+		Workflow:  status.Workflow,
+		Status:    string(status.State),
+		Attempts:  status.Attempts,
+		Message:   status.Message,
+
+		ReadyReplicas:         status.ReadyReplicas,
+		DesiredReplicas:       status.DesiredReplicas,
+		Restarts:              status.Restarts,
+		LastTerminationReason: status.LastTerminationReason,
+		Events:                mapProcessEventsToResponse(status.Events),
+	}
+}
+
+func mapProcessEventsToResponse(events []domain.ProcessEvent) []*versionpb.ProcessEvent {
+	processEvents := make([]*versionpb.ProcessEvent, 0, len(events))
+
+	for _, event := range events {
+		processEvents = append(processEvents, &versionpb.ProcessEvent{
+			Type:          event.Type,
+			Reason:        event.Reason,
+			Message:       event.Message,
+			Count:         event.Count,
+			LastTimestamp: event.LastTimestamp.Format(time.RFC3339),
+		})
+	}
+
+	return processEvents
+}
+
+func mapReqResourceQuotaToDomain(quota *versionpb.ProductResourceQuota) *domain.ProductResourceQuota {
+	if quota == nil {
+		return nil
+	}
+
+	return &domain.ProductResourceQuota{
+		CPU:    quota.Cpu,
+		Memory: quota.Memory,
+		Pods:   quota.Pods,
+	}
+}
+
+func mapManifestsToResponse(manifests []*domain.Manifest) []*versionpb.Manifest {
+	response := make([]*versionpb.Manifest, 0, len(manifests))
+
+	for _, manifest := range manifests {
+		response = append(response, &versionpb.Manifest{
+			Kind: manifest.Kind,
+			Name: manifest.Name,
+			Yaml: manifest.YAML,
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,1490 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: nats.proto
+
+package natspb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// NatsManagerServiceClient is the client API for NatsManagerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NatsManagerServiceClient interface {
+	CreateStreams(ctx context.Context, in *CreateStreamsRequest, opts ...grpc.CallOption) (*CreateStreamsResponse, error)
+	CreateObjectStores(ctx context.Context, in *CreateObjectStoresRequest, opts ...grpc.CallOption) (*CreateObjectStoresResponse, error)
+	CreateProductObjectStore(ctx context.Context, in *CreateProductObjectStoreRequest, opts ...grpc.CallOption) (*CreateProductObjectStoreResponse, error)
+	GetProductObjectStores(ctx context.Context, in *GetProductObjectStoresRequest, opts ...grpc.CallOption) (*GetProductObjectStoresResponse, error)
+	DeleteProductObjectStore(ctx context.Context, in *DeleteProductObjectStoreRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
+	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (NatsManagerService_GetObjectClient, error)
+	PutObject(ctx context.Context, opts ...grpc.CallOption) (NatsManagerService_PutObjectClient, error)
+	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	CreateVersionKeyValueStores(ctx context.Context, in *CreateVersionKeyValueStoresRequest, opts ...grpc.CallOption) (*CreateVersionKeyValueStoresResponse, error)
+	GetVersionResources(ctx context.Context, in *GetVersionResourcesRequest, opts ...grpc.CallOption) (*GetVersionResourcesResponse, error)
+	CreateGlobalKeyValueStore(ctx context.Context, in *CreateGlobalKeyValueStoreRequest, opts ...grpc.CallOption) (*CreateGlobalKeyValueStoreResponse, error)
+	UpdateKeyValueConfiguration(ctx context.Context, in *UpdateKeyValueConfigurationRequest, opts ...grpc.CallOption) (*UpdateKeyValueConfigurationResponse, error)
+	GetProcessConfiguration(ctx context.Context, in *GetProcessConfigurationRequest, opts ...grpc.CallOption) (*GetProcessConfigurationResponse, error)
+	GetConfigurationHistory(ctx context.Context, in *GetConfigurationHistoryRequest, opts ...grpc.CallOption) (*GetConfigurationHistoryResponse, error)
+	RollbackConfiguration(ctx context.Context, in *RollbackConfigurationRequest, opts ...grpc.CallOption) (*RollbackConfigurationResponse, error)
+	GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error)
+	SetConfiguration(ctx context.Context, in *SetConfigurationRequest, opts ...grpc.CallOption) (*SetConfigurationResponse, error)
+	DeleteConfiguration(ctx context.Context, in *DeleteConfigurationRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	WatchProcessConfiguration(ctx context.Context, in *WatchProcessConfigurationRequest, opts ...grpc.CallOption) (NatsManagerService_WatchProcessConfigurationClient, error)
+	CreateVersionCredentials(ctx context.Context, in *CreateVersionCredentialsRequest, opts ...grpc.CallOption) (*CreateVersionCredentialsResponse, error)
+	RevokeVersionCredentials(ctx context.Context, in *RevokeVersionCredentialsRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	StartShadow(ctx context.Context, in *StartShadowRequest, opts ...grpc.CallOption) (*StartShadowResponse, error)
+	StopShadow(ctx context.Context, in *StopShadowRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
+	DeleteResources(ctx context.Context, in *DeleteResourcesRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	DeleteStreams(ctx context.Context, in *DeleteStreamsRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	DeleteObjectStores(ctx context.Context, in *DeleteObjectStoresRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	DeleteVersionKeyValueStores(ctx context.Context, in *DeleteVersionKeyValueStoresRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	DeleteGlobalKeyValueStore(ctx context.Context, in *DeleteGlobalKeyValueStoreRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	GetProcessConsumerLag(ctx context.Context, in *GetProcessConsumerLagRequest, opts ...grpc.CallOption) (*GetProcessConsumerLagResponse, error)
+	GetVersionStreamStats(ctx context.Context, in *GetVersionStreamStatsRequest, opts ...grpc.CallOption) (*GetVersionStreamStatsResponse, error)
+	GetProcessMessages(ctx context.Context, in *GetProcessMessagesRequest, opts ...grpc.CallOption) (*GetProcessMessagesResponse, error)
+	PublishMessage(ctx context.Context, in *PublishMessageRequest, opts ...grpc.CallOption) (*PublishMessageResponse, error)
+	GetDeadLetterMessages(ctx context.Context, in *GetDeadLetterMessagesRequest, opts ...grpc.CallOption) (*GetDeadLetterMessagesResponse, error)
+	ReplayDeadLetterMessages(ctx context.Context, in *ReplayDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessagesResponse, error)
+	PurgeDeadLetterMessages(ctx context.Context, in *PurgeDeadLetterMessagesRequest, opts ...grpc.CallOption) (*PurgeDeadLetterMessagesResponse, error)
+}
+
+type natsManagerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNatsManagerServiceClient(cc grpc.ClientConnInterface) NatsManagerServiceClient {
+	return &natsManagerServiceClient{cc}
+}
+
+func (c *natsManagerServiceClient) CreateStreams(ctx context.Context, in *CreateStreamsRequest, opts ...grpc.CallOption) (*CreateStreamsResponse, error) {
+	out := new(CreateStreamsResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/CreateStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) CreateObjectStores(ctx context.Context, in *CreateObjectStoresRequest, opts ...grpc.CallOption) (*CreateObjectStoresResponse, error) {
+	out := new(CreateObjectStoresResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/CreateObjectStores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) CreateProductObjectStore(ctx context.Context, in *CreateProductObjectStoreRequest, opts ...grpc.CallOption) (*CreateProductObjectStoreResponse, error) {
+	out := new(CreateProductObjectStoreResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/CreateProductObjectStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetProductObjectStores(ctx context.Context, in *GetProductObjectStoresRequest, opts ...grpc.CallOption) (*GetProductObjectStoresResponse, error) {
+	out := new(GetProductObjectStoresResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/GetProductObjectStores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) DeleteProductObjectStore(ctx context.Context, in *DeleteProductObjectStoreRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/DeleteProductObjectStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error) {
+	out := new(ListObjectsResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/ListObjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (NatsManagerService_GetObjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NatsManagerService_ServiceDesc.Streams[0], "/nats.NatsManagerService/GetObject", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &natsManagerServiceGetObjectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NatsManagerService_GetObjectClient interface {
+	Recv() (*ObjectChunk, error)
+	grpc.ClientStream
+}
+
+type natsManagerServiceGetObjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *natsManagerServiceGetObjectClient) Recv() (*ObjectChunk, error) {
+	m := new(ObjectChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *natsManagerServiceClient) PutObject(ctx context.Context, opts ...grpc.CallOption) (NatsManagerService_PutObjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NatsManagerService_ServiceDesc.Streams[1], "/nats.NatsManagerService/PutObject", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &natsManagerServicePutObjectClient{stream}
+	return x, nil
+}
+
+type NatsManagerService_PutObjectClient interface {
+	Send(*PutObjectRequest) error
+	CloseAndRecv() (*PutObjectResponse, error)
+	grpc.ClientStream
+}
+
+type natsManagerServicePutObjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *natsManagerServicePutObjectClient) Send(m *PutObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *natsManagerServicePutObjectClient) CloseAndRecv() (*PutObjectResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PutObjectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *natsManagerServiceClient) DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/DeleteObject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) CreateVersionKeyValueStores(ctx context.Context, in *CreateVersionKeyValueStoresRequest, opts ...grpc.CallOption) (*CreateVersionKeyValueStoresResponse, error) {
+	out := new(CreateVersionKeyValueStoresResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/CreateVersionKeyValueStores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetVersionResources(ctx context.Context, in *GetVersionResourcesRequest, opts ...grpc.CallOption) (*GetVersionResourcesResponse, error) {
+	out := new(GetVersionResourcesResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/GetVersionResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) CreateGlobalKeyValueStore(ctx context.Context, in *CreateGlobalKeyValueStoreRequest, opts ...grpc.CallOption) (*CreateGlobalKeyValueStoreResponse, error) {
+	out := new(CreateGlobalKeyValueStoreResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/CreateGlobalKeyValueStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) UpdateKeyValueConfiguration(ctx context.Context, in *UpdateKeyValueConfigurationRequest, opts ...grpc.CallOption) (*UpdateKeyValueConfigurationResponse, error) {
+	out := new(UpdateKeyValueConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/UpdateKeyValueConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetProcessConfiguration(ctx context.Context, in *GetProcessConfigurationRequest, opts ...grpc.CallOption) (*GetProcessConfigurationResponse, error) {
+	out := new(GetProcessConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/GetProcessConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetConfigurationHistory(ctx context.Context, in *GetConfigurationHistoryRequest, opts ...grpc.CallOption) (*GetConfigurationHistoryResponse, error) {
+	out := new(GetConfigurationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/GetConfigurationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) RollbackConfiguration(ctx context.Context, in *RollbackConfigurationRequest, opts ...grpc.CallOption) (*RollbackConfigurationResponse, error) {
+	out := new(RollbackConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/RollbackConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetConfiguration(ctx context.Context, in *GetConfigurationRequest, opts ...grpc.CallOption) (*GetConfigurationResponse, error) {
+	out := new(GetConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/GetConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) SetConfiguration(ctx context.Context, in *SetConfigurationRequest, opts ...grpc.CallOption) (*SetConfigurationResponse, error) {
+	out := new(SetConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/SetConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) DeleteConfiguration(ctx context.Context, in *DeleteConfigurationRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/DeleteConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) WatchProcessConfiguration(ctx context.Context, in *WatchProcessConfigurationRequest, opts ...grpc.CallOption) (NatsManagerService_WatchProcessConfigurationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NatsManagerService_ServiceDesc.Streams[2], "/nats.NatsManagerService/WatchProcessConfiguration", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &natsManagerServiceWatchProcessConfigurationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NatsManagerService_WatchProcessConfigurationClient interface {
+	Recv() (*ConfigurationEntry, error)
+	grpc.ClientStream
+}
+
+type natsManagerServiceWatchProcessConfigurationClient struct {
+	grpc.ClientStream
+}
+
+func (x *natsManagerServiceWatchProcessConfigurationClient) Recv() (*ConfigurationEntry, error) {
+	m := new(ConfigurationEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *natsManagerServiceClient) CreateVersionCredentials(ctx context.Context, in *CreateVersionCredentialsRequest, opts ...grpc.CallOption) (*CreateVersionCredentialsResponse, error) {
+	out := new(CreateVersionCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/CreateVersionCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) RevokeVersionCredentials(ctx context.Context, in *RevokeVersionCredentialsRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/RevokeVersionCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) StartShadow(ctx context.Context, in *StartShadowRequest, opts ...grpc.CallOption) (*StartShadowResponse, error) {
+	out := new(StartShadowResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/StartShadow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) StopShadow(ctx context.Context, in *StopShadowRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/StopShadow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/ListResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) DeleteResources(ctx context.Context, in *DeleteResourcesRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/DeleteResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) DeleteStreams(ctx context.Context, in *DeleteStreamsRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/DeleteStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) DeleteObjectStores(ctx context.Context, in *DeleteObjectStoresRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/DeleteObjectStores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) DeleteVersionKeyValueStores(ctx context.Context, in *DeleteVersionKeyValueStoresRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/DeleteVersionKeyValueStores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) DeleteGlobalKeyValueStore(ctx context.Context, in *DeleteGlobalKeyValueStoreRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/DeleteGlobalKeyValueStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetProcessConsumerLag(ctx context.Context, in *GetProcessConsumerLagRequest, opts ...grpc.CallOption) (*GetProcessConsumerLagResponse, error) {
+	out := new(GetProcessConsumerLagResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/GetProcessConsumerLag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetVersionStreamStats(ctx context.Context, in *GetVersionStreamStatsRequest, opts ...grpc.CallOption) (*GetVersionStreamStatsResponse, error) {
+	out := new(GetVersionStreamStatsResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/GetVersionStreamStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetProcessMessages(ctx context.Context, in *GetProcessMessagesRequest, opts ...grpc.CallOption) (*GetProcessMessagesResponse, error) {
+	out := new(GetProcessMessagesResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/GetProcessMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) PublishMessage(ctx context.Context, in *PublishMessageRequest, opts ...grpc.CallOption) (*PublishMessageResponse, error) {
+	out := new(PublishMessageResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/PublishMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) GetDeadLetterMessages(ctx context.Context, in *GetDeadLetterMessagesRequest, opts ...grpc.CallOption) (*GetDeadLetterMessagesResponse, error) {
+	out := new(GetDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/GetDeadLetterMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) ReplayDeadLetterMessages(ctx context.Context, in *ReplayDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessagesResponse, error) {
+	out := new(ReplayDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/ReplayDeadLetterMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *natsManagerServiceClient) PurgeDeadLetterMessages(ctx context.Context, in *PurgeDeadLetterMessagesRequest, opts ...grpc.CallOption) (*PurgeDeadLetterMessagesResponse, error) {
+	out := new(PurgeDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, "/nats.NatsManagerService/PurgeDeadLetterMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NatsManagerServiceServer is the server API for NatsManagerService service.
+// All implementations must embed UnimplementedNatsManagerServiceServer
+// for forward compatibility
+type NatsManagerServiceServer interface {
+	CreateStreams(context.Context, *CreateStreamsRequest) (*CreateStreamsResponse, error)
+	CreateObjectStores(context.Context, *CreateObjectStoresRequest) (*CreateObjectStoresResponse, error)
+	CreateProductObjectStore(context.Context, *CreateProductObjectStoreRequest) (*CreateProductObjectStoreResponse, error)
+	GetProductObjectStores(context.Context, *GetProductObjectStoresRequest) (*GetProductObjectStoresResponse, error)
+	DeleteProductObjectStore(context.Context, *DeleteProductObjectStoreRequest) (*DeleteResponse, error)
+	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
+	GetObject(*GetObjectRequest, NatsManagerService_GetObjectServer) error
+	PutObject(NatsManagerService_PutObjectServer) error
+	DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteResponse, error)
+	CreateVersionKeyValueStores(context.Context, *CreateVersionKeyValueStoresRequest) (*CreateVersionKeyValueStoresResponse, error)
+	GetVersionResources(context.Context, *GetVersionResourcesRequest) (*GetVersionResourcesResponse, error)
+	CreateGlobalKeyValueStore(context.Context, *CreateGlobalKeyValueStoreRequest) (*CreateGlobalKeyValueStoreResponse, error)
+	UpdateKeyValueConfiguration(context.Context, *UpdateKeyValueConfigurationRequest) (*UpdateKeyValueConfigurationResponse, error)
+	GetProcessConfiguration(context.Context, *GetProcessConfigurationRequest) (*GetProcessConfigurationResponse, error)
+	GetConfigurationHistory(context.Context, *GetConfigurationHistoryRequest) (*GetConfigurationHistoryResponse, error)
+	RollbackConfiguration(context.Context, *RollbackConfigurationRequest) (*RollbackConfigurationResponse, error)
+	GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error)
+	SetConfiguration(context.Context, *SetConfigurationRequest) (*SetConfigurationResponse, error)
+	DeleteConfiguration(context.Context, *DeleteConfigurationRequest) (*DeleteResponse, error)
+	WatchProcessConfiguration(*WatchProcessConfigurationRequest, NatsManagerService_WatchProcessConfigurationServer) error
+	CreateVersionCredentials(context.Context, *CreateVersionCredentialsRequest) (*CreateVersionCredentialsResponse, error)
+	RevokeVersionCredentials(context.Context, *RevokeVersionCredentialsRequest) (*DeleteResponse, error)
+	StartShadow(context.Context, *StartShadowRequest) (*StartShadowResponse, error)
+	StopShadow(context.Context, *StopShadowRequest) (*DeleteResponse, error)
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
+	DeleteResources(context.Context, *DeleteResourcesRequest) (*DeleteResponse, error)
+	DeleteStreams(context.Context, *DeleteStreamsRequest) (*DeleteResponse, error)
+	DeleteObjectStores(context.Context, *DeleteObjectStoresRequest) (*DeleteResponse, error)
+	DeleteVersionKeyValueStores(context.Context, *DeleteVersionKeyValueStoresRequest) (*DeleteResponse, error)
+	DeleteGlobalKeyValueStore(context.Context, *DeleteGlobalKeyValueStoreRequest) (*DeleteResponse, error)
+	GetProcessConsumerLag(context.Context, *GetProcessConsumerLagRequest) (*GetProcessConsumerLagResponse, error)
+	GetVersionStreamStats(context.Context, *GetVersionStreamStatsRequest) (*GetVersionStreamStatsResponse, error)
+	GetProcessMessages(context.Context, *GetProcessMessagesRequest) (*GetProcessMessagesResponse, error)
+	PublishMessage(context.Context, *PublishMessageRequest) (*PublishMessageResponse, error)
+	GetDeadLetterMessages(context.Context, *GetDeadLetterMessagesRequest) (*GetDeadLetterMessagesResponse, error)
+	ReplayDeadLetterMessages(context.Context, *ReplayDeadLetterMessagesRequest) (*ReplayDeadLetterMessagesResponse, error)
+	PurgeDeadLetterMessages(context.Context, *PurgeDeadLetterMessagesRequest) (*PurgeDeadLetterMessagesResponse, error)
+	mustEmbedUnimplementedNatsManagerServiceServer()
+}
+
+// UnimplementedNatsManagerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNatsManagerServiceServer struct {
+}
+
+func (UnimplementedNatsManagerServiceServer) CreateStreams(context.Context, *CreateStreamsRequest) (*CreateStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStreams not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) CreateObjectStores(context.Context, *CreateObjectStoresRequest) (*CreateObjectStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateObjectStores not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) CreateProductObjectStore(context.Context, *CreateProductObjectStoreRequest) (*CreateProductObjectStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductObjectStore not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetProductObjectStores(context.Context, *GetProductObjectStoresRequest) (*GetProductObjectStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductObjectStores not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) DeleteProductObjectStore(context.Context, *DeleteProductObjectStoreRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductObjectStore not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetObject(*GetObjectRequest, NatsManagerService_GetObjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetObject not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) PutObject(NatsManagerService_PutObjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutObject not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) CreateVersionKeyValueStores(context.Context, *CreateVersionKeyValueStoresRequest) (*CreateVersionKeyValueStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVersionKeyValueStores not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetVersionResources(context.Context, *GetVersionResourcesRequest) (*GetVersionResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersionResources not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) CreateGlobalKeyValueStore(context.Context, *CreateGlobalKeyValueStoreRequest) (*CreateGlobalKeyValueStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGlobalKeyValueStore not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) UpdateKeyValueConfiguration(context.Context, *UpdateKeyValueConfigurationRequest) (*UpdateKeyValueConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKeyValueConfiguration not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetProcessConfiguration(context.Context, *GetProcessConfigurationRequest) (*GetProcessConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessConfiguration not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetConfigurationHistory(context.Context, *GetConfigurationHistoryRequest) (*GetConfigurationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigurationHistory not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) RollbackConfiguration(context.Context, *RollbackConfigurationRequest) (*RollbackConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfiguration not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetConfiguration(context.Context, *GetConfigurationRequest) (*GetConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) SetConfiguration(context.Context, *SetConfigurationRequest) (*SetConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConfiguration not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) DeleteConfiguration(context.Context, *DeleteConfigurationRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfiguration not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) WatchProcessConfiguration(*WatchProcessConfigurationRequest, NatsManagerService_WatchProcessConfigurationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProcessConfiguration not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) CreateVersionCredentials(context.Context, *CreateVersionCredentialsRequest) (*CreateVersionCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVersionCredentials not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) RevokeVersionCredentials(context.Context, *RevokeVersionCredentialsRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeVersionCredentials not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) StartShadow(context.Context, *StartShadowRequest) (*StartShadowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartShadow not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) StopShadow(context.Context, *StopShadowRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopShadow not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) DeleteResources(context.Context, *DeleteResourcesRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResources not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) DeleteStreams(context.Context, *DeleteStreamsRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStreams not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) DeleteObjectStores(context.Context, *DeleteObjectStoresRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObjectStores not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) DeleteVersionKeyValueStores(context.Context, *DeleteVersionKeyValueStoresRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVersionKeyValueStores not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) DeleteGlobalKeyValueStore(context.Context, *DeleteGlobalKeyValueStoreRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGlobalKeyValueStore not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetProcessConsumerLag(context.Context, *GetProcessConsumerLagRequest) (*GetProcessConsumerLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessConsumerLag not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetVersionStreamStats(context.Context, *GetVersionStreamStatsRequest) (*GetVersionStreamStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersionStreamStats not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetProcessMessages(context.Context, *GetProcessMessagesRequest) (*GetProcessMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessMessages not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) PublishMessage(context.Context, *PublishMessageRequest) (*PublishMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishMessage not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) GetDeadLetterMessages(context.Context, *GetDeadLetterMessagesRequest) (*GetDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetterMessages not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) ReplayDeadLetterMessages(context.Context, *ReplayDeadLetterMessagesRequest) (*ReplayDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetterMessages not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) PurgeDeadLetterMessages(context.Context, *PurgeDeadLetterMessagesRequest) (*PurgeDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetterMessages not implemented")
+}
+func (UnimplementedNatsManagerServiceServer) mustEmbedUnimplementedNatsManagerServiceServer() {}
+
+// UnsafeNatsManagerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NatsManagerServiceServer will
+// result in compilation errors.
+type UnsafeNatsManagerServiceServer interface {
+	mustEmbedUnimplementedNatsManagerServiceServer()
+}
+
+func RegisterNatsManagerServiceServer(s grpc.ServiceRegistrar, srv NatsManagerServiceServer) {
+	s.RegisterService(&NatsManagerService_ServiceDesc, srv)
+}
+
+func _NatsManagerService_CreateStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).CreateStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/CreateStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).CreateStreams(ctx, req.(*CreateStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_CreateObjectStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateObjectStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).CreateObjectStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/CreateObjectStores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).CreateObjectStores(ctx, req.(*CreateObjectStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_CreateProductObjectStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductObjectStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).CreateProductObjectStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/CreateProductObjectStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).CreateProductObjectStore(ctx, req.(*CreateProductObjectStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetProductObjectStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductObjectStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).GetProductObjectStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/GetProductObjectStores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).GetProductObjectStores(ctx, req.(*GetProductObjectStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_DeleteProductObjectStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductObjectStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).DeleteProductObjectStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/DeleteProductObjectStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).DeleteProductObjectStore(ctx, req.(*DeleteProductObjectStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/ListObjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).ListObjects(ctx, req.(*ListObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetObjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NatsManagerServiceServer).GetObject(m, &natsManagerServiceGetObjectServer{stream})
+}
+
+type NatsManagerService_GetObjectServer interface {
+	Send(*ObjectChunk) error
+	grpc.ServerStream
+}
+
+type natsManagerServiceGetObjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *natsManagerServiceGetObjectServer) Send(m *ObjectChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NatsManagerService_PutObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NatsManagerServiceServer).PutObject(&natsManagerServicePutObjectServer{stream})
+}
+
+type NatsManagerService_PutObjectServer interface {
+	SendAndClose(*PutObjectResponse) error
+	Recv() (*PutObjectRequest, error)
+	grpc.ServerStream
+}
+
+type natsManagerServicePutObjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *natsManagerServicePutObjectServer) SendAndClose(m *PutObjectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *natsManagerServicePutObjectServer) Recv() (*PutObjectRequest, error) {
+	m := new(PutObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NatsManagerService_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/DeleteObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).DeleteObject(ctx, req.(*DeleteObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_CreateVersionKeyValueStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVersionKeyValueStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).CreateVersionKeyValueStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/CreateVersionKeyValueStores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).CreateVersionKeyValueStores(ctx, req.(*CreateVersionKeyValueStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetVersionResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).GetVersionResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/GetVersionResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).GetVersionResources(ctx, req.(*GetVersionResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_CreateGlobalKeyValueStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGlobalKeyValueStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).CreateGlobalKeyValueStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/CreateGlobalKeyValueStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).CreateGlobalKeyValueStore(ctx, req.(*CreateGlobalKeyValueStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_UpdateKeyValueConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKeyValueConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).UpdateKeyValueConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/UpdateKeyValueConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).UpdateKeyValueConfiguration(ctx, req.(*UpdateKeyValueConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetProcessConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).GetProcessConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/GetProcessConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).GetProcessConfiguration(ctx, req.(*GetProcessConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetConfigurationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).GetConfigurationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/GetConfigurationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).GetConfigurationHistory(ctx, req.(*GetConfigurationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_RollbackConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).RollbackConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/RollbackConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).RollbackConfiguration(ctx, req.(*RollbackConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).GetConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/GetConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).GetConfiguration(ctx, req.(*GetConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_SetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).SetConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/SetConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).SetConfiguration(ctx, req.(*SetConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_DeleteConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).DeleteConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/DeleteConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).DeleteConfiguration(ctx, req.(*DeleteConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_WatchProcessConfiguration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProcessConfigurationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NatsManagerServiceServer).WatchProcessConfiguration(m, &natsManagerServiceWatchProcessConfigurationServer{stream})
+}
+
+type NatsManagerService_WatchProcessConfigurationServer interface {
+	Send(*ConfigurationEntry) error
+	grpc.ServerStream
+}
+
+type natsManagerServiceWatchProcessConfigurationServer struct {
+	grpc.ServerStream
+}
+
+func (x *natsManagerServiceWatchProcessConfigurationServer) Send(m *ConfigurationEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NatsManagerService_CreateVersionCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVersionCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).CreateVersionCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/CreateVersionCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).CreateVersionCredentials(ctx, req.(*CreateVersionCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_RevokeVersionCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeVersionCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).RevokeVersionCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/RevokeVersionCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).RevokeVersionCredentials(ctx, req.(*RevokeVersionCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_StartShadow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartShadowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).StartShadow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/StartShadow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).StartShadow(ctx, req.(*StartShadowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_StopShadow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopShadowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).StopShadow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/StopShadow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).StopShadow(ctx, req.(*StopShadowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/ListResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_DeleteResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).DeleteResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/DeleteResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).DeleteResources(ctx, req.(*DeleteResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_DeleteStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).DeleteStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/DeleteStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).DeleteStreams(ctx, req.(*DeleteStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_DeleteObjectStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).DeleteObjectStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/DeleteObjectStores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).DeleteObjectStores(ctx, req.(*DeleteObjectStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_DeleteVersionKeyValueStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVersionKeyValueStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).DeleteVersionKeyValueStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/DeleteVersionKeyValueStores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).DeleteVersionKeyValueStores(ctx, req.(*DeleteVersionKeyValueStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_DeleteGlobalKeyValueStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGlobalKeyValueStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).DeleteGlobalKeyValueStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/DeleteGlobalKeyValueStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).DeleteGlobalKeyValueStore(ctx, req.(*DeleteGlobalKeyValueStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetProcessConsumerLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessConsumerLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).GetProcessConsumerLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/GetProcessConsumerLag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).GetProcessConsumerLag(ctx, req.(*GetProcessConsumerLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetVersionStreamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionStreamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).GetVersionStreamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/GetVersionStreamStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).GetVersionStreamStats(ctx, req.(*GetVersionStreamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetProcessMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).GetProcessMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/GetProcessMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).GetProcessMessages(ctx, req.(*GetProcessMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_PublishMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).PublishMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/PublishMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).PublishMessage(ctx, req.(*PublishMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_GetDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).GetDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/GetDeadLetterMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).GetDeadLetterMessages(ctx, req.(*GetDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_ReplayDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).ReplayDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/ReplayDeadLetterMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).ReplayDeadLetterMessages(ctx, req.(*ReplayDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NatsManagerService_PurgeDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NatsManagerServiceServer).PurgeDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nats.NatsManagerService/PurgeDeadLetterMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NatsManagerServiceServer).PurgeDeadLetterMessages(ctx, req.(*PurgeDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NatsManagerService_ServiceDesc is the grpc.ServiceDesc for NatsManagerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NatsManagerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nats.NatsManagerService",
+	HandlerType: (*NatsManagerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStreams",
+			Handler:    _NatsManagerService_CreateStreams_Handler,
+		},
+		{
+			MethodName: "CreateObjectStores",
+			Handler:    _NatsManagerService_CreateObjectStores_Handler,
+		},
+		{
+			MethodName: "CreateProductObjectStore",
+			Handler:    _NatsManagerService_CreateProductObjectStore_Handler,
+		},
+		{
+			MethodName: "GetProductObjectStores",
+			Handler:    _NatsManagerService_GetProductObjectStores_Handler,
+		},
+		{
+			MethodName: "DeleteProductObjectStore",
+			Handler:    _NatsManagerService_DeleteProductObjectStore_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _NatsManagerService_ListObjects_Handler,
+		},
+		{
+			MethodName: "DeleteObject",
+			Handler:    _NatsManagerService_DeleteObject_Handler,
+		},
+		{
+			MethodName: "CreateVersionKeyValueStores",
+			Handler:    _NatsManagerService_CreateVersionKeyValueStores_Handler,
+		},
+		{
+			MethodName: "GetVersionResources",
+			Handler:    _NatsManagerService_GetVersionResources_Handler,
+		},
+		{
+			MethodName: "CreateGlobalKeyValueStore",
+			Handler:    _NatsManagerService_CreateGlobalKeyValueStore_Handler,
+		},
+		{
+			MethodName: "UpdateKeyValueConfiguration",
+			Handler:    _NatsManagerService_UpdateKeyValueConfiguration_Handler,
+		},
+		{
+			MethodName: "GetProcessConfiguration",
+			Handler:    _NatsManagerService_GetProcessConfiguration_Handler,
+		},
+		{
+			MethodName: "GetConfigurationHistory",
+			Handler:    _NatsManagerService_GetConfigurationHistory_Handler,
+		},
+		{
+			MethodName: "RollbackConfiguration",
+			Handler:    _NatsManagerService_RollbackConfiguration_Handler,
+		},
+		{
+			MethodName: "GetConfiguration",
+			Handler:    _NatsManagerService_GetConfiguration_Handler,
+		},
+		{
+			MethodName: "SetConfiguration",
+			Handler:    _NatsManagerService_SetConfiguration_Handler,
+		},
+		{
+			MethodName: "DeleteConfiguration",
+			Handler:    _NatsManagerService_DeleteConfiguration_Handler,
+		},
+		{
+			MethodName: "CreateVersionCredentials",
+			Handler:    _NatsManagerService_CreateVersionCredentials_Handler,
+		},
+		{
+			MethodName: "RevokeVersionCredentials",
+			Handler:    _NatsManagerService_RevokeVersionCredentials_Handler,
+		},
+		{
+			MethodName: "StartShadow",
+			Handler:    _NatsManagerService_StartShadow_Handler,
+		},
+		{
+			MethodName: "StopShadow",
+			Handler:    _NatsManagerService_StopShadow_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _NatsManagerService_ListResources_Handler,
+		},
+		{
+			MethodName: "DeleteResources",
+			Handler:    _NatsManagerService_DeleteResources_Handler,
+		},
+		{
+			MethodName: "DeleteStreams",
+			Handler:    _NatsManagerService_DeleteStreams_Handler,
+		},
+		{
+			MethodName: "DeleteObjectStores",
+			Handler:    _NatsManagerService_DeleteObjectStores_Handler,
+		},
+		{
+			MethodName: "DeleteVersionKeyValueStores",
+			Handler:    _NatsManagerService_DeleteVersionKeyValueStores_Handler,
+		},
+		{
+			MethodName: "DeleteGlobalKeyValueStore",
+			Handler:    _NatsManagerService_DeleteGlobalKeyValueStore_Handler,
+		},
+		{
+			MethodName: "GetProcessConsumerLag",
+			Handler:    _NatsManagerService_GetProcessConsumerLag_Handler,
+		},
+		{
+			MethodName: "GetVersionStreamStats",
+			Handler:    _NatsManagerService_GetVersionStreamStats_Handler,
+		},
+		{
+			MethodName: "GetProcessMessages",
+			Handler:    _NatsManagerService_GetProcessMessages_Handler,
+		},
+		{
+			MethodName: "PublishMessage",
+			Handler:    _NatsManagerService_PublishMessage_Handler,
+		},
+		{
+			MethodName: "GetDeadLetterMessages",
+			Handler:    _NatsManagerService_GetDeadLetterMessages_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetterMessages",
+			Handler:    _NatsManagerService_ReplayDeadLetterMessages_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetterMessages",
+			Handler:    _NatsManagerService_PurgeDeadLetterMessages_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetObject",
+			Handler:       _NatsManagerService_GetObject_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PutObject",
+			Handler:       _NatsManagerService_PutObject_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchProcessConfiguration",
+			Handler:       _NatsManagerService_WatchProcessConfiguration_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "nats.proto",
+}
@@ -23,12 +23,20 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VersionServiceClient interface {
 	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*Response, error)
+	RenderManifests(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*RenderManifestsResponse, error)
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*Response, error)
 	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
 	Unpublish(ctx context.Context, in *UnpublishRequest, opts ...grpc.CallOption) (*Response, error)
 	WatchProcessStatus(ctx context.Context, in *ProcessStatusRequest, opts ...grpc.CallOption) (VersionService_WatchProcessStatusClient, error)
+	GetProcessStatus(ctx context.Context, in *ProcessStatusRequest, opts ...grpc.CallOption) (*GetProcessStatusResponse, error)
 	RegisterProcess(ctx context.Context, in *RegisterProcessRequest, opts ...grpc.CallOption) (*RegisterProcessResponse, error)
 	GetPublishedTriggers(ctx context.Context, in *GetPublishedTriggersRequest, opts ...grpc.CallOption) (*PublishResponse, error)
+	UpdateProcessImage(ctx context.Context, in *UpdateProcessImageRequest, opts ...grpc.CallOption) (*Response, error)
+	ScaleProcess(ctx context.Context, in *ScaleProcessRequest, opts ...grpc.CallOption) (*Response, error)
+	RunWorkflow(ctx context.Context, in *RunWorkflowRequest, opts ...grpc.CallOption) (*RunWorkflowResponse, error)
+	CreateProductNamespace(ctx context.Context, in *CreateProductNamespaceRequest, opts ...grpc.CallOption) (*Response, error)
+	UpdateProductNamespace(ctx context.Context, in *UpdateProductNamespaceRequest, opts ...grpc.CallOption) (*Response, error)
+	DeleteProductNamespace(ctx context.Context, in *DeleteProductNamespaceRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type versionServiceClient struct {
@@ -48,6 +56,15 @@ func (c *versionServiceClient) Start(ctx context.Context, in *StartRequest, opts
 	return out, nil
 }
 
+func (c *versionServiceClient) RenderManifests(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*RenderManifestsResponse, error) {
+	out := new(RenderManifestsResponse)
+	err := c.cc.Invoke(ctx, "/version.VersionService/RenderManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *versionServiceClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := c.cc.Invoke(ctx, "/version.VersionService/Stop", in, out, opts...)
@@ -107,6 +124,15 @@ func (x *versionServiceWatchProcessStatusClient) Recv() (*ProcessStatusResponse,
 	return m, nil
 }
 
+func (c *versionServiceClient) GetProcessStatus(ctx context.Context, in *ProcessStatusRequest, opts ...grpc.CallOption) (*GetProcessStatusResponse, error) {
+	out := new(GetProcessStatusResponse)
+	err := c.cc.Invoke(ctx, "/version.VersionService/GetProcessStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *versionServiceClient) RegisterProcess(ctx context.Context, in *RegisterProcessRequest, opts ...grpc.CallOption) (*RegisterProcessResponse, error) {
 	out := new(RegisterProcessResponse)
 	err := c.cc.Invoke(ctx, "/version.VersionService/RegisterProcess", in, out, opts...)
@@ -125,17 +151,79 @@ func (c *versionServiceClient) GetPublishedTriggers(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *versionServiceClient) UpdateProcessImage(ctx context.Context, in *UpdateProcessImageRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/version.VersionService/UpdateProcessImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionServiceClient) ScaleProcess(ctx context.Context, in *ScaleProcessRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/version.VersionService/ScaleProcess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionServiceClient) RunWorkflow(ctx context.Context, in *RunWorkflowRequest, opts ...grpc.CallOption) (*RunWorkflowResponse, error) {
+	out := new(RunWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/version.VersionService/RunWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionServiceClient) CreateProductNamespace(ctx context.Context, in *CreateProductNamespaceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/version.VersionService/CreateProductNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionServiceClient) UpdateProductNamespace(ctx context.Context, in *UpdateProductNamespaceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/version.VersionService/UpdateProductNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *versionServiceClient) DeleteProductNamespace(ctx context.Context, in *DeleteProductNamespaceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/version.VersionService/DeleteProductNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VersionServiceServer is the server API for VersionService service.
 // All implementations must embed UnimplementedVersionServiceServer
 // for forward compatibility
 type VersionServiceServer interface {
 	Start(context.Context, *StartRequest) (*Response, error)
+	RenderManifests(context.Context, *StartRequest) (*RenderManifestsResponse, error)
 	Stop(context.Context, *StopRequest) (*Response, error)
 	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
 	Unpublish(context.Context, *UnpublishRequest) (*Response, error)
 	WatchProcessStatus(*ProcessStatusRequest, VersionService_WatchProcessStatusServer) error
+	GetProcessStatus(context.Context, *ProcessStatusRequest) (*GetProcessStatusResponse, error)
 	RegisterProcess(context.Context, *RegisterProcessRequest) (*RegisterProcessResponse, error)
 	GetPublishedTriggers(context.Context, *GetPublishedTriggersRequest) (*PublishResponse, error)
+	UpdateProcessImage(context.Context, *UpdateProcessImageRequest) (*Response, error)
+	ScaleProcess(context.Context, *ScaleProcessRequest) (*Response, error)
+	RunWorkflow(context.Context, *RunWorkflowRequest) (*RunWorkflowResponse, error)
+	CreateProductNamespace(context.Context, *CreateProductNamespaceRequest) (*Response, error)
+	UpdateProductNamespace(context.Context, *UpdateProductNamespaceRequest) (*Response, error)
+	DeleteProductNamespace(context.Context, *DeleteProductNamespaceRequest) (*Response, error)
 	mustEmbedUnimplementedVersionServiceServer()
 }
 
@@ -146,6 +234,9 @@ type UnimplementedVersionServiceServer struct {
 func (UnimplementedVersionServiceServer) Start(context.Context, *StartRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
 }
+func (UnimplementedVersionServiceServer) RenderManifests(context.Context, *StartRequest) (*RenderManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderManifests not implemented")
+}
 func (UnimplementedVersionServiceServer) Stop(context.Context, *StopRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
@@ -158,12 +249,33 @@ func (UnimplementedVersionServiceServer) Unpublish(context.Context, *UnpublishRe
 func (UnimplementedVersionServiceServer) WatchProcessStatus(*ProcessStatusRequest, VersionService_WatchProcessStatusServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchProcessStatus not implemented")
 }
+func (UnimplementedVersionServiceServer) GetProcessStatus(context.Context, *ProcessStatusRequest) (*GetProcessStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessStatus not implemented")
+}
 func (UnimplementedVersionServiceServer) RegisterProcess(context.Context, *RegisterProcessRequest) (*RegisterProcessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterProcess not implemented")
 }
 func (UnimplementedVersionServiceServer) GetPublishedTriggers(context.Context, *GetPublishedTriggersRequest) (*PublishResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublishedTriggers not implemented")
 }
+func (UnimplementedVersionServiceServer) UpdateProcessImage(context.Context, *UpdateProcessImageRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProcessImage not implemented")
+}
+func (UnimplementedVersionServiceServer) ScaleProcess(context.Context, *ScaleProcessRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleProcess not implemented")
+}
+func (UnimplementedVersionServiceServer) RunWorkflow(context.Context, *RunWorkflowRequest) (*RunWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunWorkflow not implemented")
+}
+func (UnimplementedVersionServiceServer) CreateProductNamespace(context.Context, *CreateProductNamespaceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductNamespace not implemented")
+}
+func (UnimplementedVersionServiceServer) UpdateProductNamespace(context.Context, *UpdateProductNamespaceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductNamespace not implemented")
+}
+func (UnimplementedVersionServiceServer) DeleteProductNamespace(context.Context, *DeleteProductNamespaceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductNamespace not implemented")
+}
 func (UnimplementedVersionServiceServer) mustEmbedUnimplementedVersionServiceServer() {}
 
 // UnsafeVersionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -195,6 +307,24 @@ func _VersionService_Start_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VersionService_RenderManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).RenderManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/version.VersionService/RenderManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).RenderManifests(ctx, req.(*StartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VersionService_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -270,6 +400,24 @@ func (x *versionServiceWatchProcessStatusServer) Send(m *ProcessStatusResponse)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _VersionService_GetProcessStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).GetProcessStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/version.VersionService/GetProcessStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).GetProcessStatus(ctx, req.(*ProcessStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VersionService_RegisterProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterProcessRequest)
 	if err := dec(in); err != nil {
@@ -306,6 +454,114 @@ func _VersionService_GetPublishedTriggers_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VersionService_UpdateProcessImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProcessImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).UpdateProcessImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/version.VersionService/UpdateProcessImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).UpdateProcessImage(ctx, req.(*UpdateProcessImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionService_ScaleProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleProcessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).ScaleProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/version.VersionService/ScaleProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).ScaleProcess(ctx, req.(*ScaleProcessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionService_RunWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).RunWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/version.VersionService/RunWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).RunWorkflow(ctx, req.(*RunWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionService_CreateProductNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).CreateProductNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/version.VersionService/CreateProductNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).CreateProductNamespace(ctx, req.(*CreateProductNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionService_UpdateProductNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).UpdateProductNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/version.VersionService/UpdateProductNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).UpdateProductNamespace(ctx, req.(*UpdateProductNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VersionService_DeleteProductNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).DeleteProductNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/version.VersionService/DeleteProductNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).DeleteProductNamespace(ctx, req.(*DeleteProductNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VersionService_ServiceDesc is the grpc.ServiceDesc for VersionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +573,10 @@ var VersionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Start",
 			Handler:    _VersionService_Start_Handler,
 		},
+		{
+			MethodName: "RenderManifests",
+			Handler:    _VersionService_RenderManifests_Handler,
+		},
 		{
 			MethodName: "Stop",
 			Handler:    _VersionService_Stop_Handler,
@@ -329,6 +589,10 @@ var VersionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unpublish",
 			Handler:    _VersionService_Unpublish_Handler,
 		},
+		{
+			MethodName: "GetProcessStatus",
+			Handler:    _VersionService_GetProcessStatus_Handler,
+		},
 		{
 			MethodName: "RegisterProcess",
 			Handler:    _VersionService_RegisterProcess_Handler,
@@ -337,6 +601,30 @@ var VersionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublishedTriggers",
 			Handler:    _VersionService_GetPublishedTriggers_Handler,
 		},
+		{
+			MethodName: "UpdateProcessImage",
+			Handler:    _VersionService_UpdateProcessImage_Handler,
+		},
+		{
+			MethodName: "ScaleProcess",
+			Handler:    _VersionService_ScaleProcess_Handler,
+		},
+		{
+			MethodName: "RunWorkflow",
+			Handler:    _VersionService_RunWorkflow_Handler,
+		},
+		{
+			MethodName: "CreateProductNamespace",
+			Handler:    _VersionService_CreateProductNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateProductNamespace",
+			Handler:    _VersionService_UpdateProductNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteProductNamespace",
+			Handler:    _VersionService_DeleteProductNamespace_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
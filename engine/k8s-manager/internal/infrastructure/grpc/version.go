@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/usecase"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/grpc/proto/versionpb"
 )
 
@@ -16,7 +17,11 @@ type VersionService struct {
 	stopper         usecase.VersionStopperService
 	publisher       usecase.VersionPublisherService
 	unpublisher     usecase.VersionUnpublisherService
+	updater         usecase.VersionUpdaterService
+	runner          usecase.VersionRunnerService
 	processRegister usecase.ProcessService
+	namespaces      usecase.ProductNamespaceService
+	renderer        usecase.VersionRendererService
 }
 
 func NewVersionService(
@@ -25,7 +30,11 @@ func NewVersionService(
 	stopper usecase.VersionStopperService,
 	publisher usecase.VersionPublisherService,
 	unpublisher usecase.VersionUnpublisherService,
+	updater usecase.VersionUpdaterService,
+	runner usecase.VersionRunnerService,
 	processRegister usecase.ProcessService,
+	namespaces usecase.ProductNamespaceService,
+	renderer usecase.VersionRendererService,
 ) *VersionService {
 	return &VersionService{
 		versionpb.UnimplementedVersionServiceServer{},
@@ -34,7 +43,11 @@ func NewVersionService(
 		stopper,
 		publisher,
 		unpublisher,
+		updater,
+		runner,
 		processRegister,
+		namespaces,
+		renderer,
 	}
 }
 
@@ -55,6 +68,21 @@ func (v *VersionService) Start(
 	}, nil
 }
 
+func (v *VersionService) RenderManifests(
+	ctx context.Context, req *versionpb.StartRequest,
+) (*versionpb.RenderManifestsResponse, error) {
+	v.logger.Info("RenderManifests request received")
+
+	manifests, err := v.renderer.RenderVersion(ctx, mapRequestToVersion(req))
+	if err != nil {
+		return nil, fmt.Errorf("render version %q manifests in product %q: %w", req.VersionTag, req.ProductId, err)
+	}
+
+	return &versionpb.RenderManifestsResponse{
+		Manifests: mapManifestsToResponse(manifests),
+	}, nil
+}
+
 func (v *VersionService) Stop(
 	ctx context.Context,
 	req *versionpb.StopRequest,
@@ -140,3 +168,161 @@ func (v *VersionService) GetPublishedTriggers(
 		NetworkUrls: publishedTriggers,
 	}, nil
 }
+
+func (v *VersionService) UpdateProcessImage(
+	ctx context.Context,
+	req *versionpb.UpdateProcessImageRequest,
+) (*versionpb.Response, error) {
+	v.logger.Info("UpdateProcessImage request received")
+
+	err := v.updater.UpdateProcessImage(ctx, usecase.UpdateProcessImageParams{
+		Product:  req.ProductId,
+		Version:  req.VersionTag,
+		Workflow: req.Workflow,
+		Process:  req.Process,
+		Image:    req.Image,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("updating process %q image: %w", req.Process, err)
+	}
+
+	return &versionpb.Response{
+		Message: fmt.Sprintf("Process %q on version %q updated to image %q", req.Process, req.VersionTag, req.Image),
+	}, nil
+}
+
+func (v *VersionService) ScaleProcess(
+	ctx context.Context,
+	req *versionpb.ScaleProcessRequest,
+) (*versionpb.Response, error) {
+	v.logger.Info("ScaleProcess request received")
+
+	err := v.updater.ScaleProcess(ctx, usecase.ScaleProcessParams{
+		Product:     req.ProductId,
+		Version:     req.VersionTag,
+		Workflow:    req.Workflow,
+		Process:     req.Process,
+		Replicas:    req.Replicas,
+		Autoscaling: mapReqAutoscalingToDomain(req.Autoscaling),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scaling process %q: %w", req.Process, err)
+	}
+
+	return &versionpb.Response{
+		Message: fmt.Sprintf("Process %q on version %q scaled", req.Process, req.VersionTag),
+	}, nil
+}
+
+func (v *VersionService) RunWorkflow(
+	ctx context.Context,
+	req *versionpb.RunWorkflowRequest,
+) (*versionpb.RunWorkflowResponse, error) {
+	v.logger.Info("RunWorkflow request received")
+
+	jobs, err := v.runner.RunWorkflow(ctx, req.ProductId, req.VersionTag, req.Workflow)
+	if err != nil {
+		return nil, fmt.Errorf("running workflow %q: %w", req.Workflow, err)
+	}
+
+	return &versionpb.RunWorkflowResponse{
+		Jobs: jobs,
+	}, nil
+}
+
+func (v *VersionService) WatchProcessStatus(
+	req *versionpb.ProcessStatusRequest,
+	stream versionpb.VersionService_WatchProcessStatusServer,
+) error {
+	v.logger.Info("WatchProcessStatus request received")
+
+	ctx := stream.Context()
+	statusCh := make(chan *domain.ProcessStatus, 1)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- v.runner.WatchProcessStatus(ctx, req.ProductId, req.VersionTag, statusCh)
+	}()
+
+	for {
+		select {
+		case status := <-statusCh:
+			if err := stream.Send(mapProcessStatusToResponse(status)); err != nil {
+				return fmt.Errorf("sending process status: %w", err)
+			}
+		case err := <-errCh:
+			if err != nil {
+				return fmt.Errorf("watching process status of version %q: %w", req.VersionTag, err)
+			}
+
+			return nil
+		}
+	}
+}
+
+func (v *VersionService) GetProcessStatus(
+	ctx context.Context,
+	req *versionpb.ProcessStatusRequest,
+) (*versionpb.GetProcessStatusResponse, error) {
+	v.logger.Info("GetProcessStatus request received")
+
+	statuses, err := v.runner.GetProcessStatus(ctx, req.ProductId, req.VersionTag)
+	if err != nil {
+		return nil, fmt.Errorf("getting process status of version %q: %w", req.VersionTag, err)
+	}
+
+	processes := make([]*versionpb.ProcessStatusResponse, 0, len(statuses))
+	for _, status := range statuses {
+		processes = append(processes, mapProcessStatusToResponse(status))
+	}
+
+	return &versionpb.GetProcessStatusResponse{Processes: processes}, nil
+}
+
+func (v *VersionService) CreateProductNamespace(
+	ctx context.Context,
+	req *versionpb.CreateProductNamespaceRequest,
+) (*versionpb.Response, error) {
+	v.logger.Info("CreateProductNamespace request received")
+
+	err := v.namespaces.CreateProductNamespace(ctx, req.ProductId, mapReqResourceQuotaToDomain(req.ResourceQuota))
+	if err != nil {
+		return nil, fmt.Errorf("creating product %q namespace: %w", req.ProductId, err)
+	}
+
+	return &versionpb.Response{
+		Message: fmt.Sprintf("Namespace for product %q created", req.ProductId),
+	}, nil
+}
+
+func (v *VersionService) UpdateProductNamespace(
+	ctx context.Context,
+	req *versionpb.UpdateProductNamespaceRequest,
+) (*versionpb.Response, error) {
+	v.logger.Info("UpdateProductNamespace request received")
+
+	err := v.namespaces.UpdateProductNamespace(ctx, req.ProductId, mapReqResourceQuotaToDomain(req.ResourceQuota))
+	if err != nil {
+		return nil, fmt.Errorf("updating product %q namespace: %w", req.ProductId, err)
+	}
+
+	return &versionpb.Response{
+		Message: fmt.Sprintf("Namespace for product %q updated", req.ProductId),
+	}, nil
+}
+
+func (v *VersionService) DeleteProductNamespace(
+	ctx context.Context,
+	req *versionpb.DeleteProductNamespaceRequest,
+) (*versionpb.Response, error) {
+	v.logger.Info("DeleteProductNamespace request received")
+
+	err := v.namespaces.DeleteProductNamespace(ctx, req.ProductId)
+	if err != nil {
+		return nil, fmt.Errorf("deleting product %q namespace: %w", req.ProductId, err)
+	}
+
+	return &versionpb.Response{
+		Message: fmt.Sprintf("Namespace for product %q deleted", req.ProductId),
+	}, nil
+}
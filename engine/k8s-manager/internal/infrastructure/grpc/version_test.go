@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -14,6 +15,7 @@ import (
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/grpc"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/grpc/proto/versionpb"
 	"github.com/konstellation-io/kai/engine/k8s-manager/mocks"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -42,7 +44,11 @@ func (s *VersionServiceTestSuite) SetupSuite() {
 		s.versionServiceMock,
 		s.versionServiceMock,
 		s.versionServiceMock,
+		s.versionServiceMock,
+		s.versionServiceMock,
 		s.processServiceMock,
+		s.versionServiceMock,
+		s.versionServiceMock,
 	)
 
 	s.logger = logger
@@ -64,6 +70,7 @@ func (s *VersionServiceTestSuite) TestStart() {
 			Username: "test-user",
 			Password: "test-password",
 		},
+		NatsCredentials: "test-nats-credentials",
 		Workflows: []*versionpb.Workflow{
 			{
 				Name: "test-workflow",
@@ -97,6 +104,16 @@ func (s *VersionServiceTestSuite) TestStart() {
 								Limit:   "1000Mi",
 							},
 						},
+						Probes: &versionpb.ProcessProbes{
+							Readiness: &versionpb.ProcessProbe{
+								Type:             versionpb.ProbeType_ProbeTypeHTTP,
+								Path:             "/ready",
+								Port:             8081,
+								PeriodSeconds:    5,
+								FailureThreshold: 3,
+							},
+						},
+						MaxDeliver: 10,
 					},
 				},
 			},
@@ -115,6 +132,7 @@ func (s *VersionServiceTestSuite) TestStart() {
 			Username: req.ServiceAccount.Username,
 			Password: req.ServiceAccount.Password,
 		},
+		NatsCredentials: req.NatsCredentials,
 		Workflows: []*domain.Workflow{
 			{
 				Name: req.Workflows[0].Name,
@@ -147,6 +165,16 @@ func (s *VersionServiceTestSuite) TestStart() {
 								Limit:   req.Workflows[0].Processes[0].ResourceLimits.Memory.Limit,
 							},
 						},
+						Probes: &domain.ProcessProbes{
+							Readiness: &domain.ProcessProbe{
+								Type:             domain.HTTPProbeType,
+								Path:             "/ready",
+								Port:             8081,
+								PeriodSeconds:    5,
+								FailureThreshold: 3,
+							},
+						},
+						MaxDeliver: req.Workflows[0].Processes[0].MaxDeliver,
 					},
 				},
 			},
@@ -160,6 +188,33 @@ func (s *VersionServiceTestSuite) TestStart() {
 	s.NotNil(res)
 }
 
+func (s *VersionServiceTestSuite) TestRenderManifests() {
+	ctx := context.Background()
+
+	req := &versionpb.StartRequest{
+		ProductId:          "test-product",
+		VersionTag:         "test-version",
+		MinioConfiguration: &versionpb.MinioConfiguration{Bucket: "test-minio-bucket"},
+		ServiceAccount:     &versionpb.ServiceAccount{Username: "test-user", Password: "test-password"},
+	}
+
+	manifests := []*domain.Manifest{
+		{Kind: "ConfigMap", Name: "test-product-test-version-conf-files", YAML: "kind: ConfigMap\n"},
+	}
+
+	s.versionServiceMock.EXPECT().
+		RenderVersion(ctx, mock.AnythingOfType("*domain.Version")).
+		Return(manifests, nil).
+		Once()
+
+	res, err := s.versionGRPCService.RenderManifests(ctx, req)
+	s.Require().NoError(err)
+
+	s.Equal([]*versionpb.Manifest{
+		{Kind: "ConfigMap", Name: "test-product-test-version-conf-files", Yaml: "kind: ConfigMap\n"},
+	}, res.Manifests)
+}
+
 func (s *VersionServiceTestSuite) TestStop() {
 	ctx := context.Background()
 
@@ -180,6 +235,71 @@ func (s *VersionServiceTestSuite) TestStop() {
 	s.NotNil(res)
 }
 
+func (s *VersionServiceTestSuite) TestUpdateProcessImage() {
+	ctx := context.Background()
+
+	req := &versionpb.UpdateProcessImageRequest{
+		ProductId:  "test-product",
+		VersionTag: "test-version",
+		Workflow:   "test-workflow",
+		Process:    "test-process",
+		Image:      "test-image@hotfix",
+	}
+
+	expectedParams := usecase.UpdateProcessImageParams{
+		Product:  req.ProductId,
+		Version:  req.VersionTag,
+		Workflow: req.Workflow,
+		Process:  req.Process,
+		Image:    req.Image,
+	}
+
+	s.versionServiceMock.EXPECT().UpdateProcessImage(ctx, expectedParams).Return(nil)
+
+	res, err := s.versionGRPCService.UpdateProcessImage(ctx, req)
+	s.Require().NoError(err)
+	s.NotNil(res)
+}
+
+func (s *VersionServiceTestSuite) TestScaleProcess() {
+	ctx := context.Background()
+
+	replicas := int32(2)
+	req := &versionpb.ScaleProcessRequest{
+		ProductId:  "test-product",
+		VersionTag: "test-version",
+		Workflow:   "test-workflow",
+		Process:    "test-process",
+		Replicas:   &replicas,
+		Autoscaling: &versionpb.ProcessAutoscaling{
+			MinReplicas:         1,
+			MaxReplicas:         4,
+			CpuTargetPercentage: 75,
+			ConsumerLagTarget:   100,
+		},
+	}
+
+	expectedParams := usecase.ScaleProcessParams{
+		Product:  req.ProductId,
+		Version:  req.VersionTag,
+		Workflow: req.Workflow,
+		Process:  req.Process,
+		Replicas: &replicas,
+		Autoscaling: &domain.ProcessAutoscaling{
+			MinReplicas:         1,
+			MaxReplicas:         4,
+			CPUTargetPercentage: 75,
+			ConsumerLagTarget:   100,
+		},
+	}
+
+	s.versionServiceMock.EXPECT().ScaleProcess(ctx, expectedParams).Return(nil)
+
+	res, err := s.versionGRPCService.ScaleProcess(ctx, req)
+	s.Require().NoError(err)
+	s.NotNil(res)
+}
+
 func (s *VersionServiceTestSuite) TestRegisterProcess() {
 	ctx := context.Background()
 
@@ -241,3 +361,142 @@ func (s *VersionServiceTestSuite) TestGetPublishedTriggers() {
 
 	s.Equal(expectedURLs, res.NetworkUrls)
 }
+
+func (s *VersionServiceTestSuite) TestRunWorkflow() {
+	ctx := context.Background()
+
+	req := &versionpb.RunWorkflowRequest{
+		ProductId:  "test-product",
+		VersionTag: "test-version",
+		Workflow:   "test-workflow",
+	}
+
+	expectedJobs := []string{"test-product-test-version-test-workflow-test-process-1700000000"}
+
+	s.versionServiceMock.EXPECT().
+		RunWorkflow(ctx, req.ProductId, req.VersionTag, req.Workflow).
+		Return(expectedJobs, nil).
+		Once()
+
+	res, err := s.versionGRPCService.RunWorkflow(ctx, req)
+	s.Require().NoError(err)
+	s.Equal(expectedJobs, res.Jobs)
+}
+
+func (s *VersionServiceTestSuite) TestGetProcessStatus() {
+	ctx := context.Background()
+
+	req := &versionpb.ProcessStatusRequest{
+		ProductId:  "test-product",
+		VersionTag: "test-version",
+	}
+
+	lastTimestamp := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s.versionServiceMock.EXPECT().
+		GetProcessStatus(ctx, req.ProductId, req.VersionTag).
+		Return([]*domain.ProcessStatus{
+			{
+				Workflow:              "test-workflow",
+				Process:               "test-process",
+				State:                 domain.ProcessStateStarting,
+				ReadyReplicas:         1,
+				DesiredReplicas:       2,
+				Restarts:              3,
+				LastTerminationReason: "OOMKilled",
+				Events: []domain.ProcessEvent{
+					{
+						Type:          "Warning",
+						Reason:        "BackOff",
+						Message:       "Back-off restarting failed container",
+						Count:         3,
+						LastTimestamp: lastTimestamp,
+					},
+				},
+			},
+		}, nil).
+		Once()
+
+	res, err := s.versionGRPCService.GetProcessStatus(ctx, req)
+	s.Require().NoError(err)
+
+	s.Require().Len(res.Processes, 1)
+	s.Equal(&versionpb.ProcessStatusResponse{
+		ProcessId:             "test-process",
+		Name:                  "test-process",
+		Workflow:              "test-workflow",
+		Status:                "STARTING",
+		ReadyReplicas:         1,
+		DesiredReplicas:       2,
+		Restarts:              3,
+		LastTerminationReason: "OOMKilled",
+		Events: []*versionpb.ProcessEvent{
+			{
+				Type:          "Warning",
+				Reason:        "BackOff",
+				Message:       "Back-off restarting failed container",
+				Count:         3,
+				LastTimestamp: "2024-01-01T10:00:00Z",
+			},
+		},
+	}, res.Processes[0])
+}
+
+func (s *VersionServiceTestSuite) TestCreateProductNamespace() {
+	ctx := context.Background()
+
+	req := &versionpb.CreateProductNamespaceRequest{
+		ProductId: "test-product",
+		ResourceQuota: &versionpb.ProductResourceQuota{
+			Cpu:    "2",
+			Memory: "4Gi",
+			Pods:   10,
+		},
+	}
+
+	s.versionServiceMock.EXPECT().
+		CreateProductNamespace(ctx, req.ProductId, &domain.ProductResourceQuota{CPU: "2", Memory: "4Gi", Pods: 10}).
+		Return(nil).
+		Once()
+
+	_, err := s.versionGRPCService.CreateProductNamespace(ctx, req)
+	s.Require().NoError(err)
+}
+
+func (s *VersionServiceTestSuite) TestUpdateProductNamespace() {
+	ctx := context.Background()
+
+	req := &versionpb.UpdateProductNamespaceRequest{
+		ProductId: "test-product",
+		ResourceQuota: &versionpb.ProductResourceQuota{
+			Cpu:    "4",
+			Memory: "8Gi",
+		},
+	}
+
+	s.versionServiceMock.EXPECT().
+		UpdateProductNamespace(ctx, req.ProductId, &domain.ProductResourceQuota{CPU: "4", Memory: "8Gi"}).
+		Return(nil).
+		Once()
+
+	_, err := s.versionGRPCService.UpdateProductNamespace(ctx, req)
+	s.Require().NoError(err)
+}
+
+func (s *VersionServiceTestSuite) TestDeleteProductNamespace() {
+	ctx := context.Background()
+
+	req := &versionpb.DeleteProductNamespaceRequest{
+		ProductId: "test-product",
+	}
+
+	expectedErr := errors.New("delete error")
+
+	s.versionServiceMock.EXPECT().
+		DeleteProductNamespace(ctx, req.ProductId).
+		Return(expectedErr).
+		Once()
+
+	_, err := s.versionGRPCService.DeleteProductNamespace(ctx, req)
+	s.Require().ErrorIs(err, expectedErr)
+}
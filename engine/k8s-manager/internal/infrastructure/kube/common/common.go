@@ -2,8 +2,20 @@ package common
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
+	"github.com/spf13/viper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// NatsCredentialsKey is the key of the NATS credentials file in the version credentials secret.
+	NatsCredentialsKey = "nats.creds"
+	// NatsCredentialsPath is the directory where the version credentials secret is mounted in the processes.
+	NatsCredentialsPath = "/var/run/secrets/kai/nats"
 )
 
 func GetLabelSelector(product, version string) string {
@@ -19,3 +31,45 @@ func GetDeleteOptions() metav1.DeleteOptions {
 		GracePeriodSeconds: &gracePeriod,
 	}
 }
+
+// GetNatsCredentialsSecretName returns the name of the secret holding the NATS credentials of a version.
+func GetNatsCredentialsSecretName(product, version string) string {
+	return fmt.Sprintf("%s-%s-nats-credentials", product, version)
+}
+
+// GetProductNamespace returns the namespace where the product resources live. With namespace isolation
+// enabled each product gets its own namespace, otherwise every product shares the k8s-manager one.
+func GetProductNamespace(namespace, product string) string {
+	if !IsNamespaceIsolationEnabled() {
+		return namespace
+	}
+
+	return strings.ToLower(strings.ReplaceAll(fmt.Sprintf("%s-%s", namespace, product), ".", "-"))
+}
+
+// GetListNamespace returns the namespace to list resources of every product.
+func GetListNamespace(namespace string) string {
+	if !IsNamespaceIsolationEnabled() {
+		return namespace
+	}
+
+	return metav1.NamespaceAll
+}
+
+func IsNamespaceIsolationEnabled() bool {
+	return viper.GetBool(config.NamespaceIsolationEnabledKey)
+}
+
+// RenderManifest serializes a Kubernetes object to YAML using its JSON field names, as kubectl does.
+func RenderManifest(kind, name string, obj interface{}) (*domain.Manifest, error) {
+	manifest, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("rendering %s %q: %w", kind, name, err)
+	}
+
+	return &domain.Manifest{
+		Kind: kind,
+		Name: name,
+		YAML: string(manifest),
+	}, nil
+}
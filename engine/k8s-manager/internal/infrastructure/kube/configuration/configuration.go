@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -18,3 +19,7 @@ func NewKubeConfiguration(logger logr.Logger, client kubernetes.Interface, names
 		namespace: namespace,
 	}
 }
+
+func (kc KubeConfiguration) getNamespace(product string) string {
+	return common.GetProductNamespace(kc.namespace, product)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -18,13 +19,31 @@ func (kc KubeConfiguration) CreateVersionConfiguration(ctx context.Context, vers
 		"version", version.Tag,
 	)
 
+	configMap, err := kc.getVersionConfigMap(version)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = kc.client.CoreV1().ConfigMaps(kc.getNamespace(version.Product)).Create(ctx, configMap, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	if err := kc.createNatsCredentialsSecret(ctx, version); err != nil {
+		return "", err
+	}
+
+	return configMap.Name, nil
+}
+
+func (kc KubeConfiguration) getVersionConfigMap(version *domain.Version) (*corev1.ConfigMap, error) {
 	processYamlConfigs := make(map[string]string, getProcessesAmount(version))
 
 	for _, workflow := range version.Workflows {
 		for _, process := range workflow.Processes {
 			processYaml, err := yaml.Marshal(kc.getProcessConfig(version, workflow, process))
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 
 			processYamlConfigs[kc.getFullProcessIdentifier(version.Product, version.Tag, workflow.Name, process.Name)] = string(processYaml)
@@ -33,12 +52,7 @@ func (kc KubeConfiguration) CreateVersionConfiguration(ctx context.Context, vers
 
 	configMap := GetAppConfig(version, processYamlConfigs)
 
-	_, err := kc.client.CoreV1().ConfigMaps(kc.namespace).Create(ctx, &configMap, metav1.CreateOptions{})
-	if err != nil {
-		return "", err
-	}
-
-	return configMap.Name, nil
+	return &configMap, nil
 }
 
 func (kc KubeConfiguration) getFullProcessIdentifier(product, version, workflow, process string) string {
@@ -67,6 +81,8 @@ func (kc KubeConfiguration) getProcessConfig(
 			Subject:       process.Subject,
 			Subscriptions: process.Subscriptions,
 			ObjectStore:   process.ObjectStore,
+			Credentials:   getNatsCredentialsFile(version),
+			MaxDeliver:    getMaxDeliver(process),
 		},
 		CentralizedConfig: CentralizedConfig{
 			Global: ConfigDefinition{
@@ -119,3 +135,12 @@ func getProcessesAmount(v *domain.Version) int {
 
 	return amount
 }
+
+// getMaxDeliver returns how many times the process gets a message before it's sent to the dead-letter stream.
+func getMaxDeliver(process *domain.Process) int32 {
+	if process.MaxDeliver > 0 {
+		return process.MaxDeliver
+	}
+
+	return viper.GetInt32(config.NatsDefaultMaxDeliverKey)
+}
@@ -5,8 +5,11 @@ package configuration_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
@@ -53,6 +56,118 @@ func TestConfigCreation(t *testing.T) {
 	g.Assert(t, "ConfigMapCreation", configMapYaml)
 }
 
+func TestConfigCreation_WithNatsCredentials(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	viper.Set(config.KubeNamespaceKey, _namespace)
+
+	version := testhelpers.NewVersionBuilder().
+		WithNatsCredentials("test-nats-credentials").
+		Build()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	configMapName, err := svc.CreateVersionConfiguration(ctx, version)
+	require.NoError(t, err)
+
+	configMap, err := clientset.CoreV1().ConfigMaps(_namespace).Get(ctx, configMapName, v1.GetOptions{})
+	require.NoError(t, err)
+
+	workflow := version.Workflows[0]
+	processConfigKey := strings.ReplaceAll(
+		fmt.Sprintf("%s-%s-%s-%s", version.Product, version.Tag, workflow.Name, workflow.Processes[0].Name), ".", "-",
+	)
+
+	var processConfig struct {
+		Nats struct {
+			CredentialsFile string `yaml:"credentials_file"`
+		} `yaml:"nats"`
+	}
+
+	require.NoError(t, yaml.Unmarshal([]byte(configMap.Data[processConfigKey]), &processConfig))
+	assert.Equal(t, "/var/run/secrets/kai/nats/nats.creds", processConfig.Nats.CredentialsFile)
+	assert.NotContains(t, configMap.Data[processConfigKey], "test-nats-credentials")
+
+	secretName := fmt.Sprintf("%s-%s-nats-credentials", version.Product, version.Tag)
+	secret, err := clientset.CoreV1().Secrets(_namespace).Get(ctx, secretName, v1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "test-nats-credentials", string(secret.Data["nats.creds"]))
+}
+
+func TestConfigCreation_WithoutNatsCredentials(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	viper.Set(config.KubeNamespaceKey, _namespace)
+
+	version := testhelpers.NewVersionBuilder().Build()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	_, err := svc.CreateVersionConfiguration(ctx, version)
+	require.NoError(t, err)
+
+	secrets, err := clientset.CoreV1().Secrets(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+
+	assert.Empty(t, secrets.Items)
+}
+
+func TestConfigCreation_WithMaxDeliver(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	viper.Set(config.KubeNamespaceKey, _namespace)
+	viper.Set(config.NatsDefaultMaxDeliverKey, 5)
+
+	t.Cleanup(func() { viper.Set(config.NatsDefaultMaxDeliverKey, nil) })
+
+	version := testhelpers.NewVersionBuilder().
+		WithWorkflows([]*domain.Workflow{
+			testhelpers.NewWorkflowBuilder().
+				WithProcesses([]*domain.Process{
+					testhelpers.NewProcessBuilder().WithName("retried-process").WithMaxDeliver(10).Build(),
+					testhelpers.NewProcessBuilder().WithName("default-process").Build(),
+				}).
+				Build(),
+		}).
+		Build()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	configMapName, err := svc.CreateVersionConfiguration(ctx, version)
+	require.NoError(t, err)
+
+	configMap, err := clientset.CoreV1().ConfigMaps(_namespace).Get(ctx, configMapName, v1.GetOptions{})
+	require.NoError(t, err)
+
+	expectedMaxDeliver := map[string]int32{
+		"retried-process": 10,
+		"default-process": 5,
+	}
+
+	workflow := version.Workflows[0]
+	for processName, maxDeliver := range expectedMaxDeliver {
+		processConfigKey := strings.ReplaceAll(
+			fmt.Sprintf("%s-%s-%s-%s", version.Product, version.Tag, workflow.Name, processName), ".", "-",
+		)
+
+		var processConfig struct {
+			Nats struct {
+				MaxDeliver int32 `yaml:"max_deliver"`
+			} `yaml:"nats"`
+		}
+
+		require.NoError(t, yaml.Unmarshal([]byte(configMap.Data[processConfigKey]), &processConfig))
+		assert.Equal(t, maxDeliver, processConfig.Nats.MaxDeliver, processName)
+	}
+}
+
 func TestConfigCreation_ClientError(t *testing.T) {
 	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
 	viper.Set(config.KubeNamespaceKey, _namespace)
@@ -8,7 +8,15 @@ import (
 )
 
 func (kc KubeConfiguration) DeleteConfiguration(ctx context.Context, product, version string) error {
-	err := kc.client.CoreV1().ConfigMaps(kc.namespace).DeleteCollection(
+	err := kc.client.CoreV1().ConfigMaps(kc.getNamespace(product)).DeleteCollection(
+		ctx,
+		common.GetDeleteOptions(),
+		metav1.ListOptions{LabelSelector: common.GetLabelSelector(product, version)})
+	if err != nil {
+		return err
+	}
+
+	err = kc.client.CoreV1().Secrets(kc.getNamespace(product)).DeleteCollection(
 		ctx,
 		common.GetDeleteOptions(),
 		metav1.ListOptions{LabelSelector: common.GetLabelSelector(product, version)})
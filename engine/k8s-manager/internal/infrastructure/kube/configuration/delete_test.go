@@ -56,3 +56,25 @@ func TestDeleteConfiguration_ClientError(t *testing.T) {
 	err := svc.DeleteConfiguration(ctx, _testProduct, _testVersion)
 	require.ErrorIs(t, err, expectedErr)
 }
+
+func TestDeleteConfiguration_SecretsClientError(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	viper.Set(config.KubeNamespaceKey, _namespace)
+
+	expectedErr := errors.New("error deleting secrets")
+
+	testhelpers.SetMockCall(clientset, testhelpers.MockCallParams{
+		Action:   "delete-collection",
+		Resource: "secrets",
+		Obj:      nil,
+		Err:      expectedErr,
+	})
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.DeleteConfiguration(ctx, _testProduct, _testVersion)
+	require.ErrorIs(t, err, expectedErr)
+}
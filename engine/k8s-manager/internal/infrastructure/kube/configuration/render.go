@@ -0,0 +1,25 @@
+package configuration
+
+import (
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const _kindConfigMap = "ConfigMap"
+
+// RenderVersionConfiguration returns the configmap created when starting the version, without creating it.
+func (kc KubeConfiguration) RenderVersionConfiguration(version *domain.Version) (*domain.Manifest, error) {
+	configMap, err := kc.getVersionConfigMap(version)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap.TypeMeta = metav1.TypeMeta{
+		Kind:       _kindConfigMap,
+		APIVersion: "v1",
+	}
+	configMap.Namespace = kc.getNamespace(version.Product)
+
+	return common.RenderManifest(_kindConfigMap, configMap.Name, configMap)
+}
@@ -0,0 +1,41 @@
+//go:build unit
+
+package configuration_test
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
+	"github.com/sebdah/goldie/v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRenderVersionConfiguration(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	viper.Set(config.KubeNamespaceKey, _namespace)
+	viper.Set(config.MinioEndpointKey, "test-minio-endpoint")
+	viper.Set(config.AuthEndpointKey, "test-auth-endpoint")
+	viper.Set(config.AuthRealmKey, "test-auth-realm")
+	viper.Set(config.AuthClientIDKey, "test-auth-client-id")
+	viper.Set(config.AuthClientSecretKey, "test-auth-client-secret")
+	viper.Set(config.PredictionsIndexKey, "predictionsIdx")
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	manifest, err := svc.RenderVersionConfiguration(testhelpers.NewVersionBuilder().Build())
+	require.NoError(t, err)
+
+	assert.Equal(t, "ConfigMap", manifest.Kind)
+	assert.Empty(t, clientset.Actions())
+
+	g := goldie.New(t)
+	g.Assert(t, "RenderVersionConfiguration", []byte(manifest.YAML))
+}
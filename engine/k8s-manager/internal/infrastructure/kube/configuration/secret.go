@@ -0,0 +1,49 @@
+package configuration
+
+import (
+	"context"
+	"path"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// createNatsCredentialsSecret stores the version NATS credentials in a secret mounted by the version processes,
+// so they are not readable from the configuration configmap.
+func (kc KubeConfiguration) createNatsCredentialsSecret(ctx context.Context, version *domain.Version) error {
+	if version.NatsCredentials == "" {
+		return nil
+	}
+
+	_, err := kc.client.CoreV1().Secrets(kc.getNamespace(version.Product)).
+		Create(ctx, getNatsCredentialsSecret(version), metav1.CreateOptions{})
+
+	return err
+}
+
+func getNatsCredentialsSecret(version *domain.Version) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: common.GetNatsCredentialsSecretName(version.Product, version.Tag),
+			Labels: map[string]string{
+				"product": version.Product,
+				"version": version.Tag,
+				"type":    "nats-credentials",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			common.NatsCredentialsKey: []byte(version.NatsCredentials),
+		},
+	}
+}
+
+func getNatsCredentialsFile(version *domain.Version) string {
+	if version.NatsCredentials == "" {
+		return ""
+	}
+
+	return path.Join(common.NatsCredentialsPath, common.NatsCredentialsKey)
+}
@@ -38,6 +38,8 @@ type NatsConfig struct {
 	Subject       string   `yaml:"output"`
 	Subscriptions []string `yaml:"inputs"`
 	ObjectStore   *string  `yaml:"object_store,omitempty"`
+	Credentials   string   `yaml:"credentials_file,omitempty"`
+	MaxDeliver    int32    `yaml:"max_deliver"`
 }
 
 type MinioConfig struct {
@@ -0,0 +1,243 @@
+package namespace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
+	"github.com/spf13/viper"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	_resourceQuotaName = "product-quota"
+	_limitRangeName    = "product-limits"
+	_networkPolicyName = "product-isolation"
+
+	_namespaceNameLabel = "kubernetes.io/metadata.name"
+)
+
+var ErrInvalidResourceQuota = errors.New("invalid product resource quota")
+
+// CreateProductNamespace creates the product namespace with its resource quota, default container limits
+// and a network policy that only accepts traffic from the same product and from the allowed namespaces.
+// The image registry and TLS secrets are copied so the product processes and ingresses can use them.
+// It can be called again for an existing product, so namespaces of products created before the namespace
+// isolation was enabled are created, and the resource quota of existing namespaces is set to the given one.
+func (kn KubeNamespace) CreateProductNamespace(ctx context.Context, params service.CreateProductNamespaceParams) error {
+	if !common.IsNamespaceIsolationEnabled() {
+		return nil
+	}
+
+	if err := validateResourceQuota(params.ResourceQuota); err != nil {
+		return err
+	}
+
+	namespace := kn.getNamespace(params.Product)
+
+	kn.logger.Info("Creating product namespace", "product", params.Product, "namespace", namespace)
+
+	_, err := kn.client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   namespace,
+			Labels: kn.getLabels(params.Product),
+		},
+	}, metav1.CreateOptions{})
+	if ignoreAlreadyExists(err) != nil {
+		return fmt.Errorf("creating namespace %q: %w", namespace, err)
+	}
+
+	if err := kn.applyResourceQuota(ctx, namespace, params.Product, params.ResourceQuota); err != nil {
+		return fmt.Errorf("creating resource quota: %w", err)
+	}
+
+	if err := kn.applyLimitRange(ctx, namespace, params.Product, params.ResourceQuota); err != nil {
+		return fmt.Errorf("creating limit range: %w", err)
+	}
+
+	_, err = kn.client.NetworkingV1().NetworkPolicies(namespace).
+		Create(ctx, kn.getNetworkPolicy(params.Product), metav1.CreateOptions{})
+	if ignoreAlreadyExists(err) != nil {
+		return fmt.Errorf("creating network policy: %w", err)
+	}
+
+	for _, secretName := range []string{
+		viper.GetString(config.ImageRegistryAuthSecretKey),
+		viper.GetString(config.TLSSecretNameKey),
+	} {
+		if err := kn.copySecret(ctx, secretName, namespace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateResourceQuota(quota *domain.ProductResourceQuota) error {
+	if quota == nil {
+		return nil
+	}
+
+	for _, quantity := range []string{quota.CPU, quota.Memory} {
+		if quantity == "" {
+			continue
+		}
+
+		if _, err := resource.ParseQuantity(quantity); err != nil {
+			return fmt.Errorf("%w: %q: %w", ErrInvalidResourceQuota, quantity, err)
+		}
+	}
+
+	return nil
+}
+
+func (kn KubeNamespace) getResourceQuota(product string, quota *domain.ProductResourceQuota) *corev1.ResourceQuota {
+	hard := corev1.ResourceList{}
+
+	if quota.CPU != "" {
+		hard[corev1.ResourceRequestsCPU] = resource.MustParse(quota.CPU)
+	}
+
+	if quota.Memory != "" {
+		hard[corev1.ResourceRequestsMemory] = resource.MustParse(quota.Memory)
+	}
+
+	if quota.Pods > 0 {
+		hard[corev1.ResourcePods] = *resource.NewQuantity(int64(quota.Pods), resource.DecimalSI)
+	}
+
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   _resourceQuotaName,
+			Labels: kn.getLabels(product),
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: hard,
+		},
+	}
+}
+
+// getLimitRange sets default requests for containers without them, like the process sidecars, so they
+// are accounted by the resource quota. No single container can request more than the whole product.
+func (kn KubeNamespace) getLimitRange(product string, quota *domain.ProductResourceQuota) *corev1.LimitRange {
+	limit := corev1.LimitRangeItem{
+		Type: corev1.LimitTypeContainer,
+		Default: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(viper.GetString(config.NamespaceDefaultCPULimitKey)),
+			corev1.ResourceMemory: resource.MustParse(viper.GetString(config.NamespaceDefaultMemoryLimitKey)),
+		},
+		DefaultRequest: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(viper.GetString(config.NamespaceDefaultCPURequestKey)),
+			corev1.ResourceMemory: resource.MustParse(viper.GetString(config.NamespaceDefaultMemoryRequestKey)),
+		},
+	}
+
+	if quota != nil && (quota.CPU != "" || quota.Memory != "") {
+		limit.Max = corev1.ResourceList{}
+
+		if quota.CPU != "" {
+			limit.Max[corev1.ResourceCPU] = resource.MustParse(quota.CPU)
+		}
+
+		if quota.Memory != "" {
+			limit.Max[corev1.ResourceMemory] = resource.MustParse(quota.Memory)
+		}
+	}
+
+	return &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   _limitRangeName,
+			Labels: kn.getLabels(product),
+		},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{limit},
+		},
+	}
+}
+
+func (kn KubeNamespace) getNetworkPolicy(product string) *networkingv1.NetworkPolicy {
+	allowedNamespaces := append([]string{kn.namespace}, viper.GetStringSlice(config.NamespaceIsolationAllowedNamespacesKey)...)
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   _networkPolicyName,
+			Labels: kn.getLabels(product),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From: []networkingv1.NetworkPolicyPeer{
+						{
+							PodSelector: &metav1.LabelSelector{},
+						},
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchExpressions: []metav1.LabelSelectorRequirement{
+									{
+										Key:      _namespaceNameLabel,
+										Operator: metav1.LabelSelectorOpIn,
+										Values:   allowedNamespaces,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (kn KubeNamespace) copySecret(ctx context.Context, secretName, namespace string) error {
+	if secretName == "" {
+		return nil
+	}
+
+	secret, err := kn.client.CoreV1().Secrets(kn.namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if kubeerrors.IsNotFound(err) {
+		kn.logger.Info("Secret not found, skipping copy to product namespace", "secret", secretName)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting secret %q: %w", secretName, err)
+	}
+
+	_, err = kn.client.CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   secret.Name,
+			Labels: secret.Labels,
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	}, metav1.CreateOptions{})
+	if ignoreAlreadyExists(err) != nil {
+		return fmt.Errorf("copying secret %q: %w", secretName, err)
+	}
+
+	return nil
+}
+
+func (kn KubeNamespace) getLabels(product string) map[string]string {
+	return map[string]string{
+		"product": product,
+	}
+}
+
+func ignoreAlreadyExists(err error) error {
+	if kubeerrors.IsAlreadyExists(err) {
+		return nil
+	}
+
+	return err
+}
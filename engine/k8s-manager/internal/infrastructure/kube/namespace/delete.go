@@ -0,0 +1,27 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// DeleteProductNamespace deletes the product namespace along with every resource in it.
+func (kn KubeNamespace) DeleteProductNamespace(ctx context.Context, product string) error {
+	if !common.IsNamespaceIsolationEnabled() {
+		return nil
+	}
+
+	namespace := kn.getNamespace(product)
+
+	kn.logger.Info("Deleting product namespace", "product", product, "namespace", namespace)
+
+	err := kn.client.CoreV1().Namespaces().Delete(ctx, namespace, common.GetDeleteOptions())
+	if err != nil && !kubeerrors.IsNotFound(err) {
+		return fmt.Errorf("deleting namespace %q: %w", namespace, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package namespace
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
+	"k8s.io/client-go/kubernetes"
+)
+
+type KubeNamespace struct {
+	logger    logr.Logger
+	client    kubernetes.Interface
+	namespace string
+}
+
+func NewKubeNamespace(logger logr.Logger, client kubernetes.Interface, namespace string) KubeNamespace {
+	return KubeNamespace{
+		logger:    logger,
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+func (kn KubeNamespace) getNamespace(product string) string {
+	return common.GetProductNamespace(kn.namespace, product)
+}
@@ -0,0 +1,324 @@
+//go:build unit
+
+package namespace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/namespace"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	_namespace        = "kai"
+	_product          = "Test.Product"
+	_productNamespace = "kai-test-product"
+	_registrySecret   = "registry-auth"
+)
+
+func setNamespaceIsolationConfig(t *testing.T, enabled bool) {
+	t.Helper()
+
+	viper.Set(config.KubeNamespaceKey, _namespace)
+	viper.Set(config.NamespaceIsolationEnabledKey, enabled)
+	viper.Set(config.NamespaceIsolationAllowedNamespacesKey, []string{"ingress-nginx"})
+	viper.Set(config.NamespaceDefaultCPURequestKey, "100m")
+	viper.Set(config.NamespaceDefaultCPULimitKey, "500m")
+	viper.Set(config.NamespaceDefaultMemoryRequestKey, "128Mi")
+	viper.Set(config.NamespaceDefaultMemoryLimitKey, "256Mi")
+	viper.Set(config.ImageRegistryAuthSecretKey, _registrySecret)
+	viper.Set(config.TLSSecretNameKey, "")
+
+	t.Cleanup(func() {
+		viper.Set(config.NamespaceIsolationEnabledKey, false)
+	})
+}
+
+func TestCreateProductNamespace(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: _registrySecret, Namespace: _namespace},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte("{}")},
+	})
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.CreateProductNamespace(ctx, service.CreateProductNamespaceParams{
+		Product: _product,
+		ResourceQuota: &domain.ProductResourceQuota{
+			CPU:    "4",
+			Memory: "8Gi",
+			Pods:   20,
+		},
+	})
+	require.NoError(t, err)
+
+	ns, err := clientset.CoreV1().Namespaces().Get(ctx, _productNamespace, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, _product, ns.Labels["product"])
+
+	quota, err := clientset.CoreV1().ResourceQuotas(_productNamespace).Get(ctx, "product-quota", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, resource.MustParse("4").Equal(quota.Spec.Hard[corev1.ResourceRequestsCPU]))
+	assert.True(t, resource.MustParse("8Gi").Equal(quota.Spec.Hard[corev1.ResourceRequestsMemory]))
+	assert.Equal(t, int64(20), quota.Spec.Hard.Pods().Value())
+
+	limitRange, err := clientset.CoreV1().LimitRanges(_productNamespace).Get(ctx, "product-limits", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, limitRange.Spec.Limits, 1)
+	assert.True(t, resource.MustParse("100m").Equal(limitRange.Spec.Limits[0].DefaultRequest[corev1.ResourceCPU]))
+	assert.True(t, resource.MustParse("8Gi").Equal(limitRange.Spec.Limits[0].Max[corev1.ResourceMemory]))
+
+	policy, err := clientset.NetworkingV1().NetworkPolicies(_productNamespace).Get(ctx, "product-isolation", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, policy.Spec.Ingress, 1)
+	require.Len(t, policy.Spec.Ingress[0].From, 2)
+	assert.Equal(t,
+		[]string{_namespace, "ingress-nginx"},
+		policy.Spec.Ingress[0].From[1].NamespaceSelector.MatchExpressions[0].Values,
+	)
+
+	secret, err := clientset.CoreV1().Secrets(_productNamespace).Get(ctx, _registrySecret, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, corev1.SecretTypeDockerConfigJson, secret.Type)
+}
+
+func TestCreateProductNamespace_WithoutQuota(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.CreateProductNamespace(ctx, service.CreateProductNamespaceParams{Product: _product})
+	require.NoError(t, err)
+
+	quotas, err := clientset.CoreV1().ResourceQuotas(_productNamespace).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, quotas.Items)
+
+	limitRange, err := clientset.CoreV1().LimitRanges(_productNamespace).Get(ctx, "product-limits", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, limitRange.Spec.Limits[0].Max)
+}
+
+func TestCreateProductNamespace_IsolationDisabled(t *testing.T) {
+	setNamespaceIsolationConfig(t, false)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.CreateProductNamespace(ctx, service.CreateProductNamespaceParams{Product: _product})
+	require.NoError(t, err)
+
+	namespaces, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, namespaces.Items)
+}
+
+func TestCreateProductNamespace_InvalidQuota(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProductNamespace(context.Background(), service.CreateProductNamespaceParams{
+		Product:       _product,
+		ResourceQuota: &domain.ProductResourceQuota{CPU: "four"},
+	})
+	require.ErrorIs(t, err, namespace.ErrInvalidResourceQuota)
+}
+
+func TestCreateProductNamespace_ClientError(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	expectedErr := errors.New("error creating network policy")
+
+	testhelpers.SetMockCall(clientset, testhelpers.MockCallParams{
+		Action:   "create",
+		Resource: "networkpolicies",
+		Obj:      nil,
+		Err:      expectedErr,
+	})
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProductNamespace(context.Background(), service.CreateProductNamespaceParams{Product: _product})
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestCreateProductNamespace_ExistingNamespace(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.CreateProductNamespace(ctx, service.CreateProductNamespaceParams{
+		Product:       _product,
+		ResourceQuota: &domain.ProductResourceQuota{CPU: "4", Memory: "8Gi", Pods: 20},
+	})
+	require.NoError(t, err)
+
+	err = svc.CreateProductNamespace(ctx, service.CreateProductNamespaceParams{
+		Product:       _product,
+		ResourceQuota: &domain.ProductResourceQuota{CPU: "2"},
+	})
+	require.NoError(t, err)
+
+	quota, err := clientset.CoreV1().ResourceQuotas(_productNamespace).Get(ctx, "product-quota", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, resource.MustParse("2").Equal(quota.Spec.Hard[corev1.ResourceRequestsCPU]))
+	assert.NotContains(t, quota.Spec.Hard, corev1.ResourceRequestsMemory)
+	assert.NotContains(t, quota.Spec.Hard, corev1.ResourcePods)
+}
+
+func TestUpdateProductNamespace(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.CreateProductNamespace(ctx, service.CreateProductNamespaceParams{
+		Product:       _product,
+		ResourceQuota: &domain.ProductResourceQuota{CPU: "4", Memory: "8Gi", Pods: 20},
+	})
+	require.NoError(t, err)
+
+	err = svc.UpdateProductNamespace(ctx, service.UpdateProductNamespaceParams{
+		Product:       _product,
+		ResourceQuota: &domain.ProductResourceQuota{CPU: "8", Memory: "16Gi"},
+	})
+	require.NoError(t, err)
+
+	quota, err := clientset.CoreV1().ResourceQuotas(_productNamespace).Get(ctx, "product-quota", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, resource.MustParse("8").Equal(quota.Spec.Hard[corev1.ResourceRequestsCPU]))
+	assert.True(t, resource.MustParse("16Gi").Equal(quota.Spec.Hard[corev1.ResourceRequestsMemory]))
+	assert.NotContains(t, quota.Spec.Hard, corev1.ResourcePods)
+
+	limitRange, err := clientset.CoreV1().LimitRanges(_productNamespace).Get(ctx, "product-limits", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, resource.MustParse("16Gi").Equal(limitRange.Spec.Limits[0].Max[corev1.ResourceMemory]))
+}
+
+func TestUpdateProductNamespace_CreatesMissingQuota(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.CreateProductNamespace(ctx, service.CreateProductNamespaceParams{Product: _product})
+	require.NoError(t, err)
+
+	err = svc.UpdateProductNamespace(ctx, service.UpdateProductNamespaceParams{
+		Product:       _product,
+		ResourceQuota: &domain.ProductResourceQuota{Pods: 10},
+	})
+	require.NoError(t, err)
+
+	quota, err := clientset.CoreV1().ResourceQuotas(_productNamespace).Get(ctx, "product-quota", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), quota.Spec.Hard.Pods().Value())
+}
+
+func TestUpdateProductNamespace_RemovesQuota(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.CreateProductNamespace(ctx, service.CreateProductNamespaceParams{
+		Product:       _product,
+		ResourceQuota: &domain.ProductResourceQuota{CPU: "4"},
+	})
+	require.NoError(t, err)
+
+	err = svc.UpdateProductNamespace(ctx, service.UpdateProductNamespaceParams{Product: _product})
+	require.NoError(t, err)
+
+	quotas, err := clientset.CoreV1().ResourceQuotas(_productNamespace).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, quotas.Items)
+
+	limitRange, err := clientset.CoreV1().LimitRanges(_productNamespace).Get(ctx, "product-limits", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, limitRange.Spec.Limits[0].Max)
+}
+
+func TestUpdateProductNamespace_InvalidQuota(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.UpdateProductNamespace(context.Background(), service.UpdateProductNamespaceParams{
+		Product:       _product,
+		ResourceQuota: &domain.ProductResourceQuota{Memory: "lots"},
+	})
+	require.ErrorIs(t, err, namespace.ErrInvalidResourceQuota)
+}
+
+func TestDeleteProductNamespace(t *testing.T) {
+	setNamespaceIsolationConfig(t, true)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: _productNamespace},
+	})
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.DeleteProductNamespace(ctx, _product)
+	require.NoError(t, err)
+
+	namespaces, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, namespaces.Items)
+
+	// Deleting a namespace that no longer exists is not an error.
+	err = svc.DeleteProductNamespace(ctx, _product)
+	require.NoError(t, err)
+}
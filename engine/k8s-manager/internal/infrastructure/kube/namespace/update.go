@@ -0,0 +1,100 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpdateProductNamespace applies a new resource quota to an existing product namespace. The resource quota is
+// removed when the new quota has no limits, and the container limits are recomputed from it.
+func (kn KubeNamespace) UpdateProductNamespace(ctx context.Context, params service.UpdateProductNamespaceParams) error {
+	if !common.IsNamespaceIsolationEnabled() {
+		return nil
+	}
+
+	if err := validateResourceQuota(params.ResourceQuota); err != nil {
+		return err
+	}
+
+	namespace := kn.getNamespace(params.Product)
+
+	kn.logger.Info("Updating product namespace quota", "product", params.Product, "namespace", namespace)
+
+	if err := kn.applyResourceQuota(ctx, namespace, params.Product, params.ResourceQuota); err != nil {
+		return fmt.Errorf("updating resource quota: %w", err)
+	}
+
+	if err := kn.applyLimitRange(ctx, namespace, params.Product, params.ResourceQuota); err != nil {
+		return fmt.Errorf("updating limit range: %w", err)
+	}
+
+	return nil
+}
+
+func (kn KubeNamespace) applyResourceQuota(
+	ctx context.Context,
+	namespace, product string,
+	quota *domain.ProductResourceQuota,
+) error {
+	resourceQuotas := kn.client.CoreV1().ResourceQuotas(namespace)
+
+	if !hasLimits(quota) {
+		err := resourceQuotas.Delete(ctx, _resourceQuotaName, metav1.DeleteOptions{})
+		if kubeerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	newQuota := kn.getResourceQuota(product, quota)
+
+	current, err := resourceQuotas.Get(ctx, _resourceQuotaName, metav1.GetOptions{})
+	if kubeerrors.IsNotFound(err) {
+		_, err = resourceQuotas.Create(ctx, newQuota, metav1.CreateOptions{})
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	current.Spec = newQuota.Spec
+	_, err = resourceQuotas.Update(ctx, current, metav1.UpdateOptions{})
+
+	return err
+}
+
+func (kn KubeNamespace) applyLimitRange(
+	ctx context.Context,
+	namespace, product string,
+	quota *domain.ProductResourceQuota,
+) error {
+	limitRanges := kn.client.CoreV1().LimitRanges(namespace)
+	newLimitRange := kn.getLimitRange(product, quota)
+
+	current, err := limitRanges.Get(ctx, _limitRangeName, metav1.GetOptions{})
+	if kubeerrors.IsNotFound(err) {
+		_, err = limitRanges.Create(ctx, newLimitRange, metav1.CreateOptions{})
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	current.Spec = newLimitRange.Spec
+	_, err = limitRanges.Update(ctx, current, metav1.UpdateOptions{})
+
+	return err
+}
+
+func hasLimits(quota *domain.ProductResourceQuota) bool {
+	return quota != nil && (quota.CPU != "" || quota.Memory != "" || quota.Pods > 0)
+}
@@ -30,9 +30,15 @@ func (kn KubeNetwork) CreateNetwork(ctx context.Context, params service.CreateNe
 		"protocol", params.Process.Networking.Protocol,
 	)
 
+	_, err := kn.client.CoreV1().Services(kn.getNamespace(params.Product)).Create(ctx, kn.getService(params), metav1.CreateOptions{})
+
+	return err
+}
+
+func (kn KubeNetwork) getService(params service.CreateNetworkParams) *corev1.Service {
 	networking := params.Process.Networking
 
-	_, err := kn.client.CoreV1().Services(kn.namespace).Create(ctx, &corev1.Service{
+	return &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: kn.getServiceName(params.Product, params.Version, params.Workflow, params.Process.Name),
 			Labels: kn.getServiceLabels(
@@ -55,9 +61,7 @@ func (kn KubeNetwork) CreateNetwork(ctx context.Context, params service.CreateNe
 				},
 			},
 		},
-	}, metav1.CreateOptions{})
-
-	return err
+	}
 }
 
 func (kn KubeNetwork) getSelector(product, version, workflow, process string) map[string]string {
@@ -12,7 +12,9 @@ import (
 )
 
 func (kn KubeNetwork) DeleteNetwork(ctx context.Context, product, version string) error {
-	services, err := kn.listServices(ctx, common.GetLabelSelector(product, version))
+	namespace := kn.getNamespace(product)
+
+	services, err := kn.listServices(ctx, namespace, common.GetLabelSelector(product, version))
 	if err != nil {
 		return err
 	}
@@ -28,7 +30,7 @@ func (kn KubeNetwork) DeleteNetwork(ctx context.Context, product, version string
 		go func(svcName string) {
 			defer wg.Done()
 
-			err := kn.deleteService(ctx, svcName)
+			err := kn.deleteService(ctx, namespace, svcName)
 			if err != nil {
 				errCh <- fmt.Errorf("error deleting service %q: %w", svcName, err)
 			}
@@ -46,16 +48,16 @@ func (kn KubeNetwork) DeleteNetwork(ctx context.Context, product, version string
 	return errs
 }
 
-func (kn KubeNetwork) listServices(ctx context.Context, labelSelector string) (*corev1.ServiceList, error) {
-	return kn.client.CoreV1().Services(kn.namespace).List(ctx, metav1.ListOptions{
+func (kn KubeNetwork) listServices(ctx context.Context, namespace, labelSelector string) (*corev1.ServiceList, error) {
+	return kn.client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
 	})
 }
 
-func (kn KubeNetwork) deleteService(ctx context.Context, serviceName string) error {
+func (kn KubeNetwork) deleteService(ctx context.Context, namespace, serviceName string) error {
 	kn.logger.V(1).Info("Deleting service", "serviceName", serviceName)
 
-	return kn.client.CoreV1().Services(kn.namespace).Delete(
+	return kn.client.CoreV1().Services(namespace).Delete(
 		ctx,
 		serviceName,
 		common.GetDeleteOptions(),
@@ -2,6 +2,7 @@ package network
 
 import (
 	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -18,3 +19,7 @@ func NewKubeNetwork(logger logr.Logger, client kubernetes.Interface, namespace s
 		namespace: namespace,
 	}
 }
+
+func (kn KubeNetwork) getNamespace(product string) string {
+	return common.GetProductNamespace(kn.namespace, product)
+}
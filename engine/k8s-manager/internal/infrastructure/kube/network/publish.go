@@ -29,16 +29,36 @@ const (
 )
 
 func (kn KubeNetwork) PublishNetwork(ctx context.Context, params service.PublishNetworkParams) (map[string]string, error) {
-	servicesToPublish, err := kn.client.CoreV1().Services(kn.namespace).List(ctx, metav1.ListOptions{
+	servicesToPublish, err := kn.client.CoreV1().Services(kn.getNamespace(params.Product)).List(ctx, metav1.ListOptions{
 		LabelSelector: fmt.Sprintf("product=%s,version=%s", params.Product, params.Version),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("listing services: %w", err)
 	}
 
+	ingress, publishedEndpoints, err := kn.getIngress(params, servicesToPublish)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = kn.client.NetworkingV1().Ingresses(kn.getNamespace(params.Product)).Apply(ctx, ingress, metav1.ApplyOptions{
+		FieldManager: _fieldManager,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating ingress: %w", err)
+	}
+
+	return publishedEndpoints, nil
+}
+
+// getIngress returns the ingress that publishes the given services and the endpoints where each one is reachable.
+func (kn KubeNetwork) getIngress(
+	params service.PublishNetworkParams,
+	servicesToPublish *corev1.ServiceList,
+) (*applynetworkingv1.IngressApplyConfiguration, map[string]string, error) {
 	annotations, err := kn.getIngressAnnotations()
 	if err != nil {
-		return nil, fmt.Errorf("parsing ingress annotations: %w", err)
+		return nil, nil, fmt.Errorf("parsing ingress annotations: %w", err)
 	}
 
 	ingressName := kn.getIngressName(params.Product)
@@ -66,14 +86,7 @@ func (kn KubeNetwork) PublishNetwork(ctx context.Context, params service.Publish
 		},
 	}
 
-	_, err = kn.client.NetworkingV1().Ingresses(kn.namespace).Apply(ctx, ingress, metav1.ApplyOptions{
-		FieldManager: _fieldManager,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("creating ingress: %w", err)
-	}
-
-	return publishedEndpoints, nil
+	return ingress, publishedEndpoints, nil
 }
 
 func (kn KubeNetwork) getIngressAnnotations() (map[string]string, error) {
@@ -13,7 +13,7 @@ import (
 func (kn KubeNetwork) GetPublishedTriggers(ctx context.Context, product string) (map[string]string, error) {
 	ingressName := kn.getIngressName(product)
 
-	ingress, err := kn.client.NetworkingV1().Ingresses(kn.namespace).Get(ctx, ingressName, metav1.GetOptions{})
+	ingress, err := kn.client.NetworkingV1().Ingresses(kn.getNamespace(product)).Get(ctx, ingressName, metav1.GetOptions{})
 	if err != nil {
 		if kubeerrors.IsNotFound(err) {
 			return nil, nil
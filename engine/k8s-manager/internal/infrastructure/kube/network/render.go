@@ -0,0 +1,53 @@
+package network
+
+import (
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+const _kindService = "Service"
+
+// RenderNetwork returns the service created for a trigger process, without creating it.
+func (kn KubeNetwork) RenderNetwork(params service.CreateNetworkParams) (*domain.Manifest, error) {
+	svc := kn.getRenderedService(params)
+
+	return common.RenderManifest(_kindService, svc.Name, svc)
+}
+
+// RenderPublishedNetwork returns the ingress applied when publishing a version with the given networks.
+func (kn KubeNetwork) RenderPublishedNetwork(params service.RenderPublishedNetworkParams) (*domain.Manifest, error) {
+	servicesToPublish := &corev1.ServiceList{
+		Items: make([]corev1.Service, 0, len(params.Networks)),
+	}
+
+	for _, network := range params.Networks {
+		servicesToPublish.Items = append(servicesToPublish.Items, *kn.getRenderedService(network))
+	}
+
+	ingress, _, err := kn.getIngress(service.PublishNetworkParams{
+		Product: params.Product,
+		Version: params.Version,
+	}, servicesToPublish)
+	if err != nil {
+		return nil, err
+	}
+
+	ingress.Namespace = pointer.String(kn.getNamespace(params.Product))
+
+	return common.RenderManifest(_kindIngress, *ingress.Name, ingress)
+}
+
+func (kn KubeNetwork) getRenderedService(params service.CreateNetworkParams) *corev1.Service {
+	svc := kn.getService(params)
+	svc.TypeMeta = metav1.TypeMeta{
+		Kind:       _kindService,
+		APIVersion: "v1",
+	}
+	svc.Namespace = kn.getNamespace(params.Product)
+
+	return svc
+}
@@ -0,0 +1,67 @@
+//go:build unit
+
+package network_test
+
+import (
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
+	"github.com/sebdah/goldie/v2"
+	"github.com/spf13/viper"
+)
+
+func (s *networkSuite) TestRenderPublishedNetwork() {
+	s.clientset.ClearActions()
+
+	viper.Set(config.TriggersTLSEnabledKey, true)
+	viper.Set(config.TLSSecretNameKey, "")
+
+	networks := []service.CreateNetworkParams{
+		{
+			Product:  _product,
+			Version:  _version,
+			Workflow: _workflow,
+			Process: &domain.Process{
+				Name: _process,
+				Networking: &domain.Networking{
+					SourcePort: 8080,
+					Protocol:   domain.NetworkingProtocolHTTP,
+					TargetPort: 8080,
+				},
+			},
+		},
+		{
+			Product:  _product,
+			Version:  _version,
+			Workflow: _workflow,
+			Process: &domain.Process{
+				Name: "grpc-process",
+				Networking: &domain.Networking{
+					SourcePort: 9000,
+					Protocol:   domain.NetworkingProtocolGRPC,
+					TargetPort: 9000,
+				},
+			},
+		},
+	}
+
+	serviceManifest, err := s.service.RenderNetwork(networks[0])
+	s.Require().NoError(err)
+	s.Equal("Service", serviceManifest.Kind)
+	s.Equal(_fullProcessIdentifier, serviceManifest.Name)
+
+	ingressManifest, err := s.service.RenderPublishedNetwork(service.RenderPublishedNetworkParams{
+		Product:  _product,
+		Version:  _version,
+		Networks: networks,
+	})
+	s.Require().NoError(err)
+	s.Equal("Ingress", ingressManifest.Kind)
+
+	// Rendering must not create anything in the cluster.
+	s.Empty(s.clientset.Actions())
+
+	g := goldie.New(s.T())
+	g.Assert(s.T(), "RenderNetwork_Service", []byte(serviceManifest.YAML))
+	g.Assert(s.T(), "RenderPublishedNetwork_Ingress", []byte(ingressManifest.YAML))
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func (kn KubeNetwork) UnpublishNetwork(ctx context.Context, product, version string) error {
-	err := kn.client.NetworkingV1().Ingresses(kn.namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+	err := kn.client.NetworkingV1().Ingresses(kn.getNamespace(product)).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
 		LabelSelector: fmt.Sprintf("product=%s,version=%s", product, version),
 	})
 	if err != nil {
@@ -19,7 +19,18 @@ const (
 )
 
 func (kp *KubeProcess) createAutoscaler(ctx context.Context, deployment *appsv1.Deployment, process *domain.Process) error {
-	autoscaler := &autoscalilngv2.HorizontalPodAutoscaler{
+	autoscaler := getAutoscaler(deployment, process)
+
+	_, err := kp.client.AutoscalingV2().HorizontalPodAutoscalers(deployment.Namespace).Create(ctx, autoscaler, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func getAutoscaler(deployment *appsv1.Deployment, process *domain.Process) *autoscalilngv2.HorizontalPodAutoscaler {
+	return &autoscalilngv2.HorizontalPodAutoscaler{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:   deployment.Name,
 			Labels: deployment.Labels,
@@ -32,34 +43,105 @@ func (kp *KubeProcess) createAutoscaler(ctx context.Context, deployment *appsv1.
 				},
 			},
 		},
-		Spec: autoscalilngv2.HorizontalPodAutoscalerSpec{
-			ScaleTargetRef: autoscalilngv2.CrossVersionObjectReference{
-				Kind:       _kindDeployment,
-				Name:       deployment.Name,
-				APIVersion: _appsV1APIVersion,
-			},
-			MinReplicas: pointer.Int32(1),
-			MaxReplicas: process.Replicas,
-			Metrics: []autoscalilngv2.MetricSpec{
-				{
-					Type: autoscalilngv2.ContainerResourceMetricSourceType,
-					ContainerResource: &autoscalilngv2.ContainerResourceMetricSource{
-						Name:      corev1.ResourceCPU,
-						Container: process.Name,
-						Target: autoscalilngv2.MetricTarget{
-							Type:               autoscalilngv2.UtilizationMetricType,
-							AverageUtilization: pointer.Int32(viper.GetInt32(config.AutoscaleCPUPercentageKey)),
-						},
-					},
-				},
+		Spec: getAutoscalerSpec(deployment.Name, process.Name, getProcessAutoscaling(process)),
+	}
+}
+
+// getProcessAutoscaling returns the process autoscaling settings. Processes without explicit
+// settings scale between one replica and the number of replicas declared.
+func getProcessAutoscaling(process *domain.Process) *domain.ProcessAutoscaling {
+	if process.Autoscaling != nil {
+		return process.Autoscaling
+	}
+
+	return &domain.ProcessAutoscaling{
+		MinReplicas: 1,
+		MaxReplicas: process.Replicas,
+	}
+}
+
+func getAutoscalerSpec(
+	deploymentName, containerName string,
+	autoscaling *domain.ProcessAutoscaling,
+) autoscalilngv2.HorizontalPodAutoscalerSpec {
+	minReplicas := autoscaling.MinReplicas
+	if minReplicas < 1 {
+		minReplicas = 1
+	}
+
+	maxReplicas := autoscaling.MaxReplicas
+	if maxReplicas < minReplicas {
+		maxReplicas = minReplicas
+	}
+
+	return autoscalilngv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalilngv2.CrossVersionObjectReference{
+			Kind:       _kindDeployment,
+			Name:       deploymentName,
+			APIVersion: _appsV1APIVersion,
+		},
+		MinReplicas: pointer.Int32(minReplicas),
+		MaxReplicas: maxReplicas,
+		Metrics:     getAutoscalerMetrics(containerName, autoscaling),
+		Behavior:    getAutoscalerBehavior(autoscaling),
+	}
+}
+
+func getAutoscalerMetrics(containerName string, autoscaling *domain.ProcessAutoscaling) []autoscalilngv2.MetricSpec {
+	cpuTarget := autoscaling.CPUTargetPercentage
+	if cpuTarget == 0 {
+		cpuTarget = viper.GetInt32(config.AutoscaleCPUPercentageKey)
+	}
+
+	metrics := []autoscalilngv2.MetricSpec{
+		getContainerResourceMetric(containerName, corev1.ResourceCPU, cpuTarget),
+	}
+
+	if autoscaling.MemoryTargetPercentage > 0 {
+		metrics = append(metrics,
+			getContainerResourceMetric(containerName, corev1.ResourceMemory, autoscaling.MemoryTargetPercentage),
+		)
+	}
+
+	return metrics
+}
+
+func getContainerResourceMetric(
+	containerName string,
+	resource corev1.ResourceName,
+	targetPercentage int32,
+) autoscalilngv2.MetricSpec {
+	return autoscalilngv2.MetricSpec{
+		Type: autoscalilngv2.ContainerResourceMetricSourceType,
+		ContainerResource: &autoscalilngv2.ContainerResourceMetricSource{
+			Name:      resource,
+			Container: containerName,
+			Target: autoscalilngv2.MetricTarget{
+				Type:               autoscalilngv2.UtilizationMetricType,
+				AverageUtilization: pointer.Int32(targetPercentage),
 			},
 		},
 	}
+}
 
-	_, err := kp.client.AutoscalingV2().HorizontalPodAutoscalers(kp.namespace).Create(ctx, autoscaler, metav1.CreateOptions{})
-	if err != nil {
-		return err
+func getAutoscalerBehavior(autoscaling *domain.ProcessAutoscaling) *autoscalilngv2.HorizontalPodAutoscalerBehavior {
+	if autoscaling.ScaleUpStabilizationSeconds == 0 && autoscaling.ScaleDownStabilizationSeconds == 0 {
+		return nil
 	}
 
-	return nil
+	behavior := &autoscalilngv2.HorizontalPodAutoscalerBehavior{}
+
+	if autoscaling.ScaleUpStabilizationSeconds > 0 {
+		behavior.ScaleUp = &autoscalilngv2.HPAScalingRules{
+			StabilizationWindowSeconds: pointer.Int32(autoscaling.ScaleUpStabilizationSeconds),
+		}
+	}
+
+	if autoscaling.ScaleDownStabilizationSeconds > 0 {
+		behavior.ScaleDown = &autoscalilngv2.HPAScalingRules{
+			StabilizationWindowSeconds: pointer.Int32(autoscaling.ScaleDownStabilizationSeconds),
+		}
+	}
+
+	return behavior
 }
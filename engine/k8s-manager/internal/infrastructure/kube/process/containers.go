@@ -5,6 +5,7 @@ import (
 
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
 	"github.com/spf13/viper"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -42,6 +43,11 @@ func (kp *KubeProcess) getAppContainer(configMapName string, process *domain.Pro
 				Name:      "app-log-volume",
 				MountPath: "/var/log/app",
 			},
+			{
+				Name:      natsCredentialsFiles,
+				ReadOnly:  true,
+				MountPath: common.NatsCredentialsPath,
+			},
 		},
 		Resources: getContainerResources(process.EnableGpu, process.ResourceLimits),
 	}
@@ -49,11 +55,13 @@ func (kp *KubeProcess) getAppContainer(configMapName string, process *domain.Pro
 	if process.Networking != nil {
 		container.Ports = []corev1.ContainerPort{
 			{
-				ContainerPort: int32(process.Networking.SourcePort),
+				ContainerPort: int32(process.Networking.TargetPort),
 			},
 		}
 	}
 
+	setAppContainerProbes(&container, process)
+
 	return container
 }
 
@@ -127,6 +135,64 @@ func (kp *KubeProcess) getTelegrafContainer() corev1.Container {
 	}
 }
 
+// _jobSidecarsScript runs telegraf while the process container of a job pod is running. Once every process other
+// than the sidecars and the pod sandbox has exited, it stops fluent-bit and telegraf so the pod, and the job, can
+// complete. Kubelet starts the containers in order, so the process container is already running when this starts.
+const _jobSidecarsScript = `
+telegraf &
+telegraf_pid=$!
+
+is_sidecar() {
+	[ "$1" = "$$" ] && return 0
+
+	case "$2" in
+	"" | /pause* | telegraf* | */telegraf* | /fluent-bit/* | sleep*) return 0 ;;
+	esac
+
+	return 1
+}
+
+process_running() {
+	for cmdline in /proc/[0-9]*/cmdline; do
+		pid=${cmdline#/proc/}
+		cmd=""
+		{ read -r cmd < "$cmdline"; } 2>/dev/null
+		is_sidecar "${pid%/cmdline}" "$cmd" || return 0
+	done
+
+	return 1
+}
+
+while process_running; do sleep 5; done
+
+# Let fluent-bit ship the last log lines of the process.
+sleep 10
+
+for cmdline in /proc/[0-9]*/cmdline; do
+	pid=${cmdline#/proc/}
+	cmd=""
+	{ read -r cmd < "$cmdline"; } 2>/dev/null
+	case "$cmd" in
+	/fluent-bit/*) kill -TERM "${pid%/cmdline}" ;;
+	esac
+done
+
+kill -TERM "$telegraf_pid"
+wait "$telegraf_pid"
+
+# The job result is the process result, stopping the sidecars must not fail the pod.
+exit 0
+`
+
+// getJobTelegrafContainer returns the telegraf sidecar of job pods, which also stops the pod sidecars once the
+// process finishes.
+func (kp *KubeProcess) getJobTelegrafContainer() corev1.Container {
+	container := kp.getTelegrafContainer()
+	container.Command = []string{"/bin/sh", "-c", _jobSidecarsScript}
+
+	return container
+}
+
 func getContainerResources(isGPUEnabled bool, resourceLimits *domain.ProcessResourceLimits) corev1.ResourceRequirements {
 	requests := corev1.ResourceList{
 		corev1.ResourceCPU:    resource.MustParse(resourceLimits.CPU.Request),
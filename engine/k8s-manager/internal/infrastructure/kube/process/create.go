@@ -9,6 +9,7 @@ import (
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
 	"github.com/spf13/viper"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -39,12 +40,20 @@ func (kp *KubeProcess) Create(
 		Process:  params.Process,
 	}
 
+	if params.Job != nil {
+		if err := kp.createProcessJob(ctx, params.ConfigName, process, params.Job); err != nil {
+			return fmt.Errorf("creating job: %w", err)
+		}
+
+		return nil
+	}
+
 	createdDeployment, err := kp.createProcessDeployment(ctx, params.ConfigName, process)
 	if err != nil {
 		return fmt.Errorf("creating deployment: %w", err)
 	}
 
-	if params.Process.Replicas > 1 {
+	if params.Process.IsAutoscaled() && !params.Process.IsQueueAutoscaled() {
 		if err := kp.createAutoscaler(ctx, createdDeployment, params.Process); err != nil {
 			return fmt.Errorf("creating autoscaler: %w", err)
 		}
@@ -58,38 +67,59 @@ func (kp *KubeProcess) getDeploymentSpec(configMapName string, spec *processSpec
 
 	processIdentifier := getDeploymentName(spec.Product, spec.Version, spec.Workflow, spec.Process.Name)
 
-	return &appsv1.Deployment{
+	deployment := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Deployment",
 			APIVersion: "apps/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      processIdentifier,
-			Namespace: kp.namespace,
+			Namespace: kp.getNamespace(spec.Product),
 			Labels:    labels,
 		},
 		Spec: appsv1.DeploymentSpec{
 			Selector: &metav1.LabelSelector{
 				MatchLabels: labels,
 			},
+			Strategy: getRollingUpdateStrategy(),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      labels,
 					Annotations: kp.getPrometheusAnnotations(),
 				},
-				Spec: corev1.PodSpec{
-					ImagePullSecrets: []corev1.LocalObjectReference{
-						{
-							Name: viper.GetString(config.ImageRegistryAuthSecretKey),
-						},
-					},
-					Containers:   kp.getContainers(configMapName, spec),
-					NodeSelector: kp.getNodeSelector(spec.Process),
-					Tolerations:  kp.getTolerations(spec.Process.EnableGpu),
-					Volumes:      kp.getVolumes(configMapName, processIdentifier),
-				},
+				Spec: kp.getPodSpec(configMapName, processIdentifier, kp.getContainers(configMapName, spec), spec),
+			},
+		},
+	}
+
+	if spec.Process.IsQueueAutoscaled() {
+		// The autoscaling label only goes on the deployment, so it can't share the selector and pod labels map.
+		deployment.Labels = kp.getProcessLabels(spec)
+		setQueueAutoscaling(deployment, spec.Process.Autoscaling)
+	}
+
+	return deployment
+}
+
+func (kp *KubeProcess) getPodSpec(
+	configMapName, processIdentifier string,
+	containers []corev1.Container,
+	spec *processSpec,
+) corev1.PodSpec {
+	process := spec.Process
+
+	return corev1.PodSpec{
+		ImagePullSecrets: []corev1.LocalObjectReference{
+			{
+				Name: viper.GetString(config.ImageRegistryAuthSecretKey),
 			},
 		},
+		Containers:   containers,
+		NodeSelector: kp.getNodeSelector(process),
+		Tolerations:  kp.getTolerations(process.EnableGpu),
+		Volumes: kp.getVolumes(
+			configMapName, processIdentifier, common.GetNatsCredentialsSecretName(spec.Product, spec.Version),
+		),
 	}
 }
 
@@ -112,7 +142,7 @@ func (kp *KubeProcess) getContainers(configmapName string, spec *processSpec) []
 }
 
 func (kp *KubeProcess) createProcessDeployment(ctx context.Context, configMapName string, spec *processSpec) (*appsv1.Deployment, error) {
-	return kp.client.AppsV1().Deployments(kp.namespace).
+	return kp.client.AppsV1().Deployments(kp.getNamespace(spec.Product)).
 		Create(ctx, kp.getDeploymentSpec(configMapName, spec), metav1.CreateOptions{})
 }
 
@@ -99,6 +99,49 @@ func TestStartProcess_WithHPA(t *testing.T) {
 	g.Assert(t, "StartProcess_WithHPA_HPA", autoscalerYaml)
 }
 
+func TestStartProcess_WithAutoscaling(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	process := testhelpers.NewProcessBuilder().
+		WithAutoscaling(&domain.ProcessAutoscaling{
+			MinReplicas:                   2,
+			MaxReplicas:                   6,
+			CPUTargetPercentage:           70,
+			MemoryTargetPercentage:        85,
+			ScaleUpStabilizationSeconds:   30,
+			ScaleDownStabilizationSeconds: 300,
+		}).
+		Build()
+
+	params := service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    process,
+	}
+
+	err := svc.CreateProcess(ctx, params)
+	require.NoError(t, err)
+
+	autoscaler, err := clientset.AutoscalingV2().HorizontalPodAutoscalers(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+
+	autoscalerYaml, err := yaml.Marshal(autoscaler)
+	require.NoError(t, err)
+
+	g := goldie.New(t)
+	g.Assert(t, "StartProcess_WithAutoscaling_HPA", autoscalerYaml)
+}
+
 func TestStartProcess_WithNetwork(t *testing.T) {
 	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
 	clientset := fake.NewSimpleClientset()
@@ -138,6 +181,59 @@ func TestStartProcess_WithNetwork(t *testing.T) {
 	g.Assert(t, "StartProcess_WithNetworking", deploymentYaml)
 }
 
+func TestStartProcess_WithProbes(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+
+	process := testhelpers.NewProcessBuilder().
+		WithNetworking(domain.Networking{
+			SourcePort: 8080,
+			TargetPort: 80,
+			Protocol:   "HTTP",
+		}).
+		WithProbes(&domain.ProcessProbes{
+			Readiness: &domain.ProcessProbe{
+				Type:             domain.HTTPProbeType,
+				Path:             "/ready",
+				PeriodSeconds:    5,
+				FailureThreshold: 3,
+			},
+			Startup: &domain.ProcessProbe{
+				Type:                domain.ExecProbeType,
+				Command:             []string{"cat", "/tmp/model-loaded"},
+				InitialDelaySeconds: 30,
+				FailureThreshold:    60,
+			},
+		}).
+		Build()
+
+	params := service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    process,
+	}
+
+	err := svc.CreateProcess(ctx, params)
+	require.NoError(t, err)
+
+	deployment, err := clientset.AppsV1().Deployments(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+
+	deploymentYaml, err := yaml.Marshal(deployment)
+	require.NoError(t, err)
+
+	g := goldie.New(t)
+	g.Assert(t, "StartProcess_WithProbes", deploymentYaml)
+}
+
 func TestStartProcess_WithResourceLimits(t *testing.T) {
 	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
 	clientset := fake.NewSimpleClientset()
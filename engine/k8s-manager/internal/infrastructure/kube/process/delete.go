@@ -9,8 +9,9 @@ import (
 
 func (kp *KubeProcess) DeleteProcesses(ctx context.Context, product, version string) error {
 	labelSelector := kp.getLabelSelector(product, version)
+	namespace := kp.getNamespace(product)
 
-	err := kp.client.AppsV1().Deployments(kp.namespace).DeleteCollection(
+	err := kp.client.AppsV1().Deployments(namespace).DeleteCollection(
 		ctx,
 		kp.getDeleteOptions(),
 		metav1.ListOptions{LabelSelector: labelSelector},
@@ -19,7 +20,25 @@ func (kp *KubeProcess) DeleteProcesses(ctx context.Context, product, version str
 		return err
 	}
 
-	err = kp.client.CoreV1().Pods(kp.namespace).DeleteCollection(
+	err = kp.client.BatchV1().CronJobs(namespace).DeleteCollection(
+		ctx,
+		kp.getDeleteOptions(),
+		metav1.ListOptions{LabelSelector: labelSelector},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = kp.client.BatchV1().Jobs(namespace).DeleteCollection(
+		ctx,
+		kp.getDeleteOptions(),
+		metav1.ListOptions{LabelSelector: labelSelector},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = kp.client.CoreV1().Pods(namespace).DeleteCollection(
 		ctx,
 		kp.getDeleteOptions(),
 		metav1.ListOptions{LabelSelector: labelSelector},
@@ -84,3 +84,27 @@ func TestDeleteProcess_DeletePodsError(t *testing.T) {
 	err := svc.DeleteProcesses(ctx, product, version)
 	require.ErrorIs(t, err, deletePodsErr)
 }
+
+func TestDeleteProcess_DeleteJobsError(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	viper.Set(config.KubeNamespaceKey, _namespace)
+
+	deleteJobsErr := errors.New("error deleting jobs")
+	testhelpers.SetMockCall(clientset, testhelpers.MockCallParams{
+		Action:   "delete-collection",
+		Resource: "jobs",
+		Obj:      nil,
+		Err:      deleteJobsErr,
+	})
+
+	product := faker.UUIDHyphenated()
+	version := faker.UUIDHyphenated()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx := context.Background()
+	err := svc.DeleteProcesses(ctx, product, version)
+	require.ErrorIs(t, err, deleteJobsErr)
+}
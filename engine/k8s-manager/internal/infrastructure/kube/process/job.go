@@ -0,0 +1,230 @@
+package process
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// Kubernetes appends an 11 character suffix to the names of the jobs created by a cronjob, so cronjob names
+// cannot be longer than 52 characters.
+const (
+	_maxCronJobNameLength  = 52
+	_cronJobNameHashLength = 8
+)
+
+// Processes of batch workflows without a schedule are kept as suspended cronjobs, so
+// their job template is available to trigger new runs on demand. This schedule is never used.
+const _onDemandSchedule = "@yearly"
+
+var ErrNoWorkflowJobs = errors.New("no batch processes found for the workflow")
+
+func (kp *KubeProcess) createProcessJob(
+	ctx context.Context,
+	configMapName string,
+	spec *processSpec,
+	job *domain.WorkflowJob,
+) error {
+	cronJob, err := kp.client.BatchV1().CronJobs(kp.getNamespace(spec.Product)).
+		Create(ctx, kp.getCronJobSpec(configMapName, spec, job), metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("creating cronjob: %w", err)
+	}
+
+	if job.IsScheduled() {
+		return nil
+	}
+
+	_, err = kp.runCronJob(ctx, cronJob)
+
+	return err
+}
+
+// RunWorkflow creates a new job for each process of a batch workflow from its job template.
+func (kp *KubeProcess) RunWorkflow(ctx context.Context, product, version, workflow string) ([]string, error) {
+	cronJobs, err := kp.client.BatchV1().CronJobs(kp.getNamespace(product)).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s,workflow=%s", kp.getLabelSelector(product, version), workflow),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing cronjobs: %w", err)
+	}
+
+	if len(cronJobs.Items) == 0 {
+		return nil, ErrNoWorkflowJobs
+	}
+
+	jobs := make([]string, 0, len(cronJobs.Items))
+
+	for i := range cronJobs.Items {
+		job, err := kp.runCronJob(ctx, &cronJobs.Items[i])
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+func (kp *KubeProcess) runCronJob(ctx context.Context, cronJob *batchv1.CronJob) (string, error) {
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%d", cronJob.Name, time.Now().Unix()),
+			Namespace:   cronJob.Namespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: map[string]string{"cronjob.kubernetes.io/instantiate": "manual"},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+
+	createdJob, err := kp.client.BatchV1().Jobs(cronJob.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("creating job from cronjob %q: %w", cronJob.Name, err)
+	}
+
+	kp.logger.Info("Job created", "job", createdJob.Name)
+
+	return createdJob.Name, nil
+}
+
+func (kp *KubeProcess) getCronJobSpec(configMapName string, spec *processSpec, job *domain.WorkflowJob) *batchv1.CronJob {
+	labels := kp.getProcessLabels(spec)
+
+	processIdentifier := getDeploymentName(spec.Product, spec.Version, spec.Workflow, spec.Process.Name)
+
+	schedule := job.Schedule
+	if !job.IsScheduled() {
+		schedule = _onDemandSchedule
+	}
+
+	return &batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CronJob",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getCronJobName(processIdentifier),
+			Namespace: kp.getNamespace(spec.Product),
+			Labels:    labels,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:          schedule,
+			Suspend:           pointer.Bool(!job.IsScheduled()),
+			ConcurrencyPolicy: batchv1.ForbidConcurrent,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: kp.getJobSpec(configMapName, processIdentifier, spec, job),
+			},
+		},
+	}
+}
+
+func (kp *KubeProcess) getJobSpec(configMapName, processIdentifier string, spec *processSpec, job *domain.WorkflowJob) batchv1.JobSpec {
+	containers := []corev1.Container{
+		kp.getFluentBitContainer(spec),
+		kp.getAppContainer(configMapName, spec.Process),
+		kp.getJobTelegrafContainer(),
+	}
+
+	// The telegraf sidecar stops both sidecars once the process exits, so it must see the other containers.
+	podSpec := kp.getPodSpec(configMapName, processIdentifier, containers, spec)
+	podSpec.RestartPolicy = corev1.RestartPolicyNever
+	podSpec.ShareProcessNamespace = pointer.Bool(true)
+
+	jobSpec := batchv1.JobSpec{
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: kp.getProcessLabels(spec),
+			},
+			Spec: podSpec,
+		},
+	}
+
+	if job.BackoffLimit > 0 {
+		jobSpec.BackoffLimit = pointer.Int32(job.BackoffLimit)
+	}
+
+	if job.ActiveDeadlineSeconds > 0 {
+		jobSpec.ActiveDeadlineSeconds = pointer.Int64(job.ActiveDeadlineSeconds)
+	}
+
+	if job.TTLSecondsAfterFinished > 0 {
+		jobSpec.TTLSecondsAfterFinished = pointer.Int32(job.TTLSecondsAfterFinished)
+	}
+
+	return jobSpec
+}
+
+// getJobProcessStatus maps the job conditions to the process state. Attempts count every pod run by the job,
+// finished jobs don't desire any replica.
+func getJobProcessStatus(job *batchv1.Job) *domain.ProcessStatus {
+	status := &domain.ProcessStatus{
+		Workflow: job.Labels["workflow"],
+		Process:  job.Labels["process"],
+		State:    domain.ProcessStateStarting,
+		Attempts: job.Status.Active + job.Status.Failed + job.Status.Succeeded,
+	}
+
+	if job.Status.Ready != nil {
+		status.ReadyReplicas = *job.Status.Ready
+	}
+
+	status.DesiredReplicas = 1
+	if job.Spec.Parallelism != nil {
+		status.DesiredReplicas = *job.Spec.Parallelism
+	}
+
+	if job.Status.Active > 0 {
+		status.State = domain.ProcessStateStarted
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		if condition.Type == batchv1.JobComplete {
+			status.State = domain.ProcessStateSucceeded
+			status.DesiredReplicas = 0
+		}
+
+		if condition.Type == batchv1.JobFailed {
+			status.State = domain.ProcessStateFailed
+			status.Message = condition.Message
+			status.DesiredReplicas = 0
+		}
+	}
+
+	return status
+}
+
+// getCronJobName shortens the process identifier to a valid cronjob name. Long identifiers are truncated and
+// suffixed with a hash of the full identifier, so processes sharing a prefix still get different names.
+func getCronJobName(processIdentifier string) string {
+	if len(processIdentifier) <= _maxCronJobNameLength {
+		return processIdentifier
+	}
+
+	hash := sha256.Sum256([]byte(processIdentifier))
+	suffix := hex.EncodeToString(hash[:])[:_cronJobNameHashLength]
+	prefix := strings.TrimRight(processIdentifier[:_maxCronJobNameLength-_cronJobNameHashLength-1], "-")
+
+	return fmt.Sprintf("%s-%s", prefix, suffix)
+}
@@ -0,0 +1,303 @@
+//go:build unit
+
+package process_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/process"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
+	"github.com/sebdah/goldie/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStartProcess_Job(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	params := service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().Build(),
+		Job: &domain.WorkflowJob{
+			BackoffLimit:            3,
+			ActiveDeadlineSeconds:   3600,
+			TTLSecondsAfterFinished: 600,
+		},
+	}
+
+	err := svc.CreateProcess(ctx, params)
+	require.NoError(t, err)
+
+	deployments, err := clientset.AppsV1().Deployments(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, deployments.Items)
+
+	cronJobs, err := clientset.BatchV1().CronJobs(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+
+	cronJobsYaml, err := yaml.Marshal(cronJobs)
+	require.NoError(t, err)
+
+	g := goldie.New(t)
+	g.Assert(t, "StartProcess_Job", cronJobsYaml)
+
+	jobs, err := clientset.BatchV1().Jobs(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, jobs.Items, 1)
+	assert.Equal(t, cronJobs.Items[0].Spec.JobTemplate.Spec, jobs.Items[0].Spec)
+	assert.Equal(t, cronJobs.Items[0].Labels, jobs.Items[0].Labels)
+}
+
+func TestStartProcess_JobKeepsSidecars(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().Build(),
+		Job:        &domain.WorkflowJob{},
+	})
+	require.NoError(t, err)
+
+	cronJobs, err := clientset.BatchV1().CronJobs(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, cronJobs.Items, 1)
+
+	podSpec := cronJobs.Items[0].Spec.JobTemplate.Spec.Template.Spec
+
+	containerNames := make([]string, 0, len(podSpec.Containers))
+	for _, container := range podSpec.Containers {
+		containerNames = append(containerNames, container.Name)
+	}
+
+	assert.Equal(t, []string{"fluent-bit", "test-process", "telegraf"}, containerNames)
+	require.NotNil(t, podSpec.ShareProcessNamespace)
+	assert.True(t, *podSpec.ShareProcessNamespace)
+	assert.NotEmpty(t, podSpec.Containers[2].Command)
+}
+
+func TestStartProcess_JobWithLongName(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	for _, processName := range []string{"entrypoint-first", "entrypoint-second"} {
+		err := svc.CreateProcess(ctx, service.CreateProcessParams{
+			ConfigName: "configmap-name",
+			Product:    "email-classificator",
+			Version:    "v1.0.0",
+			Workflow:   "go-classificator",
+			Process:    testhelpers.NewProcessBuilder().WithName(processName).Build(),
+			Job:        &domain.WorkflowJob{},
+		})
+		require.NoError(t, err)
+	}
+
+	cronJobs, err := clientset.BatchV1().CronJobs(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, cronJobs.Items, 2)
+
+	assert.NotEqual(t, cronJobs.Items[0].Name, cronJobs.Items[1].Name)
+
+	for _, cronJob := range cronJobs.Items {
+		assert.LessOrEqual(t, len(cronJob.Name), 52)
+		assert.True(t, strings.HasPrefix(cronJob.Name, "email-classificator-v1-0-0-go-classificator-"))
+	}
+
+	jobs, err := clientset.BatchV1().Jobs(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+
+	for _, job := range jobs.Items {
+		assert.LessOrEqual(t, len(job.Name), 63)
+	}
+}
+
+func TestStartProcess_ScheduledJob(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	params := service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().Build(),
+		Job: &domain.WorkflowJob{
+			Schedule: "0 3 * * *",
+		},
+	}
+
+	err := svc.CreateProcess(ctx, params)
+	require.NoError(t, err)
+
+	cronJobs, err := clientset.BatchV1().CronJobs(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, cronJobs.Items, 1)
+	assert.Equal(t, "0 3 * * *", cronJobs.Items[0].Spec.Schedule)
+	assert.False(t, *cronJobs.Items[0].Spec.Suspend)
+
+	jobs, err := clientset.BatchV1().Jobs(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, jobs.Items)
+}
+
+func TestRunWorkflow(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	for _, processName := range []string{"process-1", "process-2"} {
+		err := svc.CreateProcess(ctx, service.CreateProcessParams{
+			ConfigName: "configmap-name",
+			Product:    "test-product",
+			Version:    "v1.0.0",
+			Workflow:   "test-workflow",
+			Process:    testhelpers.NewProcessBuilder().WithName(processName).Build(),
+			Job:        &domain.WorkflowJob{Schedule: "0 3 * * *"},
+		})
+		require.NoError(t, err)
+	}
+
+	jobNames, err := svc.RunWorkflow(ctx, "test-product", "v1.0.0", "test-workflow")
+	require.NoError(t, err)
+	assert.Len(t, jobNames, 2)
+
+	jobs, err := clientset.BatchV1().Jobs(_namespace).List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, jobs.Items, 2)
+}
+
+func TestRunWorkflow_NoJobs(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	_, err := svc.RunWorkflow(ctx, "test-product", "v1.0.0", "test-workflow")
+	require.ErrorIs(t, err, process.ErrNoWorkflowJobs)
+}
+
+func TestWatchProcessStatus_Job(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	statusCh := make(chan *domain.ProcessStatus)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- svc.WatchProcessStatus(ctx, "test-product", "v1.0.0", statusCh)
+	}()
+
+	job := &batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-job",
+			Namespace: _namespace,
+			Labels: map[string]string{
+				"product":  "test-product",
+				"version":  "v1.0.0",
+				"workflow": "test-workflow",
+				"process":  "test-process",
+			},
+		},
+		Status: batchv1.JobStatus{
+			Failed: 3,
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:    batchv1.JobFailed,
+					Status:  corev1.ConditionTrue,
+					Message: "Job has reached the specified backoff limit",
+				},
+			},
+		},
+	}
+
+	// Wait for the watchers to be registered before creating the job
+	require.Eventually(t, func() bool {
+		return len(clientset.Actions()) >= 3
+	}, time.Second, 10*time.Millisecond)
+
+	_, err := clientset.BatchV1().Jobs(_namespace).Create(ctx, job, v1.CreateOptions{})
+	require.NoError(t, err)
+
+	select {
+	case status := <-statusCh:
+		assert.Equal(t, &domain.ProcessStatus{
+			Workflow: "test-workflow",
+			Process:  "test-process",
+			State:    domain.ProcessStateFailed,
+			Attempts: 3,
+			Message:  "Job has reached the specified backoff limit",
+		}, status)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for process status")
+	}
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
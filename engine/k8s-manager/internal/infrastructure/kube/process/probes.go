@@ -0,0 +1,118 @@
+package process
+
+import (
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	_defaultProbePeriodSeconds           = 10
+	_defaultStartupProbeFailureThreshold = 30
+)
+
+func setAppContainerProbes(container *corev1.Container, process *domain.Process) {
+	probes := getProcessProbes(process)
+
+	container.LivenessProbe = getProbe(probes.Liveness, process.Networking)
+	container.ReadinessProbe = getProbe(probes.Readiness, process.Networking)
+	container.StartupProbe = getProbe(probes.Startup, process.Networking)
+}
+
+// getProcessProbes returns the process probes. Networked processes get a check on their port
+// for any probe not defined, with a startup probe that gives them some minutes to get ready.
+func getProcessProbes(process *domain.Process) *domain.ProcessProbes {
+	probes := &domain.ProcessProbes{}
+	if process.Probes != nil {
+		*probes = *process.Probes
+	}
+
+	if process.Networking == nil {
+		return probes
+	}
+
+	if probes.Liveness == nil {
+		probes.Liveness = getDefaultNetworkingProbe(process.Networking)
+	}
+
+	if probes.Readiness == nil {
+		probes.Readiness = getDefaultNetworkingProbe(process.Networking)
+	}
+
+	if probes.Startup == nil {
+		probes.Startup = getDefaultNetworkingProbe(process.Networking)
+		probes.Startup.FailureThreshold = _defaultStartupProbeFailureThreshold
+	}
+
+	return probes
+}
+
+func getDefaultNetworkingProbe(networking *domain.Networking) *domain.ProcessProbe {
+	probeType := domain.TCPProbeType
+	if networking.Protocol == domain.NetworkingProtocolGRPC {
+		probeType = domain.GRPCProbeType
+	}
+
+	return &domain.ProcessProbe{
+		Type:          probeType,
+		Port:          networking.TargetPort,
+		PeriodSeconds: _defaultProbePeriodSeconds,
+	}
+}
+
+// getProbe maps a process probe to the container one. Probes without port check the process' networking port.
+func getProbe(probe *domain.ProcessProbe, networking *domain.Networking) *corev1.Probe {
+	if probe == nil {
+		return nil
+	}
+
+	port := probe.Port
+	if port == 0 && networking != nil {
+		port = networking.TargetPort
+	}
+
+	handler, ok := getProbeHandler(probe, port)
+	if !ok {
+		return nil
+	}
+
+	return &corev1.Probe{
+		ProbeHandler:        handler,
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		SuccessThreshold:    probe.SuccessThreshold,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+}
+
+func getProbeHandler(probe *domain.ProcessProbe, port int) (corev1.ProbeHandler, bool) {
+	switch probe.Type {
+	case domain.HTTPProbeType:
+		return corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: probe.Path,
+				Port: intstr.FromInt(port),
+			},
+		}, true
+	case domain.GRPCProbeType:
+		grpcAction := &corev1.GRPCAction{Port: int32(port)}
+		if probe.GRPCService != "" {
+			grpcAction.Service = &probe.GRPCService
+		}
+
+		return corev1.ProbeHandler{GRPC: grpcAction}, true
+	case domain.ExecProbeType:
+		return corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{Command: probe.Command},
+		}, true
+	case domain.TCPProbeType:
+		return corev1.ProbeHandler{
+			TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(port)},
+		}, true
+	case domain.UnknownProbeType:
+		return corev1.ProbeHandler{}, false
+	default:
+		return corev1.ProbeHandler{}, false
+	}
+}
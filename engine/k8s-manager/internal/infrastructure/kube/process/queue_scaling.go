@@ -0,0 +1,137 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	_queueAutoscaledLabel = "queue-autoscaled"
+
+	_minReplicasAnnotation                   = "kai.autoscaling/min-replicas"
+	_maxReplicasAnnotation                   = "kai.autoscaling/max-replicas"
+	_consumerLagTargetAnnotation             = "kai.autoscaling/consumer-lag-target"
+	_scaleDownStabilizationSecondsAnnotation = "kai.autoscaling/scale-down-stabilization-seconds"
+)
+
+// ListQueueScaledProcesses returns the deployed processes whose replicas are driven by their consumer lag.
+func (kp *KubeProcess) ListQueueScaledProcesses(ctx context.Context) ([]*domain.QueueScaledProcess, error) {
+	deployments, err := kp.client.AppsV1().Deployments(common.GetListNamespace(kp.namespace)).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=true", _queueAutoscaledLabel),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing queue autoscaled deployments: %w", err)
+	}
+
+	processes := make([]*domain.QueueScaledProcess, 0, len(deployments.Items))
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+
+		autoscaling, err := getQueueAutoscalingFromAnnotations(deployment.Annotations)
+		if err != nil {
+			kp.logger.Error(err, "Invalid queue autoscaling annotations", "deployment", deployment.Name)
+			continue
+		}
+
+		replicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+
+		processes = append(processes, &domain.QueueScaledProcess{
+			Product:     deployment.Labels["product"],
+			Version:     deployment.Labels["version"],
+			Workflow:    deployment.Labels["workflow"],
+			Process:     deployment.Labels["process"],
+			Replicas:    replicas,
+			Autoscaling: *autoscaling,
+		})
+	}
+
+	return processes, nil
+}
+
+// setQueueAutoscaling marks the deployment to be scaled on its consumer lag or unmarks it
+// when the process autoscaling is no longer queue based.
+func setQueueAutoscaling(deployment *appsv1.Deployment, autoscaling *domain.ProcessAutoscaling) {
+	if deployment.Labels == nil {
+		deployment.Labels = map[string]string{}
+	}
+
+	if deployment.Annotations == nil {
+		deployment.Annotations = map[string]string{}
+	}
+
+	if !autoscaling.IsQueueBased() {
+		delete(deployment.Labels, _queueAutoscaledLabel)
+
+		for annotation := range getQueueAutoscalingAnnotations(&domain.ProcessAutoscaling{}) {
+			delete(deployment.Annotations, annotation)
+		}
+
+		// The HPA doesn't scale deployments with zero replicas, so processes left idle are woken up.
+		if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
+			deployment.Spec.Replicas = pointer.Int32(1)
+		}
+
+		return
+	}
+
+	deployment.Labels[_queueAutoscaledLabel] = "true"
+
+	for annotation, value := range getQueueAutoscalingAnnotations(autoscaling) {
+		deployment.Annotations[annotation] = value
+	}
+}
+
+func getQueueAutoscalingAnnotations(autoscaling *domain.ProcessAutoscaling) map[string]string {
+	return map[string]string{
+		_minReplicasAnnotation:                   strconv.Itoa(int(autoscaling.MinReplicas)),
+		_maxReplicasAnnotation:                   strconv.Itoa(int(autoscaling.MaxReplicas)),
+		_consumerLagTargetAnnotation:             strconv.Itoa(int(autoscaling.ConsumerLagTarget)),
+		_scaleDownStabilizationSecondsAnnotation: strconv.Itoa(int(autoscaling.ScaleDownStabilizationSeconds)),
+	}
+}
+
+func getQueueAutoscalingFromAnnotations(annotations map[string]string) (*domain.ProcessAutoscaling, error) {
+	values := make(map[string]int32, 4)
+
+	for _, annotation := range []string{
+		_minReplicasAnnotation,
+		_maxReplicasAnnotation,
+		_consumerLagTargetAnnotation,
+		_scaleDownStabilizationSecondsAnnotation,
+	} {
+		value, err := strconv.ParseInt(annotations[annotation], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("parsing annotation %q: %w", annotation, err)
+		}
+
+		values[annotation] = int32(value)
+	}
+
+	return &domain.ProcessAutoscaling{
+		MinReplicas:                   values[_minReplicasAnnotation],
+		MaxReplicas:                   values[_maxReplicasAnnotation],
+		ConsumerLagTarget:             values[_consumerLagTargetAnnotation],
+		ScaleDownStabilizationSeconds: values[_scaleDownStabilizationSecondsAnnotation],
+	}, nil
+}
+
+func (kp *KubeProcess) deleteAutoscaler(ctx context.Context, namespace, deploymentName string) error {
+	err := kp.client.AutoscalingV2().HorizontalPodAutoscalers(namespace).Delete(ctx, deploymentName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+//go:build unit
+
+package process_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+)
+
+func TestCreateProcess_QueueAutoscaled(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	autoscaling := &domain.ProcessAutoscaling{
+		MinReplicas:                   0,
+		MaxReplicas:                   5,
+		ConsumerLagTarget:             100,
+		ScaleDownStabilizationSeconds: 60,
+	}
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().WithAutoscaling(autoscaling).Build(),
+	})
+	require.NoError(t, err)
+
+	_, err = clientset.AutoscalingV2().HorizontalPodAutoscalers(_namespace).Get(ctx, _scaledDeploymentName, v1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	deployment, err := clientset.AppsV1().Deployments(_namespace).Get(ctx, _scaledDeploymentName, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, deployment.Spec.Selector.MatchLabels, "queue-autoscaled")
+
+	processes, err := svc.ListQueueScaledProcesses(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.QueueScaledProcess{
+		{
+			Product:     "test-product",
+			Version:     "v1.0.0",
+			Workflow:    "test-workflow",
+			Process:     "test-process",
+			Replicas:    1,
+			Autoscaling: *autoscaling,
+		},
+	}, processes)
+}
+
+func TestListQueueScaledProcesses_IgnoresResourceAutoscaledProcesses(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().WithReplicas(3).Build(),
+	})
+	require.NoError(t, err)
+
+	processes, err := svc.ListQueueScaledProcesses(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, processes)
+}
+
+func TestScaleProcess_SwitchesToQueueAutoscaling(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().WithReplicas(3).Build(),
+	})
+	require.NoError(t, err)
+
+	err = svc.ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:     "test-product",
+		Version:     "v1.0.0",
+		Workflow:    "test-workflow",
+		Process:     "test-process",
+		Autoscaling: &domain.ProcessAutoscaling{MaxReplicas: 4, ConsumerLagTarget: 50},
+	})
+	require.NoError(t, err)
+
+	_, err = clientset.AutoscalingV2().HorizontalPodAutoscalers(_namespace).Get(ctx, _scaledDeploymentName, v1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	processes, err := svc.ListQueueScaledProcesses(ctx)
+	require.NoError(t, err)
+	require.Len(t, processes, 1)
+	assert.Equal(t, int32(50), processes[0].Autoscaling.ConsumerLagTarget)
+}
+
+func TestScaleProcess_SwitchesBackToResourceAutoscaling(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process: testhelpers.NewProcessBuilder().
+			WithAutoscaling(&domain.ProcessAutoscaling{MaxReplicas: 4, ConsumerLagTarget: 50}).
+			Build(),
+	})
+	require.NoError(t, err)
+
+	err = svc.ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Replicas: pointer.Int32(0),
+	})
+	require.NoError(t, err)
+
+	err = svc.ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:     "test-product",
+		Version:     "v1.0.0",
+		Workflow:    "test-workflow",
+		Process:     "test-process",
+		Autoscaling: &domain.ProcessAutoscaling{MinReplicas: 1, MaxReplicas: 4},
+	})
+	require.NoError(t, err)
+
+	processes, err := svc.ListQueueScaledProcesses(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, processes)
+
+	deployment, err := clientset.AppsV1().Deployments(_namespace).Get(ctx, _scaledDeploymentName, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), *deployment.Spec.Replicas)
+
+	_, err = clientset.AutoscalingV2().HorizontalPodAutoscalers(_namespace).Get(ctx, _scaledDeploymentName, v1.GetOptions{})
+	assert.NoError(t, err)
+}
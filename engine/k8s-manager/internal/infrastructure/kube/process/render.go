@@ -0,0 +1,64 @@
+package process
+
+import (
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	_kindCronJob                 = "CronJob"
+	_kindHorizontalPodAutoscaler = "HorizontalPodAutoscaler"
+)
+
+// RenderProcess returns the objects created when starting the process, without creating them.
+// That is the cronjob of batch processes, or the deployment and its autoscaler otherwise.
+func (kp *KubeProcess) RenderProcess(params service.CreateProcessParams) ([]*domain.Manifest, error) {
+	process := &processSpec{
+		Product:  params.Product,
+		Version:  params.Version,
+		Workflow: params.Workflow,
+		Process:  params.Process,
+	}
+
+	if params.Job != nil {
+		cronJob := kp.getCronJobSpec(params.ConfigName, process, params.Job)
+
+		manifest, err := common.RenderManifest(_kindCronJob, cronJob.Name, cronJob)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*domain.Manifest{manifest}, nil
+	}
+
+	deployment := kp.getDeploymentSpec(params.ConfigName, process)
+
+	deploymentManifest, err := common.RenderManifest(_kindDeployment, deployment.Name, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := []*domain.Manifest{deploymentManifest}
+
+	if params.Process.IsAutoscaled() && !params.Process.IsQueueAutoscaled() {
+		autoscaler := getAutoscaler(deployment, params.Process)
+		autoscaler.TypeMeta = metav1.TypeMeta{
+			Kind:       _kindHorizontalPodAutoscaler,
+			APIVersion: "autoscaling/v2",
+		}
+		autoscaler.Namespace = deployment.Namespace
+		// The owner reference needs the UID the cluster assigns to the deployment, unknown until it is created.
+		autoscaler.OwnerReferences = nil
+
+		autoscalerManifest, err := common.RenderManifest(_kindHorizontalPodAutoscaler, autoscaler.Name, autoscaler)
+		if err != nil {
+			return nil, err
+		}
+
+		manifests = append(manifests, autoscalerManifest)
+	}
+
+	return manifests, nil
+}
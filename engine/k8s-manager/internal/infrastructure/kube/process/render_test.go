@@ -0,0 +1,69 @@
+//go:build unit
+
+package process_test
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
+	"github.com/sebdah/goldie/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRenderProcess_WithHPA(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	manifests, err := svc.RenderProcess(service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().WithReplicas(5).Build(),
+	})
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+
+	assert.Equal(t, "Deployment", manifests[0].Kind)
+	assert.Equal(t, "HorizontalPodAutoscaler", manifests[1].Kind)
+
+	// Rendering must not create anything in the cluster.
+	assert.Empty(t, clientset.Actions())
+
+	g := goldie.New(t)
+	g.Assert(t, "RenderProcess_WithHPA_Deployment", []byte(manifests[0].YAML))
+	g.Assert(t, "RenderProcess_WithHPA_HPA", []byte(manifests[1].YAML))
+}
+
+func TestRenderProcess_Job(t *testing.T) {
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	clientset := fake.NewSimpleClientset()
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	manifests, err := svc.RenderProcess(service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().Build(),
+		Job:        &domain.WorkflowJob{Schedule: "0 * * * *"},
+	})
+	require.NoError(t, err)
+	require.Len(t, manifests, 1)
+
+	assert.Equal(t, "CronJob", manifests[0].Kind)
+	assert.Empty(t, clientset.Actions())
+}
@@ -0,0 +1,89 @@
+package process
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScaleProcess changes the replicas and autoscaling bounds of a running process without restarting it.
+func (kp *KubeProcess) ScaleProcess(ctx context.Context, params service.ScaleProcessParams) error {
+	kp.logger.Info("Scaling process",
+		"product", params.Product,
+		"version", params.Version,
+		"process", params.Process,
+	)
+
+	deploymentName := getDeploymentName(params.Product, params.Version, params.Workflow, params.Process)
+	namespace := kp.getNamespace(params.Product)
+
+	deployment, err := kp.client.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting deployment %q: %w", deploymentName, err)
+	}
+
+	if params.Replicas != nil {
+		deployment.Spec.Replicas = params.Replicas
+
+		deployment, err = kp.client.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("updating deployment %q replicas: %w", deploymentName, err)
+		}
+	}
+
+	if params.Autoscaling == nil {
+		return nil
+	}
+
+	setQueueAutoscaling(deployment, params.Autoscaling)
+
+	deployment, err = kp.client.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("updating deployment %q autoscaling: %w", deploymentName, err)
+	}
+
+	if params.Autoscaling.IsQueueBased() {
+		if err := kp.deleteAutoscaler(ctx, namespace, deploymentName); err != nil {
+			return fmt.Errorf("deleting autoscaler %q: %w", deploymentName, err)
+		}
+
+		return nil
+	}
+
+	err = kp.applyAutoscaling(ctx, namespace, deploymentName, params.Process, params.Autoscaling)
+	if k8serrors.IsNotFound(err) {
+		err = kp.createAutoscaler(ctx, deployment, &domain.Process{
+			Name:        params.Process,
+			Autoscaling: params.Autoscaling,
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("updating autoscaler %q: %w", deploymentName, err)
+	}
+
+	return nil
+}
+
+func (kp *KubeProcess) applyAutoscaling(
+	ctx context.Context,
+	namespace, deploymentName, processName string,
+	autoscaling *domain.ProcessAutoscaling,
+) error {
+	autoscalers := kp.client.AutoscalingV2().HorizontalPodAutoscalers(namespace)
+
+	autoscaler, err := autoscalers.Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	autoscaler.Spec = getAutoscalerSpec(deploymentName, processName, autoscaling)
+
+	_, err = autoscalers.Update(ctx, autoscaler, metav1.UpdateOptions{})
+
+	return err
+}
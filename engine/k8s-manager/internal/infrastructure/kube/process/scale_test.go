@@ -0,0 +1,153 @@
+//go:build unit
+
+package process_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+)
+
+const _scaledDeploymentName = "test-product-v1-0-0-test-workflow-test-process"
+
+func TestScaleProcess_Replicas(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().Build(),
+	})
+	require.NoError(t, err)
+
+	err = svc.ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Replicas: pointer.Int32(3),
+	})
+	require.NoError(t, err)
+
+	deployment, err := clientset.AppsV1().Deployments(_namespace).Get(ctx, _scaledDeploymentName, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+}
+
+func TestScaleProcess_CreatesAutoscaler(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().Build(),
+	})
+	require.NoError(t, err)
+
+	err = svc.ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:     "test-product",
+		Version:     "v1.0.0",
+		Workflow:    "test-workflow",
+		Process:     "test-process",
+		Autoscaling: &domain.ProcessAutoscaling{MinReplicas: 2, MaxReplicas: 4},
+	})
+	require.NoError(t, err)
+
+	autoscaler, err := clientset.AutoscalingV2().HorizontalPodAutoscalers(_namespace).
+		Get(ctx, _scaledDeploymentName, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), *autoscaler.Spec.MinReplicas)
+	assert.Equal(t, int32(4), autoscaler.Spec.MaxReplicas)
+}
+
+func TestScaleProcess_UpdatesAutoscaler(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    testhelpers.NewProcessBuilder().WithReplicas(5).Build(),
+	})
+	require.NoError(t, err)
+
+	err = svc.ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Autoscaling: &domain.ProcessAutoscaling{
+			MinReplicas:                   3,
+			MaxReplicas:                   10,
+			ScaleDownStabilizationSeconds: 120,
+		},
+	})
+	require.NoError(t, err)
+
+	autoscaler, err := clientset.AutoscalingV2().HorizontalPodAutoscalers(_namespace).
+		Get(ctx, _scaledDeploymentName, v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), *autoscaler.Spec.MinReplicas)
+	assert.Equal(t, int32(10), autoscaler.Spec.MaxReplicas)
+	assert.Equal(t, int32(120), *autoscaler.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+}
+
+func TestScaleProcess_DeploymentNotFound(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.ScaleProcess(ctx, service.ScaleProcessParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Replicas: pointer.Int32(3),
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var ErrProcessContainerNotFound = errors.New("process container not found in deployment")
+
+// UpdateProcessImage rolls the process deployment to a new image and returns the image it was running before.
+func (kp *KubeProcess) UpdateProcessImage(ctx context.Context, params service.UpdateProcessImageParams) (string, error) {
+	kp.logger.Info("Updating process image",
+		"product", params.Product,
+		"version", params.Version,
+		"process", params.Process,
+		"image", params.Image,
+	)
+
+	deploymentName := getDeploymentName(params.Product, params.Version, params.Workflow, params.Process)
+	namespace := kp.getNamespace(params.Product)
+
+	deployment, err := kp.client.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting deployment %q: %w", deploymentName, err)
+	}
+
+	var previousImage string
+
+	containers := deployment.Spec.Template.Spec.Containers
+	for i := range containers {
+		if containers[i].Name == params.Process {
+			previousImage = containers[i].Image
+			containers[i].Image = params.Image
+		}
+	}
+
+	if previousImage == "" {
+		return "", fmt.Errorf("%w: %q", ErrProcessContainerNotFound, params.Process)
+	}
+
+	deployment.Spec.Strategy = getRollingUpdateStrategy()
+
+	_, err = kp.client.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("updating deployment %q: %w", deploymentName, err)
+	}
+
+	return previousImage, nil
+}
+
+// getRollingUpdateStrategy keeps every current replica serving until its replacement is available.
+func getRollingUpdateStrategy() appsv1.DeploymentStrategy {
+	maxUnavailable := intstr.FromInt(0)
+	maxSurge := intstr.FromInt(1)
+
+	return appsv1.DeploymentStrategy{
+		Type: appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{
+			MaxUnavailable: &maxUnavailable,
+			MaxSurge:       &maxSurge,
+		},
+	}
+}
@@ -0,0 +1,114 @@
+//go:build unit
+
+package process_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/process"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUpdateProcessImage(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	processToUpdate := testhelpers.NewProcessBuilder().Build()
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "configmap-name",
+		Product:    "test-product",
+		Version:    "v1.0.0",
+		Workflow:   "test-workflow",
+		Process:    processToUpdate,
+	})
+	require.NoError(t, err)
+
+	previousImage, err := svc.UpdateProcessImage(ctx, service.UpdateProcessImageParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  processToUpdate.Name,
+		Image:    "test-image@hotfix",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, processToUpdate.Image, previousImage)
+
+	deployment, err := clientset.AppsV1().Deployments(_namespace).
+		Get(ctx, "test-product-v1-0-0-test-workflow-test-process", v1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, appsv1.RollingUpdateDeploymentStrategyType, deployment.Spec.Strategy.Type)
+
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name == processToUpdate.Name {
+			assert.Equal(t, "test-image@hotfix", container.Image)
+		}
+	}
+}
+
+func TestUpdateProcessImage_DeploymentNotFound(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	_, err := svc.UpdateProcessImage(ctx, service.UpdateProcessImageParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Image:    "test-image@hotfix",
+	})
+	require.Error(t, err)
+}
+
+func TestUpdateProcessImage_ContainerNotFound(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	_, err := clientset.AppsV1().Deployments(_namespace).Create(ctx, &appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-product-v1-0-0-test-workflow-test-process",
+			Namespace: _namespace,
+		},
+	}, v1.CreateOptions{})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateProcessImage(ctx, service.UpdateProcessImageParams{
+		Product:  "test-product",
+		Version:  "v1.0.0",
+		Workflow: "test-workflow",
+		Process:  "test-process",
+		Image:    "test-image@hotfix",
+	})
+	require.ErrorIs(t, err, process.ErrProcessContainerNotFound)
+}
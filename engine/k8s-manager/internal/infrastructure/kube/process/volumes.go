@@ -1,15 +1,18 @@
 package process
 
 import (
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/common"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
 )
 
 const (
-	versionConfFiles = "version-conf-files"
-	appLogsVolume    = "app-log-volume"
+	versionConfFiles     = "version-conf-files"
+	appLogsVolume        = "app-log-volume"
+	natsCredentialsFiles = "nats-credentials"
 )
 
-func (kp *KubeProcess) getVolumes(configRef, configKey string) []corev1.Volume {
+func (kp *KubeProcess) getVolumes(configRef, configKey, credentialsRef string) []corev1.Volume {
 	return []corev1.Volume{
 		{
 			Name: versionConfFiles,
@@ -45,5 +48,21 @@ func (kp *KubeProcess) getVolumes(configRef, configKey string) []corev1.Volume {
 				EmptyDir: &corev1.EmptyDirVolumeSource{},
 			},
 		},
+		{
+			// The credentials secret only exists when NATS authentication is enabled.
+			Name: natsCredentialsFiles,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: credentialsRef,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  common.NatsCredentialsKey,
+							Path: common.NatsCredentialsKey,
+						},
+					},
+					Optional: pointer.Bool(true),
+				},
+			},
+		},
 	}
 }
@@ -30,7 +30,7 @@ func (kp *KubeProcess) watchForVersionDeployments(ctx context.Context, version *
 	wCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	deployments, err := kp.client.AppsV1().Deployments(kp.namespace).
+	deployments, err := kp.client.AppsV1().Deployments(kp.getNamespace(version.Product)).
 		List(ctx, metav1.ListOptions{LabelSelector: fmt.Sprintf("product=%s,version=%s", version.Product, version.Tag)})
 	if err != nil {
 		return fmt.Errorf("listing deployments: %w", err)
@@ -38,15 +38,19 @@ func (kp *KubeProcess) watchForVersionDeployments(ctx context.Context, version *
 
 	readyDeployments := make(map[string]bool)
 
-	for _, deployment := range deployments.Items {
-		if deployment.Status.UnavailableReplicas == 0 {
-			readyDeployments[deployment.Name] = true
+	for i := range deployments.Items {
+		if kp.isDeploymentReady(&deployments.Items[i]) {
+			readyDeployments[deployments.Items[i].Name] = true
 		}
 	}
 
+	if len(readyDeployments) == len(deployments.Items) {
+		return nil
+	}
+
 	rw, err := watchtools.NewRetryWatcher("1", &cache.ListWatch{
 		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-			return kp.client.AppsV1().Deployments(kp.namespace).Watch(wCtx, metav1.ListOptions{
+			return kp.client.AppsV1().Deployments(kp.getNamespace(version.Product)).Watch(wCtx, metav1.ListOptions{
 				LabelSelector: fmt.Sprintf(
 					"product=%s,version=%s", version.Product, version.Tag,
 				),
@@ -67,8 +71,11 @@ func (kp *KubeProcess) watchForVersionDeployments(ctx context.Context, version *
 				return ErrParsingDeployment
 			}
 
+			// A deployment being rolled out again is no longer ready until the new replicas are.
 			if kp.isDeploymentReady(deployment) {
 				readyDeployments[deployment.Name] = true
+			} else {
+				delete(readyDeployments, deployment.Name)
 			}
 
 			if len(readyDeployments) == len(deployments.Items) {
@@ -84,6 +91,15 @@ func (kp *KubeProcess) watchForVersionDeployments(ctx context.Context, version *
 	}
 }
 
+// isDeploymentReady checks all the deployment replicas are updated and have passed their readiness probes.
 func (kp *KubeProcess) isDeploymentReady(deployment *appsv1.Deployment) bool {
-	return deployment.Status.UnavailableReplicas == 0
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas >= replicas &&
+		deployment.Status.ReadyReplicas >= replicas &&
+		deployment.Status.UnavailableReplicas == 0
 }
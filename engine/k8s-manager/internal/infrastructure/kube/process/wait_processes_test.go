@@ -82,6 +82,120 @@ func TestWaitProcesses_ContextCancelled(t *testing.T) {
 	cancel()
 }
 
+func TestWaitProcesses_DeploymentsReady(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: 1})
+		clientset = fake.NewSimpleClientset()
+		ctx       = context.Background()
+
+		version = testhelpers.NewVersionBuilder().Build()
+	)
+
+	viper.Set(config.KubeNamespaceKey, _namespace)
+	viper.Set(config.ProcessTimeoutKey, 10*time.Millisecond)
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	err := svc.CreateProcess(ctx, service.CreateProcessParams{
+		ConfigName: "test",
+		Product:    version.Product,
+		Version:    version.Tag,
+		Workflow:   version.Workflows[0].Name,
+		Process:    version.Workflows[0].Processes[0],
+	})
+	require.NoError(t, err)
+
+	deployments, err := clientset.AppsV1().Deployments(_namespace).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		deployment.Status.UpdatedReplicas = 1
+		deployment.Status.ReadyReplicas = 1
+
+		_, err = clientset.AppsV1().Deployments(_namespace).UpdateStatus(ctx, deployment, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	err = svc.WaitProcesses(ctx, version)
+	require.NoError(t, err)
+}
+
+func TestWaitProcesses_DeploymentsNotRolledOut(t *testing.T) {
+	testCases := []struct {
+		name               string
+		generation         int64
+		observedGeneration int64
+		updatedReplicas    int32
+		readyReplicas      int32
+	}{
+		{
+			name:               "new generation not observed yet",
+			generation:         2,
+			observedGeneration: 1,
+			updatedReplicas:    1,
+			readyReplicas:      1,
+		},
+		{
+			name:               "replicas not updated yet",
+			generation:         2,
+			observedGeneration: 2,
+			updatedReplicas:    0,
+			readyReplicas:      1,
+		},
+		{
+			name:               "updated replicas not ready yet",
+			generation:         2,
+			observedGeneration: 2,
+			updatedReplicas:    1,
+			readyReplicas:      0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				logger    = testr.NewWithOptions(t, testr.Options{Verbosity: 1})
+				clientset = fake.NewSimpleClientset()
+				ctx       = context.Background()
+
+				version = testhelpers.NewVersionBuilder().Build()
+			)
+
+			viper.Set(config.KubeNamespaceKey, _namespace)
+			viper.Set(config.ProcessTimeoutKey, 10*time.Millisecond)
+
+			svc := kube.NewK8sContainerService(logger, clientset)
+
+			err := svc.CreateProcess(ctx, service.CreateProcessParams{
+				ConfigName: "test",
+				Product:    version.Product,
+				Version:    version.Tag,
+				Workflow:   version.Workflows[0].Name,
+				Process:    version.Workflows[0].Processes[0],
+			})
+			require.NoError(t, err)
+
+			deployments, err := clientset.AppsV1().Deployments(_namespace).List(ctx, metav1.ListOptions{})
+			require.NoError(t, err)
+
+			for i := range deployments.Items {
+				deployment := &deployments.Items[i]
+				deployment.Generation = tc.generation
+				deployment.Status.ObservedGeneration = tc.observedGeneration
+				deployment.Status.UpdatedReplicas = tc.updatedReplicas
+				deployment.Status.ReadyReplicas = tc.readyReplicas
+
+				_, err = clientset.AppsV1().Deployments(_namespace).UpdateStatus(ctx, deployment, metav1.UpdateOptions{})
+				require.NoError(t, err)
+			}
+
+			err = svc.WaitProcesses(ctx, version)
+			require.ErrorIs(t, err, process.ErrTimeoutWaitingProcesses)
+		})
+	}
+}
+
 func cleanDeployments(clientset *fake.Clientset) error {
 	ctx := context.Background()
 	deployments, err := clientset.AppsV1().Deployments(_namespace).List(ctx, metav1.ListOptions{})
@@ -0,0 +1,362 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	_maxProcessEvents      = 10
+	_jobNameLabel          = "job-name"
+	_crashLoopBackOffState = "CrashLoopBackOff"
+)
+
+var ErrWatcherClosed = errors.New("process status watcher closed")
+
+// WatchProcessStatus sends the status of the version deployments and jobs each time they or their pods change.
+func (kp *KubeProcess) WatchProcessStatus(
+	ctx context.Context,
+	product, version string,
+	statusCh chan<- *domain.ProcessStatus,
+) error {
+	listOptions := metav1.ListOptions{LabelSelector: kp.getLabelSelector(product, version)}
+	namespace := kp.getNamespace(product)
+
+	deploymentWatcher, err := kp.client.AppsV1().Deployments(namespace).Watch(ctx, listOptions)
+	if err != nil {
+		return fmt.Errorf("watching deployments: %w", err)
+	}
+	defer deploymentWatcher.Stop()
+
+	jobWatcher, err := kp.client.BatchV1().Jobs(namespace).Watch(ctx, listOptions)
+	if err != nil {
+		return fmt.Errorf("watching jobs: %w", err)
+	}
+	defer jobWatcher.Stop()
+
+	podWatcher, err := kp.client.CoreV1().Pods(namespace).Watch(ctx, listOptions)
+	if err != nil {
+		return fmt.Errorf("watching pods: %w", err)
+	}
+	defer podWatcher.Stop()
+
+	for {
+		var status *domain.ProcessStatus
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-deploymentWatcher.ResultChan():
+			if !ok {
+				return ErrWatcherClosed
+			}
+
+			if deployment, isDeployment := event.Object.(*appsv1.Deployment); isDeployment && event.Type != watch.Deleted {
+				status = kp.getDeploymentRuntimeStatus(ctx, deployment)
+			}
+		case event, ok := <-jobWatcher.ResultChan():
+			if !ok {
+				return ErrWatcherClosed
+			}
+
+			if job, isJob := event.Object.(*batchv1.Job); isJob && event.Type != watch.Deleted {
+				status = kp.getJobRuntimeStatus(ctx, job)
+			}
+		case event, ok := <-podWatcher.ResultChan():
+			if !ok {
+				return ErrWatcherClosed
+			}
+
+			if pod, isPod := event.Object.(*corev1.Pod); isPod {
+				status = kp.getPodProcessRuntimeStatus(ctx, pod)
+			}
+		}
+
+		if status == nil {
+			continue
+		}
+
+		select {
+		case statusCh <- status:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// GetProcessStatus returns the current status of every process of the version. Processes running as jobs
+// report the status of their last run.
+func (kp *KubeProcess) GetProcessStatus(ctx context.Context, product, version string) ([]*domain.ProcessStatus, error) {
+	listOptions := metav1.ListOptions{LabelSelector: kp.getLabelSelector(product, version)}
+	namespace := kp.getNamespace(product)
+
+	deployments, err := kp.client.AppsV1().Deployments(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("listing deployments: %w", err)
+	}
+
+	jobs, err := kp.client.BatchV1().Jobs(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("listing jobs: %w", err)
+	}
+
+	statuses := make([]*domain.ProcessStatus, 0, len(deployments.Items))
+
+	for i := range deployments.Items {
+		statuses = append(statuses, kp.getDeploymentRuntimeStatus(ctx, &deployments.Items[i]))
+	}
+
+	for _, job := range getLastJobs(jobs.Items) {
+		statuses = append(statuses, kp.getJobRuntimeStatus(ctx, job))
+	}
+
+	return statuses, nil
+}
+
+func (kp *KubeProcess) getDeploymentProcessStatus(deployment *appsv1.Deployment) *domain.ProcessStatus {
+	desiredReplicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desiredReplicas = *deployment.Spec.Replicas
+	}
+
+	status := &domain.ProcessStatus{
+		Workflow:        deployment.Labels["workflow"],
+		Process:         deployment.Labels["process"],
+		State:           domain.ProcessStateStarting,
+		ReadyReplicas:   deployment.Status.ReadyReplicas,
+		DesiredReplicas: desiredReplicas,
+	}
+
+	if kp.isDeploymentReady(deployment) {
+		status.State = domain.ProcessStateStarted
+	}
+
+	return status
+}
+
+func (kp *KubeProcess) getDeploymentRuntimeStatus(ctx context.Context, deployment *appsv1.Deployment) *domain.ProcessStatus {
+	status := kp.getDeploymentProcessStatus(deployment)
+	kp.addPodsStatus(ctx, deployment.Namespace, status, deployment.Name, getProcessSelector(deployment.Labels))
+
+	return status
+}
+
+func (kp *KubeProcess) getJobRuntimeStatus(ctx context.Context, job *batchv1.Job) *domain.ProcessStatus {
+	status := getJobProcessStatus(job)
+	kp.addPodsStatus(ctx, job.Namespace, status, job.Name, labels.Set{_jobNameLabel: job.Name}.String())
+
+	return status
+}
+
+// getPodProcessRuntimeStatus returns the status of the deployment or job the pod belongs to.
+func (kp *KubeProcess) getPodProcessRuntimeStatus(ctx context.Context, pod *corev1.Pod) *domain.ProcessStatus {
+	if jobName, isJobPod := pod.Labels[_jobNameLabel]; isJobPod {
+		job, err := kp.client.BatchV1().Jobs(pod.Namespace).Get(ctx, jobName, metav1.GetOptions{})
+		if err != nil {
+			kp.logger.Error(err, "Error getting pod job", "pod", pod.Name, "job", jobName)
+			return nil
+		}
+
+		return kp.getJobRuntimeStatus(ctx, job)
+	}
+
+	deployments, err := kp.client.AppsV1().Deployments(pod.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: getProcessSelector(pod.Labels),
+	})
+	if err != nil {
+		kp.logger.Error(err, "Error getting pod deployment", "pod", pod.Name)
+		return nil
+	}
+
+	if len(deployments.Items) == 0 {
+		return nil
+	}
+
+	return kp.getDeploymentRuntimeStatus(ctx, &deployments.Items[0])
+}
+
+// addPodsStatus adds the restarts, last termination reason and recent events of the process pods to the status.
+// Errors are only logged, as the state of the deployment or job is still worth reporting.
+func (kp *KubeProcess) addPodsStatus(
+	ctx context.Context,
+	namespace string,
+	status *domain.ProcessStatus,
+	owner, podSelector string,
+) {
+	pods, err := kp.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: podSelector})
+	if err != nil {
+		kp.logger.Error(err, "Error listing process pods", "process", status.Process)
+		return
+	}
+
+	involvedObjects := map[string]bool{owner: true}
+
+	var (
+		lastTermination time.Time
+		crashLooping    bool
+	)
+
+	for _, pod := range pods.Items {
+		involvedObjects[pod.Name] = true
+
+		for _, container := range pod.Status.ContainerStatuses {
+			status.Restarts += container.RestartCount
+
+			if container.State.Waiting != nil && container.State.Waiting.Reason == _crashLoopBackOffState {
+				crashLooping = true
+			}
+
+			for _, terminated := range []*corev1.ContainerStateTerminated{
+				container.State.Terminated, container.LastTerminationState.Terminated,
+			} {
+				if terminated != nil && terminated.Reason != "" && !terminated.FinishedAt.Time.Before(lastTermination) {
+					status.LastTerminationReason = terminated.Reason
+					lastTermination = terminated.FinishedAt.Time
+				}
+			}
+		}
+	}
+
+	// A crash looping container is the most relevant reason, as it will not get ready by itself.
+	if crashLooping {
+		status.LastTerminationReason = _crashLoopBackOffState
+	}
+
+	replicaSets, err := kp.client.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: podSelector})
+	if err != nil {
+		kp.logger.Error(err, "Error listing process replica sets", "process", status.Process)
+		return
+	}
+
+	for _, replicaSet := range replicaSets.Items {
+		involvedObjects[replicaSet.Name] = true
+	}
+
+	events, err := kp.listInvolvedObjectsEvents(ctx, namespace, involvedObjects)
+	if err != nil {
+		kp.logger.Error(err, "Error listing process events", "process", status.Process)
+		return
+	}
+
+	status.Events = getProcessEvents(events, involvedObjects)
+}
+
+// listInvolvedObjectsEvents lists the events of the given objects, letting the API server filter them
+// instead of listing every event of the namespace.
+func (kp *KubeProcess) listInvolvedObjectsEvents(
+	ctx context.Context,
+	namespace string,
+	involvedObjects map[string]bool,
+) ([]corev1.Event, error) {
+	objectNames := make([]string, 0, len(involvedObjects))
+	for name := range involvedObjects {
+		objectNames = append(objectNames, name)
+	}
+
+	slices.Sort(objectNames)
+
+	var events []corev1.Event
+
+	for _, name := range objectNames {
+		objectEvents, err := kp.client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("involvedObject.name", name).String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, objectEvents.Items...)
+	}
+
+	return events, nil
+}
+
+func getProcessEvents(events []corev1.Event, involvedObjects map[string]bool) []domain.ProcessEvent {
+	var processEvents []domain.ProcessEvent
+
+	added := make(map[string]bool, len(events))
+
+	for _, event := range events {
+		if !involvedObjects[event.InvolvedObject.Name] || added[event.Name] {
+			continue
+		}
+
+		added[event.Name] = true
+
+		processEvents = append(processEvents, domain.ProcessEvent{
+			Type:          event.Type,
+			Reason:        event.Reason,
+			Message:       event.Message,
+			Count:         event.Count,
+			LastTimestamp: getEventTimestamp(event),
+		})
+	}
+
+	slices.SortStableFunc(processEvents, func(a, b domain.ProcessEvent) int {
+		return b.LastTimestamp.Compare(a.LastTimestamp)
+	})
+
+	if len(processEvents) > _maxProcessEvents {
+		processEvents = processEvents[:_maxProcessEvents]
+	}
+
+	return processEvents
+}
+
+func getEventTimestamp(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// getLastJobs returns the most recently created job of each process.
+func getLastJobs(jobs []batchv1.Job) []*batchv1.Job {
+	var lastJobs []*batchv1.Job
+
+	processIndex := map[string]int{}
+
+	for i := range jobs {
+		job := &jobs[i]
+		process := job.Labels["workflow"] + "/" + job.Labels["process"]
+
+		index, found := processIndex[process]
+		if !found {
+			processIndex[process] = len(lastJobs)
+			lastJobs = append(lastJobs, job)
+
+			continue
+		}
+
+		if lastJobs[index].CreationTimestamp.Before(&job.CreationTimestamp) {
+			lastJobs[index] = job
+		}
+	}
+
+	return lastJobs
+}
+
+func getProcessSelector(objectLabels map[string]string) string {
+	return labels.Set{
+		"product":  objectLabels["product"],
+		"version":  objectLabels["version"],
+		"workflow": objectLabels["workflow"],
+		"process":  objectLabels["process"],
+	}.String()
+}
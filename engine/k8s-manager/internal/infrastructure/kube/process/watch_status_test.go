@@ -0,0 +1,220 @@
+//go:build unit
+
+package process_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+)
+
+var _processLabels = map[string]string{
+	"product":  "test-product",
+	"version":  "v1.0.0",
+	"workflow": "test-workflow",
+	"process":  "test-process",
+}
+
+func getTestDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-deployment",
+			Namespace: _namespace,
+			Labels:    _processLabels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32(2),
+		},
+		Status: appsv1.DeploymentStatus{
+			ReadyReplicas: 1,
+		},
+	}
+}
+
+func getTestPod(name string, restarts int32, lastTerminationReason string, finishedAt time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: _namespace,
+			Labels:    _processLabels,
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "test-process",
+					RestartCount: restarts,
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							Reason:     lastTerminationReason,
+							FinishedAt: v1.NewTime(finishedAt),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getTestEvent(name, involvedObject, reason string, lastTimestamp time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: _namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{Name: involvedObject},
+		Type:           corev1.EventTypeWarning,
+		Reason:         reason,
+		Message:        reason + " message",
+		Count:          1,
+		LastTimestamp:  v1.NewTime(lastTimestamp),
+	}
+}
+
+func TestGetProcessStatus_Deployment(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	clientset := fake.NewSimpleClientset(
+		getTestDeployment(),
+		getTestPod("test-pod-1", 2, "Error", now.Add(-time.Hour)),
+		getTestPod("test-pod-2", 1, "OOMKilled", now),
+		getTestEvent("old-event", "test-pod-1", "BackOff", now.Add(-time.Hour)),
+		getTestEvent("new-event", "test-pod-2", "Unhealthy", now),
+		getTestEvent("other-event", "other-pod", "Failed", now),
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(testr.NewWithOptions(t, testr.Options{Verbosity: -1}), clientset)
+
+	statuses, err := svc.GetProcessStatus(context.Background(), "test-product", "v1.0.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, []*domain.ProcessStatus{
+		{
+			Workflow:              "test-workflow",
+			Process:               "test-process",
+			State:                 domain.ProcessStateStarting,
+			ReadyReplicas:         1,
+			DesiredReplicas:       2,
+			Restarts:              3,
+			LastTerminationReason: "OOMKilled",
+			Events: []domain.ProcessEvent{
+				{
+					Type:          corev1.EventTypeWarning,
+					Reason:        "Unhealthy",
+					Message:       "Unhealthy message",
+					Count:         1,
+					LastTimestamp: now,
+				},
+				{
+					Type:          corev1.EventTypeWarning,
+					Reason:        "BackOff",
+					Message:       "BackOff message",
+					Count:         1,
+					LastTimestamp: now.Add(-time.Hour),
+				},
+			},
+		},
+	}, statuses)
+}
+
+func TestGetProcessStatus_ListsEventsOfInvolvedObjects(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		getTestDeployment(),
+		getTestPod("test-pod", 0, "", time.Now()),
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(testr.NewWithOptions(t, testr.Options{Verbosity: -1}), clientset)
+
+	_, err := svc.GetProcessStatus(context.Background(), "test-product", "v1.0.0")
+	require.NoError(t, err)
+
+	var fieldSelectors []string
+
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() != "list" || action.GetResource().Resource != "events" {
+			continue
+		}
+
+		listAction, ok := action.(kubetesting.ListAction)
+		require.True(t, ok)
+
+		fieldSelectors = append(fieldSelectors, listAction.GetListRestrictions().Fields.String())
+	}
+
+	assert.Equal(t, []string{"involvedObject.name=test-deployment", "involvedObject.name=test-pod"}, fieldSelectors)
+}
+
+func TestGetProcessStatus_CrashLoopBackOff(t *testing.T) {
+	pod := getTestPod("test-pod", 5, "Error", time.Now())
+	pod.Status.ContainerStatuses[0].State.Waiting = &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}
+
+	clientset := fake.NewSimpleClientset(getTestDeployment(), pod)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(testr.NewWithOptions(t, testr.Options{Verbosity: -1}), clientset)
+
+	statuses, err := svc.GetProcessStatus(context.Background(), "test-product", "v1.0.0")
+	require.NoError(t, err)
+
+	require.Len(t, statuses, 1)
+	assert.Equal(t, int32(5), statuses[0].Restarts)
+	assert.Equal(t, "CrashLoopBackOff", statuses[0].LastTerminationReason)
+}
+
+func TestWatchProcessStatus_PodRestarted(t *testing.T) {
+	var (
+		logger    = testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+		clientset = fake.NewSimpleClientset(getTestDeployment())
+	)
+
+	setDefaultConfig()
+
+	svc := kube.NewK8sContainerService(logger, clientset)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	statusCh := make(chan *domain.ProcessStatus)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- svc.WatchProcessStatus(ctx, "test-product", "v1.0.0", statusCh)
+	}()
+
+	// Wait for the watchers to be registered before creating the pod
+	require.Eventually(t, func() bool {
+		return len(clientset.Actions()) >= 3
+	}, time.Second, 10*time.Millisecond)
+
+	_, err := clientset.CoreV1().Pods(_namespace).
+		Create(ctx, getTestPod("test-pod", 1, "OOMKilled", time.Now()), v1.CreateOptions{})
+	require.NoError(t, err)
+
+	select {
+	case status := <-statusCh:
+		assert.Equal(t, "test-process", status.Process)
+		assert.Equal(t, int32(1), status.Restarts)
+		assert.Equal(t, "OOMKilled", status.LastTerminationReason)
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for process status")
+	}
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
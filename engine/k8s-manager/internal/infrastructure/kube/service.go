@@ -8,6 +8,7 @@ import (
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/domain"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/config"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/configuration"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/namespace"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/network"
 	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/kube/process"
 	"github.com/spf13/viper"
@@ -22,21 +23,23 @@ type K8sContainerService struct {
 	processService       process.KubeProcess
 	configurationService configuration.KubeConfiguration
 	networkService       network.KubeNetwork
+	namespaceService     namespace.KubeNamespace
 }
 
 var _ service.ContainerService = (*K8sContainerService)(nil)
 
 func NewK8sContainerService(logger logr.Logger, client kubernetes.Interface) *K8sContainerService {
-	namespace := viper.GetString(config.KubeNamespaceKey)
+	kubeNamespace := viper.GetString(config.KubeNamespaceKey)
 
 	return &K8sContainerService{
 		logger:    logger,
-		namespace: namespace,
+		namespace: kubeNamespace,
 		client:    client,
 
-		processService:       process.NewKubeProcess(logger, client, namespace),
-		configurationService: configuration.NewKubeConfiguration(logger, client, namespace),
-		networkService:       network.NewKubeNetwork(logger, client, namespace),
+		processService:       process.NewKubeProcess(logger, client, kubeNamespace),
+		configurationService: configuration.NewKubeConfiguration(logger, client, kubeNamespace),
+		networkService:       network.NewKubeNetwork(logger, client, kubeNamespace),
+		namespaceService:     namespace.NewKubeNamespace(logger, client, kubeNamespace),
 	}
 }
 
@@ -44,6 +47,22 @@ func (k *K8sContainerService) CreateProcess(ctx context.Context, params service.
 	return k.processService.Create(ctx, params)
 }
 
+func (k *K8sContainerService) RenderVersionConfiguration(version *domain.Version) (*domain.Manifest, error) {
+	return k.configurationService.RenderVersionConfiguration(version)
+}
+
+func (k *K8sContainerService) RenderProcess(params service.CreateProcessParams) ([]*domain.Manifest, error) {
+	return k.processService.RenderProcess(params)
+}
+
+func (k *K8sContainerService) RenderNetwork(params service.CreateNetworkParams) (*domain.Manifest, error) {
+	return k.networkService.RenderNetwork(params)
+}
+
+func (k *K8sContainerService) RenderPublishedNetwork(params service.RenderPublishedNetworkParams) (*domain.Manifest, error) {
+	return k.networkService.RenderPublishedNetwork(params)
+}
+
 func (k *K8sContainerService) DeleteProcesses(ctx context.Context, product, version string) error {
 	return k.processService.DeleteProcesses(ctx, product, version)
 }
@@ -78,3 +97,43 @@ func (k *K8sContainerService) WaitProcesses(ctx context.Context, version *domain
 func (k *K8sContainerService) GetPublishedTriggers(ctx context.Context, product string) (map[string]string, error) {
 	return k.networkService.GetPublishedTriggers(ctx, product)
 }
+
+func (k *K8sContainerService) UpdateProcessImage(ctx context.Context, params service.UpdateProcessImageParams) (string, error) {
+	return k.processService.UpdateProcessImage(ctx, params)
+}
+
+func (k *K8sContainerService) ScaleProcess(ctx context.Context, params service.ScaleProcessParams) error {
+	return k.processService.ScaleProcess(ctx, params)
+}
+
+func (k *K8sContainerService) ListQueueScaledProcesses(ctx context.Context) ([]*domain.QueueScaledProcess, error) {
+	return k.processService.ListQueueScaledProcesses(ctx)
+}
+
+func (k *K8sContainerService) RunWorkflow(ctx context.Context, product, version, workflow string) ([]string, error) {
+	return k.processService.RunWorkflow(ctx, product, version, workflow)
+}
+
+func (k *K8sContainerService) WatchProcessStatus(
+	ctx context.Context,
+	product, version string,
+	statusCh chan<- *domain.ProcessStatus,
+) error {
+	return k.processService.WatchProcessStatus(ctx, product, version, statusCh)
+}
+
+func (k *K8sContainerService) GetProcessStatus(ctx context.Context, product, version string) ([]*domain.ProcessStatus, error) {
+	return k.processService.GetProcessStatus(ctx, product, version)
+}
+
+func (k *K8sContainerService) CreateProductNamespace(ctx context.Context, params service.CreateProductNamespaceParams) error {
+	return k.namespaceService.CreateProductNamespace(ctx, params)
+}
+
+func (k *K8sContainerService) UpdateProductNamespace(ctx context.Context, params service.UpdateProductNamespaceParams) error {
+	return k.namespaceService.UpdateProductNamespace(ctx, params)
+}
+
+func (k *K8sContainerService) DeleteProductNamespace(ctx context.Context, product string) error {
+	return k.namespaceService.DeleteProductNamespace(ctx, product)
+}
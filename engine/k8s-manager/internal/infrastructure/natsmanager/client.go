@@ -0,0 +1,43 @@
+package natsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/application/service"
+	"github.com/konstellation-io/kai/engine/k8s-manager/internal/infrastructure/grpc/proto/natspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Client reads the processes' messaging state from nats-manager.
+type Client struct {
+	client natspb.NatsManagerServiceClient
+}
+
+var _ service.ConsumerLagService = (*Client)(nil)
+
+func NewClient(client natspb.NatsManagerServiceClient) *Client {
+	return &Client{
+		client: client,
+	}
+}
+
+// GetProcessConsumerLag returns the messages pending to be processed by the given process.
+func (c *Client) GetProcessConsumerLag(ctx context.Context, product, version, workflow, process string) (uint64, error) {
+	res, err := c.client.GetProcessConsumerLag(ctx, &natspb.GetProcessConsumerLagRequest{
+		ProductId:  product,
+		VersionTag: version,
+		Workflow:   workflow,
+		Process:    process,
+	})
+	if status.Code(err) == codes.NotFound {
+		return 0, fmt.Errorf("%w: %s", service.ErrNoProcessConsumers, status.Convert(err).Message())
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("getting consumer lag from nats-manager: %w", err)
+	}
+
+	return res.Lag, nil
+}
@@ -66,6 +66,21 @@ func (pb *ProcessBuilder) WithResourceLimits(resourceLimits *domain.ProcessResou
 	return pb
 }
 
+func (pb *ProcessBuilder) WithAutoscaling(autoscaling *domain.ProcessAutoscaling) *ProcessBuilder {
+	pb.process.Autoscaling = autoscaling
+	return pb
+}
+
 func (pb *ProcessBuilder) Build() *domain.Process {
 	return pb.process
 }
+
+func (pb *ProcessBuilder) WithProbes(probes *domain.ProcessProbes) *ProcessBuilder {
+	pb.process.Probes = probes
+	return pb
+}
+
+func (pb *ProcessBuilder) WithMaxDeliver(maxDeliver int32) *ProcessBuilder {
+	pb.process.MaxDeliver = maxDeliver
+	return pb
+}
@@ -36,6 +36,11 @@ func (b *VersionBuilder) Build() *domain.Version {
 	return b.version
 }
 
+func (b *VersionBuilder) WithNatsCredentials(credentials string) *VersionBuilder {
+	b.version.NatsCredentials = credentials
+	return b
+}
+
 func (b *VersionBuilder) WithWorkflows(workflows []*domain.Workflow) *VersionBuilder {
 	b.version.Workflows = workflows
 	return b
@@ -28,3 +28,18 @@ func (wb *WorkflowBuilder) WithProcesses(processes []*domain.Process) *WorkflowB
 	wb.workflow.Processes = processes
 	return wb
 }
+
+func (wb *WorkflowBuilder) WithType(workflowType domain.WorkflowType) *WorkflowBuilder {
+	wb.workflow.Type = workflowType
+	return wb
+}
+
+func (wb *WorkflowBuilder) WithJob(job *domain.WorkflowJob) *WorkflowBuilder {
+	wb.workflow.Job = job
+	return wb
+}
+
+func (wb *WorkflowBuilder) WithName(name string) *WorkflowBuilder {
+	wb.workflow.Name = name
+	return wb
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConsumerLagServiceMock is an autogenerated mock type for the ConsumerLagService type
+type ConsumerLagServiceMock struct {
+	mock.Mock
+}
+
+type ConsumerLagServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConsumerLagServiceMock) EXPECT() *ConsumerLagServiceMock_Expecter {
+	return &ConsumerLagServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// GetProcessConsumerLag provides a mock function with given fields: ctx, product, version, workflow, process
+func (_m *ConsumerLagServiceMock) GetProcessConsumerLag(ctx context.Context, product string, version string, workflow string, process string) (uint64, error) {
+	ret := _m.Called(ctx, product, version, workflow, process)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (uint64, error)); ok {
+		return rf(ctx, product, version, workflow, process)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) uint64); ok {
+		r0 = rf(ctx, product, version, workflow, process)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, product, version, workflow, process)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConsumerLagServiceMock_GetProcessConsumerLag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcessConsumerLag'
+type ConsumerLagServiceMock_GetProcessConsumerLag_Call struct {
+	*mock.Call
+}
+
+// GetProcessConsumerLag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+//   - version string
+//   - workflow string
+//   - process string
+func (_e *ConsumerLagServiceMock_Expecter) GetProcessConsumerLag(ctx interface{}, product interface{}, version interface{}, workflow interface{}, process interface{}) *ConsumerLagServiceMock_GetProcessConsumerLag_Call {
+	return &ConsumerLagServiceMock_GetProcessConsumerLag_Call{Call: _e.mock.On("GetProcessConsumerLag", ctx, product, version, workflow, process)}
+}
+
+func (_c *ConsumerLagServiceMock_GetProcessConsumerLag_Call) Run(run func(ctx context.Context, product string, version string, workflow string, process string)) *ConsumerLagServiceMock_GetProcessConsumerLag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *ConsumerLagServiceMock_GetProcessConsumerLag_Call) Return(_a0 uint64, _a1 error) *ConsumerLagServiceMock_GetProcessConsumerLag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConsumerLagServiceMock_GetProcessConsumerLag_Call) RunAndReturn(run func(context.Context, string, string, string, string) (uint64, error)) *ConsumerLagServiceMock_GetProcessConsumerLag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConsumerLagServiceMock creates a new instance of ConsumerLagServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConsumerLagServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConsumerLagServiceMock {
+	mock := &ConsumerLagServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
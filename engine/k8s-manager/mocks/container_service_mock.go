@@ -110,6 +110,49 @@ func (_c *ContainerServiceMock_CreateProcess_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// CreateProductNamespace provides a mock function with given fields: ctx, params
+func (_m *ContainerServiceMock) CreateProductNamespace(ctx context.Context, params service.CreateProductNamespaceParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.CreateProductNamespaceParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContainerServiceMock_CreateProductNamespace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductNamespace'
+type ContainerServiceMock_CreateProductNamespace_Call struct {
+	*mock.Call
+}
+
+// CreateProductNamespace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params service.CreateProductNamespaceParams
+func (_e *ContainerServiceMock_Expecter) CreateProductNamespace(ctx interface{}, params interface{}) *ContainerServiceMock_CreateProductNamespace_Call {
+	return &ContainerServiceMock_CreateProductNamespace_Call{Call: _e.mock.On("CreateProductNamespace", ctx, params)}
+}
+
+func (_c *ContainerServiceMock_CreateProductNamespace_Call) Run(run func(ctx context.Context, params service.CreateProductNamespaceParams)) *ContainerServiceMock_CreateProductNamespace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.CreateProductNamespaceParams))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_CreateProductNamespace_Call) Return(_a0 error) *ContainerServiceMock_CreateProductNamespace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContainerServiceMock_CreateProductNamespace_Call) RunAndReturn(run func(context.Context, service.CreateProductNamespaceParams) error) *ContainerServiceMock_CreateProductNamespace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateVersionConfiguration provides a mock function with given fields: ctx, version
 func (_m *ContainerServiceMock) CreateVersionConfiguration(ctx context.Context, version *domain.Version) (string, error) {
 	ret := _m.Called(ctx, version)
@@ -295,6 +338,105 @@ func (_c *ContainerServiceMock_DeleteProcesses_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// DeleteProductNamespace provides a mock function with given fields: ctx, product
+func (_m *ContainerServiceMock) DeleteProductNamespace(ctx context.Context, product string) error {
+	ret := _m.Called(ctx, product)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContainerServiceMock_DeleteProductNamespace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProductNamespace'
+type ContainerServiceMock_DeleteProductNamespace_Call struct {
+	*mock.Call
+}
+
+// DeleteProductNamespace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+func (_e *ContainerServiceMock_Expecter) DeleteProductNamespace(ctx interface{}, product interface{}) *ContainerServiceMock_DeleteProductNamespace_Call {
+	return &ContainerServiceMock_DeleteProductNamespace_Call{Call: _e.mock.On("DeleteProductNamespace", ctx, product)}
+}
+
+func (_c *ContainerServiceMock_DeleteProductNamespace_Call) Run(run func(ctx context.Context, product string)) *ContainerServiceMock_DeleteProductNamespace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_DeleteProductNamespace_Call) Return(_a0 error) *ContainerServiceMock_DeleteProductNamespace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContainerServiceMock_DeleteProductNamespace_Call) RunAndReturn(run func(context.Context, string) error) *ContainerServiceMock_DeleteProductNamespace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProcessStatus provides a mock function with given fields: ctx, product, version
+func (_m *ContainerServiceMock) GetProcessStatus(ctx context.Context, product string, version string) ([]*domain.ProcessStatus, error) {
+	ret := _m.Called(ctx, product, version)
+
+	var r0 []*domain.ProcessStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*domain.ProcessStatus, error)); ok {
+		return rf(ctx, product, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*domain.ProcessStatus); ok {
+		r0 = rf(ctx, product, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProcessStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, product, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContainerServiceMock_GetProcessStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcessStatus'
+type ContainerServiceMock_GetProcessStatus_Call struct {
+	*mock.Call
+}
+
+// GetProcessStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+//   - version string
+func (_e *ContainerServiceMock_Expecter) GetProcessStatus(ctx interface{}, product interface{}, version interface{}) *ContainerServiceMock_GetProcessStatus_Call {
+	return &ContainerServiceMock_GetProcessStatus_Call{Call: _e.mock.On("GetProcessStatus", ctx, product, version)}
+}
+
+func (_c *ContainerServiceMock_GetProcessStatus_Call) Run(run func(ctx context.Context, product string, version string)) *ContainerServiceMock_GetProcessStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_GetProcessStatus_Call) Return(_a0 []*domain.ProcessStatus, _a1 error) *ContainerServiceMock_GetProcessStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContainerServiceMock_GetProcessStatus_Call) RunAndReturn(run func(context.Context, string, string) ([]*domain.ProcessStatus, error)) *ContainerServiceMock_GetProcessStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPublishedTriggers provides a mock function with given fields: ctx, product
 func (_m *ContainerServiceMock) GetPublishedTriggers(ctx context.Context, product string) (map[string]string, error) {
 	ret := _m.Called(ctx, product)
@@ -350,6 +492,60 @@ func (_c *ContainerServiceMock_GetPublishedTriggers_Call) RunAndReturn(run func(
 	return _c
 }
 
+// ListQueueScaledProcesses provides a mock function with given fields: ctx
+func (_m *ContainerServiceMock) ListQueueScaledProcesses(ctx context.Context) ([]*domain.QueueScaledProcess, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*domain.QueueScaledProcess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.QueueScaledProcess, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.QueueScaledProcess); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.QueueScaledProcess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContainerServiceMock_ListQueueScaledProcesses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListQueueScaledProcesses'
+type ContainerServiceMock_ListQueueScaledProcesses_Call struct {
+	*mock.Call
+}
+
+// ListQueueScaledProcesses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ContainerServiceMock_Expecter) ListQueueScaledProcesses(ctx interface{}) *ContainerServiceMock_ListQueueScaledProcesses_Call {
+	return &ContainerServiceMock_ListQueueScaledProcesses_Call{Call: _e.mock.On("ListQueueScaledProcesses", ctx)}
+}
+
+func (_c *ContainerServiceMock_ListQueueScaledProcesses_Call) Run(run func(ctx context.Context)) *ContainerServiceMock_ListQueueScaledProcesses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_ListQueueScaledProcesses_Call) Return(_a0 []*domain.QueueScaledProcess, _a1 error) *ContainerServiceMock_ListQueueScaledProcesses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContainerServiceMock_ListQueueScaledProcesses_Call) RunAndReturn(run func(context.Context) ([]*domain.QueueScaledProcess, error)) *ContainerServiceMock_ListQueueScaledProcesses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PublishNetwork provides a mock function with given fields: ctx, params
 func (_m *ContainerServiceMock) PublishNetwork(ctx context.Context, params service.PublishNetworkParams) (map[string]string, error) {
 	ret := _m.Called(ctx, params)
@@ -405,6 +601,322 @@ func (_c *ContainerServiceMock_PublishNetwork_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// RenderNetwork provides a mock function with given fields: params
+func (_m *ContainerServiceMock) RenderNetwork(params service.CreateNetworkParams) (*domain.Manifest, error) {
+	ret := _m.Called(params)
+
+	var r0 *domain.Manifest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(service.CreateNetworkParams) (*domain.Manifest, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(service.CreateNetworkParams) *domain.Manifest); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Manifest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(service.CreateNetworkParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContainerServiceMock_RenderNetwork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderNetwork'
+type ContainerServiceMock_RenderNetwork_Call struct {
+	*mock.Call
+}
+
+// RenderNetwork is a helper method to define mock.On call
+//   - params service.CreateNetworkParams
+func (_e *ContainerServiceMock_Expecter) RenderNetwork(params interface{}) *ContainerServiceMock_RenderNetwork_Call {
+	return &ContainerServiceMock_RenderNetwork_Call{Call: _e.mock.On("RenderNetwork", params)}
+}
+
+func (_c *ContainerServiceMock_RenderNetwork_Call) Run(run func(params service.CreateNetworkParams)) *ContainerServiceMock_RenderNetwork_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.CreateNetworkParams))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_RenderNetwork_Call) Return(_a0 *domain.Manifest, _a1 error) *ContainerServiceMock_RenderNetwork_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContainerServiceMock_RenderNetwork_Call) RunAndReturn(run func(service.CreateNetworkParams) (*domain.Manifest, error)) *ContainerServiceMock_RenderNetwork_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderProcess provides a mock function with given fields: params
+func (_m *ContainerServiceMock) RenderProcess(params service.CreateProcessParams) ([]*domain.Manifest, error) {
+	ret := _m.Called(params)
+
+	var r0 []*domain.Manifest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(service.CreateProcessParams) ([]*domain.Manifest, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(service.CreateProcessParams) []*domain.Manifest); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Manifest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(service.CreateProcessParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContainerServiceMock_RenderProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderProcess'
+type ContainerServiceMock_RenderProcess_Call struct {
+	*mock.Call
+}
+
+// RenderProcess is a helper method to define mock.On call
+//   - params service.CreateProcessParams
+func (_e *ContainerServiceMock_Expecter) RenderProcess(params interface{}) *ContainerServiceMock_RenderProcess_Call {
+	return &ContainerServiceMock_RenderProcess_Call{Call: _e.mock.On("RenderProcess", params)}
+}
+
+func (_c *ContainerServiceMock_RenderProcess_Call) Run(run func(params service.CreateProcessParams)) *ContainerServiceMock_RenderProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.CreateProcessParams))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_RenderProcess_Call) Return(_a0 []*domain.Manifest, _a1 error) *ContainerServiceMock_RenderProcess_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContainerServiceMock_RenderProcess_Call) RunAndReturn(run func(service.CreateProcessParams) ([]*domain.Manifest, error)) *ContainerServiceMock_RenderProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderPublishedNetwork provides a mock function with given fields: params
+func (_m *ContainerServiceMock) RenderPublishedNetwork(params service.RenderPublishedNetworkParams) (*domain.Manifest, error) {
+	ret := _m.Called(params)
+
+	var r0 *domain.Manifest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(service.RenderPublishedNetworkParams) (*domain.Manifest, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(service.RenderPublishedNetworkParams) *domain.Manifest); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Manifest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(service.RenderPublishedNetworkParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContainerServiceMock_RenderPublishedNetwork_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderPublishedNetwork'
+type ContainerServiceMock_RenderPublishedNetwork_Call struct {
+	*mock.Call
+}
+
+// RenderPublishedNetwork is a helper method to define mock.On call
+//   - params service.RenderPublishedNetworkParams
+func (_e *ContainerServiceMock_Expecter) RenderPublishedNetwork(params interface{}) *ContainerServiceMock_RenderPublishedNetwork_Call {
+	return &ContainerServiceMock_RenderPublishedNetwork_Call{Call: _e.mock.On("RenderPublishedNetwork", params)}
+}
+
+func (_c *ContainerServiceMock_RenderPublishedNetwork_Call) Run(run func(params service.RenderPublishedNetworkParams)) *ContainerServiceMock_RenderPublishedNetwork_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.RenderPublishedNetworkParams))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_RenderPublishedNetwork_Call) Return(_a0 *domain.Manifest, _a1 error) *ContainerServiceMock_RenderPublishedNetwork_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContainerServiceMock_RenderPublishedNetwork_Call) RunAndReturn(run func(service.RenderPublishedNetworkParams) (*domain.Manifest, error)) *ContainerServiceMock_RenderPublishedNetwork_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderVersionConfiguration provides a mock function with given fields: version
+func (_m *ContainerServiceMock) RenderVersionConfiguration(version *domain.Version) (*domain.Manifest, error) {
+	ret := _m.Called(version)
+
+	var r0 *domain.Manifest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*domain.Version) (*domain.Manifest, error)); ok {
+		return rf(version)
+	}
+	if rf, ok := ret.Get(0).(func(*domain.Version) *domain.Manifest); ok {
+		r0 = rf(version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Manifest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*domain.Version) error); ok {
+		r1 = rf(version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContainerServiceMock_RenderVersionConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderVersionConfiguration'
+type ContainerServiceMock_RenderVersionConfiguration_Call struct {
+	*mock.Call
+}
+
+// RenderVersionConfiguration is a helper method to define mock.On call
+//   - version *domain.Version
+func (_e *ContainerServiceMock_Expecter) RenderVersionConfiguration(version interface{}) *ContainerServiceMock_RenderVersionConfiguration_Call {
+	return &ContainerServiceMock_RenderVersionConfiguration_Call{Call: _e.mock.On("RenderVersionConfiguration", version)}
+}
+
+func (_c *ContainerServiceMock_RenderVersionConfiguration_Call) Run(run func(version *domain.Version)) *ContainerServiceMock_RenderVersionConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*domain.Version))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_RenderVersionConfiguration_Call) Return(_a0 *domain.Manifest, _a1 error) *ContainerServiceMock_RenderVersionConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContainerServiceMock_RenderVersionConfiguration_Call) RunAndReturn(run func(*domain.Version) (*domain.Manifest, error)) *ContainerServiceMock_RenderVersionConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunWorkflow provides a mock function with given fields: ctx, product, version, workflow
+func (_m *ContainerServiceMock) RunWorkflow(ctx context.Context, product string, version string, workflow string) ([]string, error) {
+	ret := _m.Called(ctx, product, version, workflow)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]string, error)); ok {
+		return rf(ctx, product, version, workflow)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []string); ok {
+		r0 = rf(ctx, product, version, workflow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, product, version, workflow)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContainerServiceMock_RunWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunWorkflow'
+type ContainerServiceMock_RunWorkflow_Call struct {
+	*mock.Call
+}
+
+// RunWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+//   - version string
+//   - workflow string
+func (_e *ContainerServiceMock_Expecter) RunWorkflow(ctx interface{}, product interface{}, version interface{}, workflow interface{}) *ContainerServiceMock_RunWorkflow_Call {
+	return &ContainerServiceMock_RunWorkflow_Call{Call: _e.mock.On("RunWorkflow", ctx, product, version, workflow)}
+}
+
+func (_c *ContainerServiceMock_RunWorkflow_Call) Run(run func(ctx context.Context, product string, version string, workflow string)) *ContainerServiceMock_RunWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_RunWorkflow_Call) Return(_a0 []string, _a1 error) *ContainerServiceMock_RunWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContainerServiceMock_RunWorkflow_Call) RunAndReturn(run func(context.Context, string, string, string) ([]string, error)) *ContainerServiceMock_RunWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScaleProcess provides a mock function with given fields: ctx, params
+func (_m *ContainerServiceMock) ScaleProcess(ctx context.Context, params service.ScaleProcessParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.ScaleProcessParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContainerServiceMock_ScaleProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScaleProcess'
+type ContainerServiceMock_ScaleProcess_Call struct {
+	*mock.Call
+}
+
+// ScaleProcess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params service.ScaleProcessParams
+func (_e *ContainerServiceMock_Expecter) ScaleProcess(ctx interface{}, params interface{}) *ContainerServiceMock_ScaleProcess_Call {
+	return &ContainerServiceMock_ScaleProcess_Call{Call: _e.mock.On("ScaleProcess", ctx, params)}
+}
+
+func (_c *ContainerServiceMock_ScaleProcess_Call) Run(run func(ctx context.Context, params service.ScaleProcessParams)) *ContainerServiceMock_ScaleProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.ScaleProcessParams))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_ScaleProcess_Call) Return(_a0 error) *ContainerServiceMock_ScaleProcess_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContainerServiceMock_ScaleProcess_Call) RunAndReturn(run func(context.Context, service.ScaleProcessParams) error) *ContainerServiceMock_ScaleProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnpublishNetwork provides a mock function with given fields: ctx, product, version
 func (_m *ContainerServiceMock) UnpublishNetwork(ctx context.Context, product string, version string) error {
 	ret := _m.Called(ctx, product, version)
@@ -449,6 +961,102 @@ func (_c *ContainerServiceMock_UnpublishNetwork_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// UpdateProcessImage provides a mock function with given fields: ctx, params
+func (_m *ContainerServiceMock) UpdateProcessImage(ctx context.Context, params service.UpdateProcessImageParams) (string, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.UpdateProcessImageParams) (string, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.UpdateProcessImageParams) string); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.UpdateProcessImageParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContainerServiceMock_UpdateProcessImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProcessImage'
+type ContainerServiceMock_UpdateProcessImage_Call struct {
+	*mock.Call
+}
+
+// UpdateProcessImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params service.UpdateProcessImageParams
+func (_e *ContainerServiceMock_Expecter) UpdateProcessImage(ctx interface{}, params interface{}) *ContainerServiceMock_UpdateProcessImage_Call {
+	return &ContainerServiceMock_UpdateProcessImage_Call{Call: _e.mock.On("UpdateProcessImage", ctx, params)}
+}
+
+func (_c *ContainerServiceMock_UpdateProcessImage_Call) Run(run func(ctx context.Context, params service.UpdateProcessImageParams)) *ContainerServiceMock_UpdateProcessImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.UpdateProcessImageParams))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_UpdateProcessImage_Call) Return(_a0 string, _a1 error) *ContainerServiceMock_UpdateProcessImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ContainerServiceMock_UpdateProcessImage_Call) RunAndReturn(run func(context.Context, service.UpdateProcessImageParams) (string, error)) *ContainerServiceMock_UpdateProcessImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProductNamespace provides a mock function with given fields: ctx, params
+func (_m *ContainerServiceMock) UpdateProductNamespace(ctx context.Context, params service.UpdateProductNamespaceParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.UpdateProductNamespaceParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContainerServiceMock_UpdateProductNamespace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProductNamespace'
+type ContainerServiceMock_UpdateProductNamespace_Call struct {
+	*mock.Call
+}
+
+// UpdateProductNamespace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params service.UpdateProductNamespaceParams
+func (_e *ContainerServiceMock_Expecter) UpdateProductNamespace(ctx interface{}, params interface{}) *ContainerServiceMock_UpdateProductNamespace_Call {
+	return &ContainerServiceMock_UpdateProductNamespace_Call{Call: _e.mock.On("UpdateProductNamespace", ctx, params)}
+}
+
+func (_c *ContainerServiceMock_UpdateProductNamespace_Call) Run(run func(ctx context.Context, params service.UpdateProductNamespaceParams)) *ContainerServiceMock_UpdateProductNamespace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.UpdateProductNamespaceParams))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_UpdateProductNamespace_Call) Return(_a0 error) *ContainerServiceMock_UpdateProductNamespace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContainerServiceMock_UpdateProductNamespace_Call) RunAndReturn(run func(context.Context, service.UpdateProductNamespaceParams) error) *ContainerServiceMock_UpdateProductNamespace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WaitProcesses provides a mock function with given fields: ctx, version
 func (_m *ContainerServiceMock) WaitProcesses(ctx context.Context, version *domain.Version) error {
 	ret := _m.Called(ctx, version)
@@ -492,6 +1100,51 @@ func (_c *ContainerServiceMock_WaitProcesses_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// WatchProcessStatus provides a mock function with given fields: ctx, product, version, statusCh
+func (_m *ContainerServiceMock) WatchProcessStatus(ctx context.Context, product string, version string, statusCh chan<- *domain.ProcessStatus) error {
+	ret := _m.Called(ctx, product, version, statusCh)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, chan<- *domain.ProcessStatus) error); ok {
+		r0 = rf(ctx, product, version, statusCh)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContainerServiceMock_WatchProcessStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchProcessStatus'
+type ContainerServiceMock_WatchProcessStatus_Call struct {
+	*mock.Call
+}
+
+// WatchProcessStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+//   - version string
+//   - statusCh chan<- *domain.ProcessStatus
+func (_e *ContainerServiceMock_Expecter) WatchProcessStatus(ctx interface{}, product interface{}, version interface{}, statusCh interface{}) *ContainerServiceMock_WatchProcessStatus_Call {
+	return &ContainerServiceMock_WatchProcessStatus_Call{Call: _e.mock.On("WatchProcessStatus", ctx, product, version, statusCh)}
+}
+
+func (_c *ContainerServiceMock_WatchProcessStatus_Call) Run(run func(ctx context.Context, product string, version string, statusCh chan<- *domain.ProcessStatus)) *ContainerServiceMock_WatchProcessStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(chan<- *domain.ProcessStatus))
+	})
+	return _c
+}
+
+func (_c *ContainerServiceMock_WatchProcessStatus_Call) Return(_a0 error) *ContainerServiceMock_WatchProcessStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ContainerServiceMock_WatchProcessStatus_Call) RunAndReturn(run func(context.Context, string, string, chan<- *domain.ProcessStatus) error) *ContainerServiceMock_WatchProcessStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewContainerServiceMock creates a new instance of ContainerServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewContainerServiceMock(t interface {
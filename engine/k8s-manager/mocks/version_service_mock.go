@@ -24,6 +24,149 @@ func (_m *VersionServiceMock) EXPECT() *VersionServiceMock_Expecter {
 	return &VersionServiceMock_Expecter{mock: &_m.Mock}
 }
 
+// CreateProductNamespace provides a mock function with given fields: ctx, product, quota
+func (_m *VersionServiceMock) CreateProductNamespace(ctx context.Context, product string, quota *domain.ProductResourceQuota) error {
+	ret := _m.Called(ctx, product, quota)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.ProductResourceQuota) error); ok {
+		r0 = rf(ctx, product, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VersionServiceMock_CreateProductNamespace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductNamespace'
+type VersionServiceMock_CreateProductNamespace_Call struct {
+	*mock.Call
+}
+
+// CreateProductNamespace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+//   - quota *domain.ProductResourceQuota
+func (_e *VersionServiceMock_Expecter) CreateProductNamespace(ctx interface{}, product interface{}, quota interface{}) *VersionServiceMock_CreateProductNamespace_Call {
+	return &VersionServiceMock_CreateProductNamespace_Call{Call: _e.mock.On("CreateProductNamespace", ctx, product, quota)}
+}
+
+func (_c *VersionServiceMock_CreateProductNamespace_Call) Run(run func(ctx context.Context, product string, quota *domain.ProductResourceQuota)) *VersionServiceMock_CreateProductNamespace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.ProductResourceQuota))
+	})
+	return _c
+}
+
+func (_c *VersionServiceMock_CreateProductNamespace_Call) Return(_a0 error) *VersionServiceMock_CreateProductNamespace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VersionServiceMock_CreateProductNamespace_Call) RunAndReturn(run func(context.Context, string, *domain.ProductResourceQuota) error) *VersionServiceMock_CreateProductNamespace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProductNamespace provides a mock function with given fields: ctx, product
+func (_m *VersionServiceMock) DeleteProductNamespace(ctx context.Context, product string) error {
+	ret := _m.Called(ctx, product)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VersionServiceMock_DeleteProductNamespace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProductNamespace'
+type VersionServiceMock_DeleteProductNamespace_Call struct {
+	*mock.Call
+}
+
+// DeleteProductNamespace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+func (_e *VersionServiceMock_Expecter) DeleteProductNamespace(ctx interface{}, product interface{}) *VersionServiceMock_DeleteProductNamespace_Call {
+	return &VersionServiceMock_DeleteProductNamespace_Call{Call: _e.mock.On("DeleteProductNamespace", ctx, product)}
+}
+
+func (_c *VersionServiceMock_DeleteProductNamespace_Call) Run(run func(ctx context.Context, product string)) *VersionServiceMock_DeleteProductNamespace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *VersionServiceMock_DeleteProductNamespace_Call) Return(_a0 error) *VersionServiceMock_DeleteProductNamespace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VersionServiceMock_DeleteProductNamespace_Call) RunAndReturn(run func(context.Context, string) error) *VersionServiceMock_DeleteProductNamespace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProcessStatus provides a mock function with given fields: ctx, product, version
+func (_m *VersionServiceMock) GetProcessStatus(ctx context.Context, product string, version string) ([]*domain.ProcessStatus, error) {
+	ret := _m.Called(ctx, product, version)
+
+	var r0 []*domain.ProcessStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*domain.ProcessStatus, error)); ok {
+		return rf(ctx, product, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*domain.ProcessStatus); ok {
+		r0 = rf(ctx, product, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProcessStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, product, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VersionServiceMock_GetProcessStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcessStatus'
+type VersionServiceMock_GetProcessStatus_Call struct {
+	*mock.Call
+}
+
+// GetProcessStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+//   - version string
+func (_e *VersionServiceMock_Expecter) GetProcessStatus(ctx interface{}, product interface{}, version interface{}) *VersionServiceMock_GetProcessStatus_Call {
+	return &VersionServiceMock_GetProcessStatus_Call{Call: _e.mock.On("GetProcessStatus", ctx, product, version)}
+}
+
+func (_c *VersionServiceMock_GetProcessStatus_Call) Run(run func(ctx context.Context, product string, version string)) *VersionServiceMock_GetProcessStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *VersionServiceMock_GetProcessStatus_Call) Return(_a0 []*domain.ProcessStatus, _a1 error) *VersionServiceMock_GetProcessStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VersionServiceMock_GetProcessStatus_Call) RunAndReturn(run func(context.Context, string, string) ([]*domain.ProcessStatus, error)) *VersionServiceMock_GetProcessStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPublishedTriggers provides a mock function with given fields: ctx, product
 func (_m *VersionServiceMock) GetPublishedTriggers(ctx context.Context, product string) (map[string]string, error) {
 	ret := _m.Called(ctx, product)
@@ -135,6 +278,161 @@ func (_c *VersionServiceMock_PublishVersion_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// RenderVersion provides a mock function with given fields: ctx, version
+func (_m *VersionServiceMock) RenderVersion(ctx context.Context, version *domain.Version) ([]*domain.Manifest, error) {
+	ret := _m.Called(ctx, version)
+
+	var r0 []*domain.Manifest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Version) ([]*domain.Manifest, error)); ok {
+		return rf(ctx, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Version) []*domain.Manifest); ok {
+		r0 = rf(ctx, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Manifest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Version) error); ok {
+		r1 = rf(ctx, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VersionServiceMock_RenderVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderVersion'
+type VersionServiceMock_RenderVersion_Call struct {
+	*mock.Call
+}
+
+// RenderVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - version *domain.Version
+func (_e *VersionServiceMock_Expecter) RenderVersion(ctx interface{}, version interface{}) *VersionServiceMock_RenderVersion_Call {
+	return &VersionServiceMock_RenderVersion_Call{Call: _e.mock.On("RenderVersion", ctx, version)}
+}
+
+func (_c *VersionServiceMock_RenderVersion_Call) Run(run func(ctx context.Context, version *domain.Version)) *VersionServiceMock_RenderVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Version))
+	})
+	return _c
+}
+
+func (_c *VersionServiceMock_RenderVersion_Call) Return(_a0 []*domain.Manifest, _a1 error) *VersionServiceMock_RenderVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VersionServiceMock_RenderVersion_Call) RunAndReturn(run func(context.Context, *domain.Version) ([]*domain.Manifest, error)) *VersionServiceMock_RenderVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunWorkflow provides a mock function with given fields: ctx, product, version, workflow
+func (_m *VersionServiceMock) RunWorkflow(ctx context.Context, product string, version string, workflow string) ([]string, error) {
+	ret := _m.Called(ctx, product, version, workflow)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]string, error)); ok {
+		return rf(ctx, product, version, workflow)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []string); ok {
+		r0 = rf(ctx, product, version, workflow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, product, version, workflow)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VersionServiceMock_RunWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunWorkflow'
+type VersionServiceMock_RunWorkflow_Call struct {
+	*mock.Call
+}
+
+// RunWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+//   - version string
+//   - workflow string
+func (_e *VersionServiceMock_Expecter) RunWorkflow(ctx interface{}, product interface{}, version interface{}, workflow interface{}) *VersionServiceMock_RunWorkflow_Call {
+	return &VersionServiceMock_RunWorkflow_Call{Call: _e.mock.On("RunWorkflow", ctx, product, version, workflow)}
+}
+
+func (_c *VersionServiceMock_RunWorkflow_Call) Run(run func(ctx context.Context, product string, version string, workflow string)) *VersionServiceMock_RunWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *VersionServiceMock_RunWorkflow_Call) Return(_a0 []string, _a1 error) *VersionServiceMock_RunWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VersionServiceMock_RunWorkflow_Call) RunAndReturn(run func(context.Context, string, string, string) ([]string, error)) *VersionServiceMock_RunWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScaleProcess provides a mock function with given fields: ctx, params
+func (_m *VersionServiceMock) ScaleProcess(ctx context.Context, params usecase.ScaleProcessParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.ScaleProcessParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VersionServiceMock_ScaleProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScaleProcess'
+type VersionServiceMock_ScaleProcess_Call struct {
+	*mock.Call
+}
+
+// ScaleProcess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params usecase.ScaleProcessParams
+func (_e *VersionServiceMock_Expecter) ScaleProcess(ctx interface{}, params interface{}) *VersionServiceMock_ScaleProcess_Call {
+	return &VersionServiceMock_ScaleProcess_Call{Call: _e.mock.On("ScaleProcess", ctx, params)}
+}
+
+func (_c *VersionServiceMock_ScaleProcess_Call) Run(run func(ctx context.Context, params usecase.ScaleProcessParams)) *VersionServiceMock_ScaleProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.ScaleProcessParams))
+	})
+	return _c
+}
+
+func (_c *VersionServiceMock_ScaleProcess_Call) Return(_a0 error) *VersionServiceMock_ScaleProcess_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VersionServiceMock_ScaleProcess_Call) RunAndReturn(run func(context.Context, usecase.ScaleProcessParams) error) *VersionServiceMock_ScaleProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartVersion provides a mock function with given fields: ctx, version
 func (_m *VersionServiceMock) StartVersion(ctx context.Context, version *domain.Version) error {
 	ret := _m.Called(ctx, version)
@@ -265,6 +563,138 @@ func (_c *VersionServiceMock_UnpublishVersion_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// UpdateProcessImage provides a mock function with given fields: ctx, params
+func (_m *VersionServiceMock) UpdateProcessImage(ctx context.Context, params usecase.UpdateProcessImageParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, usecase.UpdateProcessImageParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VersionServiceMock_UpdateProcessImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProcessImage'
+type VersionServiceMock_UpdateProcessImage_Call struct {
+	*mock.Call
+}
+
+// UpdateProcessImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params usecase.UpdateProcessImageParams
+func (_e *VersionServiceMock_Expecter) UpdateProcessImage(ctx interface{}, params interface{}) *VersionServiceMock_UpdateProcessImage_Call {
+	return &VersionServiceMock_UpdateProcessImage_Call{Call: _e.mock.On("UpdateProcessImage", ctx, params)}
+}
+
+func (_c *VersionServiceMock_UpdateProcessImage_Call) Run(run func(ctx context.Context, params usecase.UpdateProcessImageParams)) *VersionServiceMock_UpdateProcessImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(usecase.UpdateProcessImageParams))
+	})
+	return _c
+}
+
+func (_c *VersionServiceMock_UpdateProcessImage_Call) Return(_a0 error) *VersionServiceMock_UpdateProcessImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VersionServiceMock_UpdateProcessImage_Call) RunAndReturn(run func(context.Context, usecase.UpdateProcessImageParams) error) *VersionServiceMock_UpdateProcessImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProductNamespace provides a mock function with given fields: ctx, product, quota
+func (_m *VersionServiceMock) UpdateProductNamespace(ctx context.Context, product string, quota *domain.ProductResourceQuota) error {
+	ret := _m.Called(ctx, product, quota)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.ProductResourceQuota) error); ok {
+		r0 = rf(ctx, product, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VersionServiceMock_UpdateProductNamespace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProductNamespace'
+type VersionServiceMock_UpdateProductNamespace_Call struct {
+	*mock.Call
+}
+
+// UpdateProductNamespace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+//   - quota *domain.ProductResourceQuota
+func (_e *VersionServiceMock_Expecter) UpdateProductNamespace(ctx interface{}, product interface{}, quota interface{}) *VersionServiceMock_UpdateProductNamespace_Call {
+	return &VersionServiceMock_UpdateProductNamespace_Call{Call: _e.mock.On("UpdateProductNamespace", ctx, product, quota)}
+}
+
+func (_c *VersionServiceMock_UpdateProductNamespace_Call) Run(run func(ctx context.Context, product string, quota *domain.ProductResourceQuota)) *VersionServiceMock_UpdateProductNamespace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.ProductResourceQuota))
+	})
+	return _c
+}
+
+func (_c *VersionServiceMock_UpdateProductNamespace_Call) Return(_a0 error) *VersionServiceMock_UpdateProductNamespace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VersionServiceMock_UpdateProductNamespace_Call) RunAndReturn(run func(context.Context, string, *domain.ProductResourceQuota) error) *VersionServiceMock_UpdateProductNamespace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchProcessStatus provides a mock function with given fields: ctx, product, version, statusCh
+func (_m *VersionServiceMock) WatchProcessStatus(ctx context.Context, product string, version string, statusCh chan<- *domain.ProcessStatus) error {
+	ret := _m.Called(ctx, product, version, statusCh)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, chan<- *domain.ProcessStatus) error); ok {
+		r0 = rf(ctx, product, version, statusCh)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VersionServiceMock_WatchProcessStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchProcessStatus'
+type VersionServiceMock_WatchProcessStatus_Call struct {
+	*mock.Call
+}
+
+// WatchProcessStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product string
+//   - version string
+//   - statusCh chan<- *domain.ProcessStatus
+func (_e *VersionServiceMock_Expecter) WatchProcessStatus(ctx interface{}, product interface{}, version interface{}, statusCh interface{}) *VersionServiceMock_WatchProcessStatus_Call {
+	return &VersionServiceMock_WatchProcessStatus_Call{Call: _e.mock.On("WatchProcessStatus", ctx, product, version, statusCh)}
+}
+
+func (_c *VersionServiceMock_WatchProcessStatus_Call) Run(run func(ctx context.Context, product string, version string, statusCh chan<- *domain.ProcessStatus)) *VersionServiceMock_WatchProcessStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(chan<- *domain.ProcessStatus))
+	})
+	return _c
+}
+
+func (_c *VersionServiceMock_WatchProcessStatus_Call) Return(_a0 error) *VersionServiceMock_WatchProcessStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VersionServiceMock_WatchProcessStatus_Call) RunAndReturn(run func(context.Context, string, string, chan<- *domain.ProcessStatus) error) *VersionServiceMock_WatchProcessStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewVersionServiceMock creates a new instance of VersionServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewVersionServiceMock(t interface {
@@ -38,16 +38,26 @@ func main() {
 
 	logger.Info("Connecting to NATS...")
 
-	js, err := nats.InitJetStreamConnection(viper.GetString(config.NatsURL))
+	js, err := nats.InitJetStreamConnection(viper.GetString(config.NatsURL), viper.GetString(config.NatsCredentials))
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	// Issued credentials only take effect when the NATS server runs in operator mode.
+	if err := nats.CheckServerAuth(); err != nil {
+		log.Fatalf("NATS authentication is configured but the NATS server doesn't support it: %v", err)
+	}
+
 	natsClient := nats.New(logger, js)
 
 	grpcServer := grpc.NewServer()
 
 	natsManager := manager.NewNatsManager(logger, natsClient)
+
+	if err := natsManager.StartDeadLettering(); err != nil {
+		log.Fatalf("Failed to start dead-lettering: %v", err)
+	}
+
 	natsService := service.NewNatsService(logger, natsManager)
 	natspb.RegisterNatsManagerServiceServer(grpcServer, natsService)
 	reflection.Register(grpcServer)
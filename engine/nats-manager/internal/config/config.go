@@ -11,6 +11,21 @@ const (
 	NatsManagerPort           = "NATS_MANAGER_PORT"
 	NatsURL                   = "NATS_URL"
 	ObjectStoreDefaultTTLDays = "OBJECT_STORE_DEFAULT_TTL"
+	KeyValueStoreHistory      = "KEY_VALUE_STORE_HISTORY"
+	// NatsAccountSeed is the seed of the account where version users are created. Credentials
+	// aren't issued when it's empty.
+	NatsAccountSeed = "NATS_ACCOUNT_SEED"
+	// NatsOperatorSeed is the seed of an operator signing key, used to update the account revocations.
+	NatsOperatorSeed = "NATS_OPERATOR_SEED"
+	// NatsCredentials is the path to the credentials file nats-manager connects to NATS with. It's required when
+	// credentials are issued, as the NATS server runs in operator mode.
+	NatsCredentials = "NATS_CREDENTIALS"
+	// NatsSystemCredentials is the path to the system account credentials file.
+	NatsSystemCredentials = "NATS_SYSTEM_CREDENTIALS"
+	// NatsCredentialsTTL is how long issued credentials are valid. Revocations older than it are pruned
+	// from the account. Credentials don't expire and revocations are kept when it's zero.
+	// Credentials aren't renewed, so it must be zero or longer than any version runs without a restart.
+	NatsCredentialsTTL = "NATS_CREDENTIALS_TTL"
 )
 
 func Initialize() {
@@ -18,6 +33,7 @@ func Initialize() {
 	viper.SetDefault(NatsManagerPort, 50051)
 	viper.SetDefault(NatsURL, "localhost:4222")
 	viper.SetDefault(ObjectStoreDefaultTTLDays, 5)
+	viper.SetDefault(KeyValueStoreHistory, 10)
 
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	viper.SetEnvPrefix("KAI")
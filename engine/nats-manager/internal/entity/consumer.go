@@ -0,0 +1,14 @@
+package entity
+
+// ConsumerLag holds the messages a stream consumer has not processed yet.
+type ConsumerLag struct {
+	Consumer      string
+	FilterSubject string
+	Pending       uint64
+	AckPending    uint64
+	Redelivered   uint64
+}
+
+func (c ConsumerLag) Total() uint64 {
+	return c.Pending + c.AckPending
+}
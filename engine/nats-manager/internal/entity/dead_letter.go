@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// DeadLetterMessage is a workflow message that a process consumer could not handle within its maximum deliveries.
+// Sequence identifies it in the workflow dead-letter stream, while Subject, Headers and Data are copied from the
+// original message, that had StreamSequence in the workflow stream.
+type DeadLetterMessage struct {
+	Sequence       uint64
+	Stream         string
+	StreamSequence uint64
+	Consumer       string
+	Deliveries     uint64
+	Subject        string
+	Headers        map[string][]string
+	Data           []byte
+	Timestamp      time.Time
+}
+
+// MaxDeliveriesAdvisory tells that a consumer reached its maximum deliveries for a message of a stream.
+type MaxDeliveriesAdvisory struct {
+	Stream         string
+	StreamSequence uint64
+	Consumer       string
+	Deliveries     uint64
+}
@@ -1,6 +1,24 @@
 package entity
 
+import "time"
+
 type KeyValueConfiguration struct {
 	KeyValueStore string
 	Configuration map[string]string
 }
+
+// KeyValueEntry is a revision of a key of a key-value store.
+type KeyValueEntry struct {
+	Key      string
+	Value    string
+	Revision uint64
+	// Deleted is true when the revision removed the key.
+	Deleted bool
+	Created time.Time
+}
+
+// ConfigurationEntry is a configuration key along with the scope of the key-value store it comes from.
+type ConfigurationEntry struct {
+	Scope KeyValueStoreScope
+	KeyValueEntry
+}
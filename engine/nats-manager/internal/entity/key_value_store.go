@@ -18,3 +18,13 @@ type VersionKeyValueStores struct {
 	ProjectStore    string
 	WorkflowsStores map[string]*WorkflowKeyValueStores
 }
+
+// KeyValueStoreRef identifies the key-value store of a scope. Workflow and process are only required by
+// the scopes that need them.
+type KeyValueStoreRef struct {
+	ProductID  string
+	VersionTag string
+	Workflow   string
+	Process    string
+	Scope      KeyValueStoreScope
+}
@@ -0,0 +1,20 @@
+package entity
+
+type NatsResourceType string
+
+const (
+	NatsResourceUndefined     NatsResourceType = ""
+	NatsResourceStream        NatsResourceType = "stream"
+	NatsResourceObjectStore   NatsResourceType = "object_store"
+	NatsResourceKeyValueStore NatsResourceType = "key_value_store"
+)
+
+// NatsResource is a stream, object store or key-value store named after the KAI naming schemes.
+type NatsResource struct {
+	Name      string
+	Type      NatsResourceType
+	ProductID string
+	// VersionKey is the part of the name that follows the product, starting with the version tag with its
+	// dots replaced by underscores. It's empty for the resources shared by every version of the product.
+	VersionKey string
+}
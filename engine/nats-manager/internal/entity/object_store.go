@@ -12,11 +12,14 @@ const (
 	ObjStoreScopeUndefined ObjectStoreScope = "undefined"
 	ObjStoreScopeWorkflow  ObjectStoreScope = "workflow"
 	ObjStoreScopeProject   ObjectStoreScope = "project"
+	// ObjStoreScopeProduct object stores are shared by every version of the product and outlive them.
+	ObjStoreScopeProduct ObjectStoreScope = "product"
 )
 
 type ObjectStore struct {
-	Name  string
-	Scope ObjectStoreScope
+	Name     string
+	Scope    ObjectStoreScope
+	Settings *ObjectStoreSettings
 }
 
 func (o *ObjectStore) Validate() error {
@@ -28,10 +31,19 @@ func (o *ObjectStore) Validate() error {
 
 	switch o.Scope {
 	case ObjStoreScopeProject, ObjStoreScopeWorkflow:
-		return nil
+	case ObjStoreScopeProduct:
+		if o.Settings != nil {
+			return internal.ErrProductObjectStoreSettings
+		}
 	case ObjStoreScopeUndefined:
 		return internal.ErrInvalidObjectStoreScope
 	default:
 		return internal.ErrInvalidObjectStoreScope
 	}
+
+	if o.Settings != nil {
+		return o.Settings.Validate()
+	}
+
+	return nil
 }
@@ -10,6 +10,7 @@ type Process struct {
 	Name          string
 	Subscriptions []string
 	ObjectStore   *ObjectStore
+	KeyValueStore *KeyValueStoreSettings
 }
 
 func (n *Process) Validate() error {
@@ -17,6 +18,12 @@ func (n *Process) Validate() error {
 		return internal.ErrEmptyProcessName
 	}
 
+	if n.KeyValueStore != nil {
+		if err := n.KeyValueStore.Validate(); err != nil {
+			return fmt.Errorf("invalid process key-value store settings: %w", err)
+		}
+	}
+
 	if n.ObjectStore == nil {
 		return nil
 	}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+)
+
+// MaxKeyValueStoreHistory is the highest number of values per key a NATS key-value store can keep.
+const MaxKeyValueStoreHistory = 64
+
+// ObjectStoreSettings are the JetStream settings of an object store.
+// Zero values keep the defaults: the configured TTL, file storage and no size limit.
+type ObjectStoreSettings struct {
+	TTL      time.Duration
+	MaxBytes int64
+	Replicas int
+	Storage  StreamStorageType
+}
+
+func (s *ObjectStoreSettings) Validate() error {
+	if err := validateStoreStorage(s.Storage); err != nil {
+		return err
+	}
+
+	if s.TTL < 0 || s.MaxBytes < 0 || s.Replicas < 0 {
+		return internal.ErrInvalidStoreLimits
+	}
+
+	return nil
+}
+
+// KeyValueStoreSettings are the JetStream settings of a key-value store.
+// Zero values keep the defaults: the configured history, no TTL, file storage and no size limit.
+type KeyValueStoreSettings struct {
+	TTL      time.Duration
+	MaxBytes int64
+	History  int
+	Replicas int
+	Storage  StreamStorageType
+}
+
+func (s *KeyValueStoreSettings) Validate() error {
+	if err := validateStoreStorage(s.Storage); err != nil {
+		return err
+	}
+
+	if s.TTL < 0 || s.MaxBytes < 0 || s.Replicas < 0 {
+		return internal.ErrInvalidStoreLimits
+	}
+
+	if s.History < 0 || s.History > MaxKeyValueStoreHistory {
+		return internal.ErrInvalidKeyValueStoreHistory
+	}
+
+	return nil
+}
+
+func validateStoreStorage(storage StreamStorageType) error {
+	switch storage {
+	case StreamStorageUndefined, StreamStorageFile, StreamStorageMemory:
+		return nil
+	default:
+		return internal.ErrInvalidStreamStorage
+	}
+}
@@ -5,6 +5,9 @@ type WorkflowsStreamsConfig map[string]*StreamConfig
 type StreamConfig struct {
 	Stream    string
 	Processes ProcessesStreamConfig
+	Settings  *StreamSettings
+	// Sources copy into the stream the messages of other workflows subscribed by its processes.
+	Sources []StreamSource
 }
 
 type ProcessesStreamConfig map[string]ProcessStreamConfig
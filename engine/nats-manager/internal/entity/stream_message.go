@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// StreamMessage is a message stored in a stream.
+type StreamMessage struct {
+	Sequence  uint64
+	Subject   string
+	Headers   map[string][]string
+	Data      []byte
+	Timestamp time.Time
+}
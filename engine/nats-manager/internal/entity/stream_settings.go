@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+)
+
+type StreamRetentionPolicy string
+
+const (
+	StreamRetentionUndefined StreamRetentionPolicy = ""
+	StreamRetentionInterest  StreamRetentionPolicy = "interest"
+	StreamRetentionLimits    StreamRetentionPolicy = "limits"
+	StreamRetentionWorkQueue StreamRetentionPolicy = "workqueue"
+)
+
+type StreamStorageType string
+
+const (
+	StreamStorageUndefined StreamStorageType = ""
+	StreamStorageFile      StreamStorageType = "file"
+	StreamStorageMemory    StreamStorageType = "memory"
+)
+
+// StreamSettings are the JetStream settings of a workflow stream.
+// Zero values keep the defaults: interest retention, file storage and no limits.
+type StreamSettings struct {
+	Retention  StreamRetentionPolicy
+	MaxAge     time.Duration
+	MaxBytes   int64
+	MaxMsgSize int32
+	Replicas   int
+	Storage    StreamStorageType
+}
+
+func (s *StreamSettings) Validate() error {
+	switch s.Retention {
+	case StreamRetentionUndefined, StreamRetentionInterest, StreamRetentionLimits, StreamRetentionWorkQueue:
+	default:
+		return internal.ErrInvalidStreamRetention
+	}
+
+	switch s.Storage {
+	case StreamStorageUndefined, StreamStorageFile, StreamStorageMemory:
+	default:
+		return internal.ErrInvalidStreamStorage
+	}
+
+	if s.MaxAge < 0 || s.MaxBytes < 0 || s.MaxMsgSize < 0 || s.Replicas < 0 {
+		return internal.ErrInvalidStreamLimits
+	}
+
+	return nil
+}
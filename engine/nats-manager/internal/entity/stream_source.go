@@ -0,0 +1,19 @@
+package entity
+
+// StreamSource copies into a stream the messages stored in another stream, rewriting their subjects.
+type StreamSource struct {
+	Stream            string
+	SubjectTransforms []SubjectTransform
+}
+
+// SubjectTransform maps the subjects matching Source, which may contain wildcards, to Destination.
+type SubjectTransform struct {
+	Source      string
+	Destination string
+}
+
+// ShadowWorkflow is a workflow whose trigger subjects are mirrored from the published version to a candidate.
+type ShadowWorkflow struct {
+	Name     string
+	Triggers []string
+}
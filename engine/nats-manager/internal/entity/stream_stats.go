@@ -0,0 +1,40 @@
+package entity
+
+// StreamState holds the messages stored in a stream.
+type StreamState struct {
+	Messages      uint64
+	Bytes         uint64
+	FirstSequence uint64
+	LastSequence  uint64
+	// SubjectMessages is the number of stored messages of each subject of the stream.
+	SubjectMessages map[string]uint64
+}
+
+// ConsumerStats adds up the state of a set of stream consumers.
+type ConsumerStats struct {
+	Pending     uint64
+	AckPending  uint64
+	Redelivered uint64
+}
+
+func (c *ConsumerStats) Add(consumerLag ConsumerLag) {
+	c.Pending += consumerLag.Pending
+	c.AckPending += consumerLag.AckPending
+	c.Redelivered += consumerLag.Redelivered
+}
+
+type WorkflowStreamStats struct {
+	Workflow  string
+	Stream    string
+	State     StreamState
+	Consumers ConsumerStats
+	Processes []ProcessStreamStats
+}
+
+// ProcessStreamStats holds the messages published to a process subject and the state of the process consumers.
+type ProcessStreamStats struct {
+	Process   string
+	Subject   string
+	Messages  uint64
+	Consumers ConsumerStats
+}
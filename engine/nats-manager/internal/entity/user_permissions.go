@@ -0,0 +1,21 @@
+package entity
+
+// UserPermissions are the subjects a NATS user is allowed to publish and subscribe to.
+type UserPermissions struct {
+	Publish   []string
+	Subscribe []string
+}
+
+// Allow grants publish and subscribe permissions on the given subjects.
+func (p *UserPermissions) Allow(subjects ...string) {
+	p.AllowPublish(subjects...)
+	p.AllowSubscribe(subjects...)
+}
+
+func (p *UserPermissions) AllowPublish(subjects ...string) {
+	p.Publish = append(p.Publish, subjects...)
+}
+
+func (p *UserPermissions) AllowSubscribe(subjects ...string) {
+	p.Subscribe = append(p.Subscribe, subjects...)
+}
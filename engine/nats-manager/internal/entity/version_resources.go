@@ -0,0 +1,8 @@
+package entity
+
+// VersionResources are the names of the streams, object stores and key-value stores of a version.
+type VersionResources struct {
+	Streams        WorkflowsStreamsConfig
+	ObjectStores   WorkflowsObjectStoresConfig
+	KeyValueStores *VersionKeyValueStores
+}
@@ -7,8 +7,10 @@ import (
 )
 
 type Workflow struct {
-	Name      string
-	Processes []Process
+	Name          string
+	Processes     []Process
+	Stream        *StreamSettings
+	KeyValueStore *KeyValueStoreSettings
 }
 
 func (w Workflow) Validate() error {
@@ -16,6 +18,18 @@ func (w Workflow) Validate() error {
 		return internal.ErrEmptyWorkflowName
 	}
 
+	if w.Stream != nil {
+		if err := w.Stream.Validate(); err != nil {
+			return fmt.Errorf("invalid workflow stream settings: %w", err)
+		}
+	}
+
+	if w.KeyValueStore != nil {
+		if err := w.KeyValueStore.Validate(); err != nil {
+			return fmt.Errorf("invalid workflow key-value store settings: %w", err)
+		}
+	}
+
 	for _, process := range w.Processes {
 		if err := process.Validate(); err != nil {
 			return fmt.Errorf("invalid process: %w", err)
@@ -4,8 +4,33 @@ import "errors"
 
 var ErrInvalidObjectStoreName = errors.New("invalid object store name")
 var ErrInvalidObjectStoreScope = errors.New("invalid object store scope")
+var ErrObjectStoreAlreadyExists = errors.New("object store already exists")
+var ErrObjectStoreNotFound = errors.New("object store not found")
 var ErrInvalidKeyValueStoreScope = errors.New("invalid key-value store scope")
 var ErrEmptyWorkflowName = errors.New("workflow name cannot be empty")
 var ErrEmptyProcessName = errors.New("process name cannot be empty")
 var ErrNoWorkflowsDefined = errors.New("no workflows defined")
 var ErrNoOptFilter = errors.New("optFilter param accepts 0 or 1 value")
+var ErrNoProcessConsumers = errors.New("no consumers found for the process")
+var ErrInvalidStreamRetention = errors.New("invalid stream retention policy")
+var ErrInvalidStreamStorage = errors.New("invalid stream storage type")
+var ErrInvalidStreamLimits = errors.New("stream limits cannot be negative")
+var ErrDeadLetterMessageNotFound = errors.New("dead-letter message not found")
+var ErrDeadLetterStreamNotFound = errors.New("dead-letter stream not found")
+var ErrOriginalMessageNotFound = errors.New("original message of the dead-letter message not found")
+var ErrInvalidMessagesCount = errors.New("invalid number of messages")
+var ErrSubjectNotInVersion = errors.New("subject does not belong to the version streams")
+var ErrConfigurationKeyNotFound = errors.New("configuration key not found")
+var ErrConfigurationRevisionNotFound = errors.New("configuration key revision not found")
+var ErrEmptyConfigurationKey = errors.New("configuration key cannot be empty")
+var ErrObjectStoreNotInProduct = errors.New("object store does not belong to the product")
+var ErrObjectNotFound = errors.New("object not found")
+var ErrEmptyObjectName = errors.New("object name cannot be empty")
+var ErrNatsAuthDisabled = errors.New("NATS authentication is not configured")
+var ErrNatsCredentialsRequired = errors.New("nats-manager credentials are required when NATS authentication is configured")
+var ErrInvalidResourceName = errors.New("resource name does not follow the KAI naming scheme")
+var ErrEmptyShadowTriggers = errors.New("shadow workflow has no trigger subjects to mirror")
+var ErrShadowSameVersion = errors.New("a version cannot shadow itself")
+var ErrInvalidStoreLimits = errors.New("store limits cannot be negative")
+var ErrInvalidKeyValueStoreHistory = errors.New("key-value store history must be between 0 and 64")
+var ErrProductObjectStoreSettings = errors.New("product object stores cannot define settings")
@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	"context"
+	"io"
 	"regexp"
 
 	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
@@ -11,11 +13,38 @@ import (
 type NatsClient interface {
 	GetObjectStoreNames(optFilter ...*regexp.Regexp) ([]string, error)
 	GetStreamNames(optFilter ...*regexp.Regexp) ([]string, error)
+	GetKeyValueStoreNames(optFilter ...*regexp.Regexp) ([]string, error)
+	GetConsumersLag(stream string) ([]entity.ConsumerLag, error)
+	GetStreamState(stream string) (*entity.StreamState, error)
 	CreateStream(streamConfig *entity.StreamConfig) error
-	CreateObjectStore(objectStore string) error
-	CreateKeyValueStore(keyValueStore string) error
+	CreateDeadLetterStream(deadLetterStream string) error
+	ConsumeMaxDeliveriesAdvisories(handler func(advisory *entity.MaxDeliveriesAdvisory) error) error
+	CopyToDeadLetterStream(deadLetterStream string, advisory *entity.MaxDeliveriesAdvisory) error
+	AddStreamSource(stream string, source *entity.StreamSource) error
+	RemoveStreamSources(stream string, sourceFilter *regexp.Regexp) error
+	CreateObjectStore(objectStore string, settings *entity.ObjectStoreSettings) error
+	CreatePersistentObjectStore(objectStore string) error
+	ListObjects(objectStore string) ([]entity.ObjectInfo, error)
+	GetObject(objectStore, name string, w io.Writer) error
+	PutObject(objectStore, name string, r io.Reader) (*entity.ObjectInfo, error)
+	DeleteObject(objectStore, name string) error
+	CreateKeyValueStore(keyValueStore string, settings *entity.KeyValueStoreSettings) error
 	UpdateConfiguration(keyValueStore string, keyValueConfig map[string]string) error
+	GetKeyValueEntries(keyValueStore string) ([]entity.KeyValueEntry, error)
+	GetKeyHistory(keyValueStore, key string) ([]entity.KeyValueEntry, error)
+	GetKeyRevision(keyValueStore, key string, revision uint64) (*entity.KeyValueEntry, error)
+	PutKeyValue(keyValueStore, key, value string) (*entity.KeyValueEntry, error)
+	DeleteKeyValue(keyValueStore, key string) error
+	WatchKeyValueStore(ctx context.Context, keyValueStore string, updates chan<- entity.KeyValueEntry) error
 	DeleteStream(stream string) error
 	DeleteObjectStore(stream string) error
 	DeleteKeyValueStore(keyValueStore string) error
+	GetDeadLetterMessages(deadLetterStream string) ([]entity.DeadLetterMessage, error)
+	GetDeadLetterMessage(deadLetterStream string, sequence uint64) (*entity.DeadLetterMessage, error)
+	GetLastMessages(stream, subject string, count int) ([]entity.StreamMessage, error)
+	PublishMessage(subject string, headers map[string][]string, data []byte) error
+	DeleteMessage(stream string, sequence uint64) error
+	PurgeStream(stream string) (uint64, error)
+	CreateUser(name string, permissions *entity.UserPermissions) (string, error)
+	RevokeUser(name string) error
 }
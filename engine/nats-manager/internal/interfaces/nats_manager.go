@@ -1,6 +1,9 @@
 package interfaces
 
 import (
+	"context"
+	"io"
+
 	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
 )
 
@@ -9,11 +12,45 @@ import (
 type NatsManager interface {
 	CreateStreams(productID, versionTag string, workflows []entity.Workflow) (entity.WorkflowsStreamsConfig, error)
 	CreateObjectStores(productID, versionTag string, workflows []entity.Workflow) (entity.WorkflowsObjectStoresConfig, error)
-	CreateVersionKeyValueStores(productID, versionTag string, workflows []entity.Workflow) (*entity.VersionKeyValueStores, error)
+	CreateProductObjectStore(productID, name string) (string, error)
+	GetProductObjectStores(productID string) ([]string, error)
+	DeleteProductObjectStore(productID, name string) error
+	ListObjects(productID, objectStore string) ([]entity.ObjectInfo, error)
+	GetObject(productID, objectStore, name string, w io.Writer) error
+	PutObject(productID, objectStore, name string, r io.Reader) (*entity.ObjectInfo, error)
+	DeleteObject(productID, objectStore, name string) error
+	CreateVersionKeyValueStores(
+		productID, versionTag string,
+		workflows []entity.Workflow,
+		versionStoreSettings *entity.KeyValueStoreSettings,
+	) (*entity.VersionKeyValueStores, error)
+	GetVersionResources(productID, versionTag string, workflows []entity.Workflow) (*entity.VersionResources, error)
 	CreateGlobalKeyValueStore(productID string) (string, error)
 	UpdateKeyValueStoresConfiguration(configurations []entity.KeyValueConfiguration) error
+	GetProcessConfiguration(productID, versionTag, workflow, process string) ([]entity.ConfigurationEntry, error)
+	GetConfigurationHistory(store entity.KeyValueStoreRef, key string) ([]entity.KeyValueEntry, error)
+	RollbackConfiguration(store entity.KeyValueStoreRef, key string, revision uint64) (*entity.KeyValueEntry, error)
+	GetConfiguration(store entity.KeyValueStoreRef) ([]entity.KeyValueEntry, error)
+	SetConfiguration(store entity.KeyValueStoreRef, key, value string) (*entity.KeyValueEntry, error)
+	DeleteConfiguration(store entity.KeyValueStoreRef, key string) error
+	WatchProcessConfiguration(
+		ctx context.Context, productID, versionTag, workflow, process string, updates chan<- entity.ConfigurationEntry,
+	) error
+	CreateVersionCredentials(productID, versionTag string, workflows []entity.Workflow) (string, error)
+	RevokeVersionCredentials(productID, versionTag string) error
+	StartShadow(productID, publishedVersionTag, candidateVersionTag string, workflows []entity.ShadowWorkflow) error
+	StopShadow(productID, publishedVersionTag, candidateVersionTag string) error
+	ListResources() ([]entity.NatsResource, error)
+	DeleteResources(resources []entity.NatsResource) error
 	DeleteStreams(productID, versionTag string) error
 	DeleteObjectStores(productID, versionTag string) error
 	DeleteVersionKeyValueStores(productID, versionTag string, workflows []entity.Workflow) error
 	DeleteGlobalKeyValueStore(productID string) error
+	GetProcessConsumerLag(productID, versionTag, workflow, process string) (uint64, error)
+	GetVersionStreamStats(productID, versionTag string, workflows []entity.Workflow) ([]entity.WorkflowStreamStats, error)
+	GetProcessMessages(productID, versionTag, workflow, process string, count int) ([]entity.StreamMessage, error)
+	PublishMessage(productID, versionTag, subject string, headers map[string][]string, data []byte) error
+	GetDeadLetterMessages(productID, versionTag, workflow string) ([]entity.DeadLetterMessage, error)
+	ReplayDeadLetterMessages(productID, versionTag, workflow string, sequences []uint64) (int, error)
+	PurgeDeadLetterMessages(productID, versionTag, workflow string, sequences []uint64) (int, error)
 }
@@ -0,0 +1,261 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// _configurationScopes are the scopes a process reads its configuration from, the latter overriding the former.
+var _configurationScopes = []entity.KeyValueStoreScope{
+	entity.KVScopeGlobal,
+	entity.KVScopeVersion,
+	entity.KVScopeWorkflow,
+	entity.KVScopeProcess,
+}
+
+// GetProcessConfiguration returns the configuration a process sees, resolving each key to the most specific
+// scope defining it. Entries are sorted by key.
+func (m *NatsManager) GetProcessConfiguration(
+	productID, versionTag, workflow, process string,
+) ([]entity.ConfigurationEntry, error) {
+	keyValueStores, err := m.getProcessKeyValueStores(productID, versionTag, workflow, process)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration := make(map[string]entity.ConfigurationEntry)
+
+	for i, scope := range _configurationScopes {
+		keyValueStore := keyValueStores[i]
+
+		entries, err := m.client.GetKeyValueEntries(keyValueStore)
+		if err != nil {
+			return nil, fmt.Errorf("getting %s configuration from key-value store %q: %w", scope, keyValueStore, err)
+		}
+
+		for _, entry := range entries {
+			configuration[entry.Key] = entity.ConfigurationEntry{Scope: scope, KeyValueEntry: entry}
+		}
+	}
+
+	resolvedConfiguration := make([]entity.ConfigurationEntry, 0, len(configuration))
+	for _, entry := range configuration {
+		resolvedConfiguration = append(resolvedConfiguration, entry)
+	}
+
+	sort.Slice(resolvedConfiguration, func(i, j int) bool {
+		return resolvedConfiguration[i].Key < resolvedConfiguration[j].Key
+	})
+
+	return resolvedConfiguration, nil
+}
+
+// GetConfigurationHistory returns the revisions kept for a key of a scope key-value store, oldest first.
+func (m *NatsManager) GetConfigurationHistory(
+	store entity.KeyValueStoreRef,
+	key string,
+) ([]entity.KeyValueEntry, error) {
+	keyValueStore, err := m.getKeyValueStoreName(store)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := m.client.GetKeyHistory(keyValueStore, key)
+	if err != nil {
+		return nil, fmt.Errorf("getting history of key %q from key-value store %q: %w", key, keyValueStore, err)
+	}
+
+	return history, nil
+}
+
+// RollbackConfiguration sets a key of a scope key-value store back to the value it had in a previous revision.
+// The rollback is stored as a new revision, so it can be rolled back as well.
+func (m *NatsManager) RollbackConfiguration(
+	store entity.KeyValueStoreRef,
+	key string,
+	revision uint64,
+) (*entity.KeyValueEntry, error) {
+	keyValueStore, err := m.getKeyValueStoreName(store)
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("Rolling back configuration key", "key-value-store", keyValueStore, "key", key, "revision", revision)
+
+	previousEntry, err := m.client.GetKeyRevision(keyValueStore, key, revision)
+	if err != nil {
+		return nil, fmt.Errorf("getting revision %d of key %q: %w", revision, key, err)
+	}
+
+	entry, err := m.client.PutKeyValue(keyValueStore, key, previousEntry.Value)
+	if err != nil {
+		return nil, fmt.Errorf("rolling back key %q to revision %d: %w", key, revision, err)
+	}
+
+	return entry, nil
+}
+
+// GetConfiguration returns the current entries of a scope key-value store, sorted by key.
+func (m *NatsManager) GetConfiguration(store entity.KeyValueStoreRef) ([]entity.KeyValueEntry, error) {
+	keyValueStore, err := m.getKeyValueStoreName(store)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := m.client.GetKeyValueEntries(keyValueStore)
+	if err != nil {
+		return nil, fmt.Errorf("getting configuration from key-value store %q: %w", keyValueStore, err)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+
+	return entries, nil
+}
+
+// SetConfiguration sets the value of a key of a scope key-value store and returns the new revision.
+func (m *NatsManager) SetConfiguration(
+	store entity.KeyValueStoreRef,
+	key, value string,
+) (*entity.KeyValueEntry, error) {
+	if key == "" {
+		return nil, internal.ErrEmptyConfigurationKey
+	}
+
+	keyValueStore, err := m.getKeyValueStoreName(store)
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("Setting configuration key", "key-value-store", keyValueStore, "key", key)
+
+	entry, err := m.client.PutKeyValue(keyValueStore, key, value)
+	if err != nil {
+		return nil, fmt.Errorf("setting key %q in key-value store %q: %w", key, keyValueStore, err)
+	}
+
+	return entry, nil
+}
+
+// DeleteConfiguration deletes a key of a scope key-value store. Its previous values are kept in the key history.
+func (m *NatsManager) DeleteConfiguration(store entity.KeyValueStoreRef, key string) error {
+	keyValueStore, err := m.getKeyValueStoreName(store)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("Deleting configuration key", "key-value-store", keyValueStore, "key", key)
+
+	if err := m.client.DeleteKeyValue(keyValueStore, key); err != nil {
+		return fmt.Errorf("deleting key %q from key-value store %q: %w", key, keyValueStore, err)
+	}
+
+	return nil
+}
+
+// WatchProcessConfiguration sends the changes of every key-value store a process reads its configuration from
+// until the context is done or one of the watches fails.
+func (m *NatsManager) WatchProcessConfiguration(
+	ctx context.Context,
+	productID, versionTag, workflow, process string,
+	updates chan<- entity.ConfigurationEntry,
+) error {
+	keyValueStores, err := m.getProcessKeyValueStores(productID, versionTag, workflow, process)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, len(keyValueStores))
+
+	for i, keyValueStore := range keyValueStores {
+		scope, keyValueStore := _configurationScopes[i], keyValueStore
+		storeUpdates := make(chan entity.KeyValueEntry)
+
+		go func() {
+			err := m.client.WatchKeyValueStore(ctx, keyValueStore, storeUpdates)
+			close(storeUpdates)
+
+			if err != nil {
+				err = fmt.Errorf("watching %s configuration from key-value store %q: %w", scope, keyValueStore, err)
+			}
+
+			errCh <- err
+		}()
+
+		go func() {
+			for entry := range storeUpdates {
+				select {
+				case updates <- entity.ConfigurationEntry{Scope: scope, KeyValueEntry: entry}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	for range keyValueStores {
+		if err := <-errCh; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getProcessKeyValueStores returns the key-value stores a process reads its configuration from,
+// in the same order as _configurationScopes.
+func (m *NatsManager) getProcessKeyValueStores(productID, versionTag, workflow, process string) ([]string, error) {
+	keyValueStores := make([]string, 0, len(_configurationScopes))
+
+	for _, scope := range _configurationScopes {
+		keyValueStore, err := m.getKeyValueStoreName(entity.KeyValueStoreRef{
+			ProductID:  productID,
+			VersionTag: versionTag,
+			Workflow:   workflow,
+			Process:    process,
+			Scope:      scope,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		keyValueStores = append(keyValueStores, keyValueStore)
+	}
+
+	return keyValueStores, nil
+}
+
+func (m *NatsManager) getKeyValueStoreName(store entity.KeyValueStoreRef) (string, error) {
+	switch store.Scope {
+	case entity.KVScopeGlobal:
+		return m.getProductKeyValueStoreName(store.ProductID), nil
+	case entity.KVScopeVersion:
+		return m.getVersionKeyValueStoreName(store.ProductID, store.VersionTag), nil
+	case entity.KVScopeWorkflow:
+		if store.Workflow == "" {
+			return "", internal.ErrEmptyWorkflowName
+		}
+
+		return m.getWorkflowKeyValueStoreName(store.ProductID, store.VersionTag, store.Workflow), nil
+	case entity.KVScopeProcess:
+		if store.Workflow == "" {
+			return "", internal.ErrEmptyWorkflowName
+		}
+
+		if store.Process == "" {
+			return "", internal.ErrEmptyProcessName
+		}
+
+		return m.getProcessKeyValueStoreName(store.ProductID, store.VersionTag, store.Workflow, store.Process), nil
+	default:
+		return "", fmt.Errorf("%w: %q", internal.ErrInvalidKeyValueStoreScope, store.Scope)
+	}
+}
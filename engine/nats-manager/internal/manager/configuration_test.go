@@ -0,0 +1,261 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	_testGlobalKeyValueStore   = "key-store_test-product"
+	_testVersionKeyValueStore  = "key-store_test-product_v1_0_0"
+	_testWorkflowKeyValueStore = "key-store_test-product_v1_0_0_test-workflow"
+	_testProcessKeyValueStore  = "key-store_test-product_v1_0_0_test-workflow_test-process"
+)
+
+type ConfigurationSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestConfigurationSuite(t *testing.T) {
+	suite.Run(t, new(ConfigurationSuite))
+}
+
+func (s *ConfigurationSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func getTestProcessStoreRef() entity.KeyValueStoreRef {
+	return entity.KeyValueStoreRef{
+		ProductID:  _testProductID,
+		VersionTag: _testVersionTag,
+		Workflow:   _testWorkflow,
+		Process:    _testProcess,
+		Scope:      entity.KVScopeProcess,
+	}
+}
+
+func (s *ConfigurationSuite) TestGetProcessConfiguration_MostSpecificScopeWins() {
+	globalEntry := entity.KeyValueEntry{Key: "shared", Value: "global", Revision: 1}
+	globalOnlyEntry := entity.KeyValueEntry{Key: "global-only", Value: "global", Revision: 2}
+	workflowEntry := entity.KeyValueEntry{Key: "shared", Value: "workflow", Revision: 3}
+	processEntry := entity.KeyValueEntry{Key: "process-only", Value: "process", Revision: 1}
+
+	s.client.EXPECT().GetKeyValueEntries(_testGlobalKeyValueStore).
+		Return([]entity.KeyValueEntry{globalEntry, globalOnlyEntry}, nil)
+	s.client.EXPECT().GetKeyValueEntries(_testVersionKeyValueStore).Return([]entity.KeyValueEntry{}, nil)
+	s.client.EXPECT().GetKeyValueEntries(_testWorkflowKeyValueStore).Return([]entity.KeyValueEntry{workflowEntry}, nil)
+	s.client.EXPECT().GetKeyValueEntries(_testProcessKeyValueStore).Return([]entity.KeyValueEntry{processEntry}, nil)
+
+	configuration, err := s.natsManager.GetProcessConfiguration(
+		_testProductID, _testVersionTag, _testWorkflow, _testProcess,
+	)
+	s.Require().NoError(err)
+
+	s.Equal([]entity.ConfigurationEntry{
+		{Scope: entity.KVScopeGlobal, KeyValueEntry: globalOnlyEntry},
+		{Scope: entity.KVScopeProcess, KeyValueEntry: processEntry},
+		{Scope: entity.KVScopeWorkflow, KeyValueEntry: workflowEntry},
+	}, configuration)
+}
+
+func (s *ConfigurationSuite) TestGetProcessConfiguration_ClientError() {
+	expectedErr := errors.New("bucket not found")
+
+	s.client.EXPECT().GetKeyValueEntries(_testGlobalKeyValueStore).Return(nil, expectedErr)
+
+	_, err := s.natsManager.GetProcessConfiguration(_testProductID, _testVersionTag, _testWorkflow, _testProcess)
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *ConfigurationSuite) TestGetProcessConfiguration_EmptyProcess() {
+	_, err := s.natsManager.GetProcessConfiguration(_testProductID, _testVersionTag, _testWorkflow, "")
+	s.ErrorIs(err, internal.ErrEmptyProcessName)
+}
+
+func (s *ConfigurationSuite) TestGetConfigurationHistory() {
+	expectedHistory := []entity.KeyValueEntry{
+		{Key: "key1", Value: "old", Revision: 1},
+		{Key: "key1", Value: "new", Revision: 4},
+	}
+
+	s.client.EXPECT().GetKeyHistory(_testProcessKeyValueStore, "key1").Return(expectedHistory, nil)
+
+	history, err := s.natsManager.GetConfigurationHistory(getTestProcessStoreRef(), "key1")
+	s.Require().NoError(err)
+	s.Equal(expectedHistory, history)
+}
+
+func (s *ConfigurationSuite) TestGetConfigurationHistory_VersionScope() {
+	s.client.EXPECT().GetKeyHistory(_testVersionKeyValueStore, "key1").Return([]entity.KeyValueEntry{}, nil)
+
+	_, err := s.natsManager.GetConfigurationHistory(entity.KeyValueStoreRef{
+		ProductID:  _testProductID,
+		VersionTag: _testVersionTag,
+		Scope:      entity.KVScopeVersion,
+	}, "key1")
+	s.Require().NoError(err)
+}
+
+func (s *ConfigurationSuite) TestGetConfigurationHistory_InvalidScope() {
+	_, err := s.natsManager.GetConfigurationHistory(entity.KeyValueStoreRef{ProductID: _testProductID}, "key1")
+	s.ErrorIs(err, internal.ErrInvalidKeyValueStoreScope)
+}
+
+func (s *ConfigurationSuite) TestGetConfigurationHistory_WorkflowScopeWithoutWorkflow() {
+	_, err := s.natsManager.GetConfigurationHistory(entity.KeyValueStoreRef{
+		ProductID:  _testProductID,
+		VersionTag: _testVersionTag,
+		Scope:      entity.KVScopeWorkflow,
+	}, "key1")
+	s.ErrorIs(err, internal.ErrEmptyWorkflowName)
+}
+
+func (s *ConfigurationSuite) TestRollbackConfiguration() {
+	previousEntry := &entity.KeyValueEntry{Key: "key1", Value: "old", Revision: 1}
+	expectedEntry := &entity.KeyValueEntry{Key: "key1", Value: "old", Revision: 5}
+
+	s.client.EXPECT().GetKeyRevision(_testProcessKeyValueStore, "key1", uint64(1)).Return(previousEntry, nil)
+	s.client.EXPECT().PutKeyValue(_testProcessKeyValueStore, "key1", "old").Return(expectedEntry, nil)
+
+	entry, err := s.natsManager.RollbackConfiguration(getTestProcessStoreRef(), "key1", 1)
+	s.Require().NoError(err)
+	s.Equal(expectedEntry, entry)
+}
+
+func (s *ConfigurationSuite) TestRollbackConfiguration_RevisionNotFound() {
+	s.client.EXPECT().GetKeyRevision(_testProcessKeyValueStore, "key1", uint64(1)).
+		Return(nil, internal.ErrConfigurationRevisionNotFound)
+
+	_, err := s.natsManager.RollbackConfiguration(getTestProcessStoreRef(), "key1", 1)
+	s.ErrorIs(err, internal.ErrConfigurationRevisionNotFound)
+}
+
+func (s *ConfigurationSuite) TestGetConfiguration() {
+	s.client.EXPECT().GetKeyValueEntries(_testGlobalKeyValueStore).Return([]entity.KeyValueEntry{
+		{Key: "key2", Value: "value2", Revision: 2},
+		{Key: "key1", Value: "value1", Revision: 1},
+	}, nil)
+
+	entries, err := s.natsManager.GetConfiguration(entity.KeyValueStoreRef{
+		ProductID: _testProductID,
+		Scope:     entity.KVScopeGlobal,
+	})
+	s.Require().NoError(err)
+
+	s.Equal([]entity.KeyValueEntry{
+		{Key: "key1", Value: "value1", Revision: 1},
+		{Key: "key2", Value: "value2", Revision: 2},
+	}, entries)
+}
+
+func (s *ConfigurationSuite) TestSetConfiguration() {
+	expectedEntry := &entity.KeyValueEntry{Key: "key1", Value: "value1", Revision: 3}
+
+	s.client.EXPECT().PutKeyValue(_testGlobalKeyValueStore, "key1", "value1").Return(expectedEntry, nil)
+
+	entry, err := s.natsManager.SetConfiguration(entity.KeyValueStoreRef{
+		ProductID: _testProductID,
+		Scope:     entity.KVScopeGlobal,
+	}, "key1", "value1")
+	s.Require().NoError(err)
+	s.Equal(expectedEntry, entry)
+}
+
+func (s *ConfigurationSuite) TestSetConfiguration_EmptyKey() {
+	_, err := s.natsManager.SetConfiguration(entity.KeyValueStoreRef{
+		ProductID: _testProductID,
+		Scope:     entity.KVScopeGlobal,
+	}, "", "value1")
+	s.ErrorIs(err, internal.ErrEmptyConfigurationKey)
+}
+
+func (s *ConfigurationSuite) TestDeleteConfiguration() {
+	s.client.EXPECT().DeleteKeyValue(_testGlobalKeyValueStore, "key1").Return(nil)
+
+	err := s.natsManager.DeleteConfiguration(entity.KeyValueStoreRef{
+		ProductID: _testProductID,
+		Scope:     entity.KVScopeGlobal,
+	}, "key1")
+	s.Require().NoError(err)
+}
+
+func (s *ConfigurationSuite) TestDeleteConfiguration_KeyNotFound() {
+	s.client.EXPECT().DeleteKeyValue(_testGlobalKeyValueStore, "key1").Return(internal.ErrConfigurationKeyNotFound)
+
+	err := s.natsManager.DeleteConfiguration(entity.KeyValueStoreRef{
+		ProductID: _testProductID,
+		Scope:     entity.KVScopeGlobal,
+	}, "key1")
+	s.ErrorIs(err, internal.ErrConfigurationKeyNotFound)
+}
+
+func (s *ConfigurationSuite) TestWatchProcessConfiguration() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updatedEntry := entity.KeyValueEntry{Key: "key1", Value: "new", Revision: 2}
+	waitForCancel := func(ctx context.Context, _ string, _ chan<- entity.KeyValueEntry) error {
+		<-ctx.Done()
+		return nil
+	}
+
+	s.client.EXPECT().WatchKeyValueStore(gomock.Any(), _testGlobalKeyValueStore, gomock.Any()).DoAndReturn(waitForCancel)
+	s.client.EXPECT().WatchKeyValueStore(gomock.Any(), _testVersionKeyValueStore, gomock.Any()).DoAndReturn(waitForCancel)
+	s.client.EXPECT().WatchKeyValueStore(gomock.Any(), _testWorkflowKeyValueStore, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ string, updates chan<- entity.KeyValueEntry) error {
+			updates <- updatedEntry
+			<-ctx.Done()
+			return nil
+		})
+	s.client.EXPECT().WatchKeyValueStore(gomock.Any(), _testProcessKeyValueStore, gomock.Any()).DoAndReturn(waitForCancel)
+
+	updates := make(chan entity.ConfigurationEntry, 1)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- s.natsManager.WatchProcessConfiguration(
+			ctx, _testProductID, _testVersionTag, _testWorkflow, _testProcess, updates,
+		)
+	}()
+
+	s.Equal(entity.ConfigurationEntry{Scope: entity.KVScopeWorkflow, KeyValueEntry: updatedEntry}, <-updates)
+
+	cancel()
+	s.NoError(<-errCh)
+}
+
+func (s *ConfigurationSuite) TestWatchProcessConfiguration_WatchFails() {
+	expectedErr := errors.New("bucket not found")
+
+	s.client.EXPECT().WatchKeyValueStore(gomock.Any(), _testGlobalKeyValueStore, gomock.Any()).Return(expectedErr)
+	s.client.EXPECT().WatchKeyValueStore(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ string, _ chan<- entity.KeyValueEntry) error {
+			<-ctx.Done()
+			return nil
+		}).AnyTimes()
+
+	updates := make(chan entity.ConfigurationEntry)
+
+	err := s.natsManager.WatchProcessConfiguration(
+		context.Background(), _testProductID, _testVersionTag, _testWorkflow, _testProcess, updates,
+	)
+	s.ErrorIs(err, expectedErr)
+}
@@ -0,0 +1,54 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// GetProcessConsumerLag returns the number of messages waiting to be processed by the given process,
+// adding up the backlog of every consumer it owns in the workflow stream. Consumers are matched by their exact
+// name, as processes name their durable consumers after the subject they consume from and themselves.
+func (m *NatsManager) GetProcessConsumerLag(productID, versionTag, workflow, process string) (uint64, error) {
+	stream := m.getStreamName(productID, versionTag, workflow)
+
+	consumersLag, err := m.client.GetConsumersLag(stream)
+	if err != nil {
+		return 0, fmt.Errorf("error getting stream %q consumers lag: %w", stream, err)
+	}
+
+	var (
+		lag            uint64
+		foundConsumers bool
+	)
+
+	for _, consumerLag := range consumersLag {
+		if !m.isProcessConsumer(consumerLag, process) {
+			continue
+		}
+
+		foundConsumers = true
+		lag += consumerLag.Total()
+	}
+
+	if !foundConsumers {
+		return 0, fmt.Errorf("%w: %q in stream %q", internal.ErrNoProcessConsumers, process, stream)
+	}
+
+	return lag, nil
+}
+
+// isProcessConsumer tells if the consumer belongs to the given process. A process called "process" does not own
+// the consumers of "my-process", even if their names end the same.
+func (m *NatsManager) isProcessConsumer(consumerLag entity.ConsumerLag, process string) bool {
+	return consumerLag.Consumer == m.getProcessConsumerName(consumerLag.FilterSubject, process)
+}
+
+// getProcessConsumerName returns the name of the durable consumer a process creates to consume from the subject:
+// the subject followed by the process name, with the dots of the subject replaced by dashes as consumer names
+// can't contain them.
+func (m *NatsManager) getProcessConsumerName(subject, process string) string {
+	return fmt.Sprintf("%s-%s", strings.ReplaceAll(subject, ".", "-"), process)
+}
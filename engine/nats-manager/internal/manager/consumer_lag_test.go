@@ -0,0 +1,131 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	_testProductID  = "test-product"
+	_testVersionTag = "v1.0.0"
+	_testWorkflow   = "test-workflow"
+	_testProcess    = "test-process"
+	_testStream     = "test-product_v1_0_0_test-workflow"
+)
+
+type ConsumerLagSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestConsumerLagSuite(t *testing.T) {
+	suite.Run(t, new(ConsumerLagSuite))
+}
+
+func (s *ConsumerLagSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func (s *ConsumerLagSuite) TestGetProcessConsumerLag() {
+	s.client.EXPECT().GetConsumersLag(_testStream).Return([]entity.ConsumerLag{
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-entrypoint-test-process",
+			FilterSubject: "test-product_v1_0_0_test-workflow.entrypoint",
+			Pending:       10,
+			AckPending:    2,
+		},
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-other-process-test-process",
+			FilterSubject: "test-product_v1_0_0_test-workflow.other-process",
+			Pending:       5,
+		},
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-test-process-other-process",
+			FilterSubject: "test-product_v1_0_0_test-workflow.test-process",
+			Pending:       100,
+		},
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-entrypoint-mytest-process",
+			FilterSubject: "test-product_v1_0_0_test-workflow.entrypoint",
+			Pending:       1000,
+		},
+	}, nil)
+
+	lag, err := s.natsManager.GetProcessConsumerLag(_testProductID, _testVersionTag, _testWorkflow, _testProcess)
+	s.Require().NoError(err)
+	s.Equal(uint64(17), lag)
+}
+
+func (s *ConsumerLagSuite) TestGetProcessConsumerLag_ProcessNameIsSuffixOfAnotherProcess() {
+	s.client.EXPECT().GetConsumersLag(_testStream).Return([]entity.ConsumerLag{
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-entrypoint-process",
+			FilterSubject: "test-product_v1_0_0_test-workflow.entrypoint",
+			Pending:       3,
+		},
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-entrypoint-my-process",
+			FilterSubject: "test-product_v1_0_0_test-workflow.entrypoint",
+			Pending:       1000,
+		},
+	}, nil)
+
+	lag, err := s.natsManager.GetProcessConsumerLag(_testProductID, _testVersionTag, _testWorkflow, "process")
+	s.Require().NoError(err)
+	s.Equal(uint64(3), lag)
+
+	s.client.EXPECT().GetConsumersLag(_testStream).Return([]entity.ConsumerLag{
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-entrypoint-process",
+			FilterSubject: "test-product_v1_0_0_test-workflow.entrypoint",
+			Pending:       3,
+		},
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-entrypoint-my-process",
+			FilterSubject: "test-product_v1_0_0_test-workflow.entrypoint",
+			Pending:       1000,
+		},
+	}, nil)
+
+	lag, err = s.natsManager.GetProcessConsumerLag(_testProductID, _testVersionTag, _testWorkflow, "my-process")
+	s.Require().NoError(err)
+	s.Equal(uint64(1000), lag)
+}
+
+func (s *ConsumerLagSuite) TestGetProcessConsumerLag_NoConsumers() {
+	s.client.EXPECT().GetConsumersLag(_testStream).Return([]entity.ConsumerLag{
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-test-process-other-process",
+			FilterSubject: "test-product_v1_0_0_test-workflow.test-process",
+			Pending:       100,
+		},
+	}, nil)
+
+	_, err := s.natsManager.GetProcessConsumerLag(_testProductID, _testVersionTag, _testWorkflow, _testProcess)
+	s.ErrorIs(err, internal.ErrNoProcessConsumers)
+}
+
+func (s *ConsumerLagSuite) TestGetProcessConsumerLag_ErrorGettingConsumers() {
+	expectedErr := errors.New("stream not found")
+
+	s.client.EXPECT().GetConsumersLag(_testStream).Return(nil, expectedErr)
+
+	_, err := s.natsManager.GetProcessConsumerLag(_testProductID, _testVersionTag, _testWorkflow, _testProcess)
+	s.ErrorIs(err, expectedErr)
+}
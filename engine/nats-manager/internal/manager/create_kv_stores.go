@@ -8,20 +8,33 @@ import (
 	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
 )
 
+// CreateVersionKeyValueStores creates the key-value stores of the version, its workflows and their processes.
+// Workflows and processes can define the settings of their own store.
 func (m *NatsManager) CreateVersionKeyValueStores(
 	productID,
 	versionTag string,
 	workflows []entity.Workflow,
+	versionStoreSettings *entity.KeyValueStoreSettings,
 ) (*entity.VersionKeyValueStores, error) {
 	if len(workflows) == 0 {
 		return nil, internal.ErrNoWorkflowsDefined
 	}
 
+	if versionStoreSettings != nil {
+		if err := versionStoreSettings.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid version key-value store settings: %w", err)
+		}
+	}
+
+	if err := m.validateWorkflows(workflows); err != nil {
+		return nil, err
+	}
+
 	m.logger.Info("Creating key-value stores")
 
 	productKeyValueStore := m.getVersionKeyValueStoreName(productID, versionTag)
 
-	err := m.client.CreateKeyValueStore(productKeyValueStore)
+	err := m.client.CreateKeyValueStore(productKeyValueStore, versionStoreSettings)
 	if err != nil {
 		return nil, fmt.Errorf("create product key-value store %q: %w", productKeyValueStore, err)
 	}
@@ -31,7 +44,7 @@ func (m *NatsManager) CreateVersionKeyValueStores(
 	for _, workflow := range workflows {
 		workflowKeyValueStore := m.getWorkflowKeyValueStoreName(productID, versionTag, workflow.Name)
 
-		err = m.client.CreateKeyValueStore(workflowKeyValueStore)
+		err = m.client.CreateKeyValueStore(workflowKeyValueStore, workflow.KeyValueStore)
 		if err != nil {
 			return nil, fmt.Errorf("create workflow key-value store %q: %w", workflowKeyValueStore, err)
 		}
@@ -41,7 +54,7 @@ func (m *NatsManager) CreateVersionKeyValueStores(
 		for _, process := range workflow.Processes {
 			processKeyValueStore := m.getProcessKeyValueStoreName(productID, versionTag, workflow.Name, process.Name)
 
-			err = m.client.CreateKeyValueStore(processKeyValueStore)
+			err = m.client.CreateKeyValueStore(processKeyValueStore, process.KeyValueStore)
 			if err != nil {
 				return nil, fmt.Errorf("create process key-value store %q: %w", processKeyValueStore, err)
 			}
@@ -66,7 +79,7 @@ func (m *NatsManager) CreateGlobalKeyValueStore(product string) (string, error)
 
 	keyValueStoreName := m.getProductKeyValueStoreName(product)
 
-	err := m.client.CreateKeyValueStore(keyValueStoreName)
+	err := m.client.CreateKeyValueStore(keyValueStoreName, nil)
 	if err != nil {
 		return "", fmt.Errorf("creating global key-value store: %w", err)
 	}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/golang/mock/gomock"
@@ -108,10 +109,10 @@ func TestCreateKVStore(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			for _, expectedKVStore := range tc.expectedKVStores {
-				client.EXPECT().CreateKeyValueStore(expectedKVStore).Return(nil)
+				client.EXPECT().CreateKeyValueStore(expectedKVStore, nil).Return(nil)
 			}
 
-			workflowsKVCfg, err := natsManager.CreateVersionKeyValueStores(testProductID, testVersionTag, tc.workflows)
+			workflowsKVCfg, err := natsManager.CreateVersionKeyValueStores(testProductID, testVersionTag, tc.workflows, nil)
 			if tc.wantError {
 				assert.ErrorIs(t, err, tc.wantedError)
 				return
@@ -122,6 +123,81 @@ func TestCreateKVStore(t *testing.T) {
 	}
 }
 
+func TestCreateKVStore_WithSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	versionSettings := &entity.KeyValueStoreSettings{History: 1}
+	workflowSettings := &entity.KeyValueStoreSettings{TTL: time.Hour, MaxBytes: 1024}
+	processSettings := &entity.KeyValueStoreSettings{History: 64, Replicas: 3, Storage: entity.StreamStorageMemory}
+
+	workflow := NewWorkflowBuilder().
+		WithID("test-workflow").
+		WithProcesses([]entity.Process{
+			{Name: "test-process", KeyValueStore: processSettings},
+			{Name: "other-process"},
+		}).
+		Build()
+	workflow.KeyValueStore = workflowSettings
+
+	client.EXPECT().CreateKeyValueStore("key-store_test-product_v1_0_0", versionSettings).Return(nil)
+	client.EXPECT().CreateKeyValueStore("key-store_test-product_v1_0_0_test-workflow", workflowSettings).Return(nil)
+	client.EXPECT().
+		CreateKeyValueStore("key-store_test-product_v1_0_0_test-workflow_test-process", processSettings).
+		Return(nil)
+	client.EXPECT().CreateKeyValueStore("key-store_test-product_v1_0_0_test-workflow_other-process", nil).Return(nil)
+
+	_, err := natsManager.CreateVersionKeyValueStores("test-product", "v1.0.0", []entity.Workflow{workflow}, versionSettings)
+	require.NoError(t, err)
+}
+
+func TestCreateKVStore_InvalidSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	tests := []struct {
+		name            string
+		versionSettings *entity.KeyValueStoreSettings
+		processSettings *entity.KeyValueStoreSettings
+		expectedError   error
+	}{
+		{
+			name:            "version store history over the NATS maximum",
+			versionSettings: &entity.KeyValueStoreSettings{History: 65},
+			expectedError:   internal.ErrInvalidKeyValueStoreHistory,
+		},
+		{
+			name:            "negative process store TTL",
+			processSettings: &entity.KeyValueStoreSettings{TTL: -time.Second},
+			expectedError:   internal.ErrInvalidStoreLimits,
+		},
+		{
+			name:            "unknown process store storage",
+			processSettings: &entity.KeyValueStoreSettings{Storage: "disk"},
+			expectedError:   internal.ErrInvalidStreamStorage,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			workflow := NewWorkflowBuilder().
+				WithProcesses([]entity.Process{{Name: "test-process", KeyValueStore: tc.processSettings}}).
+				Build()
+
+			_, err := natsManager.CreateVersionKeyValueStores(
+				"test-product", "v1.0.0", []entity.Workflow{workflow}, tc.versionSettings,
+			)
+			assert.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
+
 func TestCreateGlobalKVStore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -134,7 +210,7 @@ func TestCreateGlobalKVStore(t *testing.T) {
 		globalKeyValueStore = "key-store_test-product"
 	)
 
-	client.EXPECT().CreateKeyValueStore(globalKeyValueStore).Return(nil)
+	client.EXPECT().CreateKeyValueStore(globalKeyValueStore, nil).Return(nil)
 
 	globalKVStore, err := natsManager.CreateGlobalKeyValueStore(testProductID)
 	require.NoError(t, err)
@@ -154,7 +230,7 @@ func TestCreateGlobalKVStore_Error(t *testing.T) {
 		globalKeyValueStore = "key-store_test-product"
 	)
 
-	client.EXPECT().CreateKeyValueStore(globalKeyValueStore).Return(errors.New("mock error"))
+	client.EXPECT().CreateKeyValueStore(globalKeyValueStore, nil).Return(errors.New("mock error"))
 
 	_, err := natsManager.CreateGlobalKeyValueStore(testProductID)
 	assert.Error(t, err)
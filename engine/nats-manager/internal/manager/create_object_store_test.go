@@ -5,6 +5,7 @@ package manager_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/golang/mock/gomock"
@@ -335,7 +336,7 @@ func TestCreateObjectStore(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			for _, expectedObjStore := range tc.expectedObjectStores {
-				client.EXPECT().CreateObjectStore(expectedObjStore).Return(tc.expectedError)
+				client.EXPECT().CreateObjectStore(expectedObjStore, nil).Return(tc.expectedError)
 			}
 
 			_, err := natsManager.CreateObjectStores(testProductID, testVersionTag, tc.workflows)
@@ -343,3 +344,70 @@ func TestCreateObjectStore(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateObjectStore_WithSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	settings := &entity.ObjectStoreSettings{TTL: 24 * time.Hour, MaxBytes: 1 << 30, Storage: entity.StreamStorageFile}
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().
+			WithID("test-workflow").
+			WithProcessObjectStore(&entity.ObjectStore{
+				Name:     "test-object-store",
+				Scope:    entity.ObjStoreScopeWorkflow,
+				Settings: settings,
+			}).
+			Build(),
+	}
+
+	client.EXPECT().CreateObjectStore("test-product_v1_0_0_test-workflow_test-object-store", settings).Return(nil)
+
+	_, err := natsManager.CreateObjectStores("test-product", "v1.0.0", workflows)
+	assert.NoError(t, err)
+}
+
+func TestCreateObjectStore_InvalidSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	tests := []struct {
+		name          string
+		objectStore   *entity.ObjectStore
+		expectedError error
+	}{
+		{
+			name: "negative max bytes",
+			objectStore: &entity.ObjectStore{
+				Name:     "test-object-store",
+				Scope:    entity.ObjStoreScopeProject,
+				Settings: &entity.ObjectStoreSettings{MaxBytes: -1},
+			},
+			expectedError: internal.ErrInvalidStoreLimits,
+		},
+		{
+			name: "settings in a product object store",
+			objectStore: &entity.ObjectStore{
+				Name:     "test-object-store",
+				Scope:    entity.ObjStoreScopeProduct,
+				Settings: &entity.ObjectStoreSettings{TTL: time.Hour},
+			},
+			expectedError: internal.ErrProductObjectStoreSettings,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			workflows := []entity.Workflow{NewWorkflowBuilder().WithProcessObjectStore(tc.objectStore).Build()}
+
+			_, err := natsManager.CreateObjectStores("test-product", "v1.0.0", workflows)
+			assert.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
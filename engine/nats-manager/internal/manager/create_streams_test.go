@@ -3,13 +3,16 @@
 package manager_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
 	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
 	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
 	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
@@ -52,6 +55,7 @@ func TestCreateStreams(t *testing.T) {
 	customMatcher := newStreamConfigMatcher(expectedWorkflowsStreamsCfg[testWorkflowName])
 
 	client.EXPECT().CreateStream(customMatcher).Return(nil)
+	client.EXPECT().CreateDeadLetterStream(testStreamName + "_dlq").Return(nil)
 	workflowsStreamsCfg, err := natsManager.CreateStreams(testProductID, testVersionTag, workflows)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedWorkflowsStreamsCfg, workflowsStreamsCfg)
@@ -101,3 +105,186 @@ func TestCreateStreams_FailsIfNoWorkflowsAreDefined(t *testing.T) {
 	_, err := natsManager.CreateStreams(testProductID, testVersionTag, workflows)
 	assert.EqualError(t, err, "no workflows defined")
 }
+
+func TestCreateStreams_WithStreamSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	const (
+		testProductID    = "test-product"
+		testVersionTag   = "v1.0.0"
+		testWorkflowName = "test-workflow"
+		testStreamName   = "test-product_v1_0_0_test-workflow"
+		testProcess      = "test-process"
+	)
+
+	streamSettings := &entity.StreamSettings{
+		Retention:  entity.StreamRetentionLimits,
+		MaxAge:     time.Hour,
+		MaxBytes:   1024,
+		MaxMsgSize: 512,
+		Replicas:   3,
+		Storage:    entity.StreamStorageMemory,
+	}
+
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().
+			WithID(testWorkflowName).
+			WithProcessName(testProcess).
+			WithStreamSettings(streamSettings).
+			Build(),
+	}
+
+	expectedStreamConfig := &entity.StreamConfig{
+		Stream: testStreamName,
+		Processes: entity.ProcessesStreamConfig{
+			testProcess: entity.ProcessStreamConfig{
+				Subject:       fmt.Sprintf("%s.%s", testStreamName, testProcess),
+				Subscriptions: []string{},
+			},
+		},
+		Settings: streamSettings,
+	}
+
+	client.EXPECT().CreateStream(newStreamConfigMatcher(expectedStreamConfig)).Return(nil)
+	client.EXPECT().CreateDeadLetterStream(testStreamName + "_dlq").Return(nil)
+
+	_, err := natsManager.CreateStreams(testProductID, testVersionTag, workflows)
+	assert.NoError(t, err)
+}
+
+func TestCreateStreams_FailsIfStreamSettingsAreInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().
+			WithStreamSettings(&entity.StreamSettings{Storage: "disk"}).
+			Build(),
+	}
+
+	_, err := natsManager.CreateStreams("test-product", "v1.0.0", workflows)
+	assert.ErrorIs(t, err, internal.ErrInvalidStreamStorage)
+}
+
+func TestCreateStreams_DeadLetterStreamFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().Build(),
+	}
+
+	expectedErr := errors.New("dead-letter stream error")
+
+	client.EXPECT().CreateStream(gomock.Any()).Return(nil)
+	client.EXPECT().CreateDeadLetterStream("test-product_v1_0_0_test-workflow_dlq").Return(expectedErr)
+
+	_, err := natsManager.CreateStreams("test-product", "v1.0.0", workflows)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestCreateStreams_CrossWorkflowSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	const (
+		servingStream  = "test-product_v1_0_0_serving"
+		feedbackStream = "test-product_v1_0_0_feedback"
+	)
+
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().
+			WithID("serving").
+			WithProcessName("predictor").
+			Build(),
+		NewWorkflowBuilder().
+			WithID("feedback").
+			WithProcesses([]entity.Process{
+				{Name: "evaluator", Subscriptions: []string{"serving.predictor", "serving.predictor.scores"}},
+				{Name: "reporter", Subscriptions: []string{"evaluator", "serving.predictor"}},
+			}).
+			Build(),
+	}
+
+	expectedFeedbackStreamCfg := &entity.StreamConfig{
+		Stream: feedbackStream,
+		Processes: entity.ProcessesStreamConfig{
+			"evaluator": entity.ProcessStreamConfig{
+				Subject: feedbackStream + ".evaluator",
+				Subscriptions: []string{
+					servingStream + ".predictor",
+					servingStream + ".predictor.scores",
+				},
+			},
+			"reporter": entity.ProcessStreamConfig{
+				Subject:       feedbackStream + ".reporter",
+				Subscriptions: []string{feedbackStream + ".evaluator", servingStream + ".predictor"},
+			},
+		},
+		Sources: []entity.StreamSource{
+			{
+				Stream: servingStream,
+				SubjectTransforms: []entity.SubjectTransform{
+					{Source: servingStream + ".predictor", Destination: servingStream + ".predictor"},
+					{Source: servingStream + ".predictor.scores", Destination: servingStream + ".predictor.scores"},
+				},
+			},
+		},
+	}
+
+	client.EXPECT().CreateStream(gomock.Any()).Return(nil)
+	client.EXPECT().CreateStream(newStreamConfigMatcher(expectedFeedbackStreamCfg)).Return(nil)
+	client.EXPECT().CreateDeadLetterStream(gomock.Any()).Return(nil).Times(2)
+
+	workflowsStreamsCfg, err := natsManager.CreateStreams("test-product", "v1.0.0", workflows)
+	assert.NoError(t, err)
+	assert.Nil(t, workflowsStreamsCfg["serving"].Sources)
+	assert.Equal(t, expectedFeedbackStreamCfg, workflowsStreamsCfg["feedback"])
+}
+
+func TestCreateStreams_SubtopicsOfOwnProcessesAreNotCrossWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	const stream = "test-product_v1_0_0_serving"
+
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().
+			WithID("serving").
+			WithProcesses([]entity.Process{
+				{Name: "etl"},
+				{Name: "classificator", Subscriptions: []string{"etl.emails"}},
+			}).
+			Build(),
+		NewWorkflowBuilder().
+			WithID("etl").
+			Build(),
+	}
+
+	client.EXPECT().CreateStream(gomock.Any()).Return(nil).Times(2)
+	client.EXPECT().CreateDeadLetterStream(gomock.Any()).Return(nil).Times(2)
+
+	workflowsStreamsCfg, err := natsManager.CreateStreams("test-product", "v1.0.0", workflows)
+	assert.NoError(t, err)
+	assert.Nil(t, workflowsStreamsCfg["serving"].Sources)
+	assert.Equal(t,
+		[]string{stream + ".etl.emails"},
+		workflowsStreamsCfg["serving"].Processes["classificator"].Subscriptions,
+	)
+}
@@ -0,0 +1,141 @@
+package manager
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// CreateVersionCredentials issues the NATS credentials used by the processes of a version. They are only
+// allowed to use the version streams, object stores and key-value stores, and to read the product
+// global key-value store. An empty string is returned when NATS authentication isn't configured.
+func (m *NatsManager) CreateVersionCredentials(productID, versionTag string, workflows []entity.Workflow) (string, error) {
+	if len(workflows) == 0 {
+		return "", internal.ErrNoWorkflowsDefined
+	}
+
+	if err := m.validateWorkflows(workflows); err != nil {
+		return "", err
+	}
+
+	permissions, err := m.getVersionPermissions(productID, versionTag, workflows)
+	if err != nil {
+		return "", err
+	}
+
+	user := m.getVersionUserName(productID, versionTag)
+
+	credentials, err := m.client.CreateUser(user, permissions)
+	if errors.Is(err, internal.ErrNatsAuthDisabled) {
+		m.logger.Info("NATS authentication disabled, skipping version credentials", "user", user)
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("error creating user %q: %w", user, err)
+	}
+
+	return credentials, nil
+}
+
+// RevokeVersionCredentials revokes the NATS credentials issued to the processes of a version.
+func (m *NatsManager) RevokeVersionCredentials(productID, versionTag string) error {
+	user := m.getVersionUserName(productID, versionTag)
+
+	err := m.client.RevokeUser(user)
+	if errors.Is(err, internal.ErrNatsAuthDisabled) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking user %q: %w", user, err)
+	}
+
+	return nil
+}
+
+func (m *NatsManager) getVersionPermissions(
+	productID, versionTag string,
+	workflows []entity.Workflow,
+) (*entity.UserPermissions, error) {
+	permissions := &entity.UserPermissions{}
+	permissions.AllowPublish("$JS.API.INFO", "$JS.API.STREAM.NAMES")
+	permissions.AllowSubscribe("_INBOX.>")
+
+	m.allowKeyValueStore(permissions, m.getProductKeyValueStoreName(productID), true)
+	m.allowKeyValueStore(permissions, m.getVersionKeyValueStoreName(productID, versionTag), false)
+
+	objectStores := map[string]bool{}
+
+	for _, workflow := range workflows {
+		stream := m.getStreamName(productID, versionTag, workflow.Name)
+		permissions.Allow(stream + ".>")
+		permissions.AllowPublish(m.getJetStreamAPISubjects(stream, false)...)
+
+		m.allowKeyValueStore(permissions, m.getWorkflowKeyValueStoreName(productID, versionTag, workflow.Name), false)
+
+		for _, process := range workflow.Processes {
+			processKeyValueStore := m.getProcessKeyValueStoreName(productID, versionTag, workflow.Name, process.Name)
+			m.allowKeyValueStore(permissions, processKeyValueStore, false)
+
+			if process.ObjectStore == nil {
+				continue
+			}
+
+			objectStore, err := m.getObjectStoreName(productID, versionTag, workflow.Name, process.ObjectStore)
+			if err != nil {
+				return nil, err
+			}
+
+			if objectStores[objectStore] {
+				continue
+			}
+
+			objectStores[objectStore] = true
+
+			permissions.Allow(fmt.Sprintf("$O.%s.>", objectStore))
+			permissions.AllowPublish(m.getJetStreamAPISubjects("OBJ_"+objectStore, false)...)
+		}
+	}
+
+	return permissions, nil
+}
+
+func (m *NatsManager) allowKeyValueStore(permissions *entity.UserPermissions, keyValueStore string, readOnly bool) {
+	if !readOnly {
+		permissions.Allow(fmt.Sprintf("$KV.%s.>", keyValueStore))
+	}
+
+	permissions.AllowPublish(m.getJetStreamAPISubjects("KV_"+keyValueStore, readOnly)...)
+}
+
+// getJetStreamAPISubjects returns the JetStream API subjects needed to read, watch and consume a stream,
+// and to purge it unless it's read only.
+func (m *NatsManager) getJetStreamAPISubjects(stream string, readOnly bool) []string {
+	subjects := []string{
+		"$JS.API.STREAM.INFO." + stream,
+		"$JS.API.STREAM.MSG.GET." + stream,
+		"$JS.API.DIRECT.GET." + stream,
+		"$JS.API.DIRECT.GET." + stream + ".>",
+		"$JS.API.CONSUMER.CREATE." + stream,
+		"$JS.API.CONSUMER.CREATE." + stream + ".>",
+		"$JS.API.CONSUMER.DURABLE.CREATE." + stream + ".>",
+		"$JS.API.CONSUMER.INFO." + stream + ".>",
+		"$JS.API.CONSUMER.DELETE." + stream + ".>",
+		"$JS.API.CONSUMER.MSG.NEXT." + stream + ".>",
+		"$JS.ACK." + stream + ".>",
+		"$JS.FC." + stream + ".>",
+	}
+
+	if !readOnly {
+		subjects = append(subjects, "$JS.API.STREAM.PURGE."+stream)
+	}
+
+	return subjects
+}
+
+func (m *NatsManager) getVersionUserName(productID, versionTag string) string {
+	return m.replaceInvalidCharacters(m.joinWithUnderscores(productID, versionTag))
+}
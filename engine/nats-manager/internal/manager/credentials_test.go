@@ -0,0 +1,137 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	_testVersionUser = "test-product_v1_0_0"
+	_testCredentials = "-----BEGIN NATS USER JWT-----"
+)
+
+type CredentialsSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestCredentialsSuite(t *testing.T) {
+	suite.Run(t, new(CredentialsSuite))
+}
+
+func (s *CredentialsSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func (s *CredentialsSuite) TestCreateVersionCredentials() {
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().
+			WithProcessName(_testProcess).
+			WithProcessObjectStore(&entity.ObjectStore{Name: "test-objstore", Scope: entity.ObjStoreScopeProject}).
+			Build(),
+	}
+
+	var permissions *entity.UserPermissions
+
+	s.client.EXPECT().CreateUser(_testVersionUser, gomock.Any()).
+		DoAndReturn(func(_ string, p *entity.UserPermissions) (string, error) {
+			permissions = p
+			return _testCredentials, nil
+		})
+
+	credentials, err := s.natsManager.CreateVersionCredentials(_testProductID, _testVersionTag, workflows)
+	s.Require().NoError(err)
+	s.Equal(_testCredentials, credentials)
+
+	s.Contains(permissions.Publish, _testStream+".>")
+	s.Contains(permissions.Subscribe, _testStream+".>")
+	s.Contains(permissions.Publish, "$JS.API.CONSUMER.CREATE."+_testStream+".>")
+	s.Contains(permissions.Publish, "$O."+_testVersionObjectStore+".>")
+	s.Contains(permissions.Publish, "$JS.API.STREAM.INFO.OBJ_"+_testVersionObjectStore)
+	s.Contains(permissions.Publish, "$KV.key-store_test-product_v1_0_0.>")
+	s.Contains(permissions.Publish, "$KV.key-store_test-product_v1_0_0_test-workflow_"+_testProcess+".>")
+	s.Contains(permissions.Subscribe, "_INBOX.>")
+}
+
+func (s *CredentialsSuite) TestCreateVersionCredentials_GlobalKeyValueStoreIsReadOnly() {
+	workflows := []entity.Workflow{NewWorkflowBuilder().Build()}
+
+	var permissions *entity.UserPermissions
+
+	s.client.EXPECT().CreateUser(_testVersionUser, gomock.Any()).
+		DoAndReturn(func(_ string, p *entity.UserPermissions) (string, error) {
+			permissions = p
+			return _testCredentials, nil
+		})
+
+	_, err := s.natsManager.CreateVersionCredentials(_testProductID, _testVersionTag, workflows)
+	s.Require().NoError(err)
+
+	s.Contains(permissions.Publish, "$JS.API.DIRECT.GET.KV_key-store_test-product.>")
+	s.NotContains(permissions.Publish, "$KV.key-store_test-product.>")
+	s.NotContains(permissions.Publish, "$JS.API.STREAM.PURGE.KV_key-store_test-product")
+}
+
+func (s *CredentialsSuite) TestCreateVersionCredentials_AuthDisabled() {
+	workflows := []entity.Workflow{NewWorkflowBuilder().Build()}
+
+	s.client.EXPECT().CreateUser(_testVersionUser, gomock.Any()).Return("", internal.ErrNatsAuthDisabled)
+
+	credentials, err := s.natsManager.CreateVersionCredentials(_testProductID, _testVersionTag, workflows)
+	s.Require().NoError(err)
+	s.Empty(credentials)
+}
+
+func (s *CredentialsSuite) TestCreateVersionCredentials_NoWorkflows() {
+	_, err := s.natsManager.CreateVersionCredentials(_testProductID, _testVersionTag, nil)
+	s.ErrorIs(err, internal.ErrNoWorkflowsDefined)
+}
+
+func (s *CredentialsSuite) TestCreateVersionCredentials_ClientError() {
+	workflows := []entity.Workflow{NewWorkflowBuilder().Build()}
+	clientErr := errors.New("client error")
+
+	s.client.EXPECT().CreateUser(_testVersionUser, gomock.Any()).Return("", clientErr)
+
+	_, err := s.natsManager.CreateVersionCredentials(_testProductID, _testVersionTag, workflows)
+	s.ErrorIs(err, clientErr)
+}
+
+func (s *CredentialsSuite) TestRevokeVersionCredentials() {
+	s.client.EXPECT().RevokeUser(_testVersionUser).Return(nil)
+
+	err := s.natsManager.RevokeVersionCredentials(_testProductID, _testVersionTag)
+	s.Require().NoError(err)
+}
+
+func (s *CredentialsSuite) TestRevokeVersionCredentials_AuthDisabled() {
+	s.client.EXPECT().RevokeUser(_testVersionUser).Return(internal.ErrNatsAuthDisabled)
+
+	err := s.natsManager.RevokeVersionCredentials(_testProductID, _testVersionTag)
+	s.Require().NoError(err)
+}
+
+func (s *CredentialsSuite) TestRevokeVersionCredentials_ClientError() {
+	clientErr := errors.New("client error")
+
+	s.client.EXPECT().RevokeUser(_testVersionUser).Return(clientErr)
+
+	err := s.natsManager.RevokeVersionCredentials(_testProductID, _testVersionTag)
+	s.ErrorIs(err, clientErr)
+}
@@ -0,0 +1,163 @@
+package manager
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+const (
+	_deadLetterStreamSuffix = "dlq"
+
+	// _replayConsumerHeader names the only consumer meant to handle a replayed message. The other consumers of the
+	// subject already handled it, so they acknowledge it without handling it again.
+	_replayConsumerHeader = "Kai-Replay-Consumer"
+)
+
+// StartDeadLettering copies the messages that the consumers of the workflow streams could not handle to the
+// dead-letter stream of their workflow, as JetStream notifies them.
+func (m *NatsManager) StartDeadLettering() error {
+	return m.client.ConsumeMaxDeliveriesAdvisories(m.copyToDeadLetterStream)
+}
+
+func (m *NatsManager) copyToDeadLetterStream(advisory *entity.MaxDeliveriesAdvisory) error {
+	deadLetterStream := m.getDeadLetterStreamName(advisory.Stream)
+
+	err := m.client.CopyToDeadLetterStream(deadLetterStream, advisory)
+	if errors.Is(err, internal.ErrDeadLetterStreamNotFound) {
+		// Only workflow streams have a dead-letter stream.
+		m.logger.V(1).Info("Stream without dead-letter stream, skipping message",
+			"stream", advisory.Stream,
+			"sequence", advisory.StreamSequence,
+		)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error dead-lettering message %d of stream %q: %w", advisory.StreamSequence, advisory.Stream, err)
+	}
+
+	m.logger.Info("Message sent to the dead-letter stream",
+		"stream", advisory.Stream,
+		"sequence", advisory.StreamSequence,
+		"consumer", advisory.Consumer,
+	)
+
+	return nil
+}
+
+// GetDeadLetterMessages returns the messages of the workflow that its processes could not handle.
+func (m *NatsManager) GetDeadLetterMessages(productID, versionTag, workflow string) ([]entity.DeadLetterMessage, error) {
+	deadLetterStream := m.getDeadLetterStreamName(m.getStreamName(productID, versionTag, workflow))
+
+	messages, err := m.client.GetDeadLetterMessages(deadLetterStream)
+	if err != nil {
+		return nil, fmt.Errorf("error getting dead-letter messages from stream %q: %w", deadLetterStream, err)
+	}
+
+	return messages, nil
+}
+
+// ReplayDeadLetterMessages publishes the given dead-letter messages again to their original subject, for the consumer
+// that failed them only, and removes them from the dead-letter stream. Every dead-letter message of the workflow is
+// replayed when no sequence is given. It returns the number of replayed messages.
+func (m *NatsManager) ReplayDeadLetterMessages(productID, versionTag, workflow string, sequences []uint64) (int, error) {
+	deadLetterStream := m.getDeadLetterStreamName(m.getStreamName(productID, versionTag, workflow))
+
+	messages, err := m.getDeadLetterMessagesBySequence(deadLetterStream, sequences)
+	if err != nil {
+		return 0, err
+	}
+
+	replayed := 0
+
+	for _, message := range messages {
+		if message.Subject == "" {
+			return replayed, fmt.Errorf("%w: sequence %d", internal.ErrOriginalMessageNotFound, message.Sequence)
+		}
+
+		err = m.client.PublishMessage(message.Subject, m.getReplayHeaders(message), message.Data)
+		if err != nil {
+			return replayed, fmt.Errorf("error replaying dead-letter message %d: %w", message.Sequence, err)
+		}
+
+		err = m.client.DeleteMessage(deadLetterStream, message.Sequence)
+		if err != nil {
+			return replayed, fmt.Errorf("error deleting replayed dead-letter message %d: %w", message.Sequence, err)
+		}
+
+		replayed++
+	}
+
+	return replayed, nil
+}
+
+// PurgeDeadLetterMessages removes the given messages from the workflow dead-letter stream, or all of them when no
+// sequence is given. It returns the number of removed messages.
+func (m *NatsManager) PurgeDeadLetterMessages(productID, versionTag, workflow string, sequences []uint64) (int, error) {
+	deadLetterStream := m.getDeadLetterStreamName(m.getStreamName(productID, versionTag, workflow))
+
+	if len(sequences) == 0 {
+		purged, err := m.client.PurgeStream(deadLetterStream)
+		if err != nil {
+			return 0, fmt.Errorf("error purging stream %q: %w", deadLetterStream, err)
+		}
+
+		return int(purged), nil
+	}
+
+	for i, sequence := range sequences {
+		err := m.client.DeleteMessage(deadLetterStream, sequence)
+		if err != nil {
+			return i, fmt.Errorf("error deleting dead-letter message %d: %w", sequence, err)
+		}
+	}
+
+	return len(sequences), nil
+}
+
+func (m *NatsManager) getDeadLetterMessagesBySequence(
+	deadLetterStream string,
+	sequences []uint64,
+) ([]entity.DeadLetterMessage, error) {
+	if len(sequences) == 0 {
+		messages, err := m.client.GetDeadLetterMessages(deadLetterStream)
+		if err != nil {
+			return nil, fmt.Errorf("error getting dead-letter messages from stream %q: %w", deadLetterStream, err)
+		}
+
+		return messages, nil
+	}
+
+	messages := make([]entity.DeadLetterMessage, 0, len(sequences))
+
+	for _, sequence := range sequences {
+		message, err := m.client.GetDeadLetterMessage(deadLetterStream, sequence)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, *message)
+	}
+
+	return messages, nil
+}
+
+func (m *NatsManager) getReplayHeaders(message entity.DeadLetterMessage) map[string][]string {
+	headers := make(map[string][]string, len(message.Headers)+1)
+
+	for header, values := range message.Headers {
+		headers[header] = values
+	}
+
+	headers[_replayConsumerHeader] = []string{message.Consumer}
+
+	return headers
+}
+
+func (m *NatsManager) getDeadLetterStreamName(stream string) string {
+	return m.joinWithUnderscores(stream, _deadLetterStreamSuffix)
+}
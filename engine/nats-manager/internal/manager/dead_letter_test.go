@@ -0,0 +1,193 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+const _testDeadLetterStream = "test-product_v1_0_0_test-workflow_dlq"
+
+type DeadLetterSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestDeadLetterSuite(t *testing.T) {
+	suite.Run(t, new(DeadLetterSuite))
+}
+
+func (s *DeadLetterSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func getTestDeadLetterMessage(sequence uint64) entity.DeadLetterMessage {
+	return entity.DeadLetterMessage{
+		Sequence:       sequence,
+		Stream:         _testStream,
+		StreamSequence: sequence + 100,
+		Consumer:       "test-workflow-test-process",
+		Deliveries:     3,
+		Subject:        _testStream + "." + _testProcess,
+		Headers:        map[string][]string{"trace-id": {"test-trace"}},
+		Data:           []byte("test-data"),
+	}
+}
+
+func getTestReplayHeaders(message entity.DeadLetterMessage) map[string][]string {
+	return map[string][]string{
+		"trace-id":            message.Headers["trace-id"],
+		"Kai-Replay-Consumer": {message.Consumer},
+	}
+}
+
+func (s *DeadLetterSuite) TestStartDeadLettering() {
+	advisory := &entity.MaxDeliveriesAdvisory{
+		Stream:         _testStream,
+		StreamSequence: 10,
+		Consumer:       "test-workflow-test-process",
+		Deliveries:     3,
+	}
+
+	s.client.EXPECT().ConsumeMaxDeliveriesAdvisories(gomock.Any()).DoAndReturn(
+		func(handler func(*entity.MaxDeliveriesAdvisory) error) error {
+			return handler(advisory)
+		},
+	)
+	s.client.EXPECT().CopyToDeadLetterStream(_testDeadLetterStream, advisory).Return(nil)
+
+	err := s.natsManager.StartDeadLettering()
+	s.Require().NoError(err)
+}
+
+func (s *DeadLetterSuite) TestStartDeadLettering_SkipsStreamsWithoutDeadLetterStream() {
+	advisory := &entity.MaxDeliveriesAdvisory{Stream: "KV_test-store", StreamSequence: 10}
+
+	s.client.EXPECT().ConsumeMaxDeliveriesAdvisories(gomock.Any()).DoAndReturn(
+		func(handler func(*entity.MaxDeliveriesAdvisory) error) error {
+			return handler(advisory)
+		},
+	)
+	s.client.EXPECT().CopyToDeadLetterStream("KV_test-store_dlq", advisory).Return(internal.ErrDeadLetterStreamNotFound)
+
+	err := s.natsManager.StartDeadLettering()
+	s.Require().NoError(err)
+}
+
+func (s *DeadLetterSuite) TestStartDeadLettering_CopyFails() {
+	advisory := &entity.MaxDeliveriesAdvisory{Stream: _testStream, StreamSequence: 10}
+	expectedErr := errors.New("publish error")
+
+	s.client.EXPECT().ConsumeMaxDeliveriesAdvisories(gomock.Any()).DoAndReturn(
+		func(handler func(*entity.MaxDeliveriesAdvisory) error) error {
+			return handler(advisory)
+		},
+	)
+	s.client.EXPECT().CopyToDeadLetterStream(_testDeadLetterStream, advisory).Return(expectedErr)
+
+	err := s.natsManager.StartDeadLettering()
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *DeadLetterSuite) TestGetDeadLetterMessages() {
+	expectedMessages := []entity.DeadLetterMessage{getTestDeadLetterMessage(1)}
+
+	s.client.EXPECT().GetDeadLetterMessages(_testDeadLetterStream).Return(expectedMessages, nil)
+
+	messages, err := s.natsManager.GetDeadLetterMessages(_testProductID, _testVersionTag, _testWorkflow)
+	s.Require().NoError(err)
+	s.Equal(expectedMessages, messages)
+}
+
+func (s *DeadLetterSuite) TestReplayDeadLetterMessages_SelectedSequences() {
+	message := getTestDeadLetterMessage(2)
+
+	s.client.EXPECT().GetDeadLetterMessage(_testDeadLetterStream, uint64(2)).Return(&message, nil)
+	s.client.EXPECT().PublishMessage(message.Subject, getTestReplayHeaders(message), message.Data).Return(nil)
+	s.client.EXPECT().DeleteMessage(_testDeadLetterStream, uint64(2)).Return(nil)
+
+	replayed, err := s.natsManager.ReplayDeadLetterMessages(_testProductID, _testVersionTag, _testWorkflow, []uint64{2})
+	s.Require().NoError(err)
+	s.Equal(1, replayed)
+}
+
+func (s *DeadLetterSuite) TestReplayDeadLetterMessages_AllMessages() {
+	messages := []entity.DeadLetterMessage{getTestDeadLetterMessage(1), getTestDeadLetterMessage(2)}
+
+	s.client.EXPECT().GetDeadLetterMessages(_testDeadLetterStream).Return(messages, nil)
+	s.client.EXPECT().
+		PublishMessage(messages[0].Subject, getTestReplayHeaders(messages[0]), messages[0].Data).
+		Return(nil).
+		Times(2)
+	s.client.EXPECT().DeleteMessage(_testDeadLetterStream, uint64(1)).Return(nil)
+	s.client.EXPECT().DeleteMessage(_testDeadLetterStream, uint64(2)).Return(nil)
+
+	replayed, err := s.natsManager.ReplayDeadLetterMessages(_testProductID, _testVersionTag, _testWorkflow, nil)
+	s.Require().NoError(err)
+	s.Equal(2, replayed)
+}
+
+func (s *DeadLetterSuite) TestReplayDeadLetterMessages_OriginalMessageNotFound() {
+	message := getTestDeadLetterMessage(1)
+	message.Subject = ""
+
+	s.client.EXPECT().GetDeadLetterMessage(_testDeadLetterStream, uint64(1)).Return(&message, nil)
+
+	replayed, err := s.natsManager.ReplayDeadLetterMessages(_testProductID, _testVersionTag, _testWorkflow, []uint64{1})
+	s.ErrorIs(err, internal.ErrOriginalMessageNotFound)
+	s.Zero(replayed)
+}
+
+func (s *DeadLetterSuite) TestReplayDeadLetterMessages_PublishFails() {
+	message := getTestDeadLetterMessage(1)
+	expectedErr := errors.New("publish error")
+
+	s.client.EXPECT().GetDeadLetterMessage(_testDeadLetterStream, uint64(1)).Return(&message, nil)
+	s.client.EXPECT().PublishMessage(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
+
+	_, err := s.natsManager.ReplayDeadLetterMessages(_testProductID, _testVersionTag, _testWorkflow, []uint64{1})
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *DeadLetterSuite) TestPurgeDeadLetterMessages_AllMessages() {
+	s.client.EXPECT().PurgeStream(_testDeadLetterStream).Return(uint64(5), nil)
+
+	purged, err := s.natsManager.PurgeDeadLetterMessages(_testProductID, _testVersionTag, _testWorkflow, nil)
+	s.Require().NoError(err)
+	s.Equal(5, purged)
+}
+
+func (s *DeadLetterSuite) TestPurgeDeadLetterMessages_SelectedSequences() {
+	s.client.EXPECT().DeleteMessage(_testDeadLetterStream, uint64(1)).Return(nil)
+	s.client.EXPECT().DeleteMessage(_testDeadLetterStream, uint64(3)).Return(nil)
+
+	purged, err := s.natsManager.PurgeDeadLetterMessages(_testProductID, _testVersionTag, _testWorkflow, []uint64{1, 3})
+	s.Require().NoError(err)
+	s.Equal(2, purged)
+}
+
+func (s *DeadLetterSuite) TestPurgeDeadLetterMessages_DeleteFails() {
+	expectedErr := errors.New("delete error")
+
+	s.client.EXPECT().DeleteMessage(_testDeadLetterStream, uint64(1)).Return(nil)
+	s.client.EXPECT().DeleteMessage(_testDeadLetterStream, uint64(3)).Return(expectedErr)
+
+	purged, err := s.natsManager.PurgeDeadLetterMessages(_testProductID, _testVersionTag, _testWorkflow, []uint64{1, 3})
+	s.ErrorIs(err, expectedErr)
+	s.Equal(1, purged)
+}
@@ -0,0 +1,49 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+const _maxPeekedMessages = 100
+
+// GetProcessMessages returns the last messages published by a process to its subject, newest first.
+func (m *NatsManager) GetProcessMessages(
+	productID, versionTag, workflow, process string,
+	count int,
+) ([]entity.StreamMessage, error) {
+	if count <= 0 || count > _maxPeekedMessages {
+		return nil, fmt.Errorf("%w: %d, must be between 1 and %d", internal.ErrInvalidMessagesCount, count, _maxPeekedMessages)
+	}
+
+	stream := m.getStreamName(productID, versionTag, workflow)
+	subject := m.getSubjectName(stream, process)
+
+	messages, err := m.client.GetLastMessages(stream, subject, count)
+	if err != nil {
+		return nil, fmt.Errorf("error getting messages of subject %q: %w", subject, err)
+	}
+
+	return messages, nil
+}
+
+// PublishMessage publishes a message to a subject of one of the version streams.
+func (m *NatsManager) PublishMessage(
+	productID, versionTag, subject string,
+	headers map[string][]string,
+	data []byte,
+) error {
+	stream, _, _ := strings.Cut(subject, ".")
+	if !strings.HasPrefix(stream, m.getStreamName(productID, versionTag, "")) {
+		return fmt.Errorf("%w: %q", internal.ErrSubjectNotInVersion, subject)
+	}
+
+	if err := m.client.PublishMessage(subject, headers, data); err != nil {
+		return fmt.Errorf("error publishing message to subject %q: %w", subject, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type MessagesSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestMessagesSuite(t *testing.T) {
+	suite.Run(t, new(MessagesSuite))
+}
+
+func (s *MessagesSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func (s *MessagesSuite) TestGetProcessMessages() {
+	subject := _testStream + "." + _testProcess
+	expectedMessages := []entity.StreamMessage{
+		{Sequence: 2, Subject: subject, Data: []byte(`{"key":"value"}`)},
+	}
+
+	s.client.EXPECT().GetLastMessages(_testStream, subject, 5).Return(expectedMessages, nil)
+
+	messages, err := s.natsManager.GetProcessMessages(_testProductID, _testVersionTag, _testWorkflow, _testProcess, 5)
+	s.Require().NoError(err)
+	s.Equal(expectedMessages, messages)
+}
+
+func (s *MessagesSuite) TestGetProcessMessages_InvalidCount() {
+	for _, count := range []int{0, -1, 101} {
+		_, err := s.natsManager.GetProcessMessages(_testProductID, _testVersionTag, _testWorkflow, _testProcess, count)
+		s.ErrorIs(err, internal.ErrInvalidMessagesCount)
+	}
+}
+
+func (s *MessagesSuite) TestGetProcessMessages_ClientError() {
+	expectedErr := errors.New("stream not found")
+
+	s.client.EXPECT().GetLastMessages(_testStream, gomock.Any(), 1).Return(nil, expectedErr)
+
+	_, err := s.natsManager.GetProcessMessages(_testProductID, _testVersionTag, _testWorkflow, _testProcess, 1)
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *MessagesSuite) TestPublishMessage() {
+	subject := _testStream + "." + _testProcess
+	headers := map[string][]string{"test-header": {"test-value"}}
+	data := []byte("test-data")
+
+	s.client.EXPECT().PublishMessage(subject, headers, data).Return(nil)
+
+	err := s.natsManager.PublishMessage(_testProductID, _testVersionTag, subject, headers, data)
+	s.Require().NoError(err)
+}
+
+func (s *MessagesSuite) TestPublishMessage_SubjectNotInVersion() {
+	for _, subject := range []string{
+		"other-product_v1_0_0_test-workflow.test-process",
+		"test-product_v2_0_0_test-workflow.test-process",
+		"$JS.API.STREAM.DELETE." + _testStream,
+	} {
+		err := s.natsManager.PublishMessage(_testProductID, _testVersionTag, subject, nil, []byte("test-data"))
+		s.ErrorIs(err, internal.ErrSubjectNotInVersion)
+	}
+}
@@ -12,7 +12,8 @@ import (
 )
 
 const (
-	_keyValueStorePrefix = "key-store_"
+	_keyValueStorePrefix      = "key-store_"
+	_productObjectStorePrefix = "object-store_"
 )
 
 type NatsManager struct {
@@ -36,22 +37,28 @@ func (m *NatsManager) CreateStreams(
 		return nil, internal.ErrNoWorkflowsDefined
 	}
 
+	if err := m.validateWorkflows(workflows); err != nil {
+		return nil, err
+	}
+
 	workflowsStreamsConfig := entity.WorkflowsStreamsConfig{}
 
 	for _, workflow := range workflows {
-		stream := m.getStreamName(productID, versionTag, workflow.Name)
-		processesStreamConfig := m.getProcessesStreamConfig(stream, workflow.Processes)
-
-		streamConfig := &entity.StreamConfig{
-			Stream:    stream,
-			Processes: processesStreamConfig,
-		}
+		streamConfig := m.getStreamConfig(productID, versionTag, workflow, workflows)
+		stream := streamConfig.Stream
 
 		err := m.client.CreateStream(streamConfig)
 		if err != nil {
 			return nil, fmt.Errorf("error creating stream %q: %w", stream, err)
 		}
 
+		deadLetterStream := m.getDeadLetterStreamName(stream)
+
+		err = m.client.CreateDeadLetterStream(deadLetterStream)
+		if err != nil {
+			return nil, fmt.Errorf("error creating dead-letter stream %q: %w", deadLetterStream, err)
+		}
+
 		workflowsStreamsConfig[workflow.Name] = streamConfig
 	}
 
@@ -86,7 +93,12 @@ func (m *NatsManager) CreateObjectStores(
 				return nil, err
 			}
 
-			err = m.client.CreateObjectStore(objectStore)
+			if process.ObjectStore.Scope == entity.ObjStoreScopeProduct {
+				err = m.ensureProductObjectStore(objectStore)
+			} else {
+				err = m.client.CreateObjectStore(objectStore, process.ObjectStore.Settings)
+			}
+
 			if err != nil {
 				return nil, fmt.Errorf("error creating object store %q: %w", objectStore, err)
 			}
@@ -128,6 +140,8 @@ func (m *NatsManager) getObjectStoreName(productID, versionTag, workflowName str
 		return m.joinWithUnderscores(productID, versionTag, objectStore.Name), nil
 	case entity.ObjStoreScopeWorkflow:
 		return m.joinWithUnderscores(productID, versionTag, workflowName, objectStore.Name), nil
+	case entity.ObjStoreScopeProduct:
+		return m.getProductObjectStoreName(productID, objectStore.Name), nil
 	case entity.ObjStoreScopeUndefined:
 		return "", internal.ErrInvalidObjectStoreScope
 	default:
@@ -153,35 +167,122 @@ func (m *NatsManager) DeleteObjectStores(productID, versionTag string) error {
 	return nil
 }
 
+func (m *NatsManager) getStreamConfig(
+	productID, versionTag string,
+	workflow entity.Workflow,
+	workflows []entity.Workflow,
+) *entity.StreamConfig {
+	processesStreamConfig, sources := m.getProcessesStreamConfig(productID, versionTag, workflow, workflows)
+
+	return &entity.StreamConfig{
+		Stream:    m.getStreamName(productID, versionTag, workflow.Name),
+		Processes: processesStreamConfig,
+		Settings:  workflow.Stream,
+		Sources:   sources,
+	}
+}
+
 func (m *NatsManager) getStreamName(productID, versionTag, workflowID string) string {
 	versionTag = strings.ReplaceAll(versionTag, ".", "_")
 	return m.joinWithUnderscores(productID, versionTag, workflowID)
 }
 
-func (m *NatsManager) getProcessesStreamConfig(stream string, processes []entity.Process) entity.ProcessesStreamConfig {
+// getProcessesStreamConfig returns the subjects of the workflow processes and the sources the workflow stream needs
+// to receive the messages of the processes of other workflows its processes subscribe to.
+func (m *NatsManager) getProcessesStreamConfig(
+	productID, versionTag string,
+	workflow entity.Workflow,
+	workflows []entity.Workflow,
+) (entity.ProcessesStreamConfig, []entity.StreamSource) {
+	stream := m.getStreamName(productID, versionTag, workflow.Name)
 	processesConfig := entity.ProcessesStreamConfig{}
-	for _, process := range processes {
+
+	var sources []entity.StreamSource
+
+	for _, process := range workflow.Processes {
+		subjectsToSubscribe := make([]string, 0, len(process.Subscriptions))
+
+		for _, subscription := range process.Subscriptions {
+			sourceWorkflow, subject := m.resolveSubscription(workflow, workflows, subscription)
+			if sourceWorkflow == workflow.Name {
+				subjectsToSubscribe = append(subjectsToSubscribe, m.getSubjectName(stream, subject))
+				continue
+			}
+
+			sourceStream := m.getStreamName(productID, versionTag, sourceWorkflow)
+			sourceSubject := m.getSubjectName(sourceStream, subject)
+			sources = m.addStreamSourceSubject(sources, sourceStream, sourceSubject)
+			subjectsToSubscribe = append(subjectsToSubscribe, sourceSubject)
+		}
+
 		processesConfig[process.Name] = entity.ProcessStreamConfig{
 			Subject:       m.getSubjectName(stream, process.Name),
-			Subscriptions: m.getSubjectsToSubscribe(stream, process.Subscriptions),
+			Subscriptions: subjectsToSubscribe,
 		}
 	}
 
-	return processesConfig
+	return processesConfig, sources
 }
 
 func (m *NatsManager) getSubjectName(stream, process string) string {
 	return fmt.Sprintf("%s.%s", stream, process)
 }
 
-func (m *NatsManager) getSubjectsToSubscribe(stream string, subscriptions []string) []string {
-	subjectsToSubscribe := make([]string, 0, len(subscriptions))
+// resolveSubscription returns the workflow publishing to the subscription and the subject within its stream.
+// Subscriptions refer to processes of the same workflow, or to any of their subtopics, unless they are prefixed
+// with the name of another workflow, as in "serving.predictor".
+func (m *NatsManager) resolveSubscription(
+	workflow entity.Workflow,
+	workflows []entity.Workflow,
+	subscription string,
+) (string, string) {
+	for _, process := range workflow.Processes {
+		if subscription == process.Name || strings.HasPrefix(subscription, process.Name+".") {
+			return workflow.Name, subscription
+		}
+	}
+
+	workflowName, subject, hasSubject := strings.Cut(subscription, ".")
+	if !hasSubject || workflowName == workflow.Name {
+		return workflow.Name, subscription
+	}
+
+	for _, otherWorkflow := range workflows {
+		if otherWorkflow.Name == workflowName {
+			return workflowName, subject
+		}
+	}
+
+	return workflow.Name, subscription
+}
+
+// addStreamSourceSubject adds the subject to the source of the given stream, keeping the sourced subjects as they are.
+func (m *NatsManager) addStreamSourceSubject(
+	sources []entity.StreamSource,
+	sourceStream, subject string,
+) []entity.StreamSource {
+	transform := entity.SubjectTransform{Source: subject, Destination: subject}
+
+	for i := range sources {
+		if sources[i].Stream != sourceStream {
+			continue
+		}
+
+		for _, sourceTransform := range sources[i].SubjectTransforms {
+			if sourceTransform == transform {
+				return sources
+			}
+		}
+
+		sources[i].SubjectTransforms = append(sources[i].SubjectTransforms, transform)
 
-	for _, processToSubscribe := range subscriptions {
-		subjectsToSubscribe = append(subjectsToSubscribe, m.getSubjectName(stream, processToSubscribe))
+		return sources
 	}
 
-	return subjectsToSubscribe
+	return append(sources, entity.StreamSource{
+		Stream:            sourceStream,
+		SubjectTransforms: []entity.SubjectTransform{transform},
+	})
 }
 
 func (m *NatsManager) validateWorkflows(workflows []entity.Workflow) error {
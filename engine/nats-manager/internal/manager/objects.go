@@ -0,0 +1,79 @@
+package manager
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// ListObjects returns the objects stored in one of the object stores of the product.
+func (m *NatsManager) ListObjects(productID, objectStore string) ([]entity.ObjectInfo, error) {
+	if err := m.checkObjectStoreInProduct(productID, objectStore); err != nil {
+		return nil, err
+	}
+
+	objects, err := m.client.ListObjects(objectStore)
+	if err != nil {
+		return nil, fmt.Errorf("error listing objects of object store %q: %w", objectStore, err)
+	}
+
+	return objects, nil
+}
+
+// GetObject writes the content of an object stored in one of the object stores of the product to w.
+func (m *NatsManager) GetObject(productID, objectStore, name string, w io.Writer) error {
+	if err := m.checkObjectStoreInProduct(productID, objectStore); err != nil {
+		return err
+	}
+
+	if err := m.client.GetObject(objectStore, name, w); err != nil {
+		return fmt.Errorf("error getting object %q from object store %q: %w", name, objectStore, err)
+	}
+
+	return nil
+}
+
+// PutObject stores the content read from r as an object of one of the object stores of the product.
+func (m *NatsManager) PutObject(productID, objectStore, name string, r io.Reader) (*entity.ObjectInfo, error) {
+	if err := m.checkObjectStoreInProduct(productID, objectStore); err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return nil, internal.ErrEmptyObjectName
+	}
+
+	object, err := m.client.PutObject(objectStore, name, r)
+	if err != nil {
+		return nil, fmt.Errorf("error putting object %q to object store %q: %w", name, objectStore, err)
+	}
+
+	return object, nil
+}
+
+// DeleteObject deletes an object from one of the object stores of the product.
+func (m *NatsManager) DeleteObject(productID, objectStore, name string) error {
+	if err := m.checkObjectStoreInProduct(productID, objectStore); err != nil {
+		return err
+	}
+
+	if err := m.client.DeleteObject(objectStore, name); err != nil {
+		return fmt.Errorf("error deleting object %q from object store %q: %w", name, objectStore, err)
+	}
+
+	return nil
+}
+
+// checkObjectStoreInProduct checks the object store is either one of the product version object stores
+// or one of the object stores shared by every version of the product.
+func (m *NatsManager) checkObjectStoreInProduct(productID, objectStore string) error {
+	if strings.HasPrefix(objectStore, productID+"_") ||
+		strings.HasPrefix(objectStore, m.getProductObjectStoreName(productID, "")) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %q", internal.ErrObjectStoreNotInProduct, objectStore)
+}
@@ -0,0 +1,125 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+const _testVersionObjectStore = "test-product_v1_0_0_test-objstore"
+
+type ObjectsSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestObjectsSuite(t *testing.T) {
+	suite.Run(t, new(ObjectsSuite))
+}
+
+func (s *ObjectsSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func (s *ObjectsSuite) TestListObjects() {
+	expectedObjects := []entity.ObjectInfo{
+		{Name: "object1", Size: 10, Digest: "SHA-256=digest", Modified: time.Now()},
+	}
+
+	s.client.EXPECT().ListObjects(_testVersionObjectStore).Return(expectedObjects, nil)
+
+	objects, err := s.natsManager.ListObjects(_testProductID, _testVersionObjectStore)
+	s.Require().NoError(err)
+	s.Equal(expectedObjects, objects)
+}
+
+func (s *ObjectsSuite) TestListObjects_ProductObjectStore() {
+	s.client.EXPECT().ListObjects(_testProductObjectStore).Return([]entity.ObjectInfo{}, nil)
+
+	objects, err := s.natsManager.ListObjects(_testProductID, _testProductObjectStore)
+	s.Require().NoError(err)
+	s.Empty(objects)
+}
+
+func (s *ObjectsSuite) TestListObjects_ObjectStoreNotInProduct() {
+	_, err := s.natsManager.ListObjects(_testProductID, "other-product_v1_0_0_test-objstore")
+	s.ErrorIs(err, internal.ErrObjectStoreNotInProduct)
+
+	_, err = s.natsManager.ListObjects(_testProductID, "object-store_other-product_models")
+	s.ErrorIs(err, internal.ErrObjectStoreNotInProduct)
+}
+
+func (s *ObjectsSuite) TestGetObject() {
+	var content bytes.Buffer
+
+	s.client.EXPECT().GetObject(_testVersionObjectStore, "object1", &content).Return(nil)
+
+	err := s.natsManager.GetObject(_testProductID, _testVersionObjectStore, "object1", &content)
+	s.Require().NoError(err)
+}
+
+func (s *ObjectsSuite) TestGetObject_ClientError() {
+	var content bytes.Buffer
+
+	s.client.EXPECT().
+		GetObject(_testVersionObjectStore, "object1", &content).
+		Return(internal.ErrObjectNotFound)
+
+	err := s.natsManager.GetObject(_testProductID, _testVersionObjectStore, "object1", &content)
+	s.ErrorIs(err, internal.ErrObjectNotFound)
+}
+
+func (s *ObjectsSuite) TestPutObject() {
+	content := strings.NewReader("content")
+	expectedObject := &entity.ObjectInfo{Name: "object1", Size: 7}
+
+	s.client.EXPECT().PutObject(_testProductObjectStore, "object1", content).Return(expectedObject, nil)
+
+	object, err := s.natsManager.PutObject(_testProductID, _testProductObjectStore, "object1", content)
+	s.Require().NoError(err)
+	s.Equal(expectedObject, object)
+}
+
+func (s *ObjectsSuite) TestPutObject_EmptyName() {
+	_, err := s.natsManager.PutObject(_testProductID, _testProductObjectStore, "", strings.NewReader("content"))
+	s.ErrorIs(err, internal.ErrEmptyObjectName)
+}
+
+func (s *ObjectsSuite) TestDeleteObject() {
+	s.client.EXPECT().DeleteObject(_testVersionObjectStore, "object1").Return(nil)
+
+	err := s.natsManager.DeleteObject(_testProductID, _testVersionObjectStore, "object1")
+	s.Require().NoError(err)
+}
+
+func (s *ObjectsSuite) TestDeleteObject_ClientError() {
+	clientErr := errors.New("client error")
+
+	s.client.EXPECT().DeleteObject(_testVersionObjectStore, "object1").Return(clientErr)
+
+	err := s.natsManager.DeleteObject(_testProductID, _testVersionObjectStore, "object1")
+	s.ErrorIs(err, clientErr)
+}
+
+func (s *ObjectsSuite) TestDeleteObject_ObjectStoreNotInProduct() {
+	err := s.natsManager.DeleteObject(_testProductID, "other-product_v1_0_0_test-objstore", "object1")
+	s.ErrorIs(err, internal.ErrObjectStoreNotInProduct)
+}
@@ -0,0 +1,100 @@
+package manager
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// CreateProductObjectStore creates an object store shared by every version of the product.
+// Unlike version object stores, it isn't deleted when a version stops and its objects don't expire.
+func (m *NatsManager) CreateProductObjectStore(productID, name string) (string, error) {
+	objectStore := &entity.ObjectStore{Name: name, Scope: entity.ObjStoreScopeProduct}
+	if err := objectStore.Validate(); err != nil {
+		return "", err
+	}
+
+	objectStoreName := m.getProductObjectStoreName(productID, name)
+
+	exists, err := m.productObjectStoreExists(objectStoreName)
+	if err != nil {
+		return "", err
+	}
+
+	if exists {
+		return "", fmt.Errorf("%w: %q", internal.ErrObjectStoreAlreadyExists, name)
+	}
+
+	if err := m.client.CreatePersistentObjectStore(objectStoreName); err != nil {
+		return "", fmt.Errorf("error creating object store %q: %w", objectStoreName, err)
+	}
+
+	return objectStoreName, nil
+}
+
+// GetProductObjectStores returns the names of the object stores shared by every version of the product.
+func (m *NatsManager) GetProductObjectStores(productID string) ([]string, error) {
+	prefix := m.getProductObjectStoreName(productID, "")
+
+	objectStores, err := m.client.GetObjectStoreNames(regexp.MustCompile("^" + regexp.QuoteMeta(prefix)))
+	if err != nil {
+		return nil, fmt.Errorf("error getting object store names: %w", err)
+	}
+
+	names := make([]string, 0, len(objectStores))
+	for _, objectStore := range objectStores {
+		names = append(names, strings.TrimPrefix(objectStore, prefix))
+	}
+
+	return names, nil
+}
+
+// DeleteProductObjectStore deletes an object store shared by every version of the product, with all its objects.
+func (m *NatsManager) DeleteProductObjectStore(productID, name string) error {
+	objectStoreName := m.getProductObjectStoreName(productID, name)
+
+	exists, err := m.productObjectStoreExists(objectStoreName)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("%w: %q", internal.ErrObjectStoreNotFound, name)
+	}
+
+	if err := m.client.DeleteObjectStore(objectStoreName); err != nil {
+		return fmt.Errorf("error deleting object store %q: %w", objectStoreName, err)
+	}
+
+	return nil
+}
+
+// ensureProductObjectStore creates the product object store used by a version unless it already exists.
+func (m *NatsManager) ensureProductObjectStore(objectStoreName string) error {
+	exists, err := m.productObjectStoreExists(objectStoreName)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	return m.client.CreatePersistentObjectStore(objectStoreName)
+}
+
+func (m *NatsManager) productObjectStoreExists(objectStoreName string) (bool, error) {
+	objectStores, err := m.client.GetObjectStoreNames(regexp.MustCompile("^" + regexp.QuoteMeta(objectStoreName) + "$"))
+	if err != nil {
+		return false, fmt.Errorf("error getting object store names: %w", err)
+	}
+
+	return len(objectStores) > 0, nil
+}
+
+func (m *NatsManager) getProductObjectStoreName(productID, name string) string {
+	return fmt.Sprintf("%s%s_%s", _productObjectStorePrefix, productID, name)
+}
@@ -0,0 +1,127 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+const _testProductObjectStore = "object-store_test-product_models"
+
+type ProductObjectStoresSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestProductObjectStoresSuite(t *testing.T) {
+	suite.Run(t, new(ProductObjectStoresSuite))
+}
+
+func (s *ProductObjectStoresSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func (s *ProductObjectStoresSuite) expectExistingObjectStores(objectStores ...string) {
+	s.client.EXPECT().
+		GetObjectStoreNames(regexp.MustCompile("^"+regexp.QuoteMeta(_testProductObjectStore)+"$")).
+		Return(objectStores, nil)
+}
+
+func (s *ProductObjectStoresSuite) TestCreateProductObjectStore() {
+	s.expectExistingObjectStores()
+	s.client.EXPECT().CreatePersistentObjectStore(_testProductObjectStore).Return(nil)
+
+	objectStore, err := s.natsManager.CreateProductObjectStore(_testProductID, "models")
+	s.Require().NoError(err)
+	s.Equal(_testProductObjectStore, objectStore)
+}
+
+func (s *ProductObjectStoresSuite) TestCreateProductObjectStore_AlreadyExists() {
+	s.expectExistingObjectStores(_testProductObjectStore)
+
+	_, err := s.natsManager.CreateProductObjectStore(_testProductID, "models")
+	s.ErrorIs(err, internal.ErrObjectStoreAlreadyExists)
+}
+
+func (s *ProductObjectStoresSuite) TestCreateProductObjectStore_InvalidName() {
+	_, err := s.natsManager.CreateProductObjectStore(_testProductID, "Invalid_Name")
+	s.ErrorIs(err, internal.ErrInvalidObjectStoreName)
+}
+
+func (s *ProductObjectStoresSuite) TestGetProductObjectStores() {
+	s.client.EXPECT().
+		GetObjectStoreNames(regexp.MustCompile("^"+regexp.QuoteMeta("object-store_test-product_"))).
+		Return([]string{_testProductObjectStore, "object-store_test-product_reference-data"}, nil)
+
+	names, err := s.natsManager.GetProductObjectStores(_testProductID)
+	s.Require().NoError(err)
+	s.Equal([]string{"models", "reference-data"}, names)
+}
+
+func (s *ProductObjectStoresSuite) TestDeleteProductObjectStore() {
+	s.expectExistingObjectStores(_testProductObjectStore)
+	s.client.EXPECT().DeleteObjectStore(_testProductObjectStore).Return(nil)
+
+	err := s.natsManager.DeleteProductObjectStore(_testProductID, "models")
+	s.Require().NoError(err)
+}
+
+func (s *ProductObjectStoresSuite) TestDeleteProductObjectStore_NotFound() {
+	s.expectExistingObjectStores()
+
+	err := s.natsManager.DeleteProductObjectStore(_testProductID, "models")
+	s.ErrorIs(err, internal.ErrObjectStoreNotFound)
+}
+
+func (s *ProductObjectStoresSuite) TestCreateObjectStores_ProductScopeIsSharedAcrossVersions() {
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().
+			WithProcessObjectStore(&entity.ObjectStore{Name: "models", Scope: entity.ObjStoreScopeProduct}).
+			Build(),
+	}
+
+	s.expectExistingObjectStores()
+	s.client.EXPECT().CreatePersistentObjectStore(_testProductObjectStore).Return(nil)
+
+	objectStores, err := s.natsManager.CreateObjectStores(_testProductID, "v1.0.0", workflows)
+	s.Require().NoError(err)
+
+	workflow := workflows[0]
+	s.Equal(_testProductObjectStore, objectStores[workflow.Name].Processes[workflow.Processes[0].Name])
+
+	s.expectExistingObjectStores(_testProductObjectStore)
+
+	objectStores, err = s.natsManager.CreateObjectStores(_testProductID, "v2.0.0", workflows)
+	s.Require().NoError(err)
+	s.Equal(_testProductObjectStore, objectStores[workflow.Name].Processes[workflow.Processes[0].Name])
+}
+
+func (s *ProductObjectStoresSuite) TestCreateObjectStores_ProductScopeClientError() {
+	expectedErr := errors.New("nats client error")
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().
+			WithProcessObjectStore(&entity.ObjectStore{Name: "models", Scope: entity.ObjStoreScopeProduct}).
+			Build(),
+	}
+
+	s.client.EXPECT().GetObjectStoreNames(gomock.Any()).Return(nil, expectedErr)
+
+	_, err := s.natsManager.CreateObjectStores(_testProductID, "v1.0.0", workflows)
+	s.ErrorIs(err, expectedErr)
+}
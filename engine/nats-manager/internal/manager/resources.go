@@ -0,0 +1,133 @@
+package manager
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// Product IDs are lowercase, so the streams backing object stores and key-value stores, prefixed with
+// OBJ_ and KV_, never match.
+var _productIDRegexp = regexp.MustCompile("^[a-z0-9-]+$")
+
+// ListResources returns every stream, object store and key-value store following the naming schemes of
+// product and version resources, so they can be checked against the existing products and versions.
+func (m *NatsManager) ListResources() ([]entity.NatsResource, error) {
+	streams, err := m.client.GetStreamNames()
+	if err != nil {
+		return nil, fmt.Errorf("error getting stream names: %w", err)
+	}
+
+	objectStores, err := m.client.GetObjectStoreNames()
+	if err != nil {
+		return nil, fmt.Errorf("error getting object store names: %w", err)
+	}
+
+	keyValueStores, err := m.client.GetKeyValueStoreNames()
+	if err != nil {
+		return nil, fmt.Errorf("error getting key-value store names: %w", err)
+	}
+
+	resources := make([]entity.NatsResource, 0, len(streams)+len(objectStores)+len(keyValueStores))
+	resources = m.appendResources(resources, entity.NatsResourceStream, streams)
+	resources = m.appendResources(resources, entity.NatsResourceObjectStore, objectStores)
+	resources = m.appendResources(resources, entity.NatsResourceKeyValueStore, keyValueStores)
+
+	return resources, nil
+}
+
+// DeleteResources deletes the given resources. Resources that don't follow the naming schemes are refused,
+// so resources not managed by KAI are never deleted.
+func (m *NatsManager) DeleteResources(resources []entity.NatsResource) error {
+	for _, resource := range resources {
+		if _, ok := m.parseResource(resource.Type, resource.Name); !ok {
+			return fmt.Errorf("%w: %q", internal.ErrInvalidResourceName, resource.Name)
+		}
+	}
+
+	for _, resource := range resources {
+		m.logger.Info("Deleting resource", "type", resource.Type, "name", resource.Name)
+
+		var err error
+
+		switch resource.Type {
+		case entity.NatsResourceStream:
+			err = m.client.DeleteStream(resource.Name)
+		case entity.NatsResourceObjectStore:
+			err = m.client.DeleteObjectStore(resource.Name)
+		case entity.NatsResourceKeyValueStore:
+			err = m.client.DeleteKeyValueStore(resource.Name)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting %s %q: %w", resource.Type, resource.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *NatsManager) appendResources(
+	resources []entity.NatsResource,
+	resourceType entity.NatsResourceType,
+	names []string,
+) []entity.NatsResource {
+	for _, name := range names {
+		if resource, ok := m.parseResource(resourceType, name); ok {
+			resources = append(resources, resource)
+		}
+	}
+
+	return resources
+}
+
+// parseResource gets the product and version of a resource from its name:
+//   - streams and version object stores: <product>_<version>_...
+//   - product object stores: object-store_<product>_<name>
+//   - version key-value stores: key-store_<product>_<version>[_...]
+//   - global key-value stores: key-store_<product>
+func (m *NatsManager) parseResource(resourceType entity.NatsResourceType, name string) (entity.NatsResource, bool) {
+	resource := entity.NatsResource{Name: name, Type: resourceType}
+	productScoped := false
+
+	switch resourceType {
+	case entity.NatsResourceStream:
+	case entity.NatsResourceObjectStore:
+		if strings.HasPrefix(name, _productObjectStorePrefix) {
+			name = strings.TrimPrefix(name, _productObjectStorePrefix)
+			productScoped = true
+		}
+	case entity.NatsResourceKeyValueStore:
+		if !strings.HasPrefix(name, _keyValueStorePrefix) {
+			return entity.NatsResource{}, false
+		}
+
+		name = strings.TrimPrefix(name, _keyValueStorePrefix)
+	default:
+		return entity.NatsResource{}, false
+	}
+
+	productID, versionKey, found := strings.Cut(name, "_")
+	if !_productIDRegexp.MatchString(productID) {
+		return entity.NatsResource{}, false
+	}
+
+	resource.ProductID = productID
+
+	switch {
+	case productScoped:
+		return resource, found && versionKey != ""
+	case !found:
+		// Only the global key-value store is named after the product alone.
+		return resource, resourceType == entity.NatsResourceKeyValueStore
+	case versionKey == "":
+		return entity.NatsResource{}, false
+	}
+
+	resource.VersionKey = versionKey
+
+	return resource, true
+}
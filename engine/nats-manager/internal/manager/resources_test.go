@@ -0,0 +1,123 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResourcesSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestResourcesSuite(t *testing.T) {
+	suite.Run(t, new(ResourcesSuite))
+}
+
+func (s *ResourcesSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func (s *ResourcesSuite) TestListResources() {
+	s.client.EXPECT().GetStreamNames().Return([]string{
+		_testStream,
+		_testStream + "_dlq",
+		"OBJ_" + _testVersionObjectStore,
+		"KV_key-store_test-product",
+		"external-stream",
+	}, nil)
+	s.client.EXPECT().GetObjectStoreNames().Return([]string{
+		_testVersionObjectStore,
+		_testProductObjectStore,
+	}, nil)
+	s.client.EXPECT().GetKeyValueStoreNames().Return([]string{
+		"key-store_test-product",
+		"key-store_test-product_v1_0_0",
+		"key-store_test-product_v1_0_0_test-workflow",
+		"external-kv-store",
+	}, nil)
+
+	resources, err := s.natsManager.ListResources()
+	s.Require().NoError(err)
+
+	s.Equal([]entity.NatsResource{
+		{Name: _testStream, Type: entity.NatsResourceStream, ProductID: _testProductID, VersionKey: "v1_0_0_test-workflow"},
+		{
+			Name: _testStream + "_dlq", Type: entity.NatsResourceStream,
+			ProductID: _testProductID, VersionKey: "v1_0_0_test-workflow_dlq",
+		},
+		{
+			Name: _testVersionObjectStore, Type: entity.NatsResourceObjectStore,
+			ProductID: _testProductID, VersionKey: "v1_0_0_test-objstore",
+		},
+		{Name: _testProductObjectStore, Type: entity.NatsResourceObjectStore, ProductID: _testProductID},
+		{Name: "key-store_test-product", Type: entity.NatsResourceKeyValueStore, ProductID: _testProductID},
+		{
+			Name: "key-store_test-product_v1_0_0", Type: entity.NatsResourceKeyValueStore,
+			ProductID: _testProductID, VersionKey: "v1_0_0",
+		},
+		{
+			Name: "key-store_test-product_v1_0_0_test-workflow", Type: entity.NatsResourceKeyValueStore,
+			ProductID: _testProductID, VersionKey: "v1_0_0_test-workflow",
+		},
+	}, resources)
+}
+
+func (s *ResourcesSuite) TestListResources_ClientError() {
+	clientErr := errors.New("client error")
+
+	s.client.EXPECT().GetStreamNames().Return(nil, clientErr)
+
+	_, err := s.natsManager.ListResources()
+	s.ErrorIs(err, clientErr)
+}
+
+func (s *ResourcesSuite) TestDeleteResources() {
+	resources := []entity.NatsResource{
+		{Name: _testStream, Type: entity.NatsResourceStream},
+		{Name: _testVersionObjectStore, Type: entity.NatsResourceObjectStore},
+		{Name: "key-store_test-product", Type: entity.NatsResourceKeyValueStore},
+	}
+
+	s.client.EXPECT().DeleteStream(_testStream).Return(nil)
+	s.client.EXPECT().DeleteObjectStore(_testVersionObjectStore).Return(nil)
+	s.client.EXPECT().DeleteKeyValueStore("key-store_test-product").Return(nil)
+
+	err := s.natsManager.DeleteResources(resources)
+	s.Require().NoError(err)
+}
+
+func (s *ResourcesSuite) TestDeleteResources_RefusesResourcesNotFollowingNamingSchemes() {
+	resources := []entity.NatsResource{
+		{Name: _testStream, Type: entity.NatsResourceStream},
+		{Name: "KV_key-store_test-product", Type: entity.NatsResourceStream},
+	}
+
+	err := s.natsManager.DeleteResources(resources)
+	s.ErrorIs(err, internal.ErrInvalidResourceName)
+}
+
+func (s *ResourcesSuite) TestDeleteResources_ClientError() {
+	clientErr := errors.New("client error")
+
+	s.client.EXPECT().DeleteStream(_testStream).Return(clientErr)
+
+	err := s.natsManager.DeleteResources([]entity.NatsResource{{Name: _testStream, Type: entity.NatsResourceStream}})
+	s.ErrorIs(err, clientErr)
+}
@@ -0,0 +1,94 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// StartShadow mirrors the messages entering the trigger subjects of the published version workflows into
+// the streams of the candidate version. The candidate processes them as if its own triggers had sent them,
+// without affecting the responses of the published version.
+func (m *NatsManager) StartShadow(
+	productID,
+	publishedVersionTag,
+	candidateVersionTag string,
+	workflows []entity.ShadowWorkflow,
+) error {
+	if len(workflows) == 0 {
+		return internal.ErrNoWorkflowsDefined
+	}
+
+	if publishedVersionTag == candidateVersionTag {
+		return internal.ErrShadowSameVersion
+	}
+
+	for _, workflow := range workflows {
+		if workflow.Name == "" {
+			return internal.ErrEmptyWorkflowName
+		}
+
+		if len(workflow.Triggers) == 0 {
+			return fmt.Errorf("workflow %q: %w", workflow.Name, internal.ErrEmptyShadowTriggers)
+		}
+	}
+
+	for _, workflow := range workflows {
+		publishedStream := m.getStreamName(productID, publishedVersionTag, workflow.Name)
+		candidateStream := m.getStreamName(productID, candidateVersionTag, workflow.Name)
+
+		source := &entity.StreamSource{
+			Stream:            publishedStream,
+			SubjectTransforms: m.getShadowSubjectTransforms(publishedStream, candidateStream, workflow.Triggers),
+		}
+
+		err := m.client.AddStreamSource(candidateStream, source)
+		if err != nil {
+			return fmt.Errorf("error mirroring stream %q into %q: %w", publishedStream, candidateStream, err)
+		}
+	}
+
+	return nil
+}
+
+// StopShadow stops mirroring the messages of the published version streams into the candidate version streams.
+func (m *NatsManager) StopShadow(productID, publishedVersionTag, candidateVersionTag string) error {
+	candidateStreams, err := m.client.GetStreamNames(m.getVersionStreamFilter(productID, candidateVersionTag))
+	if err != nil {
+		return fmt.Errorf("error getting streams: %w", err)
+	}
+
+	publishedStreamsFilter := m.getVersionStreamFilter(productID, publishedVersionTag)
+
+	for _, stream := range candidateStreams {
+		err := m.client.RemoveStreamSources(stream, publishedStreamsFilter)
+		if err != nil {
+			return fmt.Errorf("error removing shadow sources of stream %q: %w", stream, err)
+		}
+	}
+
+	return nil
+}
+
+// getShadowSubjectTransforms renames the trigger subjects of the published stream, and their subtopics,
+// to the same subjects of the candidate stream.
+func (m *NatsManager) getShadowSubjectTransforms(
+	publishedStream,
+	candidateStream string,
+	triggers []string,
+) []entity.SubjectTransform {
+	subjectTransforms := make([]entity.SubjectTransform, 0, len(triggers)*2)
+
+	for _, trigger := range triggers {
+		publishedSubject := m.getSubjectName(publishedStream, trigger)
+		candidateSubject := m.getSubjectName(candidateStream, trigger)
+
+		subjectTransforms = append(subjectTransforms,
+			entity.SubjectTransform{Source: publishedSubject, Destination: candidateSubject},
+			entity.SubjectTransform{Source: publishedSubject + ".*", Destination: candidateSubject + ".{{wildcard(1)}}"},
+		)
+	}
+
+	return subjectTransforms
+}
@@ -0,0 +1,137 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	_shadowPublishedTag    = "v1.0.0"
+	_shadowCandidateTag    = "v2.0.0"
+	_shadowPublishedStream = "test-product_v1_0_0_test-workflow"
+	_shadowCandidateStream = "test-product_v2_0_0_test-workflow"
+)
+
+type ShadowSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestShadowSuite(t *testing.T) {
+	suite.Run(t, new(ShadowSuite))
+}
+
+func (s *ShadowSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func (s *ShadowSuite) TestStartShadow() {
+	workflows := []entity.ShadowWorkflow{{Name: "test-workflow", Triggers: []string{"entrypoint"}}}
+
+	s.client.EXPECT().AddStreamSource(_shadowCandidateStream, &entity.StreamSource{
+		Stream: _shadowPublishedStream,
+		SubjectTransforms: []entity.SubjectTransform{
+			{
+				Source:      _shadowPublishedStream + ".entrypoint",
+				Destination: _shadowCandidateStream + ".entrypoint",
+			},
+			{
+				Source:      _shadowPublishedStream + ".entrypoint.*",
+				Destination: _shadowCandidateStream + ".entrypoint.{{wildcard(1)}}",
+			},
+		},
+	}).Return(nil)
+
+	err := s.natsManager.StartShadow(_testProductID, _shadowPublishedTag, _shadowCandidateTag, workflows)
+	s.Require().NoError(err)
+}
+
+func (s *ShadowSuite) TestStartShadow_InvalidRequests() {
+	tests := []struct {
+		name        string
+		candidate   string
+		workflows   []entity.ShadowWorkflow
+		expectedErr error
+	}{
+		{
+			name:        "no workflows",
+			candidate:   _shadowCandidateTag,
+			expectedErr: internal.ErrNoWorkflowsDefined,
+		},
+		{
+			name:        "same version",
+			candidate:   _shadowPublishedTag,
+			workflows:   []entity.ShadowWorkflow{{Name: "test-workflow", Triggers: []string{"entrypoint"}}},
+			expectedErr: internal.ErrShadowSameVersion,
+		},
+		{
+			name:        "empty workflow name",
+			candidate:   _shadowCandidateTag,
+			workflows:   []entity.ShadowWorkflow{{Triggers: []string{"entrypoint"}}},
+			expectedErr: internal.ErrEmptyWorkflowName,
+		},
+		{
+			name:        "workflow without triggers",
+			candidate:   _shadowCandidateTag,
+			workflows:   []entity.ShadowWorkflow{{Name: "test-workflow"}},
+			expectedErr: internal.ErrEmptyShadowTriggers,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.natsManager.StartShadow(_testProductID, _shadowPublishedTag, tc.candidate, tc.workflows)
+			s.ErrorIs(err, tc.expectedErr)
+		})
+	}
+}
+
+func (s *ShadowSuite) TestStartShadow_ClientError() {
+	workflows := []entity.ShadowWorkflow{{Name: "test-workflow", Triggers: []string{"entrypoint"}}}
+	expectedErr := errors.New("subject transforms not supported")
+
+	s.client.EXPECT().AddStreamSource(_shadowCandidateStream, gomock.Any()).Return(expectedErr)
+
+	err := s.natsManager.StartShadow(_testProductID, _shadowPublishedTag, _shadowCandidateTag, workflows)
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *ShadowSuite) TestStopShadow() {
+	candidateStreamsFilter := regexp.MustCompile("^test-product_v2.0.0_.*")
+	publishedStreamsFilter := regexp.MustCompile("^test-product_v1.0.0_.*")
+
+	s.client.EXPECT().GetStreamNames(candidateStreamsFilter).
+		Return([]string{_shadowCandidateStream, _shadowCandidateStream + "_dlq"}, nil)
+	s.client.EXPECT().RemoveStreamSources(_shadowCandidateStream, publishedStreamsFilter).Return(nil)
+	s.client.EXPECT().RemoveStreamSources(_shadowCandidateStream+"_dlq", publishedStreamsFilter).Return(nil)
+
+	err := s.natsManager.StopShadow(_testProductID, _shadowPublishedTag, _shadowCandidateTag)
+	s.Require().NoError(err)
+}
+
+func (s *ShadowSuite) TestStopShadow_ClientError() {
+	expectedErr := errors.New("stream not found")
+
+	s.client.EXPECT().GetStreamNames(gomock.Any()).Return([]string{_shadowCandidateStream}, nil)
+	s.client.EXPECT().RemoveStreamSources(_shadowCandidateStream, gomock.Any()).Return(expectedErr)
+
+	err := s.natsManager.StopShadow(_testProductID, _shadowPublishedTag, _shadowCandidateTag)
+	s.ErrorIs(err, expectedErr)
+}
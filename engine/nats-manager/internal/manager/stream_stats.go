@@ -0,0 +1,82 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// GetVersionStreamStats returns the messages stored in each workflow stream of the version and the state of
+// its consumers, broken down by process subject. Process consumers are matched by name as in GetProcessConsumerLag.
+func (m *NatsManager) GetVersionStreamStats(
+	productID,
+	versionTag string,
+	workflows []entity.Workflow,
+) ([]entity.WorkflowStreamStats, error) {
+	workflowsStats := make([]entity.WorkflowStreamStats, 0, len(workflows))
+
+	for _, workflow := range workflows {
+		stream := m.getStreamName(productID, versionTag, workflow.Name)
+
+		streamState, err := m.client.GetStreamState(stream)
+		if err != nil {
+			return nil, fmt.Errorf("error getting stream %q state: %w", stream, err)
+		}
+
+		consumersLag, err := m.client.GetConsumersLag(stream)
+		if err != nil {
+			return nil, fmt.Errorf("error getting stream %q consumers lag: %w", stream, err)
+		}
+
+		workflowStats := entity.WorkflowStreamStats{
+			Workflow:  workflow.Name,
+			Stream:    stream,
+			State:     *streamState,
+			Processes: make([]entity.ProcessStreamStats, 0, len(workflow.Processes)),
+		}
+
+		for _, consumerLag := range consumersLag {
+			workflowStats.Consumers.Add(consumerLag)
+		}
+
+		for _, process := range workflow.Processes {
+			workflowStats.Processes = append(
+				workflowStats.Processes,
+				m.getProcessStreamStats(stream, process.Name, streamState, consumersLag),
+			)
+		}
+
+		workflowsStats = append(workflowsStats, workflowStats)
+	}
+
+	return workflowsStats, nil
+}
+
+func (m *NatsManager) getProcessStreamStats(
+	stream, process string,
+	streamState *entity.StreamState,
+	consumersLag []entity.ConsumerLag,
+) entity.ProcessStreamStats {
+	subject := m.getSubjectName(stream, process)
+
+	processStats := entity.ProcessStreamStats{
+		Process: process,
+		Subject: subject,
+	}
+
+	// processes publish both to their subject and to its subtopics
+	for streamSubject, messages := range streamState.SubjectMessages {
+		if streamSubject == subject || strings.HasPrefix(streamSubject, subject+".") {
+			processStats.Messages += messages
+		}
+	}
+
+	for _, consumerLag := range consumersLag {
+		if m.isProcessConsumer(consumerLag, process) {
+			processStats.Consumers.Add(consumerLag)
+		}
+	}
+
+	return processStats
+}
@@ -0,0 +1,120 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type StreamStatsSuite struct {
+	suite.Suite
+
+	client      *mocks.MockNatsClient
+	natsManager *manager.NatsManager
+}
+
+func TestStreamStatsSuite(t *testing.T) {
+	suite.Run(t, new(StreamStatsSuite))
+}
+
+func (s *StreamStatsSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	logger := testr.NewWithOptions(s.T(), testr.Options{Verbosity: -1})
+	s.client = mocks.NewMockNatsClient(ctrl)
+	s.natsManager = manager.NewNatsManager(logger, s.client)
+}
+
+func (s *StreamStatsSuite) TestGetVersionStreamStats() {
+	workflows := []entity.Workflow{
+		{
+			Name: _testWorkflow,
+			Processes: []entity.Process{
+				{Name: "entrypoint"},
+				{Name: _testProcess, Subscriptions: []string{"entrypoint"}},
+			},
+		},
+	}
+
+	s.client.EXPECT().GetStreamState(_testStream).Return(&entity.StreamState{
+		Messages:      15,
+		Bytes:         1024,
+		FirstSequence: 6,
+		LastSequence:  20,
+		SubjectMessages: map[string]uint64{
+			_testStream + ".entrypoint":                10,
+			_testStream + "." + _testProcess:           3,
+			_testStream + "." + _testProcess + ".data": 2,
+		},
+	}, nil)
+	s.client.EXPECT().GetConsumersLag(_testStream).Return([]entity.ConsumerLag{
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-entrypoint-test-process",
+			FilterSubject: _testStream + ".entrypoint",
+			Pending:       4,
+			AckPending:    1,
+			Redelivered:   1,
+		},
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-test-process-entrypoint",
+			FilterSubject: _testStream + "." + _testProcess,
+			Pending:       2,
+		},
+		{
+			Consumer:      "test-product_v1_0_0_test-workflow-entrypoint-mytest-process",
+			FilterSubject: _testStream + ".entrypoint",
+			Pending:       50,
+		},
+	}, nil)
+
+	stats, err := s.natsManager.GetVersionStreamStats(_testProductID, _testVersionTag, workflows)
+	s.Require().NoError(err)
+
+	s.Require().Len(stats, 1)
+	s.Equal(_testWorkflow, stats[0].Workflow)
+	s.Equal(_testStream, stats[0].Stream)
+	s.Equal(uint64(15), stats[0].State.Messages)
+	s.Equal(uint64(1024), stats[0].State.Bytes)
+	s.Equal(entity.ConsumerStats{Pending: 56, AckPending: 1, Redelivered: 1}, stats[0].Consumers)
+	s.Equal([]entity.ProcessStreamStats{
+		{
+			Process:   "entrypoint",
+			Subject:   _testStream + ".entrypoint",
+			Messages:  10,
+			Consumers: entity.ConsumerStats{Pending: 2},
+		},
+		{
+			Process:   _testProcess,
+			Subject:   _testStream + "." + _testProcess,
+			Messages:  5,
+			Consumers: entity.ConsumerStats{Pending: 4, AckPending: 1, Redelivered: 1},
+		},
+	}, stats[0].Processes)
+}
+
+func (s *StreamStatsSuite) TestGetVersionStreamStats_StreamStateFails() {
+	expectedErr := errors.New("stream not found")
+
+	s.client.EXPECT().GetStreamState(_testStream).Return(nil, expectedErr)
+
+	_, err := s.natsManager.GetVersionStreamStats(_testProductID, _testVersionTag, []entity.Workflow{{Name: _testWorkflow}})
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *StreamStatsSuite) TestGetVersionStreamStats_ConsumersLagFails() {
+	expectedErr := errors.New("consumers error")
+
+	s.client.EXPECT().GetStreamState(_testStream).Return(&entity.StreamState{}, nil)
+	s.client.EXPECT().GetConsumersLag(_testStream).Return(nil, expectedErr)
+
+	_, err := s.natsManager.GetVersionStreamStats(_testProductID, _testVersionTag, []entity.Workflow{{Name: _testWorkflow}})
+	s.ErrorIs(err, expectedErr)
+}
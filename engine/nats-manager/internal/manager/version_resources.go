@@ -0,0 +1,65 @@
+package manager
+
+import (
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// GetVersionResources returns the names of the streams, object stores and key-value stores that starting the
+// version creates, following the same rules as their creation, without creating them.
+func (m *NatsManager) GetVersionResources(
+	productID,
+	versionTag string,
+	workflows []entity.Workflow,
+) (*entity.VersionResources, error) {
+	if len(workflows) == 0 {
+		return nil, internal.ErrNoWorkflowsDefined
+	}
+
+	if err := m.validateWorkflows(workflows); err != nil {
+		return nil, err
+	}
+
+	streams := make(entity.WorkflowsStreamsConfig, len(workflows))
+	objectStores := make(entity.WorkflowsObjectStoresConfig, len(workflows))
+	workflowsKeyValueStores := make(map[string]*entity.WorkflowKeyValueStores, len(workflows))
+
+	for _, workflow := range workflows {
+		streams[workflow.Name] = m.getStreamConfig(productID, versionTag, workflow, workflows)
+
+		processesObjectStores := entity.ProcessesObjectStoresConfig{}
+		processesKeyValueStores := make(map[string]string, len(workflow.Processes))
+
+		for _, process := range workflow.Processes {
+			processesKeyValueStores[process.Name] = m.getProcessKeyValueStoreName(
+				productID, versionTag, workflow.Name, process.Name,
+			)
+
+			if process.ObjectStore == nil {
+				continue
+			}
+
+			objectStore, err := m.getObjectStoreName(productID, versionTag, workflow.Name, process.ObjectStore)
+			if err != nil {
+				return nil, err
+			}
+
+			processesObjectStores[process.Name] = objectStore
+		}
+
+		objectStores[workflow.Name] = &entity.WorkflowObjectStoresConfig{Processes: processesObjectStores}
+		workflowsKeyValueStores[workflow.Name] = &entity.WorkflowKeyValueStores{
+			WorkflowStore: m.getWorkflowKeyValueStoreName(productID, versionTag, workflow.Name),
+			Processes:     processesKeyValueStores,
+		}
+	}
+
+	return &entity.VersionResources{
+		Streams:      streams,
+		ObjectStores: objectStores,
+		KeyValueStores: &entity.VersionKeyValueStores{
+			ProjectStore:    m.getVersionKeyValueStoreName(productID, versionTag),
+			WorkflowsStores: workflowsKeyValueStores,
+		},
+	}, nil
+}
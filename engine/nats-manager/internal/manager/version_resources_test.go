@@ -0,0 +1,86 @@
+//go:build unit
+
+package manager_test
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/manager"
+	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVersionResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	// No client calls are expected, resources are not created.
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	workflows := []entity.Workflow{
+		NewWorkflowBuilder().
+			WithProcesses([]entity.Process{
+				{Name: "entrypoint"},
+				{
+					Name:          "exitpoint",
+					Subscriptions: []string{"entrypoint"},
+					ObjectStore:   &entity.ObjectStore{Name: "images", Scope: entity.ObjStoreScopeWorkflow},
+				},
+			}).
+			Build(),
+	}
+
+	resources, err := natsManager.GetVersionResources("test-product", "v1.0.0", workflows)
+	require.NoError(t, err)
+
+	stream := "test-product_v1_0_0_test-workflow"
+	expectedResources := &entity.VersionResources{
+		Streams: entity.WorkflowsStreamsConfig{
+			"test-workflow": {
+				Stream: stream,
+				Processes: entity.ProcessesStreamConfig{
+					"entrypoint": {Subject: stream + ".entrypoint", Subscriptions: []string{}},
+					"exitpoint":  {Subject: stream + ".exitpoint", Subscriptions: []string{stream + ".entrypoint"}},
+				},
+			},
+		},
+		ObjectStores: entity.WorkflowsObjectStoresConfig{
+			"test-workflow": {
+				Processes: entity.ProcessesObjectStoresConfig{
+					"exitpoint": "test-product_v1_0_0_test-workflow_images",
+				},
+			},
+		},
+		KeyValueStores: &entity.VersionKeyValueStores{
+			ProjectStore: "key-store_test-product_v1_0_0",
+			WorkflowsStores: map[string]*entity.WorkflowKeyValueStores{
+				"test-workflow": {
+					WorkflowStore: "key-store_test-product_v1_0_0_test-workflow",
+					Processes: map[string]string{
+						"entrypoint": "key-store_test-product_v1_0_0_test-workflow_entrypoint",
+						"exitpoint":  "key-store_test-product_v1_0_0_test-workflow_exitpoint",
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expectedResources, resources)
+}
+
+func TestGetVersionResources_NoWorkflows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	logger := testr.NewWithOptions(t, testr.Options{Verbosity: -1})
+	client := mocks.NewMockNatsClient(ctrl)
+	natsManager := manager.NewNatsManager(logger, client)
+
+	_, err := natsManager.GetVersionResources("test-product", "v1.0.0", nil)
+	assert.ErrorIs(t, err, internal.ErrNoWorkflowsDefined)
+}
@@ -51,3 +51,8 @@ func (w *WorkflowBuilder) WithProcesses(processes []entity.Process) *WorkflowBui
 	w.workflow.Processes = processes
 	return w
 }
+
+func (w *WorkflowBuilder) WithStreamSettings(settings *entity.StreamSettings) *WorkflowBuilder {
+	w.workflow.Stream = settings
+	return w
+}
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"strings"
+	"time"
+
 	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
 	"github.com/konstellation-io/kai/engine/nats-manager/proto/natspb"
 )
@@ -10,14 +13,59 @@ func (n *NatsService) dtoToWorkflows(dtoWorkflows []*natspb.Workflow) []entity.W
 
 	for _, dtoWorkflow := range dtoWorkflows {
 		workflows = append(workflows, entity.Workflow{
-			Name:      dtoWorkflow.Name,
-			Processes: n.dtoToProcesses(dtoWorkflow.Processes),
+			Name:          dtoWorkflow.Name,
+			Processes:     n.dtoToProcesses(dtoWorkflow.Processes),
+			Stream:        n.dtoToStreamSettings(dtoWorkflow.Stream),
+			KeyValueStore: n.dtoToKeyValueStoreSettings(dtoWorkflow.KeyValueStore),
 		})
 	}
 
 	return workflows
 }
 
+func (n *NatsService) dtoToStreamSettings(settingsDTO *natspb.StreamSettings) *entity.StreamSettings {
+	if settingsDTO == nil {
+		return nil
+	}
+
+	return &entity.StreamSettings{
+		Retention:  n.dtoToStreamRetention(settingsDTO.Retention),
+		MaxAge:     time.Duration(settingsDTO.MaxAgeSeconds) * time.Second,
+		MaxBytes:   settingsDTO.MaxBytes,
+		MaxMsgSize: settingsDTO.MaxMsgSize,
+		Replicas:   int(settingsDTO.Replicas),
+		Storage:    n.dtoToStreamStorage(settingsDTO.Storage),
+	}
+}
+
+func (n *NatsService) dtoToStreamRetention(retention natspb.StreamRetention) entity.StreamRetentionPolicy {
+	switch retention {
+	case natspb.StreamRetention_RETENTION_INTEREST:
+		return entity.StreamRetentionInterest
+	case natspb.StreamRetention_RETENTION_LIMITS:
+		return entity.StreamRetentionLimits
+	case natspb.StreamRetention_RETENTION_WORKQUEUE:
+		return entity.StreamRetentionWorkQueue
+	case natspb.StreamRetention_RETENTION_UNDEFINED:
+		return entity.StreamRetentionUndefined
+	default:
+		return entity.StreamRetentionUndefined
+	}
+}
+
+func (n *NatsService) dtoToStreamStorage(storage natspb.StreamStorage) entity.StreamStorageType {
+	switch storage {
+	case natspb.StreamStorage_STORAGE_FILE:
+		return entity.StreamStorageFile
+	case natspb.StreamStorage_STORAGE_MEMORY:
+		return entity.StreamStorageMemory
+	case natspb.StreamStorage_STORAGE_UNDEFINED:
+		return entity.StreamStorageUndefined
+	default:
+		return entity.StreamStorageUndefined
+	}
+}
+
 func (n *NatsService) dtoToProcesses(processesDTO []*natspb.Process) []entity.Process {
 	processes := make([]entity.Process, 0, len(processesDTO))
 
@@ -25,12 +73,14 @@ func (n *NatsService) dtoToProcesses(processesDTO []*natspb.Process) []entity.Pr
 		process := entity.Process{
 			Name:          processDTO.Name,
 			Subscriptions: processDTO.Subscriptions,
+			KeyValueStore: n.dtoToKeyValueStoreSettings(processDTO.KeyValueStore),
 		}
 
 		if processDTO.ObjectStore != nil {
 			process.ObjectStore = &entity.ObjectStore{
-				Name:  processDTO.ObjectStore.Name,
-				Scope: n.dtoToObjectStoreScope(processDTO.ObjectStore.Scope),
+				Name:     processDTO.ObjectStore.Name,
+				Scope:    n.dtoToObjectStoreScope(processDTO.ObjectStore.Scope),
+				Settings: n.dtoToObjectStoreSettings(processDTO.ObjectStore.Settings),
 			}
 		}
 
@@ -40,12 +90,41 @@ func (n *NatsService) dtoToProcesses(processesDTO []*natspb.Process) []entity.Pr
 	return processes
 }
 
+func (n *NatsService) dtoToObjectStoreSettings(settingsDTO *natspb.ObjectStoreSettings) *entity.ObjectStoreSettings {
+	if settingsDTO == nil {
+		return nil
+	}
+
+	return &entity.ObjectStoreSettings{
+		TTL:      time.Duration(settingsDTO.TtlSeconds) * time.Second,
+		MaxBytes: settingsDTO.MaxBytes,
+		Replicas: int(settingsDTO.Replicas),
+		Storage:  n.dtoToStreamStorage(settingsDTO.Storage),
+	}
+}
+
+func (n *NatsService) dtoToKeyValueStoreSettings(settingsDTO *natspb.KeyValueStoreSettings) *entity.KeyValueStoreSettings {
+	if settingsDTO == nil {
+		return nil
+	}
+
+	return &entity.KeyValueStoreSettings{
+		TTL:      time.Duration(settingsDTO.TtlSeconds) * time.Second,
+		MaxBytes: settingsDTO.MaxBytes,
+		History:  int(settingsDTO.History),
+		Replicas: int(settingsDTO.Replicas),
+		Storage:  n.dtoToStreamStorage(settingsDTO.Storage),
+	}
+}
+
 func (n *NatsService) dtoToObjectStoreScope(scope natspb.ObjectStoreScope) entity.ObjectStoreScope {
 	switch scope {
 	case natspb.ObjectStoreScope_SCOPE_PROJECT:
 		return entity.ObjStoreScopeProject
 	case natspb.ObjectStoreScope_SCOPE_WORKFLOW:
 		return entity.ObjStoreScopeWorkflow
+	case natspb.ObjectStoreScope_SCOPE_PRODUCT:
+		return entity.ObjStoreScopeProduct
 	case natspb.ObjectStoreScope_SCOPE_UNDEFINED:
 		return entity.ObjStoreScopeUndefined
 	default:
@@ -125,3 +204,255 @@ func (n *NatsService) mapDTOToKeyValueStoreConfigurations(dto []*natspb.KeyValue
 
 	return keyValueConfigurations
 }
+
+func (n *NatsService) mapWorkflowsStreamStatsToDTO(stats []entity.WorkflowStreamStats) []*natspb.WorkflowStreamStats {
+	workflowsDTO := make([]*natspb.WorkflowStreamStats, 0, len(stats))
+
+	for _, workflowStats := range stats {
+		processesDTO := make([]*natspb.ProcessStreamStats, 0, len(workflowStats.Processes))
+
+		for _, processStats := range workflowStats.Processes {
+			processesDTO = append(processesDTO, &natspb.ProcessStreamStats{
+				Process:   processStats.Process,
+				Subject:   processStats.Subject,
+				Messages:  processStats.Messages,
+				Consumers: n.mapConsumerStatsToDTO(processStats.Consumers),
+			})
+		}
+
+		workflowsDTO = append(workflowsDTO, &natspb.WorkflowStreamStats{
+			Workflow:      workflowStats.Workflow,
+			Stream:        workflowStats.Stream,
+			Messages:      workflowStats.State.Messages,
+			Bytes:         workflowStats.State.Bytes,
+			FirstSequence: workflowStats.State.FirstSequence,
+			LastSequence:  workflowStats.State.LastSequence,
+			Consumers:     n.mapConsumerStatsToDTO(workflowStats.Consumers),
+			Processes:     processesDTO,
+		})
+	}
+
+	return workflowsDTO
+}
+
+func (n *NatsService) mapConsumerStatsToDTO(stats entity.ConsumerStats) *natspb.ConsumerStats {
+	return &natspb.ConsumerStats{
+		Pending:     stats.Pending,
+		AckPending:  stats.AckPending,
+		Redelivered: stats.Redelivered,
+	}
+}
+
+func (n *NatsService) mapDeadLetterMessagesToDTO(messages []entity.DeadLetterMessage) []*natspb.DeadLetterMessage {
+	messagesDTO := make([]*natspb.DeadLetterMessage, 0, len(messages))
+
+	for _, message := range messages {
+		messagesDTO = append(messagesDTO, &natspb.DeadLetterMessage{
+			Sequence:       message.Sequence,
+			Stream:         message.Stream,
+			StreamSequence: message.StreamSequence,
+			Consumer:       message.Consumer,
+			Deliveries:     message.Deliveries,
+			Subject:        message.Subject,
+			Headers:        n.mapHeadersToDTO(message.Headers),
+			Data:           message.Data,
+			Timestamp:      message.Timestamp.Format(time.RFC3339),
+		})
+	}
+
+	return messagesDTO
+}
+
+func (n *NatsService) mapStreamMessagesToDTO(messages []entity.StreamMessage) []*natspb.StreamMessage {
+	messagesDTO := make([]*natspb.StreamMessage, 0, len(messages))
+
+	for _, message := range messages {
+		messagesDTO = append(messagesDTO, &natspb.StreamMessage{
+			Sequence:  message.Sequence,
+			Subject:   message.Subject,
+			Headers:   n.mapHeadersToDTO(message.Headers),
+			Data:      message.Data,
+			Timestamp: message.Timestamp.Format(time.RFC3339),
+		})
+	}
+
+	return messagesDTO
+}
+
+// mapHeadersToDTO joins the values of repeated headers with commas.
+func (n *NatsService) mapHeadersToDTO(headers map[string][]string) map[string]string {
+	headersDTO := make(map[string]string, len(headers))
+	for key, values := range headers {
+		headersDTO[key] = strings.Join(values, ",")
+	}
+
+	return headersDTO
+}
+
+func (n *NatsService) mapDTOToHeaders(headersDTO map[string]string) map[string][]string {
+	headers := make(map[string][]string, len(headersDTO))
+	for key, value := range headersDTO {
+		headers[key] = []string{value}
+	}
+
+	return headers
+}
+
+func (n *NatsService) dtoToKeyValueStoreRef(store *natspb.KeyValueStoreRef) entity.KeyValueStoreRef {
+	return entity.KeyValueStoreRef{
+		ProductID:  store.GetProductId(),
+		VersionTag: store.GetVersionTag(),
+		Workflow:   store.GetWorkflow(),
+		Process:    store.GetProcess(),
+		Scope:      n.dtoToKeyValueStoreScope(store.GetScope()),
+	}
+}
+
+func (n *NatsService) dtoToKeyValueStoreScope(scope natspb.KeyValueStoreScope) entity.KeyValueStoreScope {
+	switch scope {
+	case natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL:
+		return entity.KVScopeGlobal
+	case natspb.KeyValueStoreScope_KV_SCOPE_VERSION:
+		return entity.KVScopeVersion
+	case natspb.KeyValueStoreScope_KV_SCOPE_WORKFLOW:
+		return entity.KVScopeWorkflow
+	case natspb.KeyValueStoreScope_KV_SCOPE_PROCESS:
+		return entity.KVScopeProcess
+	case natspb.KeyValueStoreScope_KV_SCOPE_UNDEFINED:
+		return ""
+	default:
+		return ""
+	}
+}
+
+func (n *NatsService) mapKeyValueStoreScopeToDTO(scope entity.KeyValueStoreScope) natspb.KeyValueStoreScope {
+	switch scope {
+	case entity.KVScopeGlobal:
+		return natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL
+	case entity.KVScopeVersion:
+		return natspb.KeyValueStoreScope_KV_SCOPE_VERSION
+	case entity.KVScopeWorkflow:
+		return natspb.KeyValueStoreScope_KV_SCOPE_WORKFLOW
+	case entity.KVScopeProcess:
+		return natspb.KeyValueStoreScope_KV_SCOPE_PROCESS
+	default:
+		return natspb.KeyValueStoreScope_KV_SCOPE_UNDEFINED
+	}
+}
+
+func (n *NatsService) mapObjectInfoToDTO(object entity.ObjectInfo) *natspb.ObjectInfo {
+	return &natspb.ObjectInfo{
+		Name:     object.Name,
+		Size:     object.Size,
+		Digest:   object.Digest,
+		Modified: object.Modified.Format(time.RFC3339),
+	}
+}
+
+func (n *NatsService) mapObjectsInfoToDTO(objects []entity.ObjectInfo) []*natspb.ObjectInfo {
+	objectsDTO := make([]*natspb.ObjectInfo, 0, len(objects))
+	for _, object := range objects {
+		objectsDTO = append(objectsDTO, n.mapObjectInfoToDTO(object))
+	}
+
+	return objectsDTO
+}
+
+func (n *NatsService) mapKeyValueEntryToDTO(entry entity.KeyValueEntry) *natspb.KeyValueEntry {
+	return &natspb.KeyValueEntry{
+		Key:      entry.Key,
+		Value:    entry.Value,
+		Revision: entry.Revision,
+		Deleted:  entry.Deleted,
+		Created:  entry.Created.Format(time.RFC3339),
+	}
+}
+
+func (n *NatsService) mapConfigurationEntryToDTO(entry entity.ConfigurationEntry) *natspb.ConfigurationEntry {
+	return &natspb.ConfigurationEntry{
+		Scope: n.mapKeyValueStoreScopeToDTO(entry.Scope),
+		Entry: n.mapKeyValueEntryToDTO(entry.KeyValueEntry),
+	}
+}
+
+func (n *NatsService) mapConfigurationEntriesToDTO(entries []entity.ConfigurationEntry) []*natspb.ConfigurationEntry {
+	entriesDTO := make([]*natspb.ConfigurationEntry, 0, len(entries))
+	for _, entry := range entries {
+		entriesDTO = append(entriesDTO, n.mapConfigurationEntryToDTO(entry))
+	}
+
+	return entriesDTO
+}
+
+func (n *NatsService) dtoToShadowWorkflows(workflowsDTO []*natspb.ShadowWorkflow) []entity.ShadowWorkflow {
+	workflows := make([]entity.ShadowWorkflow, 0, len(workflowsDTO))
+
+	for _, workflow := range workflowsDTO {
+		workflows = append(workflows, entity.ShadowWorkflow{
+			Name:     workflow.Name,
+			Triggers: workflow.Triggers,
+		})
+	}
+
+	return workflows
+}
+
+func (n *NatsService) mapNatsResourcesToDTO(resources []entity.NatsResource) []*natspb.NatsResource {
+	resourcesDTO := make([]*natspb.NatsResource, 0, len(resources))
+
+	for _, resource := range resources {
+		resourcesDTO = append(resourcesDTO, &natspb.NatsResource{
+			Name:       resource.Name,
+			Type:       n.mapNatsResourceTypeToDTO(resource.Type),
+			ProductId:  resource.ProductID,
+			VersionKey: resource.VersionKey,
+		})
+	}
+
+	return resourcesDTO
+}
+
+func (n *NatsService) dtoToNatsResources(resourcesDTO []*natspb.NatsResource) []entity.NatsResource {
+	resources := make([]entity.NatsResource, 0, len(resourcesDTO))
+
+	for _, resource := range resourcesDTO {
+		resources = append(resources, entity.NatsResource{
+			Name:       resource.Name,
+			Type:       n.dtoToNatsResourceType(resource.Type),
+			ProductID:  resource.ProductId,
+			VersionKey: resource.VersionKey,
+		})
+	}
+
+	return resources
+}
+
+func (n *NatsService) mapNatsResourceTypeToDTO(resourceType entity.NatsResourceType) natspb.NatsResourceType {
+	switch resourceType {
+	case entity.NatsResourceStream:
+		return natspb.NatsResourceType_RESOURCE_TYPE_STREAM
+	case entity.NatsResourceObjectStore:
+		return natspb.NatsResourceType_RESOURCE_TYPE_OBJECT_STORE
+	case entity.NatsResourceKeyValueStore:
+		return natspb.NatsResourceType_RESOURCE_TYPE_KEY_VALUE_STORE
+	case entity.NatsResourceUndefined:
+		return natspb.NatsResourceType_RESOURCE_TYPE_UNDEFINED
+	default:
+		return natspb.NatsResourceType_RESOURCE_TYPE_UNDEFINED
+	}
+}
+
+func (n *NatsService) dtoToNatsResourceType(resourceType natspb.NatsResourceType) entity.NatsResourceType {
+	switch resourceType {
+	case natspb.NatsResourceType_RESOURCE_TYPE_STREAM:
+		return entity.NatsResourceStream
+	case natspb.NatsResourceType_RESOURCE_TYPE_OBJECT_STORE:
+		return entity.NatsResourceObjectStore
+	case natspb.NatsResourceType_RESOURCE_TYPE_KEY_VALUE_STORE:
+		return entity.NatsResourceKeyValueStore
+	case natspb.NatsResourceType_RESOURCE_TYPE_UNDEFINED:
+		return entity.NatsResourceUndefined
+	default:
+		return entity.NatsResourceUndefined
+	}
+}
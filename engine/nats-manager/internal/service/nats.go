@@ -2,11 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/go-logr/logr"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
 	"github.com/konstellation-io/kai/engine/nats-manager/internal/interfaces"
 	"github.com/konstellation-io/kai/engine/nats-manager/proto/natspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // NatsService basic server.
@@ -64,6 +70,235 @@ func (n *NatsService) CreateObjectStores(
 	}, nil
 }
 
+// CreateProductObjectStore creates an object store shared by every version of the product.
+func (n *NatsService) CreateProductObjectStore(
+	_ context.Context,
+	req *natspb.CreateProductObjectStoreRequest,
+) (*natspb.CreateProductObjectStoreResponse, error) {
+	n.logger.Info("CreateProductObjectStore request received")
+
+	objectStore, err := n.manager.CreateProductObjectStore(req.ProductId, req.Name)
+	if err != nil {
+		n.logger.Error(err, "Error creating product object store", "product", req.ProductId, "name", req.Name)
+		return nil, err
+	}
+
+	return &natspb.CreateProductObjectStoreResponse{ObjectStore: objectStore}, nil
+}
+
+// GetProductObjectStores returns the names of the object stores shared by every version of the product.
+func (n *NatsService) GetProductObjectStores(
+	_ context.Context,
+	req *natspb.GetProductObjectStoresRequest,
+) (*natspb.GetProductObjectStoresResponse, error) {
+	n.logger.Info("GetProductObjectStores request received")
+
+	names, err := n.manager.GetProductObjectStores(req.ProductId)
+	if err != nil {
+		n.logger.Error(err, "Error getting product object stores", "product", req.ProductId)
+		return nil, err
+	}
+
+	return &natspb.GetProductObjectStoresResponse{Names: names}, nil
+}
+
+// DeleteProductObjectStore deletes an object store shared by every version of the product.
+func (n *NatsService) DeleteProductObjectStore(
+	_ context.Context,
+	req *natspb.DeleteProductObjectStoreRequest,
+) (*natspb.DeleteResponse, error) {
+	n.logger.Info("DeleteProductObjectStore request received")
+
+	err := n.manager.DeleteProductObjectStore(req.ProductId, req.Name)
+	if err != nil {
+		n.logger.Error(err, "Error deleting product object store", "product", req.ProductId, "name", req.Name)
+		return nil, err
+	}
+
+	return &natspb.DeleteResponse{
+		Message: fmt.Sprintf("Object store %q for product %q deleted", req.Name, req.ProductId),
+	}, nil
+}
+
+// ListObjects returns the objects stored in one of the object stores of the product.
+func (n *NatsService) ListObjects(
+	_ context.Context,
+	req *natspb.ListObjectsRequest,
+) (*natspb.ListObjectsResponse, error) {
+	n.logger.Info("ListObjects request received")
+
+	objects, err := n.manager.ListObjects(req.ProductId, req.ObjectStore)
+	if err != nil {
+		n.logger.Error(err, "Error listing objects", "product", req.ProductId, "objectStore", req.ObjectStore)
+		return nil, err
+	}
+
+	return &natspb.ListObjectsResponse{Objects: n.mapObjectsInfoToDTO(objects)}, nil
+}
+
+// GetObject streams the content of an object in chunks.
+func (n *NatsService) GetObject(
+	req *natspb.GetObjectRequest,
+	stream natspb.NatsManagerService_GetObjectServer,
+) error {
+	n.logger.Info("GetObject request received")
+
+	err := n.manager.GetObject(req.ProductId, req.ObjectStore, req.Name, &objectChunkWriter{stream: stream})
+	if err != nil {
+		n.logger.Error(err, "Error getting object", "objectStore", req.ObjectStore, "name", req.Name)
+		return err
+	}
+
+	return nil
+}
+
+// PutObject stores the content streamed by the client as an object. The first message of the stream
+// identifies the object.
+func (n *NatsService) PutObject(stream natspb.NatsManagerService_PutObjectServer) error {
+	n.logger.Info("PutObject request received")
+
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		req = &natspb.PutObjectRequest{}
+	} else if err != nil {
+		return fmt.Errorf("receiving object metadata: %w", err)
+	}
+
+	object, err := n.manager.PutObject(
+		req.ProductId, req.ObjectStore, req.Name,
+		&objectStreamReader{stream: stream, buf: req.Data},
+	)
+	if err != nil {
+		n.logger.Error(err, "Error putting object", "objectStore", req.ObjectStore, "name", req.Name)
+		return err
+	}
+
+	return stream.SendAndClose(&natspb.PutObjectResponse{Object: n.mapObjectInfoToDTO(*object)})
+}
+
+// DeleteObject deletes an object from one of the object stores of the product.
+func (n *NatsService) DeleteObject(
+	_ context.Context,
+	req *natspb.DeleteObjectRequest,
+) (*natspb.DeleteResponse, error) {
+	n.logger.Info("DeleteObject request received")
+
+	err := n.manager.DeleteObject(req.ProductId, req.ObjectStore, req.Name)
+	if err != nil {
+		n.logger.Error(err, "Error deleting object", "objectStore", req.ObjectStore, "name", req.Name)
+		return nil, err
+	}
+
+	return &natspb.DeleteResponse{
+		Message: fmt.Sprintf("Object %q deleted from object store %q", req.Name, req.ObjectStore),
+	}, nil
+}
+
+// CreateVersionCredentials issues the NATS credentials used by the processes of the version.
+func (n *NatsService) CreateVersionCredentials(
+	_ context.Context,
+	req *natspb.CreateVersionCredentialsRequest,
+) (*natspb.CreateVersionCredentialsResponse, error) {
+	n.logger.Info("CreateVersionCredentials request received")
+
+	credentials, err := n.manager.CreateVersionCredentials(req.ProductId, req.VersionTag, n.dtoToWorkflows(req.Workflows))
+	if err != nil {
+		n.logger.Error(err, "Error creating version credentials")
+		return nil, err
+	}
+
+	return &natspb.CreateVersionCredentialsResponse{Credentials: credentials}, nil
+}
+
+// RevokeVersionCredentials revokes the NATS credentials issued to the processes of the version.
+func (n *NatsService) RevokeVersionCredentials(
+	_ context.Context,
+	req *natspb.RevokeVersionCredentialsRequest,
+) (*natspb.DeleteResponse, error) {
+	n.logger.Info("RevokeVersionCredentials request received")
+
+	err := n.manager.RevokeVersionCredentials(req.ProductId, req.VersionTag)
+	if err != nil {
+		n.logger.Error(err, "Error revoking version credentials")
+		return nil, err
+	}
+
+	return &natspb.DeleteResponse{
+		Message: fmt.Sprintf("Credentials for version %q on product %s revoked", req.VersionTag, req.ProductId),
+	}, nil
+}
+
+// StartShadow mirrors the trigger subjects of the published version into the streams of the candidate version.
+func (n *NatsService) StartShadow(
+	_ context.Context,
+	req *natspb.StartShadowRequest,
+) (*natspb.StartShadowResponse, error) {
+	n.logger.Info("StartShadow request received")
+
+	err := n.manager.StartShadow(
+		req.ProductId, req.PublishedVersionTag, req.CandidateVersionTag, n.dtoToShadowWorkflows(req.Workflows),
+	)
+	if err != nil {
+		n.logger.Error(err, "Error starting shadow")
+		return nil, err
+	}
+
+	return &natspb.StartShadowResponse{}, nil
+}
+
+// StopShadow stops mirroring the published version into the streams of the candidate version.
+func (n *NatsService) StopShadow(
+	_ context.Context,
+	req *natspb.StopShadowRequest,
+) (*natspb.DeleteResponse, error) {
+	n.logger.Info("StopShadow request received")
+
+	err := n.manager.StopShadow(req.ProductId, req.PublishedVersionTag, req.CandidateVersionTag)
+	if err != nil {
+		n.logger.Error(err, "Error stopping shadow")
+		return nil, err
+	}
+
+	return &natspb.DeleteResponse{
+		Message: fmt.Sprintf("Version %q on product %s no longer shadows version %q",
+			req.CandidateVersionTag, req.ProductId, req.PublishedVersionTag),
+	}, nil
+}
+
+// ListResources lists the NATS resources following the naming schemes of products and versions.
+func (n *NatsService) ListResources(
+	_ context.Context,
+	_ *natspb.ListResourcesRequest,
+) (*natspb.ListResourcesResponse, error) {
+	n.logger.Info("ListResources request received")
+
+	resources, err := n.manager.ListResources()
+	if err != nil {
+		n.logger.Error(err, "Error listing resources")
+		return nil, err
+	}
+
+	return &natspb.ListResourcesResponse{Resources: n.mapNatsResourcesToDTO(resources)}, nil
+}
+
+// DeleteResources deletes the given NATS resources, as long as they follow the naming schemes.
+func (n *NatsService) DeleteResources(
+	_ context.Context,
+	req *natspb.DeleteResourcesRequest,
+) (*natspb.DeleteResponse, error) {
+	n.logger.Info("DeleteResources request received")
+
+	err := n.manager.DeleteResources(n.dtoToNatsResources(req.Resources))
+	if err != nil {
+		n.logger.Error(err, "Error deleting resources")
+		return nil, err
+	}
+
+	return &natspb.DeleteResponse{
+		Message: fmt.Sprintf("%d resources deleted", len(req.Resources)),
+	}, nil
+}
+
 // DeleteStreams delete streams for given workflows.
 func (n *NatsService) DeleteStreams(
 	_ context.Context,
@@ -106,7 +341,12 @@ func (n *NatsService) CreateVersionKeyValueStores(
 ) (*natspb.CreateVersionKeyValueStoresResponse, error) {
 	n.logger.Info("CreateVersionKeyValueStores request received")
 
-	keyValueStores, err := n.manager.CreateVersionKeyValueStores(req.ProductId, req.VersionTag, n.dtoToWorkflows(req.Workflows))
+	keyValueStores, err := n.manager.CreateVersionKeyValueStores(
+		req.ProductId,
+		req.VersionTag,
+		n.dtoToWorkflows(req.Workflows),
+		n.dtoToKeyValueStoreSettings(req.KeyValueStore),
+	)
 	if err != nil {
 		n.logger.Error(err, "Error creating version's key-value store")
 		return nil, err
@@ -115,6 +355,29 @@ func (n *NatsService) CreateVersionKeyValueStores(
 	return n.mapKeyValueStoresToDTO(keyValueStores), nil
 }
 
+// GetVersionResources returns the names of the NATS resources of the version without creating them.
+func (n *NatsService) GetVersionResources(
+	_ context.Context,
+	req *natspb.GetVersionResourcesRequest,
+) (*natspb.GetVersionResourcesResponse, error) {
+	n.logger.Info("GetVersionResources request received")
+
+	resources, err := n.manager.GetVersionResources(req.ProductId, req.VersionTag, n.dtoToWorkflows(req.Workflows))
+	if err != nil {
+		n.logger.Error(err, "Error getting version's resources")
+		return nil, err
+	}
+
+	keyValueStores := n.mapKeyValueStoresToDTO(resources.KeyValueStores)
+
+	return &natspb.GetVersionResourcesResponse{
+		Streams:        n.workflowsStreamConfigToDto(resources.Streams),
+		ObjectStores:   n.mapWorkflowsObjStoreToDTO(resources.ObjectStores),
+		KeyValueStore:  keyValueStores.KeyValueStore,
+		KeyValueStores: keyValueStores.Workflows,
+	}, nil
+}
+
 func (n *NatsService) CreateGlobalKeyValueStore(
 	_ context.Context,
 	req *natspb.CreateGlobalKeyValueStoreRequest,
@@ -180,3 +443,268 @@ func (n *NatsService) DeleteGlobalKeyValueStore(
 		Message: fmt.Sprintf("Global key-value store for product %q deleted", req.ProductId),
 	}, nil
 }
+
+// GetProcessConsumerLag returns the messages pending to be processed by a running process.
+func (n *NatsService) GetProcessConsumerLag(
+	_ context.Context,
+	req *natspb.GetProcessConsumerLagRequest,
+) (*natspb.GetProcessConsumerLagResponse, error) {
+	n.logger.V(1).Info("GetProcessConsumerLag request received")
+
+	lag, err := n.manager.GetProcessConsumerLag(req.ProductId, req.VersionTag, req.Workflow, req.Process)
+	if errors.Is(err, internal.ErrNoProcessConsumers) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		n.logger.Error(err, "Error getting process consumer lag", "product", req.ProductId, "process", req.Process)
+		return nil, err
+	}
+
+	return &natspb.GetProcessConsumerLagResponse{Lag: lag}, nil
+}
+
+// GetVersionStreamStats returns the messages stored in the workflow streams of a running version
+// and the state of their consumers.
+func (n *NatsService) GetVersionStreamStats(
+	_ context.Context,
+	req *natspb.GetVersionStreamStatsRequest,
+) (*natspb.GetVersionStreamStatsResponse, error) {
+	n.logger.V(1).Info("GetVersionStreamStats request received")
+
+	stats, err := n.manager.GetVersionStreamStats(req.ProductId, req.VersionTag, n.dtoToWorkflows(req.Workflows))
+	if err != nil {
+		n.logger.Error(err, "Error getting version stream stats", "product", req.ProductId, "version", req.VersionTag)
+		return nil, err
+	}
+
+	return &natspb.GetVersionStreamStatsResponse{
+		Workflows: n.mapWorkflowsStreamStatsToDTO(stats),
+	}, nil
+}
+
+// GetProcessMessages returns the last messages published by a process of a running version.
+func (n *NatsService) GetProcessMessages(
+	_ context.Context,
+	req *natspb.GetProcessMessagesRequest,
+) (*natspb.GetProcessMessagesResponse, error) {
+	n.logger.Info("GetProcessMessages request received")
+
+	messages, err := n.manager.GetProcessMessages(req.ProductId, req.VersionTag, req.Workflow, req.Process, int(req.Count))
+	if err != nil {
+		n.logger.Error(err, "Error getting process messages", "product", req.ProductId, "process", req.Process)
+		return nil, err
+	}
+
+	return &natspb.GetProcessMessagesResponse{
+		Messages: n.mapStreamMessagesToDTO(messages),
+	}, nil
+}
+
+// PublishMessage publishes a message to a subject of a running version.
+func (n *NatsService) PublishMessage(
+	_ context.Context,
+	req *natspb.PublishMessageRequest,
+) (*natspb.PublishMessageResponse, error) {
+	n.logger.Info("PublishMessage request received")
+
+	err := n.manager.PublishMessage(req.ProductId, req.VersionTag, req.Subject, n.mapDTOToHeaders(req.Headers), req.Data)
+	if err != nil {
+		n.logger.Error(err, "Error publishing message", "product", req.ProductId, "subject", req.Subject)
+		return nil, err
+	}
+
+	return &natspb.PublishMessageResponse{}, nil
+}
+
+// GetDeadLetterMessages returns the messages of a workflow that its processes could not handle.
+func (n *NatsService) GetDeadLetterMessages(
+	_ context.Context,
+	req *natspb.GetDeadLetterMessagesRequest,
+) (*natspb.GetDeadLetterMessagesResponse, error) {
+	n.logger.Info("GetDeadLetterMessages request received")
+
+	messages, err := n.manager.GetDeadLetterMessages(req.ProductId, req.VersionTag, req.Workflow)
+	if err != nil {
+		n.logger.Error(err, "Error getting dead-letter messages", "product", req.ProductId, "workflow", req.Workflow)
+		return nil, err
+	}
+
+	return &natspb.GetDeadLetterMessagesResponse{
+		Messages: n.mapDeadLetterMessagesToDTO(messages),
+	}, nil
+}
+
+// ReplayDeadLetterMessages publishes dead-letter messages again to their original subject.
+func (n *NatsService) ReplayDeadLetterMessages(
+	_ context.Context,
+	req *natspb.ReplayDeadLetterMessagesRequest,
+) (*natspb.ReplayDeadLetterMessagesResponse, error) {
+	n.logger.Info("ReplayDeadLetterMessages request received")
+
+	replayed, err := n.manager.ReplayDeadLetterMessages(req.ProductId, req.VersionTag, req.Workflow, req.Sequences)
+	if err != nil {
+		n.logger.Error(err, "Error replaying dead-letter messages", "product", req.ProductId, "workflow", req.Workflow)
+		return nil, err
+	}
+
+	return &natspb.ReplayDeadLetterMessagesResponse{Replayed: uint64(replayed)}, nil
+}
+
+// PurgeDeadLetterMessages removes dead-letter messages without replaying them.
+func (n *NatsService) PurgeDeadLetterMessages(
+	_ context.Context,
+	req *natspb.PurgeDeadLetterMessagesRequest,
+) (*natspb.PurgeDeadLetterMessagesResponse, error) {
+	n.logger.Info("PurgeDeadLetterMessages request received")
+
+	purged, err := n.manager.PurgeDeadLetterMessages(req.ProductId, req.VersionTag, req.Workflow, req.Sequences)
+	if err != nil {
+		n.logger.Error(err, "Error purging dead-letter messages", "product", req.ProductId, "workflow", req.Workflow)
+		return nil, err
+	}
+
+	return &natspb.PurgeDeadLetterMessagesResponse{Purged: uint64(purged)}, nil
+}
+
+// GetProcessConfiguration returns the configuration of a process resolved from all its scopes.
+func (n *NatsService) GetProcessConfiguration(
+	_ context.Context,
+	req *natspb.GetProcessConfigurationRequest,
+) (*natspb.GetProcessConfigurationResponse, error) {
+	n.logger.Info("GetProcessConfiguration request received")
+
+	configuration, err := n.manager.GetProcessConfiguration(req.ProductId, req.VersionTag, req.Workflow, req.Process)
+	if err != nil {
+		n.logger.Error(err, "Error getting process configuration", "product", req.ProductId, "process", req.Process)
+		return nil, err
+	}
+
+	return &natspb.GetProcessConfigurationResponse{
+		Configuration: n.mapConfigurationEntriesToDTO(configuration),
+	}, nil
+}
+
+// GetConfigurationHistory returns the revisions of a configuration key.
+func (n *NatsService) GetConfigurationHistory(
+	_ context.Context,
+	req *natspb.GetConfigurationHistoryRequest,
+) (*natspb.GetConfigurationHistoryResponse, error) {
+	n.logger.Info("GetConfigurationHistory request received")
+
+	history, err := n.manager.GetConfigurationHistory(n.dtoToKeyValueStoreRef(req.Store), req.Key)
+	if err != nil {
+		n.logger.Error(err, "Error getting configuration history", "key", req.Key)
+		return nil, err
+	}
+
+	revisions := make([]*natspb.KeyValueEntry, 0, len(history))
+	for _, entry := range history {
+		revisions = append(revisions, n.mapKeyValueEntryToDTO(entry))
+	}
+
+	return &natspb.GetConfigurationHistoryResponse{Revisions: revisions}, nil
+}
+
+// RollbackConfiguration sets a configuration key back to a previous revision.
+func (n *NatsService) RollbackConfiguration(
+	_ context.Context,
+	req *natspb.RollbackConfigurationRequest,
+) (*natspb.RollbackConfigurationResponse, error) {
+	n.logger.Info("RollbackConfiguration request received")
+
+	entry, err := n.manager.RollbackConfiguration(n.dtoToKeyValueStoreRef(req.Store), req.Key, req.Revision)
+	if err != nil {
+		n.logger.Error(err, "Error rolling back configuration", "key", req.Key, "revision", req.Revision)
+		return nil, err
+	}
+
+	return &natspb.RollbackConfigurationResponse{Entry: n.mapKeyValueEntryToDTO(*entry)}, nil
+}
+
+// GetConfiguration returns the current entries of a configuration key-value store.
+func (n *NatsService) GetConfiguration(
+	_ context.Context,
+	req *natspb.GetConfigurationRequest,
+) (*natspb.GetConfigurationResponse, error) {
+	n.logger.Info("GetConfiguration request received")
+
+	entries, err := n.manager.GetConfiguration(n.dtoToKeyValueStoreRef(req.Store))
+	if err != nil {
+		n.logger.Error(err, "Error getting configuration")
+		return nil, err
+	}
+
+	entriesDTO := make([]*natspb.KeyValueEntry, 0, len(entries))
+	for _, entry := range entries {
+		entriesDTO = append(entriesDTO, n.mapKeyValueEntryToDTO(entry))
+	}
+
+	return &natspb.GetConfigurationResponse{Entries: entriesDTO}, nil
+}
+
+// SetConfiguration sets the value of a configuration key.
+func (n *NatsService) SetConfiguration(
+	_ context.Context,
+	req *natspb.SetConfigurationRequest,
+) (*natspb.SetConfigurationResponse, error) {
+	n.logger.Info("SetConfiguration request received")
+
+	entry, err := n.manager.SetConfiguration(n.dtoToKeyValueStoreRef(req.Store), req.Key, req.Value)
+	if err != nil {
+		n.logger.Error(err, "Error setting configuration", "key", req.Key)
+		return nil, err
+	}
+
+	return &natspb.SetConfigurationResponse{Entry: n.mapKeyValueEntryToDTO(*entry)}, nil
+}
+
+// DeleteConfiguration deletes a configuration key.
+func (n *NatsService) DeleteConfiguration(
+	_ context.Context,
+	req *natspb.DeleteConfigurationRequest,
+) (*natspb.DeleteResponse, error) {
+	n.logger.Info("DeleteConfiguration request received")
+
+	err := n.manager.DeleteConfiguration(n.dtoToKeyValueStoreRef(req.Store), req.Key)
+	if err != nil {
+		n.logger.Error(err, "Error deleting configuration", "key", req.Key)
+		return nil, err
+	}
+
+	return &natspb.DeleteResponse{
+		Message: fmt.Sprintf("Configuration key %q deleted", req.Key),
+	}, nil
+}
+
+// WatchProcessConfiguration streams the changes of the configuration of a process until the client disconnects.
+func (n *NatsService) WatchProcessConfiguration(
+	req *natspb.WatchProcessConfigurationRequest,
+	stream natspb.NatsManagerService_WatchProcessConfigurationServer,
+) error {
+	n.logger.Info("WatchProcessConfiguration request received")
+
+	ctx := stream.Context()
+	updatesCh := make(chan entity.ConfigurationEntry, 1)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- n.manager.WatchProcessConfiguration(ctx, req.ProductId, req.VersionTag, req.Workflow, req.Process, updatesCh)
+	}()
+
+	for {
+		select {
+		case update := <-updatesCh:
+			if err := stream.Send(n.mapConfigurationEntryToDTO(update)); err != nil {
+				return fmt.Errorf("sending configuration update: %w", err)
+			}
+		case err := <-errCh:
+			if err != nil {
+				n.logger.Error(err, "Error watching process configuration", "product", req.ProductId, "process", req.Process)
+				return fmt.Errorf("watching process %q configuration: %w", req.Process, err)
+			}
+
+			return nil
+		}
+	}
+}
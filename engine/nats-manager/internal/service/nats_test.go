@@ -3,18 +3,25 @@
 package service_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
 	"github.com/golang/mock/gomock"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
 	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
 	"github.com/konstellation-io/kai/engine/nats-manager/internal/service"
 	"github.com/konstellation-io/kai/engine/nats-manager/mocks"
 	"github.com/konstellation-io/kai/engine/nats-manager/proto/natspb"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -123,6 +130,49 @@ func (s *NatsServiceTestSuite) TestCreateStreams() {
 	s.Equal(expectedClientResponse, clientResponse)
 }
 
+func (s *NatsServiceTestSuite) TestCreateStreams_WithStreamSettings() {
+	req := &natspb.CreateStreamsRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflows: []*natspb.Workflow{
+			{
+				Name:      "test-workflow",
+				Processes: []*natspb.Process{{Name: "test-process"}},
+				Stream: &natspb.StreamSettings{
+					Retention:     natspb.StreamRetention_RETENTION_LIMITS,
+					MaxAgeSeconds: 3600,
+					MaxBytes:      1024,
+					MaxMsgSize:    512,
+					Replicas:      3,
+					Storage:       natspb.StreamStorage_STORAGE_MEMORY,
+				},
+			},
+		},
+	}
+
+	expectedEntityWorkflows := []entity.Workflow{
+		{
+			Name:      "test-workflow",
+			Processes: []entity.Process{{Name: "test-process"}},
+			Stream: &entity.StreamSettings{
+				Retention:  entity.StreamRetentionLimits,
+				MaxAge:     time.Hour,
+				MaxBytes:   1024,
+				MaxMsgSize: 512,
+				Replicas:   3,
+				Storage:    entity.StreamStorageMemory,
+			},
+		},
+	}
+
+	s.natsManagerMock.EXPECT().
+		CreateStreams(req.ProductId, req.VersionTag, expectedEntityWorkflows).
+		Return(entity.WorkflowsStreamsConfig{}, nil)
+
+	_, err := s.natsService.CreateStreams(nil, req)
+	s.Require().NoError(err)
+}
+
 func (s *NatsServiceTestSuite) TestCreateObjectStores() {
 	req := &natspb.CreateObjectStoresRequest{
 		ProductId:  productID,
@@ -160,6 +210,381 @@ func (s *NatsServiceTestSuite) TestCreateObjectStores() {
 	s.Equal(expectedClientResponse, clientResponse)
 }
 
+func (s *NatsServiceTestSuite) TestGetVersionResources() {
+	req := &natspb.GetVersionResourcesRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflows:  protoWorkflows,
+	}
+
+	workflow := req.Workflows[0].Name
+	process := req.Workflows[0].Processes[0].Name
+
+	managerResponse := &entity.VersionResources{
+		Streams: entity.WorkflowsStreamsConfig{
+			workflow: &entity.StreamConfig{
+				Stream:    "test-stream",
+				Processes: entity.ProcessesStreamConfig{process: {Subject: "test-stream.test-process"}},
+			},
+		},
+		ObjectStores: entity.WorkflowsObjectStoresConfig{
+			workflow: &entity.WorkflowObjectStoresConfig{
+				Processes: entity.ProcessesObjectStoresConfig{process: "test-objectStore"},
+			},
+		},
+		KeyValueStores: &entity.VersionKeyValueStores{
+			ProjectStore: "test-version-kv-store",
+			WorkflowsStores: map[string]*entity.WorkflowKeyValueStores{
+				workflow: {
+					WorkflowStore: "test-workflow-kv-store",
+					Processes:     map[string]string{process: "test-process-kv-store"},
+				},
+			},
+		},
+	}
+
+	expectedClientResponse := &natspb.GetVersionResourcesResponse{
+		Streams: map[string]*natspb.WorkflowStreamConfig{
+			workflow: {
+				Stream: "test-stream",
+				Processes: map[string]*natspb.ProcessStreamConfig{
+					process: {Subject: "test-stream.test-process"},
+				},
+			},
+		},
+		ObjectStores: map[string]*natspb.WorkflowObjectStoreConfig{
+			workflow: {Processes: map[string]string{process: "test-objectStore"}},
+		},
+		KeyValueStore: "test-version-kv-store",
+		KeyValueStores: map[string]*natspb.WorkflowKeyValueStoreConfig{
+			workflow: {
+				KeyValueStore: "test-workflow-kv-store",
+				Processes:     map[string]string{process: "test-process-kv-store"},
+			},
+		},
+	}
+
+	s.natsManagerMock.EXPECT().
+		GetVersionResources(req.ProductId, req.VersionTag, entityWorkflows).
+		Return(managerResponse, nil)
+
+	clientResponse, err := s.natsService.GetVersionResources(nil, req)
+	s.Require().NoError(err)
+	s.Equal(expectedClientResponse, clientResponse)
+}
+
+func (s *NatsServiceTestSuite) TestCreateProductObjectStore() {
+	req := &natspb.CreateProductObjectStoreRequest{ProductId: productID, Name: "models"}
+
+	s.natsManagerMock.EXPECT().CreateProductObjectStore(productID, "models").Return("object-store_productID_models", nil)
+
+	res, err := s.natsService.CreateProductObjectStore(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal("object-store_productID_models", res.ObjectStore)
+}
+
+func (s *NatsServiceTestSuite) TestGetProductObjectStores() {
+	req := &natspb.GetProductObjectStoresRequest{ProductId: productID}
+
+	s.natsManagerMock.EXPECT().GetProductObjectStores(productID).Return([]string{"models"}, nil)
+
+	res, err := s.natsService.GetProductObjectStores(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal([]string{"models"}, res.Names)
+}
+
+func (s *NatsServiceTestSuite) TestDeleteProductObjectStoreError() {
+	req := &natspb.DeleteProductObjectStoreRequest{ProductId: productID, Name: "models"}
+
+	s.natsManagerMock.EXPECT().DeleteProductObjectStore(productID, "models").Return(internal.ErrObjectStoreNotFound)
+
+	_, err := s.natsService.DeleteProductObjectStore(context.Background(), req)
+	s.ErrorIs(err, internal.ErrObjectStoreNotFound)
+}
+
+func (s *NatsServiceTestSuite) TestListObjects() {
+	req := &natspb.ListObjectsRequest{ProductId: productID, ObjectStore: "object-store_productID_models"}
+	modified := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s.natsManagerMock.EXPECT().ListObjects(productID, req.ObjectStore).Return([]entity.ObjectInfo{
+		{Name: "model.bin", Size: 10, Digest: "SHA-256=digest", Modified: modified},
+	}, nil)
+
+	res, err := s.natsService.ListObjects(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal([]*natspb.ObjectInfo{
+		{Name: "model.bin", Size: 10, Digest: "SHA-256=digest", Modified: "2024-01-01T10:00:00Z"},
+	}, res.Objects)
+}
+
+func (s *NatsServiceTestSuite) TestListObjectsError() {
+	req := &natspb.ListObjectsRequest{ProductId: productID, ObjectStore: "other_v1_0_0_objstore"}
+
+	s.natsManagerMock.EXPECT().
+		ListObjects(productID, req.ObjectStore).
+		Return(nil, internal.ErrObjectStoreNotInProduct)
+
+	_, err := s.natsService.ListObjects(context.Background(), req)
+	s.ErrorIs(err, internal.ErrObjectStoreNotInProduct)
+}
+
+type getObjectStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (g *getObjectStream) Send(chunk *natspb.ObjectChunk) error {
+	g.chunks = append(g.chunks, chunk.Data)
+	return nil
+}
+
+func (s *NatsServiceTestSuite) TestGetObject() {
+	req := &natspb.GetObjectRequest{ProductId: productID, ObjectStore: "object-store_productID_models", Name: "model.bin"}
+	stream := &getObjectStream{}
+
+	s.natsManagerMock.EXPECT().
+		GetObject(productID, req.ObjectStore, req.Name, gomock.Any()).
+		DoAndReturn(func(_, _, _ string, w io.Writer) error {
+			_, err := w.Write([]byte("content"))
+			return err
+		})
+
+	err := s.natsService.GetObject(req, stream)
+	s.Require().NoError(err)
+	s.Equal([][]byte{[]byte("content")}, stream.chunks)
+}
+
+func (s *NatsServiceTestSuite) TestGetObject_SplitsLargeObjectsInChunks() {
+	req := &natspb.GetObjectRequest{ProductId: productID, ObjectStore: "object-store_productID_models", Name: "model.bin"}
+	stream := &getObjectStream{}
+	content := bytes.Repeat([]byte("a"), 1024*1024+1)
+
+	s.natsManagerMock.EXPECT().
+		GetObject(productID, req.ObjectStore, req.Name, gomock.Any()).
+		DoAndReturn(func(_, _, _ string, w io.Writer) error {
+			_, err := w.Write(content)
+			return err
+		})
+
+	err := s.natsService.GetObject(req, stream)
+	s.Require().NoError(err)
+	s.Len(stream.chunks, 3)
+	s.Equal(content, bytes.Join(stream.chunks, nil))
+}
+
+type putObjectStream struct {
+	grpc.ServerStream
+	requests []*natspb.PutObjectRequest
+	response *natspb.PutObjectResponse
+}
+
+func (p *putObjectStream) Recv() (*natspb.PutObjectRequest, error) {
+	if len(p.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := p.requests[0]
+	p.requests = p.requests[1:]
+
+	return req, nil
+}
+
+func (p *putObjectStream) SendAndClose(res *natspb.PutObjectResponse) error {
+	p.response = res
+	return nil
+}
+
+func (s *NatsServiceTestSuite) TestPutObject() {
+	objectStore := "object-store_productID_models"
+	stream := &putObjectStream{requests: []*natspb.PutObjectRequest{
+		{ProductId: productID, ObjectStore: objectStore, Name: "model.bin", Data: []byte("con")},
+		{Data: []byte("tent")},
+	}}
+	modified := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s.natsManagerMock.EXPECT().
+		PutObject(productID, objectStore, "model.bin", gomock.Any()).
+		DoAndReturn(func(_, _, name string, r io.Reader) (*entity.ObjectInfo, error) {
+			content, err := io.ReadAll(r)
+			s.Require().NoError(err)
+			s.Equal("content", string(content))
+
+			return &entity.ObjectInfo{Name: name, Size: uint64(len(content)), Modified: modified}, nil
+		})
+
+	err := s.natsService.PutObject(stream)
+	s.Require().NoError(err)
+	s.Equal(&natspb.ObjectInfo{Name: "model.bin", Size: 7, Modified: "2024-01-01T10:00:00Z"}, stream.response.Object)
+}
+
+func (s *NatsServiceTestSuite) TestPutObjectError() {
+	stream := &putObjectStream{}
+
+	s.natsManagerMock.EXPECT().PutObject("", "", "", gomock.Any()).Return(nil, internal.ErrEmptyObjectName)
+
+	err := s.natsService.PutObject(stream)
+	s.ErrorIs(err, internal.ErrEmptyObjectName)
+}
+
+func (s *NatsServiceTestSuite) TestDeleteObject() {
+	req := &natspb.DeleteObjectRequest{ProductId: productID, ObjectStore: "object-store_productID_models", Name: "model.bin"}
+
+	s.natsManagerMock.EXPECT().DeleteObject(productID, req.ObjectStore, req.Name).Return(nil)
+
+	res, err := s.natsService.DeleteObject(context.Background(), req)
+	s.Require().NoError(err)
+	s.NotEmpty(res.Message)
+}
+
+func (s *NatsServiceTestSuite) TestDeleteObjectError() {
+	req := &natspb.DeleteObjectRequest{ProductId: productID, ObjectStore: "object-store_productID_models", Name: "model.bin"}
+
+	s.natsManagerMock.EXPECT().DeleteObject(productID, req.ObjectStore, req.Name).Return(internal.ErrObjectNotFound)
+
+	_, err := s.natsService.DeleteObject(context.Background(), req)
+	s.ErrorIs(err, internal.ErrObjectNotFound)
+}
+
+func (s *NatsServiceTestSuite) TestCreateVersionCredentials() {
+	req := &natspb.CreateVersionCredentialsRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflows:  protoWorkflows,
+	}
+
+	s.natsManagerMock.EXPECT().
+		CreateVersionCredentials(req.ProductId, req.VersionTag, entityWorkflows).
+		Return("test-credentials", nil)
+
+	res, err := s.natsService.CreateVersionCredentials(nil, req)
+	s.Require().NoError(err)
+	s.Equal("test-credentials", res.Credentials)
+}
+
+func (s *NatsServiceTestSuite) TestCreateVersionCredentialsError() {
+	req := &natspb.CreateVersionCredentialsRequest{}
+
+	s.natsManagerMock.EXPECT().
+		CreateVersionCredentials(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", errors.New("mock error"))
+
+	_, err := s.natsService.CreateVersionCredentials(nil, req)
+	s.Require().Error(err)
+}
+
+func (s *NatsServiceTestSuite) TestRevokeVersionCredentials() {
+	req := &natspb.RevokeVersionCredentialsRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+	}
+
+	s.natsManagerMock.EXPECT().
+		RevokeVersionCredentials(req.ProductId, req.VersionTag).
+		Return(nil)
+
+	res, err := s.natsService.RevokeVersionCredentials(nil, req)
+	s.Require().NoError(err)
+	s.NotEmpty(res.Message)
+}
+
+func (s *NatsServiceTestSuite) TestStartShadow() {
+	req := &natspb.StartShadowRequest{
+		ProductId:           productID,
+		PublishedVersionTag: "v1.0.0",
+		CandidateVersionTag: "v2.0.0",
+		Workflows:           []*natspb.ShadowWorkflow{{Name: "test-workflow", Triggers: []string{"entrypoint"}}},
+	}
+
+	s.natsManagerMock.EXPECT().
+		StartShadow(productID, "v1.0.0", "v2.0.0", []entity.ShadowWorkflow{
+			{Name: "test-workflow", Triggers: []string{"entrypoint"}},
+		}).
+		Return(nil)
+
+	_, err := s.natsService.StartShadow(nil, req)
+	s.Require().NoError(err)
+}
+
+func (s *NatsServiceTestSuite) TestStartShadowError() {
+	expectedErr := errors.New("error starting shadow")
+
+	s.natsManagerMock.EXPECT().StartShadow(productID, "v1.0.0", "v2.0.0", gomock.Any()).Return(expectedErr)
+
+	_, err := s.natsService.StartShadow(nil, &natspb.StartShadowRequest{
+		ProductId:           productID,
+		PublishedVersionTag: "v1.0.0",
+		CandidateVersionTag: "v2.0.0",
+	})
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *NatsServiceTestSuite) TestStopShadow() {
+	req := &natspb.StopShadowRequest{
+		ProductId:           productID,
+		PublishedVersionTag: "v1.0.0",
+		CandidateVersionTag: "v2.0.0",
+	}
+
+	s.natsManagerMock.EXPECT().StopShadow(productID, "v1.0.0", "v2.0.0").Return(nil)
+
+	res, err := s.natsService.StopShadow(nil, req)
+	s.Require().NoError(err)
+	s.NotEmpty(res.Message)
+}
+
+func (s *NatsServiceTestSuite) TestListResources() {
+	resources := []entity.NatsResource{
+		{
+			Name: "test-product_v1_0_0_test-workflow", Type: entity.NatsResourceStream,
+			ProductID: productID, VersionKey: "v1_0_0_test-workflow",
+		},
+		{Name: "key-store_test-product", Type: entity.NatsResourceKeyValueStore, ProductID: productID},
+	}
+
+	s.natsManagerMock.EXPECT().ListResources().Return(resources, nil)
+
+	res, err := s.natsService.ListResources(nil, &natspb.ListResourcesRequest{})
+	s.Require().NoError(err)
+	s.Equal([]*natspb.NatsResource{
+		{
+			Name:       "test-product_v1_0_0_test-workflow",
+			Type:       natspb.NatsResourceType_RESOURCE_TYPE_STREAM,
+			ProductId:  productID,
+			VersionKey: "v1_0_0_test-workflow",
+		},
+		{
+			Name:      "key-store_test-product",
+			Type:      natspb.NatsResourceType_RESOURCE_TYPE_KEY_VALUE_STORE,
+			ProductId: productID,
+		},
+	}, res.Resources)
+}
+
+func (s *NatsServiceTestSuite) TestDeleteResources() {
+	req := &natspb.DeleteResourcesRequest{
+		Resources: []*natspb.NatsResource{
+			{Name: "test-product_v1_0_0_test-objstore", Type: natspb.NatsResourceType_RESOURCE_TYPE_OBJECT_STORE},
+		},
+	}
+
+	s.natsManagerMock.EXPECT().
+		DeleteResources([]entity.NatsResource{
+			{Name: "test-product_v1_0_0_test-objstore", Type: entity.NatsResourceObjectStore},
+		}).
+		Return(nil)
+
+	res, err := s.natsService.DeleteResources(nil, req)
+	s.Require().NoError(err)
+	s.NotEmpty(res.Message)
+}
+
+func (s *NatsServiceTestSuite) TestDeleteResourcesError() {
+	s.natsManagerMock.EXPECT().DeleteResources(gomock.Any()).Return(errors.New("mock error"))
+
+	_, err := s.natsService.DeleteResources(nil, &natspb.DeleteResourcesRequest{})
+	s.Require().Error(err)
+}
+
 func (s *NatsServiceTestSuite) TestDeleteStreams() {
 	req := &natspb.DeleteStreamsRequest{
 		ProductId:  productID,
@@ -228,7 +653,7 @@ func (s *NatsServiceTestSuite) TestCreateVersionKeyValueStores() {
 	}
 
 	s.natsManagerMock.EXPECT().
-		CreateVersionKeyValueStores(req.ProductId, req.VersionTag, expectedEntityWorkflows).
+		CreateVersionKeyValueStores(req.ProductId, req.VersionTag, expectedEntityWorkflows, nil).
 		Return(managerResponse, nil)
 
 	clientResponse, err := s.natsService.CreateVersionKeyValueStores(nil, req)
@@ -236,6 +661,59 @@ func (s *NatsServiceTestSuite) TestCreateVersionKeyValueStores() {
 	s.Equal(expectedClientResponse, clientResponse)
 }
 
+func (s *NatsServiceTestSuite) TestCreateVersionKeyValueStores_WithSettings() {
+	req := &natspb.CreateVersionKeyValueStoresRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflows: []*natspb.Workflow{
+			{
+				Name: "test-workflow",
+				Processes: []*natspb.Process{
+					{
+						Name: "test-process",
+						KeyValueStore: &natspb.KeyValueStoreSettings{
+							History: 5,
+							Storage: natspb.StreamStorage_STORAGE_MEMORY,
+						},
+						ObjectStore: &natspb.ObjectStore{
+							Name:     "test-object-store",
+							Scope:    natspb.ObjectStoreScope_SCOPE_WORKFLOW,
+							Settings: &natspb.ObjectStoreSettings{TtlSeconds: 3600, Replicas: 3},
+						},
+					},
+				},
+				KeyValueStore: &natspb.KeyValueStoreSettings{TtlSeconds: 60, MaxBytes: 1024},
+			},
+		},
+		KeyValueStore: &natspb.KeyValueStoreSettings{History: 1},
+	}
+
+	expectedWorkflows := []entity.Workflow{
+		{
+			Name: "test-workflow",
+			Processes: []entity.Process{
+				{
+					Name:          "test-process",
+					KeyValueStore: &entity.KeyValueStoreSettings{History: 5, Storage: entity.StreamStorageMemory},
+					ObjectStore: &entity.ObjectStore{
+						Name:     "test-object-store",
+						Scope:    entity.ObjStoreScopeWorkflow,
+						Settings: &entity.ObjectStoreSettings{TTL: time.Hour, Replicas: 3},
+					},
+				},
+			},
+			KeyValueStore: &entity.KeyValueStoreSettings{TTL: time.Minute, MaxBytes: 1024},
+		},
+	}
+
+	s.natsManagerMock.EXPECT().
+		CreateVersionKeyValueStores(productID, versionTag, expectedWorkflows, &entity.KeyValueStoreSettings{History: 1}).
+		Return(&entity.VersionKeyValueStores{}, nil)
+
+	_, err := s.natsService.CreateVersionKeyValueStores(nil, req)
+	s.Require().NoError(err)
+}
+
 func (s *NatsServiceTestSuite) TestCreateStreamsError() {
 	req := &natspb.CreateStreamsRequest{}
 
@@ -284,7 +762,7 @@ func (s *NatsServiceTestSuite) TestCreateVersionKeyValueStoresError() {
 	req := &natspb.CreateVersionKeyValueStoresRequest{}
 
 	s.natsManagerMock.EXPECT().
-		CreateVersionKeyValueStores(gomock.Any(), gomock.Any(), gomock.Any()).
+		CreateVersionKeyValueStores(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("mock error"))
 
 	_, err := s.natsService.CreateVersionKeyValueStores(nil, req)
@@ -421,3 +899,451 @@ func (s *NatsServiceTestSuite) TestDeleteGlobalKeyValueStore_Fails() {
 	_, err := s.natsService.DeleteGlobalKeyValueStore(context.Background(), req)
 	s.Require().ErrorIs(err, expectedError)
 }
+
+func (s *NatsServiceTestSuite) TestGetProcessConsumerLag() {
+	ctx := context.Background()
+	req := &natspb.GetProcessConsumerLagRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   "test-workflow",
+		Process:    "test-process",
+	}
+
+	s.natsManagerMock.EXPECT().
+		GetProcessConsumerLag(req.ProductId, req.VersionTag, req.Workflow, req.Process).
+		Return(uint64(42), nil)
+
+	res, err := s.natsService.GetProcessConsumerLag(ctx, req)
+	s.Require().NoError(err)
+	s.Equal(uint64(42), res.Lag)
+}
+
+func (s *NatsServiceTestSuite) TestGetProcessConsumerLagError() {
+	req := &natspb.GetProcessConsumerLagRequest{}
+
+	s.natsManagerMock.EXPECT().
+		GetProcessConsumerLag(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(0), errors.New("mock error"))
+
+	_, err := s.natsService.GetProcessConsumerLag(context.Background(), req)
+	s.Require().Error(err)
+}
+
+func (s *NatsServiceTestSuite) TestGetProcessConsumerLag_NoProcessConsumers() {
+	req := &natspb.GetProcessConsumerLagRequest{}
+
+	s.natsManagerMock.EXPECT().
+		GetProcessConsumerLag(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(0), internal.ErrNoProcessConsumers)
+
+	_, err := s.natsService.GetProcessConsumerLag(context.Background(), req)
+	s.Require().Error(err)
+	s.Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *NatsServiceTestSuite) TestGetVersionStreamStats() {
+	req := &natspb.GetVersionStreamStatsRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflows:  protoWorkflows,
+	}
+
+	s.natsManagerMock.EXPECT().
+		GetVersionStreamStats(req.ProductId, req.VersionTag, entityWorkflows).
+		Return([]entity.WorkflowStreamStats{
+			{
+				Workflow:  "test-workflow",
+				Stream:    "test-stream",
+				State:     entity.StreamState{Messages: 10, Bytes: 512, FirstSequence: 1, LastSequence: 10},
+				Consumers: entity.ConsumerStats{Pending: 3, AckPending: 1, Redelivered: 2},
+				Processes: []entity.ProcessStreamStats{
+					{
+						Process:   "test-process",
+						Subject:   "test-stream.test-process",
+						Messages:  4,
+						Consumers: entity.ConsumerStats{Pending: 3},
+					},
+				},
+			},
+		}, nil)
+
+	res, err := s.natsService.GetVersionStreamStats(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal([]*natspb.WorkflowStreamStats{
+		{
+			Workflow:      "test-workflow",
+			Stream:        "test-stream",
+			Messages:      10,
+			Bytes:         512,
+			FirstSequence: 1,
+			LastSequence:  10,
+			Consumers:     &natspb.ConsumerStats{Pending: 3, AckPending: 1, Redelivered: 2},
+			Processes: []*natspb.ProcessStreamStats{
+				{
+					Process:   "test-process",
+					Subject:   "test-stream.test-process",
+					Messages:  4,
+					Consumers: &natspb.ConsumerStats{Pending: 3},
+				},
+			},
+		},
+	}, res.Workflows)
+}
+
+func (s *NatsServiceTestSuite) TestGetDeadLetterMessages() {
+	ctx := context.Background()
+	req := &natspb.GetDeadLetterMessagesRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   "test-workflow",
+	}
+
+	timestamp := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s.natsManagerMock.EXPECT().
+		GetDeadLetterMessages(req.ProductId, req.VersionTag, req.Workflow).
+		Return([]entity.DeadLetterMessage{
+			{
+				Sequence:       1,
+				Stream:         "test-stream",
+				StreamSequence: 10,
+				Consumer:       "test-consumer",
+				Deliveries:     3,
+				Subject:        "test-stream.test-process",
+				Headers:        map[string][]string{"trace-id": {"a", "b"}},
+				Data:           []byte("test-data"),
+				Timestamp:      timestamp,
+			},
+		}, nil)
+
+	res, err := s.natsService.GetDeadLetterMessages(ctx, req)
+	s.Require().NoError(err)
+	s.Equal([]*natspb.DeadLetterMessage{
+		{
+			Sequence:       1,
+			Stream:         "test-stream",
+			StreamSequence: 10,
+			Consumer:       "test-consumer",
+			Deliveries:     3,
+			Subject:        "test-stream.test-process",
+			Headers:        map[string]string{"trace-id": "a,b"},
+			Data:           []byte("test-data"),
+			Timestamp:      "2024-01-01T10:00:00Z",
+		},
+	}, res.Messages)
+}
+
+func (s *NatsServiceTestSuite) TestReplayDeadLetterMessages() {
+	ctx := context.Background()
+	req := &natspb.ReplayDeadLetterMessagesRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   "test-workflow",
+		Sequences:  []uint64{1, 2},
+	}
+
+	s.natsManagerMock.EXPECT().
+		ReplayDeadLetterMessages(req.ProductId, req.VersionTag, req.Workflow, req.Sequences).
+		Return(2, nil)
+
+	res, err := s.natsService.ReplayDeadLetterMessages(ctx, req)
+	s.Require().NoError(err)
+	s.Equal(uint64(2), res.Replayed)
+}
+
+func (s *NatsServiceTestSuite) TestPurgeDeadLetterMessagesError() {
+	req := &natspb.PurgeDeadLetterMessagesRequest{}
+
+	s.natsManagerMock.EXPECT().
+		PurgeDeadLetterMessages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(0, errors.New("mock error"))
+
+	_, err := s.natsService.PurgeDeadLetterMessages(context.Background(), req)
+	s.Require().Error(err)
+}
+
+func (s *NatsServiceTestSuite) TestGetProcessMessages() {
+	req := &natspb.GetProcessMessagesRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   "test-workflow",
+		Process:    "test-process",
+		Count:      10,
+	}
+
+	timestamp := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s.natsManagerMock.EXPECT().
+		GetProcessMessages(req.ProductId, req.VersionTag, req.Workflow, req.Process, 10).
+		Return([]entity.StreamMessage{
+			{
+				Sequence:  5,
+				Subject:   "test-stream.test-process",
+				Headers:   map[string][]string{"trace-id": {"test-trace"}},
+				Data:      []byte("test-data"),
+				Timestamp: timestamp,
+			},
+		}, nil)
+
+	res, err := s.natsService.GetProcessMessages(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal([]*natspb.StreamMessage{
+		{
+			Sequence:  5,
+			Subject:   "test-stream.test-process",
+			Headers:   map[string]string{"trace-id": "test-trace"},
+			Data:      []byte("test-data"),
+			Timestamp: "2024-01-01T10:00:00Z",
+		},
+	}, res.Messages)
+}
+
+func (s *NatsServiceTestSuite) TestPublishMessage() {
+	req := &natspb.PublishMessageRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Subject:    "test-stream.test-process",
+		Headers:    map[string]string{"test-header": "test-value"},
+		Data:       []byte("test-data"),
+	}
+
+	s.natsManagerMock.EXPECT().
+		PublishMessage(req.ProductId, req.VersionTag, req.Subject, map[string][]string{"test-header": {"test-value"}}, req.Data).
+		Return(nil)
+
+	_, err := s.natsService.PublishMessage(context.Background(), req)
+	s.Require().NoError(err)
+}
+
+func (s *NatsServiceTestSuite) TestPublishMessageError() {
+	s.natsManagerMock.EXPECT().
+		PublishMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("mock error"))
+
+	_, err := s.natsService.PublishMessage(context.Background(), &natspb.PublishMessageRequest{})
+	s.Require().Error(err)
+}
+
+func (s *NatsServiceTestSuite) TestGetProcessConfiguration() {
+	req := &natspb.GetProcessConfigurationRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   "test-workflow",
+		Process:    "test-process",
+	}
+
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s.natsManagerMock.EXPECT().
+		GetProcessConfiguration(req.ProductId, req.VersionTag, req.Workflow, req.Process).
+		Return([]entity.ConfigurationEntry{
+			{
+				Scope:         entity.KVScopeWorkflow,
+				KeyValueEntry: entity.KeyValueEntry{Key: "key1", Value: "value1", Revision: 3, Created: created},
+			},
+		}, nil)
+
+	res, err := s.natsService.GetProcessConfiguration(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal([]*natspb.ConfigurationEntry{
+		{
+			Scope: natspb.KeyValueStoreScope_KV_SCOPE_WORKFLOW,
+			Entry: &natspb.KeyValueEntry{Key: "key1", Value: "value1", Revision: 3, Created: "2024-01-01T10:00:00Z"},
+		},
+	}, res.Configuration)
+}
+
+func (s *NatsServiceTestSuite) TestGetConfigurationHistory() {
+	req := &natspb.GetConfigurationHistoryRequest{
+		Store: &natspb.KeyValueStoreRef{
+			ProductId: productID,
+			Scope:     natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL,
+		},
+		Key: "key1",
+	}
+
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s.natsManagerMock.EXPECT().
+		GetConfigurationHistory(entity.KeyValueStoreRef{ProductID: productID, Scope: entity.KVScopeGlobal}, "key1").
+		Return([]entity.KeyValueEntry{
+			{Key: "key1", Value: "value1", Revision: 1, Created: created},
+			{Key: "key1", Revision: 2, Deleted: true, Created: created},
+		}, nil)
+
+	res, err := s.natsService.GetConfigurationHistory(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal([]*natspb.KeyValueEntry{
+		{Key: "key1", Value: "value1", Revision: 1, Created: "2024-01-01T10:00:00Z"},
+		{Key: "key1", Revision: 2, Deleted: true, Created: "2024-01-01T10:00:00Z"},
+	}, res.Revisions)
+}
+
+func (s *NatsServiceTestSuite) TestRollbackConfiguration() {
+	req := &natspb.RollbackConfigurationRequest{
+		Store: &natspb.KeyValueStoreRef{
+			ProductId:  productID,
+			VersionTag: versionTag,
+			Workflow:   "test-workflow",
+			Process:    "test-process",
+			Scope:      natspb.KeyValueStoreScope_KV_SCOPE_PROCESS,
+		},
+		Key:      "key1",
+		Revision: 1,
+	}
+
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	expectedStore := entity.KeyValueStoreRef{
+		ProductID:  productID,
+		VersionTag: versionTag,
+		Workflow:   "test-workflow",
+		Process:    "test-process",
+		Scope:      entity.KVScopeProcess,
+	}
+
+	s.natsManagerMock.EXPECT().
+		RollbackConfiguration(expectedStore, "key1", uint64(1)).
+		Return(&entity.KeyValueEntry{Key: "key1", Value: "value1", Revision: 4, Created: created}, nil)
+
+	res, err := s.natsService.RollbackConfiguration(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal(&natspb.KeyValueEntry{Key: "key1", Value: "value1", Revision: 4, Created: "2024-01-01T10:00:00Z"}, res.Entry)
+}
+
+func (s *NatsServiceTestSuite) TestRollbackConfigurationError() {
+	req := &natspb.RollbackConfigurationRequest{
+		Store:    &natspb.KeyValueStoreRef{ProductId: productID},
+		Key:      "key1",
+		Revision: 1,
+	}
+	expectedErr := errors.New("invalid scope")
+
+	s.natsManagerMock.EXPECT().
+		RollbackConfiguration(entity.KeyValueStoreRef{ProductID: productID}, "key1", uint64(1)).
+		Return(nil, expectedErr)
+
+	_, err := s.natsService.RollbackConfiguration(context.Background(), req)
+	s.ErrorIs(err, expectedErr)
+}
+
+func (s *NatsServiceTestSuite) TestGetConfiguration() {
+	req := &natspb.GetConfigurationRequest{
+		Store: &natspb.KeyValueStoreRef{ProductId: productID, Scope: natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL},
+	}
+
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	expectedStore := entity.KeyValueStoreRef{ProductID: productID, Scope: entity.KVScopeGlobal}
+
+	s.natsManagerMock.EXPECT().
+		GetConfiguration(expectedStore).
+		Return([]entity.KeyValueEntry{{Key: "key1", Value: "value1", Revision: 2, Created: created}}, nil)
+
+	res, err := s.natsService.GetConfiguration(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal([]*natspb.KeyValueEntry{
+		{Key: "key1", Value: "value1", Revision: 2, Created: "2024-01-01T10:00:00Z"},
+	}, res.Entries)
+}
+
+func (s *NatsServiceTestSuite) TestSetConfiguration() {
+	req := &natspb.SetConfigurationRequest{
+		Store: &natspb.KeyValueStoreRef{ProductId: productID, Scope: natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL},
+		Key:   "key1",
+		Value: "value1",
+	}
+
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	expectedStore := entity.KeyValueStoreRef{ProductID: productID, Scope: entity.KVScopeGlobal}
+
+	s.natsManagerMock.EXPECT().
+		SetConfiguration(expectedStore, "key1", "value1").
+		Return(&entity.KeyValueEntry{Key: "key1", Value: "value1", Revision: 3, Created: created}, nil)
+
+	res, err := s.natsService.SetConfiguration(context.Background(), req)
+	s.Require().NoError(err)
+	s.Equal(&natspb.KeyValueEntry{Key: "key1", Value: "value1", Revision: 3, Created: "2024-01-01T10:00:00Z"}, res.Entry)
+}
+
+func (s *NatsServiceTestSuite) TestDeleteConfiguration() {
+	req := &natspb.DeleteConfigurationRequest{
+		Store: &natspb.KeyValueStoreRef{ProductId: productID, Scope: natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL},
+		Key:   "key1",
+	}
+
+	s.natsManagerMock.EXPECT().
+		DeleteConfiguration(entity.KeyValueStoreRef{ProductID: productID, Scope: entity.KVScopeGlobal}, "key1").
+		Return(nil)
+
+	_, err := s.natsService.DeleteConfiguration(context.Background(), req)
+	s.Require().NoError(err)
+}
+
+func (s *NatsServiceTestSuite) TestDeleteConfigurationError() {
+	req := &natspb.DeleteConfigurationRequest{
+		Store: &natspb.KeyValueStoreRef{ProductId: productID, Scope: natspb.KeyValueStoreScope_KV_SCOPE_GLOBAL},
+		Key:   "unknown",
+	}
+
+	s.natsManagerMock.EXPECT().
+		DeleteConfiguration(entity.KeyValueStoreRef{ProductID: productID, Scope: entity.KVScopeGlobal}, "unknown").
+		Return(internal.ErrConfigurationKeyNotFound)
+
+	_, err := s.natsService.DeleteConfiguration(context.Background(), req)
+	s.ErrorIs(err, internal.ErrConfigurationKeyNotFound)
+}
+
+type watchConfigurationStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *natspb.ConfigurationEntry
+}
+
+func (w *watchConfigurationStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *watchConfigurationStream) Send(entry *natspb.ConfigurationEntry) error {
+	w.sent <- entry
+	return nil
+}
+
+func (s *NatsServiceTestSuite) TestWatchProcessConfiguration() {
+	req := &natspb.WatchProcessConfigurationRequest{
+		ProductId:  productID,
+		VersionTag: versionTag,
+		Workflow:   "test-workflow",
+		Process:    "test-process",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &watchConfigurationStream{ctx: ctx, sent: make(chan *natspb.ConfigurationEntry, 1)}
+	created := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s.natsManagerMock.EXPECT().
+		WatchProcessConfiguration(ctx, req.ProductId, req.VersionTag, req.Workflow, req.Process, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _, _, _, _ string, updates chan<- entity.ConfigurationEntry) error {
+			updates <- entity.ConfigurationEntry{
+				Scope:         entity.KVScopeVersion,
+				KeyValueEntry: entity.KeyValueEntry{Key: "key1", Value: "value1", Revision: 2, Created: created},
+			}
+			<-ctx.Done()
+
+			return nil
+		})
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- s.natsService.WatchProcessConfiguration(req, stream)
+	}()
+
+	s.Equal(&natspb.ConfigurationEntry{
+		Scope: natspb.KeyValueStoreScope_KV_SCOPE_VERSION,
+		Entry: &natspb.KeyValueEntry{Key: "key1", Value: "value1", Revision: 2, Created: "2024-01-01T10:00:00Z"},
+	}, <-stream.sent)
+
+	cancel()
+	s.NoError(<-errCh)
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"github.com/konstellation-io/kai/engine/nats-manager/proto/natspb"
+)
+
+// _objectChunkSize keeps streamed object chunks well below the default gRPC message size limit.
+const _objectChunkSize = 512 * 1024
+
+// objectChunkWriter sends everything written to it as object chunks through a GetObject stream.
+type objectChunkWriter struct {
+	stream natspb.NatsManagerService_GetObjectServer
+}
+
+func (w *objectChunkWriter) Write(p []byte) (int, error) {
+	written := 0
+
+	for written < len(p) {
+		end := min(written+_objectChunkSize, len(p))
+
+		if err := w.stream.Send(&natspb.ObjectChunk{Data: p[written:end]}); err != nil {
+			return written, err
+		}
+
+		written = end
+	}
+
+	return written, nil
+}
+
+// objectStreamReader reads the object content sent by the client through a PutObject stream.
+type objectStreamReader struct {
+	stream natspb.NatsManagerService_PutObjectServer
+	buf    []byte
+}
+
+func (r *objectStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.buf = req.Data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
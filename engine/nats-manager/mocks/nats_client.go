@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	context "context"
+	io "io"
 	reflect "reflect"
 	regexp "regexp"
 
@@ -35,32 +37,102 @@ func (m *MockNatsClient) EXPECT() *MockNatsClientMockRecorder {
 	return m.recorder
 }
 
+// AddStreamSource mocks base method.
+func (m *MockNatsClient) AddStreamSource(stream string, source *entity.StreamSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddStreamSource", stream, source)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddStreamSource indicates an expected call of AddStreamSource.
+func (mr *MockNatsClientMockRecorder) AddStreamSource(stream, source interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStreamSource", reflect.TypeOf((*MockNatsClient)(nil).AddStreamSource), stream, source)
+}
+
+// ConsumeMaxDeliveriesAdvisories mocks base method.
+func (m *MockNatsClient) ConsumeMaxDeliveriesAdvisories(handler func(*entity.MaxDeliveriesAdvisory) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeMaxDeliveriesAdvisories", handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsumeMaxDeliveriesAdvisories indicates an expected call of ConsumeMaxDeliveriesAdvisories.
+func (mr *MockNatsClientMockRecorder) ConsumeMaxDeliveriesAdvisories(handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMaxDeliveriesAdvisories", reflect.TypeOf((*MockNatsClient)(nil).ConsumeMaxDeliveriesAdvisories), handler)
+}
+
+// CopyToDeadLetterStream mocks base method.
+func (m *MockNatsClient) CopyToDeadLetterStream(deadLetterStream string, advisory *entity.MaxDeliveriesAdvisory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyToDeadLetterStream", deadLetterStream, advisory)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyToDeadLetterStream indicates an expected call of CopyToDeadLetterStream.
+func (mr *MockNatsClientMockRecorder) CopyToDeadLetterStream(deadLetterStream, advisory interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyToDeadLetterStream", reflect.TypeOf((*MockNatsClient)(nil).CopyToDeadLetterStream), deadLetterStream, advisory)
+}
+
+// CreateDeadLetterStream mocks base method.
+func (m *MockNatsClient) CreateDeadLetterStream(deadLetterStream string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeadLetterStream", deadLetterStream)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeadLetterStream indicates an expected call of CreateDeadLetterStream.
+func (mr *MockNatsClientMockRecorder) CreateDeadLetterStream(deadLetterStream interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeadLetterStream", reflect.TypeOf((*MockNatsClient)(nil).CreateDeadLetterStream), deadLetterStream)
+}
+
 // CreateKeyValueStore mocks base method.
-func (m *MockNatsClient) CreateKeyValueStore(keyValueStore string) error {
+func (m *MockNatsClient) CreateKeyValueStore(keyValueStore string, settings *entity.KeyValueStoreSettings) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKeyValueStore", keyValueStore)
+	ret := m.ctrl.Call(m, "CreateKeyValueStore", keyValueStore, settings)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateKeyValueStore indicates an expected call of CreateKeyValueStore.
-func (mr *MockNatsClientMockRecorder) CreateKeyValueStore(keyValueStore interface{}) *gomock.Call {
+func (mr *MockNatsClientMockRecorder) CreateKeyValueStore(keyValueStore, settings interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKeyValueStore", reflect.TypeOf((*MockNatsClient)(nil).CreateKeyValueStore), keyValueStore)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKeyValueStore", reflect.TypeOf((*MockNatsClient)(nil).CreateKeyValueStore), keyValueStore, settings)
 }
 
 // CreateObjectStore mocks base method.
-func (m *MockNatsClient) CreateObjectStore(objectStore string) error {
+func (m *MockNatsClient) CreateObjectStore(objectStore string, settings *entity.ObjectStoreSettings) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateObjectStore", objectStore)
+	ret := m.ctrl.Call(m, "CreateObjectStore", objectStore, settings)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateObjectStore indicates an expected call of CreateObjectStore.
-func (mr *MockNatsClientMockRecorder) CreateObjectStore(objectStore interface{}) *gomock.Call {
+func (mr *MockNatsClientMockRecorder) CreateObjectStore(objectStore, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateObjectStore", reflect.TypeOf((*MockNatsClient)(nil).CreateObjectStore), objectStore, settings)
+}
+
+// CreatePersistentObjectStore mocks base method.
+func (m *MockNatsClient) CreatePersistentObjectStore(objectStore string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersistentObjectStore", objectStore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePersistentObjectStore indicates an expected call of CreatePersistentObjectStore.
+func (mr *MockNatsClientMockRecorder) CreatePersistentObjectStore(objectStore interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateObjectStore", reflect.TypeOf((*MockNatsClient)(nil).CreateObjectStore), objectStore)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersistentObjectStore", reflect.TypeOf((*MockNatsClient)(nil).CreatePersistentObjectStore), objectStore)
 }
 
 // CreateStream mocks base method.
@@ -77,6 +149,35 @@ func (mr *MockNatsClientMockRecorder) CreateStream(streamConfig interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStream", reflect.TypeOf((*MockNatsClient)(nil).CreateStream), streamConfig)
 }
 
+// CreateUser mocks base method.
+func (m *MockNatsClient) CreateUser(name string, permissions *entity.UserPermissions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", name, permissions)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockNatsClientMockRecorder) CreateUser(name, permissions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockNatsClient)(nil).CreateUser), name, permissions)
+}
+
+// DeleteKeyValue mocks base method.
+func (m *MockNatsClient) DeleteKeyValue(keyValueStore, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKeyValue", keyValueStore, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKeyValue indicates an expected call of DeleteKeyValue.
+func (mr *MockNatsClientMockRecorder) DeleteKeyValue(keyValueStore, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKeyValue", reflect.TypeOf((*MockNatsClient)(nil).DeleteKeyValue), keyValueStore, key)
+}
+
 // DeleteKeyValueStore mocks base method.
 func (m *MockNatsClient) DeleteKeyValueStore(keyValueStore string) error {
 	m.ctrl.T.Helper()
@@ -91,6 +192,34 @@ func (mr *MockNatsClientMockRecorder) DeleteKeyValueStore(keyValueStore interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKeyValueStore", reflect.TypeOf((*MockNatsClient)(nil).DeleteKeyValueStore), keyValueStore)
 }
 
+// DeleteMessage mocks base method.
+func (m *MockNatsClient) DeleteMessage(stream string, sequence uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", stream, sequence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockNatsClientMockRecorder) DeleteMessage(stream, sequence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockNatsClient)(nil).DeleteMessage), stream, sequence)
+}
+
+// DeleteObject mocks base method.
+func (m *MockNatsClient) DeleteObject(objectStore, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", objectStore, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockNatsClientMockRecorder) DeleteObject(objectStore, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockNatsClient)(nil).DeleteObject), objectStore, name)
+}
+
 // DeleteObjectStore mocks base method.
 func (m *MockNatsClient) DeleteObjectStore(stream string) error {
 	m.ctrl.T.Helper()
@@ -119,6 +248,144 @@ func (mr *MockNatsClientMockRecorder) DeleteStream(stream interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStream", reflect.TypeOf((*MockNatsClient)(nil).DeleteStream), stream)
 }
 
+// GetConsumersLag mocks base method.
+func (m *MockNatsClient) GetConsumersLag(stream string) ([]entity.ConsumerLag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumersLag", stream)
+	ret0, _ := ret[0].([]entity.ConsumerLag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumersLag indicates an expected call of GetConsumersLag.
+func (mr *MockNatsClientMockRecorder) GetConsumersLag(stream interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumersLag", reflect.TypeOf((*MockNatsClient)(nil).GetConsumersLag), stream)
+}
+
+// GetDeadLetterMessage mocks base method.
+func (m *MockNatsClient) GetDeadLetterMessage(deadLetterStream string, sequence uint64) (*entity.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterMessage", deadLetterStream, sequence)
+	ret0, _ := ret[0].(*entity.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterMessage indicates an expected call of GetDeadLetterMessage.
+func (mr *MockNatsClientMockRecorder) GetDeadLetterMessage(deadLetterStream, sequence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessage", reflect.TypeOf((*MockNatsClient)(nil).GetDeadLetterMessage), deadLetterStream, sequence)
+}
+
+// GetDeadLetterMessages mocks base method.
+func (m *MockNatsClient) GetDeadLetterMessages(deadLetterStream string) ([]entity.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterMessages", deadLetterStream)
+	ret0, _ := ret[0].([]entity.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterMessages indicates an expected call of GetDeadLetterMessages.
+func (mr *MockNatsClientMockRecorder) GetDeadLetterMessages(deadLetterStream interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessages", reflect.TypeOf((*MockNatsClient)(nil).GetDeadLetterMessages), deadLetterStream)
+}
+
+// GetKeyHistory mocks base method.
+func (m *MockNatsClient) GetKeyHistory(keyValueStore, key string) ([]entity.KeyValueEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyHistory", keyValueStore, key)
+	ret0, _ := ret[0].([]entity.KeyValueEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyHistory indicates an expected call of GetKeyHistory.
+func (mr *MockNatsClientMockRecorder) GetKeyHistory(keyValueStore, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyHistory", reflect.TypeOf((*MockNatsClient)(nil).GetKeyHistory), keyValueStore, key)
+}
+
+// GetKeyRevision mocks base method.
+func (m *MockNatsClient) GetKeyRevision(keyValueStore, key string, revision uint64) (*entity.KeyValueEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyRevision", keyValueStore, key, revision)
+	ret0, _ := ret[0].(*entity.KeyValueEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyRevision indicates an expected call of GetKeyRevision.
+func (mr *MockNatsClientMockRecorder) GetKeyRevision(keyValueStore, key, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyRevision", reflect.TypeOf((*MockNatsClient)(nil).GetKeyRevision), keyValueStore, key, revision)
+}
+
+// GetKeyValueEntries mocks base method.
+func (m *MockNatsClient) GetKeyValueEntries(keyValueStore string) ([]entity.KeyValueEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyValueEntries", keyValueStore)
+	ret0, _ := ret[0].([]entity.KeyValueEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyValueEntries indicates an expected call of GetKeyValueEntries.
+func (mr *MockNatsClientMockRecorder) GetKeyValueEntries(keyValueStore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyValueEntries", reflect.TypeOf((*MockNatsClient)(nil).GetKeyValueEntries), keyValueStore)
+}
+
+// GetKeyValueStoreNames mocks base method.
+func (m *MockNatsClient) GetKeyValueStoreNames(optFilter ...*regexp.Regexp) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range optFilter {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetKeyValueStoreNames", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyValueStoreNames indicates an expected call of GetKeyValueStoreNames.
+func (mr *MockNatsClientMockRecorder) GetKeyValueStoreNames(optFilter ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyValueStoreNames", reflect.TypeOf((*MockNatsClient)(nil).GetKeyValueStoreNames), optFilter...)
+}
+
+// GetLastMessages mocks base method.
+func (m *MockNatsClient) GetLastMessages(stream, subject string, count int) ([]entity.StreamMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMessages", stream, subject, count)
+	ret0, _ := ret[0].([]entity.StreamMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMessages indicates an expected call of GetLastMessages.
+func (mr *MockNatsClientMockRecorder) GetLastMessages(stream, subject, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMessages", reflect.TypeOf((*MockNatsClient)(nil).GetLastMessages), stream, subject, count)
+}
+
+// GetObject mocks base method.
+func (m *MockNatsClient) GetObject(objectStore, name string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", objectStore, name, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockNatsClientMockRecorder) GetObject(objectStore, name, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockNatsClient)(nil).GetObject), objectStore, name, w)
+}
+
 // GetObjectStoreNames mocks base method.
 func (m *MockNatsClient) GetObjectStoreNames(optFilter ...*regexp.Regexp) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +424,123 @@ func (mr *MockNatsClientMockRecorder) GetStreamNames(optFilter ...interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamNames", reflect.TypeOf((*MockNatsClient)(nil).GetStreamNames), optFilter...)
 }
 
+// GetStreamState mocks base method.
+func (m *MockNatsClient) GetStreamState(stream string) (*entity.StreamState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamState", stream)
+	ret0, _ := ret[0].(*entity.StreamState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamState indicates an expected call of GetStreamState.
+func (mr *MockNatsClientMockRecorder) GetStreamState(stream interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamState", reflect.TypeOf((*MockNatsClient)(nil).GetStreamState), stream)
+}
+
+// ListObjects mocks base method.
+func (m *MockNatsClient) ListObjects(objectStore string) ([]entity.ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", objectStore)
+	ret0, _ := ret[0].([]entity.ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockNatsClientMockRecorder) ListObjects(objectStore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockNatsClient)(nil).ListObjects), objectStore)
+}
+
+// PublishMessage mocks base method.
+func (m *MockNatsClient) PublishMessage(subject string, headers map[string][]string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMessage", subject, headers, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishMessage indicates an expected call of PublishMessage.
+func (mr *MockNatsClientMockRecorder) PublishMessage(subject, headers, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMessage", reflect.TypeOf((*MockNatsClient)(nil).PublishMessage), subject, headers, data)
+}
+
+// PurgeStream mocks base method.
+func (m *MockNatsClient) PurgeStream(stream string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeStream", stream)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeStream indicates an expected call of PurgeStream.
+func (mr *MockNatsClientMockRecorder) PurgeStream(stream interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeStream", reflect.TypeOf((*MockNatsClient)(nil).PurgeStream), stream)
+}
+
+// PutKeyValue mocks base method.
+func (m *MockNatsClient) PutKeyValue(keyValueStore, key, value string) (*entity.KeyValueEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutKeyValue", keyValueStore, key, value)
+	ret0, _ := ret[0].(*entity.KeyValueEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutKeyValue indicates an expected call of PutKeyValue.
+func (mr *MockNatsClientMockRecorder) PutKeyValue(keyValueStore, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutKeyValue", reflect.TypeOf((*MockNatsClient)(nil).PutKeyValue), keyValueStore, key, value)
+}
+
+// PutObject mocks base method.
+func (m *MockNatsClient) PutObject(objectStore, name string, r io.Reader) (*entity.ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObject", objectStore, name, r)
+	ret0, _ := ret[0].(*entity.ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutObject indicates an expected call of PutObject.
+func (mr *MockNatsClientMockRecorder) PutObject(objectStore, name, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockNatsClient)(nil).PutObject), objectStore, name, r)
+}
+
+// RemoveStreamSources mocks base method.
+func (m *MockNatsClient) RemoveStreamSources(stream string, sourceFilter *regexp.Regexp) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveStreamSources", stream, sourceFilter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveStreamSources indicates an expected call of RemoveStreamSources.
+func (mr *MockNatsClientMockRecorder) RemoveStreamSources(stream, sourceFilter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveStreamSources", reflect.TypeOf((*MockNatsClient)(nil).RemoveStreamSources), stream, sourceFilter)
+}
+
+// RevokeUser mocks base method.
+func (m *MockNatsClient) RevokeUser(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUser", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUser indicates an expected call of RevokeUser.
+func (mr *MockNatsClientMockRecorder) RevokeUser(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUser", reflect.TypeOf((*MockNatsClient)(nil).RevokeUser), name)
+}
+
 // UpdateConfiguration mocks base method.
 func (m *MockNatsClient) UpdateConfiguration(keyValueStore string, keyValueConfig map[string]string) error {
 	m.ctrl.T.Helper()
@@ -170,3 +554,17 @@ func (mr *MockNatsClientMockRecorder) UpdateConfiguration(keyValueStore, keyValu
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfiguration", reflect.TypeOf((*MockNatsClient)(nil).UpdateConfiguration), keyValueStore, keyValueConfig)
 }
+
+// WatchKeyValueStore mocks base method.
+func (m *MockNatsClient) WatchKeyValueStore(ctx context.Context, keyValueStore string, updates chan<- entity.KeyValueEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchKeyValueStore", ctx, keyValueStore, updates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchKeyValueStore indicates an expected call of WatchKeyValueStore.
+func (mr *MockNatsClientMockRecorder) WatchKeyValueStore(ctx, keyValueStore, updates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchKeyValueStore", reflect.TypeOf((*MockNatsClient)(nil).WatchKeyValueStore), ctx, keyValueStore, updates)
+}
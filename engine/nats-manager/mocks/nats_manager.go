@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -64,6 +66,21 @@ func (mr *MockNatsManagerMockRecorder) CreateObjectStores(productID, versionTag,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateObjectStores", reflect.TypeOf((*MockNatsManager)(nil).CreateObjectStores), productID, versionTag, workflows)
 }
 
+// CreateProductObjectStore mocks base method.
+func (m *MockNatsManager) CreateProductObjectStore(productID, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductObjectStore", productID, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductObjectStore indicates an expected call of CreateProductObjectStore.
+func (mr *MockNatsManagerMockRecorder) CreateProductObjectStore(productID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductObjectStore", reflect.TypeOf((*MockNatsManager)(nil).CreateProductObjectStore), productID, name)
+}
+
 // CreateStreams mocks base method.
 func (m *MockNatsManager) CreateStreams(productID, versionTag string, workflows []entity.Workflow) (entity.WorkflowsStreamsConfig, error) {
 	m.ctrl.T.Helper()
@@ -79,19 +96,48 @@ func (mr *MockNatsManagerMockRecorder) CreateStreams(productID, versionTag, work
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreams", reflect.TypeOf((*MockNatsManager)(nil).CreateStreams), productID, versionTag, workflows)
 }
 
+// CreateVersionCredentials mocks base method.
+func (m *MockNatsManager) CreateVersionCredentials(productID, versionTag string, workflows []entity.Workflow) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVersionCredentials", productID, versionTag, workflows)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVersionCredentials indicates an expected call of CreateVersionCredentials.
+func (mr *MockNatsManagerMockRecorder) CreateVersionCredentials(productID, versionTag, workflows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionCredentials", reflect.TypeOf((*MockNatsManager)(nil).CreateVersionCredentials), productID, versionTag, workflows)
+}
+
 // CreateVersionKeyValueStores mocks base method.
-func (m *MockNatsManager) CreateVersionKeyValueStores(productID, versionTag string, workflows []entity.Workflow) (*entity.VersionKeyValueStores, error) {
+func (m *MockNatsManager) CreateVersionKeyValueStores(productID, versionTag string, workflows []entity.Workflow, versionStoreSettings *entity.KeyValueStoreSettings) (*entity.VersionKeyValueStores, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVersionKeyValueStores", productID, versionTag, workflows)
+	ret := m.ctrl.Call(m, "CreateVersionKeyValueStores", productID, versionTag, workflows, versionStoreSettings)
 	ret0, _ := ret[0].(*entity.VersionKeyValueStores)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVersionKeyValueStores indicates an expected call of CreateVersionKeyValueStores.
-func (mr *MockNatsManagerMockRecorder) CreateVersionKeyValueStores(productID, versionTag, workflows interface{}) *gomock.Call {
+func (mr *MockNatsManagerMockRecorder) CreateVersionKeyValueStores(productID, versionTag, workflows, versionStoreSettings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionKeyValueStores", reflect.TypeOf((*MockNatsManager)(nil).CreateVersionKeyValueStores), productID, versionTag, workflows, versionStoreSettings)
+}
+
+// DeleteConfiguration mocks base method.
+func (m *MockNatsManager) DeleteConfiguration(store entity.KeyValueStoreRef, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfiguration", store, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConfiguration indicates an expected call of DeleteConfiguration.
+func (mr *MockNatsManagerMockRecorder) DeleteConfiguration(store, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVersionKeyValueStores", reflect.TypeOf((*MockNatsManager)(nil).CreateVersionKeyValueStores), productID, versionTag, workflows)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfiguration", reflect.TypeOf((*MockNatsManager)(nil).DeleteConfiguration), store, key)
 }
 
 // DeleteGlobalKeyValueStore mocks base method.
@@ -108,6 +154,20 @@ func (mr *MockNatsManagerMockRecorder) DeleteGlobalKeyValueStore(productID inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalKeyValueStore", reflect.TypeOf((*MockNatsManager)(nil).DeleteGlobalKeyValueStore), productID)
 }
 
+// DeleteObject mocks base method.
+func (m *MockNatsManager) DeleteObject(productID, objectStore, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", productID, objectStore, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockNatsManagerMockRecorder) DeleteObject(productID, objectStore, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockNatsManager)(nil).DeleteObject), productID, objectStore, name)
+}
+
 // DeleteObjectStores mocks base method.
 func (m *MockNatsManager) DeleteObjectStores(productID, versionTag string) error {
 	m.ctrl.T.Helper()
@@ -122,6 +182,34 @@ func (mr *MockNatsManagerMockRecorder) DeleteObjectStores(productID, versionTag
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectStores", reflect.TypeOf((*MockNatsManager)(nil).DeleteObjectStores), productID, versionTag)
 }
 
+// DeleteProductObjectStore mocks base method.
+func (m *MockNatsManager) DeleteProductObjectStore(productID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductObjectStore", productID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductObjectStore indicates an expected call of DeleteProductObjectStore.
+func (mr *MockNatsManagerMockRecorder) DeleteProductObjectStore(productID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductObjectStore", reflect.TypeOf((*MockNatsManager)(nil).DeleteProductObjectStore), productID, name)
+}
+
+// DeleteResources mocks base method.
+func (m *MockNatsManager) DeleteResources(resources []entity.NatsResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResources", resources)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResources indicates an expected call of DeleteResources.
+func (mr *MockNatsManagerMockRecorder) DeleteResources(resources interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResources", reflect.TypeOf((*MockNatsManager)(nil).DeleteResources), resources)
+}
+
 // DeleteStreams mocks base method.
 func (m *MockNatsManager) DeleteStreams(productID, versionTag string) error {
 	m.ctrl.T.Helper()
@@ -150,6 +238,316 @@ func (mr *MockNatsManagerMockRecorder) DeleteVersionKeyValueStores(productID, ve
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersionKeyValueStores", reflect.TypeOf((*MockNatsManager)(nil).DeleteVersionKeyValueStores), productID, versionTag, workflows)
 }
 
+// GetConfiguration mocks base method.
+func (m *MockNatsManager) GetConfiguration(store entity.KeyValueStoreRef) ([]entity.KeyValueEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfiguration", store)
+	ret0, _ := ret[0].([]entity.KeyValueEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfiguration indicates an expected call of GetConfiguration.
+func (mr *MockNatsManagerMockRecorder) GetConfiguration(store interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfiguration", reflect.TypeOf((*MockNatsManager)(nil).GetConfiguration), store)
+}
+
+// GetConfigurationHistory mocks base method.
+func (m *MockNatsManager) GetConfigurationHistory(store entity.KeyValueStoreRef, key string) ([]entity.KeyValueEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigurationHistory", store, key)
+	ret0, _ := ret[0].([]entity.KeyValueEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigurationHistory indicates an expected call of GetConfigurationHistory.
+func (mr *MockNatsManagerMockRecorder) GetConfigurationHistory(store, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigurationHistory", reflect.TypeOf((*MockNatsManager)(nil).GetConfigurationHistory), store, key)
+}
+
+// GetDeadLetterMessages mocks base method.
+func (m *MockNatsManager) GetDeadLetterMessages(productID, versionTag, workflow string) ([]entity.DeadLetterMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterMessages", productID, versionTag, workflow)
+	ret0, _ := ret[0].([]entity.DeadLetterMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterMessages indicates an expected call of GetDeadLetterMessages.
+func (mr *MockNatsManagerMockRecorder) GetDeadLetterMessages(productID, versionTag, workflow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterMessages", reflect.TypeOf((*MockNatsManager)(nil).GetDeadLetterMessages), productID, versionTag, workflow)
+}
+
+// GetObject mocks base method.
+func (m *MockNatsManager) GetObject(productID, objectStore, name string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", productID, objectStore, name, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockNatsManagerMockRecorder) GetObject(productID, objectStore, name, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockNatsManager)(nil).GetObject), productID, objectStore, name, w)
+}
+
+// GetProcessConfiguration mocks base method.
+func (m *MockNatsManager) GetProcessConfiguration(productID, versionTag, workflow, process string) ([]entity.ConfigurationEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessConfiguration", productID, versionTag, workflow, process)
+	ret0, _ := ret[0].([]entity.ConfigurationEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessConfiguration indicates an expected call of GetProcessConfiguration.
+func (mr *MockNatsManagerMockRecorder) GetProcessConfiguration(productID, versionTag, workflow, process interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessConfiguration", reflect.TypeOf((*MockNatsManager)(nil).GetProcessConfiguration), productID, versionTag, workflow, process)
+}
+
+// GetProcessConsumerLag mocks base method.
+func (m *MockNatsManager) GetProcessConsumerLag(productID, versionTag, workflow, process string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessConsumerLag", productID, versionTag, workflow, process)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessConsumerLag indicates an expected call of GetProcessConsumerLag.
+func (mr *MockNatsManagerMockRecorder) GetProcessConsumerLag(productID, versionTag, workflow, process interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessConsumerLag", reflect.TypeOf((*MockNatsManager)(nil).GetProcessConsumerLag), productID, versionTag, workflow, process)
+}
+
+// GetProcessMessages mocks base method.
+func (m *MockNatsManager) GetProcessMessages(productID, versionTag, workflow, process string, count int) ([]entity.StreamMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessMessages", productID, versionTag, workflow, process, count)
+	ret0, _ := ret[0].([]entity.StreamMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessMessages indicates an expected call of GetProcessMessages.
+func (mr *MockNatsManagerMockRecorder) GetProcessMessages(productID, versionTag, workflow, process, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessMessages", reflect.TypeOf((*MockNatsManager)(nil).GetProcessMessages), productID, versionTag, workflow, process, count)
+}
+
+// GetProductObjectStores mocks base method.
+func (m *MockNatsManager) GetProductObjectStores(productID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductObjectStores", productID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductObjectStores indicates an expected call of GetProductObjectStores.
+func (mr *MockNatsManagerMockRecorder) GetProductObjectStores(productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductObjectStores", reflect.TypeOf((*MockNatsManager)(nil).GetProductObjectStores), productID)
+}
+
+// GetVersionResources mocks base method.
+func (m *MockNatsManager) GetVersionResources(productID, versionTag string, workflows []entity.Workflow) (*entity.VersionResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionResources", productID, versionTag, workflows)
+	ret0, _ := ret[0].(*entity.VersionResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersionResources indicates an expected call of GetVersionResources.
+func (mr *MockNatsManagerMockRecorder) GetVersionResources(productID, versionTag, workflows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionResources", reflect.TypeOf((*MockNatsManager)(nil).GetVersionResources), productID, versionTag, workflows)
+}
+
+// GetVersionStreamStats mocks base method.
+func (m *MockNatsManager) GetVersionStreamStats(productID, versionTag string, workflows []entity.Workflow) ([]entity.WorkflowStreamStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionStreamStats", productID, versionTag, workflows)
+	ret0, _ := ret[0].([]entity.WorkflowStreamStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersionStreamStats indicates an expected call of GetVersionStreamStats.
+func (mr *MockNatsManagerMockRecorder) GetVersionStreamStats(productID, versionTag, workflows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionStreamStats", reflect.TypeOf((*MockNatsManager)(nil).GetVersionStreamStats), productID, versionTag, workflows)
+}
+
+// ListObjects mocks base method.
+func (m *MockNatsManager) ListObjects(productID, objectStore string) ([]entity.ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", productID, objectStore)
+	ret0, _ := ret[0].([]entity.ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockNatsManagerMockRecorder) ListObjects(productID, objectStore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockNatsManager)(nil).ListObjects), productID, objectStore)
+}
+
+// ListResources mocks base method.
+func (m *MockNatsManager) ListResources() ([]entity.NatsResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources")
+	ret0, _ := ret[0].([]entity.NatsResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockNatsManagerMockRecorder) ListResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockNatsManager)(nil).ListResources))
+}
+
+// PublishMessage mocks base method.
+func (m *MockNatsManager) PublishMessage(productID, versionTag, subject string, headers map[string][]string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMessage", productID, versionTag, subject, headers, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishMessage indicates an expected call of PublishMessage.
+func (mr *MockNatsManagerMockRecorder) PublishMessage(productID, versionTag, subject, headers, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMessage", reflect.TypeOf((*MockNatsManager)(nil).PublishMessage), productID, versionTag, subject, headers, data)
+}
+
+// PurgeDeadLetterMessages mocks base method.
+func (m *MockNatsManager) PurgeDeadLetterMessages(productID, versionTag, workflow string, sequences []uint64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetterMessages", productID, versionTag, workflow, sequences)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetterMessages indicates an expected call of PurgeDeadLetterMessages.
+func (mr *MockNatsManagerMockRecorder) PurgeDeadLetterMessages(productID, versionTag, workflow, sequences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetterMessages", reflect.TypeOf((*MockNatsManager)(nil).PurgeDeadLetterMessages), productID, versionTag, workflow, sequences)
+}
+
+// PutObject mocks base method.
+func (m *MockNatsManager) PutObject(productID, objectStore, name string, r io.Reader) (*entity.ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObject", productID, objectStore, name, r)
+	ret0, _ := ret[0].(*entity.ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutObject indicates an expected call of PutObject.
+func (mr *MockNatsManagerMockRecorder) PutObject(productID, objectStore, name, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockNatsManager)(nil).PutObject), productID, objectStore, name, r)
+}
+
+// ReplayDeadLetterMessages mocks base method.
+func (m *MockNatsManager) ReplayDeadLetterMessages(productID, versionTag, workflow string, sequences []uint64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetterMessages", productID, versionTag, workflow, sequences)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetterMessages indicates an expected call of ReplayDeadLetterMessages.
+func (mr *MockNatsManagerMockRecorder) ReplayDeadLetterMessages(productID, versionTag, workflow, sequences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetterMessages", reflect.TypeOf((*MockNatsManager)(nil).ReplayDeadLetterMessages), productID, versionTag, workflow, sequences)
+}
+
+// RevokeVersionCredentials mocks base method.
+func (m *MockNatsManager) RevokeVersionCredentials(productID, versionTag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeVersionCredentials", productID, versionTag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeVersionCredentials indicates an expected call of RevokeVersionCredentials.
+func (mr *MockNatsManagerMockRecorder) RevokeVersionCredentials(productID, versionTag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeVersionCredentials", reflect.TypeOf((*MockNatsManager)(nil).RevokeVersionCredentials), productID, versionTag)
+}
+
+// RollbackConfiguration mocks base method.
+func (m *MockNatsManager) RollbackConfiguration(store entity.KeyValueStoreRef, key string, revision uint64) (*entity.KeyValueEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackConfiguration", store, key, revision)
+	ret0, _ := ret[0].(*entity.KeyValueEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackConfiguration indicates an expected call of RollbackConfiguration.
+func (mr *MockNatsManagerMockRecorder) RollbackConfiguration(store, key, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackConfiguration", reflect.TypeOf((*MockNatsManager)(nil).RollbackConfiguration), store, key, revision)
+}
+
+// SetConfiguration mocks base method.
+func (m *MockNatsManager) SetConfiguration(store entity.KeyValueStoreRef, key, value string) (*entity.KeyValueEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConfiguration", store, key, value)
+	ret0, _ := ret[0].(*entity.KeyValueEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConfiguration indicates an expected call of SetConfiguration.
+func (mr *MockNatsManagerMockRecorder) SetConfiguration(store, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfiguration", reflect.TypeOf((*MockNatsManager)(nil).SetConfiguration), store, key, value)
+}
+
+// StartShadow mocks base method.
+func (m *MockNatsManager) StartShadow(productID, publishedVersionTag, candidateVersionTag string, workflows []entity.ShadowWorkflow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartShadow", productID, publishedVersionTag, candidateVersionTag, workflows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartShadow indicates an expected call of StartShadow.
+func (mr *MockNatsManagerMockRecorder) StartShadow(productID, publishedVersionTag, candidateVersionTag, workflows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShadow", reflect.TypeOf((*MockNatsManager)(nil).StartShadow), productID, publishedVersionTag, candidateVersionTag, workflows)
+}
+
+// StopShadow mocks base method.
+func (m *MockNatsManager) StopShadow(productID, publishedVersionTag, candidateVersionTag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopShadow", productID, publishedVersionTag, candidateVersionTag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopShadow indicates an expected call of StopShadow.
+func (mr *MockNatsManagerMockRecorder) StopShadow(productID, publishedVersionTag, candidateVersionTag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopShadow", reflect.TypeOf((*MockNatsManager)(nil).StopShadow), productID, publishedVersionTag, candidateVersionTag)
+}
+
 // UpdateKeyValueStoresConfiguration mocks base method.
 func (m *MockNatsManager) UpdateKeyValueStoresConfiguration(configurations []entity.KeyValueConfiguration) error {
 	m.ctrl.T.Helper()
@@ -163,3 +561,17 @@ func (mr *MockNatsManagerMockRecorder) UpdateKeyValueStoresConfiguration(configu
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyValueStoresConfiguration", reflect.TypeOf((*MockNatsManager)(nil).UpdateKeyValueStoresConfiguration), configurations)
 }
+
+// WatchProcessConfiguration mocks base method.
+func (m *MockNatsManager) WatchProcessConfiguration(ctx context.Context, productID, versionTag, workflow, process string, updates chan<- entity.ConfigurationEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchProcessConfiguration", ctx, productID, versionTag, workflow, process, updates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchProcessConfiguration indicates an expected call of WatchProcessConfiguration.
+func (mr *MockNatsManagerMockRecorder) WatchProcessConfiguration(ctx, productID, versionTag, workflow, process, updates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchProcessConfiguration", reflect.TypeOf((*MockNatsManager)(nil).WatchProcessConfiguration), ctx, productID, versionTag, workflow, process, updates)
+}
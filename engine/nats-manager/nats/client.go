@@ -1,8 +1,10 @@
 package nats
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -17,6 +19,8 @@ import (
 type NatsClient struct {
 	js     nats.JetStreamContext
 	logger logr.Logger
+	// accountMutex serializes the account claims updates of this client.
+	accountMutex sync.Mutex
 }
 
 func New(logger logr.Logger, js nats.JetStreamContext) *NatsClient {
@@ -26,8 +30,14 @@ func New(logger logr.Logger, js nats.JetStreamContext) *NatsClient {
 	}
 }
 
-func InitJetStreamConnection(url string) (nats.JetStreamContext, error) {
-	natsConn, err := nats.Connect(url)
+// InitJetStreamConnection connects to NATS JetStream, with the given credentials file unless it's empty.
+func InitJetStreamConnection(url, credentialsFile string) (nats.JetStreamContext, error) {
+	var opts []nats.Option
+	if credentialsFile != "" {
+		opts = append(opts, nats.UserCredentials(credentialsFile))
+	}
+
+	natsConn, err := nats.Connect(url, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error connecting to NATS: %w", err)
 	}
@@ -52,11 +62,41 @@ func (n *NatsClient) CreateStream(streamConfig *entity.StreamConfig) error {
 		Retention:   nats.InterestPolicy,
 	}
 
+	if streamConfig.Settings != nil {
+		n.applyStreamSettings(streamCfg, streamConfig.Settings)
+	}
+
+	if len(streamConfig.Sources) > 0 {
+		streamCfg.Sources = n.getStreamSources(streamConfig.Sources)
+	}
+
 	_, err := n.js.AddStream(streamCfg)
 
 	return err
 }
 
+// applyStreamSettings overrides the stream defaults with the settings defined for the workflow.
+// Zero limits are left as they are, the NATS server treats them as unlimited.
+func (n *NatsClient) applyStreamSettings(streamCfg *nats.StreamConfig, settings *entity.StreamSettings) {
+	switch settings.Retention {
+	case entity.StreamRetentionLimits:
+		streamCfg.Retention = nats.LimitsPolicy
+	case entity.StreamRetentionWorkQueue:
+		streamCfg.Retention = nats.WorkQueuePolicy
+	case entity.StreamRetentionInterest, entity.StreamRetentionUndefined:
+		streamCfg.Retention = nats.InterestPolicy
+	}
+
+	if settings.Storage == entity.StreamStorageMemory {
+		streamCfg.Storage = nats.MemoryStorage
+	}
+
+	streamCfg.MaxAge = settings.MaxAge
+	streamCfg.MaxBytes = settings.MaxBytes
+	streamCfg.MaxMsgSize = settings.MaxMsgSize
+	streamCfg.Replicas = settings.Replicas
+}
+
 // GetObjectStoreNames returns the list of object stores.
 // The optional param `optFilter` accepts 0 or 1 value.
 func (n *NatsClient) GetObjectStoreNames(optFilter ...*regexp.Regexp) ([]string, error) {
@@ -84,13 +124,78 @@ func (n *NatsClient) GetObjectStoreNames(optFilter ...*regexp.Regexp) ([]string,
 	return objectStores, nil
 }
 
-func (n *NatsClient) CreateObjectStore(objectStore string) error {
+// GetKeyValueStoreNames returns the list of key-value stores' names.
+// The optional param `optFilter` accepts 0 or 1 value.
+func (n *NatsClient) GetKeyValueStoreNames(optFilter ...*regexp.Regexp) ([]string, error) {
+	if len(optFilter) > 1 {
+		return nil, internal.ErrNoOptFilter
+	}
+
+	var regexpFilter *regexp.Regexp
+	if len(optFilter) == 1 {
+		regexpFilter = optFilter[0]
+	}
+
+	keyValueStores := make([]string, 0)
+
+	for keyValueStore := range n.js.KeyValueStoreNames() {
+		nameMatchFilter := regexpFilter == nil || regexpFilter.MatchString(keyValueStore)
+		if nameMatchFilter {
+			keyValueStores = append(keyValueStores, keyValueStore)
+		}
+	}
+
+	return keyValueStores, nil
+}
+
+// CreateObjectStore creates an object store with the given settings. Objects expire after the configured default
+// TTL unless the settings define another one.
+func (n *NatsClient) CreateObjectStore(objectStore string, settings *entity.ObjectStoreSettings) error {
 	n.logger.Info("Creating object store", "object-store", objectStore)
 
-	_, err := n.js.CreateObjectStore(&nats.ObjectStoreConfig{
+	objectStoreCfg := &nats.ObjectStoreConfig{
 		Bucket:  objectStore,
 		Storage: nats.FileStorage,
 		TTL:     time.Duration(viper.GetInt(config.ObjectStoreDefaultTTLDays)*24) * time.Hour,
+	}
+
+	if settings != nil {
+		n.applyObjectStoreSettings(objectStoreCfg, settings)
+	}
+
+	_, err := n.js.CreateObjectStore(objectStoreCfg)
+	if err != nil {
+		return fmt.Errorf("error creating the object store: %w", err)
+	}
+
+	return nil
+}
+
+// applyObjectStoreSettings overrides the object store defaults with the given settings, zero values are left
+// as they are.
+func (n *NatsClient) applyObjectStoreSettings(
+	objectStoreCfg *nats.ObjectStoreConfig,
+	settings *entity.ObjectStoreSettings,
+) {
+	if settings.TTL > 0 {
+		objectStoreCfg.TTL = settings.TTL
+	}
+
+	if settings.Storage == entity.StreamStorageMemory {
+		objectStoreCfg.Storage = nats.MemoryStorage
+	}
+
+	objectStoreCfg.MaxBytes = settings.MaxBytes
+	objectStoreCfg.Replicas = settings.Replicas
+}
+
+// CreatePersistentObjectStore creates an object store whose objects never expire.
+func (n *NatsClient) CreatePersistentObjectStore(objectStore string) error {
+	n.logger.Info("Creating persistent object store", "object-store", objectStore)
+
+	_, err := n.js.CreateObjectStore(&nats.ObjectStoreConfig{
+		Bucket:  objectStore,
+		Storage: nats.FileStorage,
 	})
 	if err != nil {
 		return fmt.Errorf("error creating the object store: %w", err)
@@ -99,12 +204,28 @@ func (n *NatsClient) CreateObjectStore(objectStore string) error {
 	return nil
 }
 
-func (n *NatsClient) CreateKeyValueStore(keyValueStore string) error {
+// _keyValueStreamPrefix prefixes the name of the stream backing a key-value store.
+const _keyValueStreamPrefix = "KV_"
+
+// CreateKeyValueStore creates a key-value store with the given settings. Keys keep the configured default
+// history unless the settings define another one. Existing key-value stores are updated to the given settings.
+func (n *NatsClient) CreateKeyValueStore(keyValueStore string, settings *entity.KeyValueStoreSettings) error {
 	n.logger.Info("Creating key-value store", "key-value-store", keyValueStore)
 
-	_, err := n.js.CreateKeyValue(&nats.KeyValueConfig{
-		Bucket: keyValueStore,
-	})
+	keyValueCfg := &nats.KeyValueConfig{
+		Bucket:  keyValueStore,
+		History: uint8(viper.GetUint(config.KeyValueStoreHistory)),
+	}
+
+	if settings != nil {
+		n.applyKeyValueStoreSettings(keyValueCfg, settings)
+	}
+
+	_, err := n.js.CreateKeyValue(keyValueCfg)
+	if errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		err = n.updateKeyValueStore(keyValueCfg, err)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error creating the key-value store: %w", err)
 	}
@@ -112,6 +233,60 @@ func (n *NatsClient) CreateKeyValueStore(keyValueStore string) error {
 	return nil
 }
 
+// updateKeyValueStore applies the history, TTL, size and replicas of the configuration to an existing key-value
+// store, keeping its entries. The storage of a key-value store can't be changed, so the given error is returned
+// when it differs.
+func (n *NatsClient) updateKeyValueStore(keyValueCfg *nats.KeyValueConfig, alreadyInUseErr error) error {
+	streamInfo, err := n.js.StreamInfo(_keyValueStreamPrefix + keyValueCfg.Bucket)
+	if err != nil {
+		return fmt.Errorf("error getting the key-value store stream info: %w", err)
+	}
+
+	if streamInfo.Config.Storage != keyValueCfg.Storage {
+		return alreadyInUseErr
+	}
+
+	n.logger.Info("Updating existing key-value store", "key-value-store", keyValueCfg.Bucket)
+
+	// Unset limits are translated as the nats client does when creating the key-value store.
+	streamCfg := streamInfo.Config
+	streamCfg.MaxMsgsPerSubject = max(int64(keyValueCfg.History), 1)
+	streamCfg.MaxAge = keyValueCfg.TTL
+	streamCfg.MaxBytes = keyValueCfg.MaxBytes
+	streamCfg.Replicas = max(keyValueCfg.Replicas, 1)
+
+	if streamCfg.MaxBytes == 0 {
+		streamCfg.MaxBytes = -1
+	}
+
+	if streamCfg.MaxAge > 0 && streamCfg.Duplicates > streamCfg.MaxAge {
+		streamCfg.Duplicates = streamCfg.MaxAge
+	}
+
+	_, err = n.js.UpdateStream(&streamCfg)
+
+	return err
+}
+
+// applyKeyValueStoreSettings overrides the key-value store defaults with the given settings, zero values are left
+// as they are.
+func (n *NatsClient) applyKeyValueStoreSettings(
+	keyValueCfg *nats.KeyValueConfig,
+	settings *entity.KeyValueStoreSettings,
+) {
+	if settings.History > 0 {
+		keyValueCfg.History = uint8(settings.History)
+	}
+
+	if settings.Storage == entity.StreamStorageMemory {
+		keyValueCfg.Storage = nats.MemoryStorage
+	}
+
+	keyValueCfg.TTL = settings.TTL
+	keyValueCfg.MaxBytes = settings.MaxBytes
+	keyValueCfg.Replicas = settings.Replicas
+}
+
 func (n *NatsClient) DeleteStream(stream string) error {
 	n.logger.Info("Deleting stream", "stream", stream)
 	return n.js.DeleteStream(stream)
@@ -152,6 +327,43 @@ func (n *NatsClient) GetStreamNames(optFilter ...*regexp.Regexp) ([]string, erro
 	return streams, nil
 }
 
+// GetConsumersLag returns the pending and unacknowledged messages of each consumer in the stream.
+func (n *NatsClient) GetConsumersLag(stream string) ([]entity.ConsumerLag, error) {
+	if _, err := n.js.StreamInfo(stream); err != nil {
+		return nil, fmt.Errorf("error getting stream %q info: %w", stream, err)
+	}
+
+	consumersLag := make([]entity.ConsumerLag, 0)
+
+	for consumerInfo := range n.js.Consumers(stream) {
+		consumersLag = append(consumersLag, entity.ConsumerLag{
+			Consumer:      consumerInfo.Name,
+			FilterSubject: consumerInfo.Config.FilterSubject,
+			Pending:       consumerInfo.NumPending,
+			AckPending:    uint64(consumerInfo.NumAckPending),
+			Redelivered:   uint64(consumerInfo.NumRedelivered),
+		})
+	}
+
+	return consumersLag, nil
+}
+
+// GetStreamState returns the messages stored in the stream, including the number of messages of each subject.
+func (n *NatsClient) GetStreamState(stream string) (*entity.StreamState, error) {
+	streamInfo, err := n.js.StreamInfo(stream, &nats.StreamInfoRequest{SubjectsFilter: ">"})
+	if err != nil {
+		return nil, fmt.Errorf("error getting stream %q info: %w", stream, err)
+	}
+
+	return &entity.StreamState{
+		Messages:        streamInfo.State.Msgs,
+		Bytes:           streamInfo.State.Bytes,
+		FirstSequence:   streamInfo.State.FirstSeq,
+		LastSequence:    streamInfo.State.LastSeq,
+		SubjectMessages: streamInfo.State.Subjects,
+	}, nil
+}
+
 func (n *NatsClient) getProcessesSubjects(processes entity.ProcessesStreamConfig) []string {
 	subjects := make([]string, 0, len(processes)*2)
 
@@ -4,9 +4,11 @@ package nats_test
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/konstellation-io/kai/engine/nats-manager/internal"
@@ -52,7 +54,7 @@ func (s *ClientTestSuite) SetupSuite() {
 		log.Fatalf("error getting nats container endpoint: %s", err.Error())
 	}
 
-	js, err := nats.InitJetStreamConnection(natsEndpoint)
+	js, err := nats.InitJetStreamConnection(natsEndpoint, "")
 	if err != nil {
 		log.Fatalf("error connecting to NATS JetStream: %s", err)
 	}
@@ -130,6 +132,38 @@ func (s *ClientTestSuite) TestNatsClient_CreateStream() {
 	s.Assert().Equal(1, amountOfStreams)
 }
 
+func (s *ClientTestSuite) TestNatsClient_CreateStream_WithSettings() {
+	streamConfig := &entity.StreamConfig{
+		Stream: "test-stream",
+		Processes: entity.ProcessesStreamConfig{
+			"test-process": entity.ProcessStreamConfig{
+				Subject: "test-stream-test-subject",
+			},
+		},
+		Settings: &entity.StreamSettings{
+			Retention:  entity.StreamRetentionLimits,
+			MaxAge:     time.Hour,
+			MaxBytes:   1024 * 1024,
+			MaxMsgSize: 1024,
+			Replicas:   1,
+			Storage:    entity.StreamStorageMemory,
+		},
+	}
+
+	err := s.natsClient.CreateStream(streamConfig)
+	s.Require().NoError(err)
+
+	streamInfo, err := s.js.StreamInfo(streamConfig.Stream)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(natslib.LimitsPolicy, streamInfo.Config.Retention)
+	s.Assert().Equal(time.Hour, streamInfo.Config.MaxAge)
+	s.Assert().Equal(int64(1024*1024), streamInfo.Config.MaxBytes)
+	s.Assert().Equal(int32(1024), streamInfo.Config.MaxMsgSize)
+	s.Assert().Equal(1, streamInfo.Config.Replicas)
+	s.Assert().Equal(natslib.MemoryStorage, streamInfo.Config.Storage)
+}
+
 func (s *ClientTestSuite) TestNatsClient_CreateStream_ErrorIfStreamAlreadyExists() {
 	testStream := "test-stream"
 	testProcesSubject := "test-stream-test-subject"
@@ -180,7 +214,7 @@ func (s *ClientTestSuite) TestNatsClient_DeleteStream_ErrorIfStreamDoesntExist()
 func (s *ClientTestSuite) TestNatsClient_CreateObjectStore() {
 	testObjectStore := "test-objstore"
 
-	err := s.natsClient.CreateObjectStore(testObjectStore)
+	err := s.natsClient.CreateObjectStore(testObjectStore, nil)
 	s.Assert().NoError(err)
 
 	objectStores := s.js.ObjectStores()
@@ -191,6 +225,39 @@ func (s *ClientTestSuite) TestNatsClient_CreateObjectStore() {
 	s.Assert().Equal(nil, <-objectStores)
 }
 
+func (s *ClientTestSuite) TestNatsClient_CreateObjectStore_WithSettings() {
+	testObjectStore := "test-objstore-settings"
+
+	err := s.natsClient.CreateObjectStore(testObjectStore, &entity.ObjectStoreSettings{
+		TTL:      time.Hour,
+		MaxBytes: 1024 * 1024,
+		Storage:  entity.StreamStorageMemory,
+	})
+	s.Require().NoError(err)
+
+	objectStore, err := s.js.ObjectStore(testObjectStore)
+	s.Require().NoError(err)
+
+	status, err := objectStore.Status()
+	s.Require().NoError(err)
+	s.Equal(time.Hour, status.TTL())
+	s.Equal(natslib.MemoryStorage, status.Storage())
+}
+
+func (s *ClientTestSuite) TestNatsClient_CreatePersistentObjectStore() {
+	testObjectStore := "test-persistent-objstore"
+
+	err := s.natsClient.CreatePersistentObjectStore(testObjectStore)
+	s.Require().NoError(err)
+
+	objectStore, err := s.js.ObjectStore(testObjectStore)
+	s.Require().NoError(err)
+
+	status, err := objectStore.Status()
+	s.Require().NoError(err)
+	s.Zero(status.TTL())
+}
+
 func (s *ClientTestSuite) TestNatsClient_CreateObjectStore_NoErrorWhenObjectStoreAlreadyExistsWithSameConfig() {
 	testObjectStore := "test-objstore"
 
@@ -200,7 +267,7 @@ func (s *ClientTestSuite) TestNatsClient_CreateObjectStore_NoErrorWhenObjectStor
 	})
 	s.Require().NoError(err)
 
-	err = s.natsClient.CreateObjectStore(testObjectStore)
+	err = s.natsClient.CreateObjectStore(testObjectStore, nil)
 	s.Assert().NoError(err)
 }
 
@@ -213,7 +280,7 @@ func (s *ClientTestSuite) TestNatsClient_CreateObjectStore_ErrorWhenObjectStoreA
 	})
 	s.Require().NoError(err)
 
-	err = s.natsClient.CreateObjectStore(testObjectStore)
+	err = s.natsClient.CreateObjectStore(testObjectStore, nil)
 	s.Assert().ErrorIs(err, natslib.ErrStreamNameAlreadyInUse)
 }
 
@@ -409,6 +476,24 @@ func (s *ClientTestSuite) TestNatsClient_GetStreamNames() {
 	}
 }
 
+func (s *ClientTestSuite) TestNatsClient_GetKeyValueStoreNames() {
+	for _, keyValueStore := range []string{"key-store_test-product", "key-store_test-product_v1_0_0", "another-kv-store"} {
+		err := s.natsClient.CreateKeyValueStore(keyValueStore, nil)
+		s.Require().NoError(err)
+	}
+
+	keyValueStores, err := s.natsClient.GetKeyValueStoreNames(regexp.MustCompile("^key-store_"))
+	s.Require().NoError(err)
+	s.ElementsMatch([]string{"key-store_test-product", "key-store_test-product_v1_0_0"}, keyValueStores)
+
+	keyValueStores, err = s.natsClient.GetKeyValueStoreNames()
+	s.Require().NoError(err)
+	s.Len(keyValueStores, 3)
+
+	_, err = s.natsClient.GetKeyValueStoreNames(regexp.MustCompile(""), regexp.MustCompile(""))
+	s.ErrorIs(err, internal.ErrNoOptFilter)
+}
+
 func (s *ClientTestSuite) TestNatsClient_GetStreamNames_DoesntReturnObjectStores() {
 	testStreamName := "product-id_version-id_workflows-id"
 
@@ -434,7 +519,7 @@ func (s *ClientTestSuite) TestNatsClient_GetStreamNames_DoesntReturnObjectStores
 func (s *ClientTestSuite) TestNatsClient_CreateCreateKeyValueStore() {
 	testKeyValueStore := "test-kv-store"
 
-	err := s.natsClient.CreateKeyValueStore(testKeyValueStore)
+	err := s.natsClient.CreateKeyValueStore(testKeyValueStore, nil)
 	s.Assert().NoError(err)
 
 	keyValueStores := s.js.KeyValueStores()
@@ -445,6 +530,25 @@ func (s *ClientTestSuite) TestNatsClient_CreateCreateKeyValueStore() {
 	s.Assert().Equal(nil, <-keyValueStores)
 }
 
+func (s *ClientTestSuite) TestNatsClient_CreateCreateKeyValueStore_WithSettings() {
+	testKeyValueStore := "test-kv-store-settings"
+
+	err := s.natsClient.CreateKeyValueStore(testKeyValueStore, &entity.KeyValueStoreSettings{
+		TTL:     time.Hour,
+		History: 5,
+		Storage: entity.StreamStorageMemory,
+	})
+	s.Require().NoError(err)
+
+	keyValueStore, err := s.js.KeyValue(testKeyValueStore)
+	s.Require().NoError(err)
+
+	status, err := keyValueStore.Status()
+	s.Require().NoError(err)
+	s.Equal(time.Hour, status.TTL())
+	s.Equal(int64(5), status.History())
+}
+
 func (s *ClientTestSuite) TestNatsClient_CreateCreateKeyValueStore_NoErrorWhenKVStoreHaveSameNameAndConfig() {
 	testKeyValueStore := "test-kv-store"
 
@@ -453,10 +557,37 @@ func (s *ClientTestSuite) TestNatsClient_CreateCreateKeyValueStore_NoErrorWhenKV
 	})
 	s.Require().NoError(err)
 
-	err = s.natsClient.CreateKeyValueStore(testKeyValueStore)
+	err = s.natsClient.CreateKeyValueStore(testKeyValueStore, nil)
 	s.Assert().NoError(err)
 }
 
+func (s *ClientTestSuite) TestNatsClient_CreateCreateKeyValueStore_UpdatesExistingKVStoreSettings() {
+	testKeyValueStore := "test-kv-store"
+
+	keyValueStore, err := s.js.CreateKeyValue(&natslib.KeyValueConfig{
+		Bucket: testKeyValueStore,
+	})
+	s.Require().NoError(err)
+
+	_, err = keyValueStore.PutString("key", "value")
+	s.Require().NoError(err)
+
+	err = s.natsClient.CreateKeyValueStore(testKeyValueStore, &entity.KeyValueStoreSettings{
+		TTL:     time.Hour,
+		History: 5,
+	})
+	s.Require().NoError(err)
+
+	status, err := keyValueStore.Status()
+	s.Require().NoError(err)
+	s.Equal(time.Hour, status.TTL())
+	s.Equal(int64(5), status.History())
+
+	entry, err := keyValueStore.Get("key")
+	s.Require().NoError(err)
+	s.Equal("value", string(entry.Value()))
+}
+
 func (s *ClientTestSuite) TestNatsClient_CreateCreateKeyValueStore_ErrorWhenDuplicatedNameHasDiffConfig() {
 	testKeyValueStore := "test-kv-store"
 
@@ -466,7 +597,7 @@ func (s *ClientTestSuite) TestNatsClient_CreateCreateKeyValueStore_ErrorWhenDupl
 	})
 	s.Require().NoError(err)
 
-	err = s.natsClient.CreateKeyValueStore(testKeyValueStore)
+	err = s.natsClient.CreateKeyValueStore(testKeyValueStore, nil)
 	s.Assert().ErrorIs(err, natslib.ErrStreamNameAlreadyInUse)
 }
 
@@ -491,3 +622,243 @@ func (s *ClientTestSuite) TestNatsClient_DeleteKeyValueStore_KeyValueStoreNotFou
 	err := s.natsClient.DeleteKeyValueStore(testKeyValueStore)
 	s.Assert().ErrorIs(err, natslib.ErrStreamNotFound)
 }
+
+func (s *ClientTestSuite) TestNatsClient_GetConsumersLag() {
+	testStream := "test-stream"
+	testProcessSubject := "test-stream-test-subject"
+
+	err := s.natsClient.CreateStream(&entity.StreamConfig{
+		Stream: testStream,
+		Processes: entity.ProcessesStreamConfig{
+			"test-process": entity.ProcessStreamConfig{
+				Subject: testProcessSubject,
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.js.AddConsumer(testStream, &natslib.ConsumerConfig{
+		Durable:       "test-process",
+		FilterSubject: testProcessSubject,
+		AckPolicy:     natslib.AckExplicitPolicy,
+	})
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		_, err = s.js.Publish(testProcessSubject, []byte("test"))
+		s.Require().NoError(err)
+	}
+
+	consumersLag, err := s.natsClient.GetConsumersLag(testStream)
+	s.Require().NoError(err)
+	s.Require().Len(consumersLag, 1)
+	s.Equal("test-process", consumersLag[0].Consumer)
+	s.Equal(testProcessSubject, consumersLag[0].FilterSubject)
+	s.Equal(uint64(3), consumersLag[0].Total())
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetConsumersLag_ErrorIfStreamNotFound() {
+	_, err := s.natsClient.GetConsumersLag("non-existent-stream")
+	s.Require().Error(err)
+}
+
+func (s *ClientTestSuite) TestNatsClient_DeadLetterMessages() {
+	testStream := "test-stream"
+	testDeadLetterStream := "test-stream_dlq"
+	testProcessSubject := "test-stream-test-subject"
+
+	err := s.natsClient.CreateStream(&entity.StreamConfig{
+		Stream: testStream,
+		Processes: entity.ProcessesStreamConfig{
+			"test-process": entity.ProcessStreamConfig{
+				Subject: testProcessSubject,
+			},
+		},
+		Settings: &entity.StreamSettings{Retention: entity.StreamRetentionWorkQueue},
+	})
+	s.Require().NoError(err)
+
+	err = s.natsClient.CreateDeadLetterStream(testDeadLetterStream)
+	s.Require().NoError(err)
+
+	msg := natslib.NewMsg(testProcessSubject)
+	msg.Header.Set("trace-id", "test-trace")
+	msg.Data = []byte("test-data")
+
+	ack, err := s.js.PublishMsg(msg)
+	s.Require().NoError(err)
+
+	copied := make(chan struct{})
+
+	err = s.natsClient.ConsumeMaxDeliveriesAdvisories(func(advisory *entity.MaxDeliveriesAdvisory) error {
+		defer close(copied)
+		return s.natsClient.CopyToDeadLetterStream(testDeadLetterStream, advisory)
+	})
+	s.Require().NoError(err)
+
+	// Simulate the advisory JetStream publishes when the consumer reaches its max deliveries
+	advisory := fmt.Sprintf(`{"stream":%q,"consumer":"test-process","stream_seq":%d,"deliveries":3}`, testStream, ack.Sequence)
+	_, err = s.js.Publish("$JS.EVENT.ADVISORY.CONSUMER.MAX_DELIVERIES.test-stream.test-process", []byte(advisory))
+	s.Require().NoError(err)
+
+	select {
+	case <-copied:
+	case <-time.After(5 * time.Second):
+		s.FailNow("timeout waiting for the message to be dead-lettered")
+	}
+
+	// The copy outlives the original message
+	err = s.natsClient.DeleteMessage(testStream, ack.Sequence)
+	s.Require().NoError(err)
+
+	messages, err := s.natsClient.GetDeadLetterMessages(testDeadLetterStream)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 1)
+	s.Equal(testStream, messages[0].Stream)
+	s.Equal(testProcessSubject, messages[0].Subject)
+	s.Equal(ack.Sequence, messages[0].StreamSequence)
+	s.Equal("test-process", messages[0].Consumer)
+	s.Equal(uint64(3), messages[0].Deliveries)
+	s.Equal([]byte("test-data"), messages[0].Data)
+	s.Equal(map[string][]string{"trace-id": {"test-trace"}}, messages[0].Headers)
+
+	err = s.natsClient.DeleteMessage(testDeadLetterStream, messages[0].Sequence)
+	s.Require().NoError(err)
+
+	_, err = s.natsClient.GetDeadLetterMessage(testDeadLetterStream, messages[0].Sequence)
+	s.ErrorIs(err, internal.ErrDeadLetterMessageNotFound)
+}
+
+func (s *ClientTestSuite) TestNatsClient_CopyToDeadLetterStream_ErrorIfNoDeadLetterStream() {
+	err := s.natsClient.CopyToDeadLetterStream("test-stream_dlq", &entity.MaxDeliveriesAdvisory{
+		Stream:         "non-existent-stream",
+		StreamSequence: 1,
+	})
+	s.ErrorIs(err, internal.ErrDeadLetterStreamNotFound)
+}
+
+func (s *ClientTestSuite) TestNatsClient_PurgeStream() {
+	_, err := s.js.AddStream(&natslib.StreamConfig{
+		Name:     "test-stream",
+		Subjects: []string{"test-subject"},
+	})
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		_, err = s.js.Publish("test-subject", []byte("test"))
+		s.Require().NoError(err)
+	}
+
+	purged, err := s.natsClient.PurgeStream("test-stream")
+	s.Require().NoError(err)
+	s.Equal(uint64(3), purged)
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetStreamState() {
+	testStream := "test-stream"
+	testProcessSubject := "test-stream.test-process"
+
+	err := s.natsClient.CreateStream(&entity.StreamConfig{
+		Stream: testStream,
+		Processes: entity.ProcessesStreamConfig{
+			"test-process": entity.ProcessStreamConfig{
+				Subject: testProcessSubject,
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	for i := 0; i < 2; i++ {
+		_, err = s.js.Publish(testProcessSubject, []byte("test"))
+		s.Require().NoError(err)
+	}
+
+	_, err = s.js.Publish(testProcessSubject+".data", []byte("test"))
+	s.Require().NoError(err)
+
+	streamState, err := s.natsClient.GetStreamState(testStream)
+	s.Require().NoError(err)
+	s.Equal(uint64(3), streamState.Messages)
+	s.Equal(uint64(1), streamState.FirstSequence)
+	s.Equal(uint64(3), streamState.LastSequence)
+	s.NotZero(streamState.Bytes)
+	s.Equal(map[string]uint64{
+		testProcessSubject:           2,
+		testProcessSubject + ".data": 1,
+	}, streamState.SubjectMessages)
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetLastMessages() {
+	testStream := "test-stream"
+	testProcessSubject := "test-stream.test-process"
+
+	err := s.natsClient.CreateStream(&entity.StreamConfig{
+		Stream: testStream,
+		Processes: entity.ProcessesStreamConfig{
+			"test-process":  entity.ProcessStreamConfig{Subject: testProcessSubject},
+			"other-process": entity.ProcessStreamConfig{Subject: "test-stream.other-process"},
+		},
+		Settings: &entity.StreamSettings{Retention: entity.StreamRetentionLimits},
+	})
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		_, err = s.js.Publish(testProcessSubject, []byte(fmt.Sprintf("message-%d", i)))
+		s.Require().NoError(err)
+	}
+
+	_, err = s.js.Publish(testProcessSubject+".data", []byte("subtopic-message"))
+	s.Require().NoError(err)
+
+	_, err = s.js.Publish("test-stream.other-process", []byte("other-message"))
+	s.Require().NoError(err)
+
+	messages, err := s.natsClient.GetLastMessages(testStream, testProcessSubject, 2)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 2)
+	s.Equal("subtopic-message", string(messages[0].Data))
+	s.Equal(testProcessSubject+".data", messages[0].Subject)
+	s.Equal("message-2", string(messages[1].Data))
+	s.Equal(uint64(3), messages[1].Sequence)
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetLastMessages_WorkQueueStream() {
+	testStream := "test-stream"
+	testProcessSubject := "test-stream.test-process"
+
+	err := s.natsClient.CreateStream(&entity.StreamConfig{
+		Stream: testStream,
+		Processes: entity.ProcessesStreamConfig{
+			"test-process":  entity.ProcessStreamConfig{Subject: testProcessSubject},
+			"other-process": entity.ProcessStreamConfig{Subject: "test-stream.other-process"},
+		},
+		Settings: &entity.StreamSettings{Retention: entity.StreamRetentionWorkQueue},
+	})
+	s.Require().NoError(err)
+
+	// The process consumer filters the process subject, so no other consumer can filter it on a work queue.
+	_, err = s.js.AddConsumer(testStream, &natslib.ConsumerConfig{
+		Durable:       "test-stream-test-process-next-process",
+		FilterSubject: testProcessSubject,
+		AckPolicy:     natslib.AckExplicitPolicy,
+	})
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		_, err = s.js.Publish(testProcessSubject, []byte(fmt.Sprintf("message-%d", i)))
+		s.Require().NoError(err)
+	}
+
+	_, err = s.js.Publish("test-stream.other-process", []byte("other-message"))
+	s.Require().NoError(err)
+
+	messages, err := s.natsClient.GetLastMessages(testStream, testProcessSubject, 2)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 2)
+	s.Equal("message-2", string(messages[0].Data))
+	s.Equal("message-1", string(messages[1].Data))
+
+	consumerInfo, err := s.js.ConsumerInfo(testStream, "test-stream-test-process-next-process")
+	s.Require().NoError(err)
+	s.Equal(uint64(3), consumerInfo.NumPending)
+}
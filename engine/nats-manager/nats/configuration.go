@@ -0,0 +1,189 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// GetKeyValueEntries returns the current entries of the key-value store, skipping deleted keys.
+func (n *NatsClient) GetKeyValueEntries(keyValueStore string) ([]entity.KeyValueEntry, error) {
+	kvStoreBucket, err := n.js.KeyValue(keyValueStore)
+	if err != nil {
+		return nil, fmt.Errorf("initializing key-value store bucket: %w", err)
+	}
+
+	keys, err := kvStoreBucket.Keys()
+	if errors.Is(err, nats.ErrNoKeysFound) {
+		return []entity.KeyValueEntry{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("listing keys of key-value store %q: %w", keyValueStore, err)
+	}
+
+	entries := make([]entity.KeyValueEntry, 0, len(keys))
+
+	for _, key := range keys {
+		entry, err := kvStoreBucket.Get(key)
+		if errors.Is(err, nats.ErrKeyNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("getting value for key %q: %w", key, err)
+		}
+
+		entries = append(entries, mapKeyValueEntry(entry))
+	}
+
+	return entries, nil
+}
+
+// GetKeyHistory returns the revisions of the key kept by the key-value store, oldest first.
+func (n *NatsClient) GetKeyHistory(keyValueStore, key string) ([]entity.KeyValueEntry, error) {
+	kvStoreBucket, err := n.js.KeyValue(keyValueStore)
+	if err != nil {
+		return nil, fmt.Errorf("initializing key-value store bucket: %w", err)
+	}
+
+	history, err := kvStoreBucket.History(key)
+	if errors.Is(err, nats.ErrKeyNotFound) {
+		return nil, fmt.Errorf("%w: %q", internal.ErrConfigurationKeyNotFound, key)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting history for key %q: %w", key, err)
+	}
+
+	entries := make([]entity.KeyValueEntry, 0, len(history))
+	for _, entry := range history {
+		entries = append(entries, mapKeyValueEntry(entry))
+	}
+
+	return entries, nil
+}
+
+// GetKeyRevision returns a revision of the key, as long as the key-value store still keeps it.
+func (n *NatsClient) GetKeyRevision(keyValueStore, key string, revision uint64) (*entity.KeyValueEntry, error) {
+	kvStoreBucket, err := n.js.KeyValue(keyValueStore)
+	if err != nil {
+		return nil, fmt.Errorf("initializing key-value store bucket: %w", err)
+	}
+
+	entry, err := kvStoreBucket.GetRevision(key, revision)
+	if errors.Is(err, nats.ErrKeyNotFound) {
+		return nil, fmt.Errorf("%w: revision %d of key %q", internal.ErrConfigurationRevisionNotFound, revision, key)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting revision %d for key %q: %w", revision, key, err)
+	}
+
+	keyValueEntry := mapKeyValueEntry(entry)
+
+	return &keyValueEntry, nil
+}
+
+// PutKeyValue sets the value of the key and returns the new revision.
+func (n *NatsClient) PutKeyValue(keyValueStore, key, value string) (*entity.KeyValueEntry, error) {
+	n.logger.V(2).Info("Putting value to key-value store", "key-value-store", keyValueStore, "key", key)
+
+	kvStoreBucket, err := n.js.KeyValue(keyValueStore)
+	if err != nil {
+		return nil, fmt.Errorf("initializing key-value store bucket: %w", err)
+	}
+
+	revision, err := kvStoreBucket.PutString(key, value)
+	if err != nil {
+		return nil, fmt.Errorf("updating value for key %q: %w", key, err)
+	}
+
+	return n.GetKeyRevision(keyValueStore, key, revision)
+}
+
+// DeleteKeyValue deletes the key from the key-value store. The deletion is stored as a new revision,
+// so previous values are kept in the key history.
+func (n *NatsClient) DeleteKeyValue(keyValueStore, key string) error {
+	n.logger.V(2).Info("Deleting key from key-value store", "key-value-store", keyValueStore, "key", key)
+
+	kvStoreBucket, err := n.js.KeyValue(keyValueStore)
+	if err != nil {
+		return fmt.Errorf("initializing key-value store bucket: %w", err)
+	}
+
+	_, err = kvStoreBucket.Get(key)
+	if errors.Is(err, nats.ErrKeyNotFound) {
+		return fmt.Errorf("%w: %q", internal.ErrConfigurationKeyNotFound, key)
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting value for key %q: %w", key, err)
+	}
+
+	if err := kvStoreBucket.Delete(key); err != nil {
+		return fmt.Errorf("deleting key %q: %w", key, err)
+	}
+
+	return nil
+}
+
+// WatchKeyValueStore sends the updates of the key-value store to the channel until the context is done.
+// Only the changes made after the watch starts are sent.
+func (n *NatsClient) WatchKeyValueStore(
+	ctx context.Context,
+	keyValueStore string,
+	updates chan<- entity.KeyValueEntry,
+) error {
+	kvStoreBucket, err := n.js.KeyValue(keyValueStore)
+	if err != nil {
+		return fmt.Errorf("initializing key-value store bucket: %w", err)
+	}
+
+	watcher, err := kvStoreBucket.WatchAll(nats.UpdatesOnly())
+	if err != nil {
+		return fmt.Errorf("watching key-value store %q: %w", keyValueStore, err)
+	}
+
+	defer func() {
+		if err := watcher.Stop(); err != nil {
+			n.logger.Error(err, "Error stopping key-value store watcher", "key-value-store", keyValueStore)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return nil
+			}
+
+			if entry == nil {
+				continue
+			}
+
+			select {
+			case updates <- mapKeyValueEntry(entry):
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+func mapKeyValueEntry(entry nats.KeyValueEntry) entity.KeyValueEntry {
+	return entity.KeyValueEntry{
+		Key:      entry.Key(),
+		Value:    string(entry.Value()),
+		Revision: entry.Revision(),
+		Deleted:  entry.Operation() != nats.KeyValuePut,
+		Created:  entry.Created(),
+	}
+}
@@ -0,0 +1,165 @@
+//go:build integration
+
+package nats_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/config"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+const _testConfigKeyValueStore = "test-config-kv-store"
+
+func (s *ClientTestSuite) createConfigKeyValueStore(history int) {
+	viper.Set(config.KeyValueStoreHistory, history)
+	s.T().Cleanup(func() { viper.Set(config.KeyValueStoreHistory, nil) })
+
+	err := s.natsClient.CreateKeyValueStore(_testConfigKeyValueStore, nil)
+	s.Require().NoError(err)
+}
+
+func (s *ClientTestSuite) TestNatsClient_CreateKeyValueStore_WithHistory() {
+	s.createConfigKeyValueStore(5)
+
+	kvStore, err := s.js.KeyValue(_testConfigKeyValueStore)
+	s.Require().NoError(err)
+
+	status, err := kvStore.Status()
+	s.Require().NoError(err)
+	s.Equal(int64(5), status.History())
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetKeyValueEntries() {
+	s.createConfigKeyValueStore(5)
+
+	err := s.natsClient.UpdateConfiguration(
+		_testConfigKeyValueStore, map[string]string{"key1": "value1", "key2": "value2"},
+	)
+	s.Require().NoError(err)
+
+	kvStore, err := s.js.KeyValue(_testConfigKeyValueStore)
+	s.Require().NoError(err)
+	s.Require().NoError(kvStore.Delete("key2"))
+
+	entries, err := s.natsClient.GetKeyValueEntries(_testConfigKeyValueStore)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Equal("key1", entries[0].Key)
+	s.Equal("value1", entries[0].Value)
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetKeyValueEntries_EmptyStore() {
+	s.createConfigKeyValueStore(5)
+
+	entries, err := s.natsClient.GetKeyValueEntries(_testConfigKeyValueStore)
+	s.Require().NoError(err)
+	s.Empty(entries)
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetKeyHistory() {
+	s.createConfigKeyValueStore(5)
+
+	_, err := s.natsClient.PutKeyValue(_testConfigKeyValueStore, "key1", "old")
+	s.Require().NoError(err)
+	_, err = s.natsClient.PutKeyValue(_testConfigKeyValueStore, "key1", "new")
+	s.Require().NoError(err)
+
+	history, err := s.natsClient.GetKeyHistory(_testConfigKeyValueStore, "key1")
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Equal("old", history[0].Value)
+	s.Equal("new", history[1].Value)
+	s.Less(history[0].Revision, history[1].Revision)
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetKeyHistory_KeyNotFound() {
+	s.createConfigKeyValueStore(5)
+
+	_, err := s.natsClient.GetKeyHistory(_testConfigKeyValueStore, "unknown")
+	s.ErrorIs(err, internal.ErrConfigurationKeyNotFound)
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetKeyRevision() {
+	s.createConfigKeyValueStore(5)
+
+	oldEntry, err := s.natsClient.PutKeyValue(_testConfigKeyValueStore, "key1", "old")
+	s.Require().NoError(err)
+	_, err = s.natsClient.PutKeyValue(_testConfigKeyValueStore, "key1", "new")
+	s.Require().NoError(err)
+
+	entry, err := s.natsClient.GetKeyRevision(_testConfigKeyValueStore, "key1", oldEntry.Revision)
+	s.Require().NoError(err)
+	s.Equal("old", entry.Value)
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetKeyRevision_RevisionNotKept() {
+	s.createConfigKeyValueStore(1)
+
+	oldEntry, err := s.natsClient.PutKeyValue(_testConfigKeyValueStore, "key1", "old")
+	s.Require().NoError(err)
+	_, err = s.natsClient.PutKeyValue(_testConfigKeyValueStore, "key1", "new")
+	s.Require().NoError(err)
+
+	_, err = s.natsClient.GetKeyRevision(_testConfigKeyValueStore, "key1", oldEntry.Revision)
+	s.ErrorIs(err, internal.ErrConfigurationRevisionNotFound)
+}
+
+func (s *ClientTestSuite) TestNatsClient_DeleteKeyValue() {
+	s.createConfigKeyValueStore(5)
+
+	_, err := s.natsClient.PutKeyValue(_testConfigKeyValueStore, "key1", "value1")
+	s.Require().NoError(err)
+
+	err = s.natsClient.DeleteKeyValue(_testConfigKeyValueStore, "key1")
+	s.Require().NoError(err)
+
+	entries, err := s.natsClient.GetKeyValueEntries(_testConfigKeyValueStore)
+	s.Require().NoError(err)
+	s.Empty(entries)
+
+	history, err := s.natsClient.GetKeyHistory(_testConfigKeyValueStore, "key1")
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.True(history[1].Deleted)
+}
+
+func (s *ClientTestSuite) TestNatsClient_DeleteKeyValue_KeyNotFound() {
+	s.createConfigKeyValueStore(5)
+
+	err := s.natsClient.DeleteKeyValue(_testConfigKeyValueStore, "unknown")
+	s.ErrorIs(err, internal.ErrConfigurationKeyNotFound)
+}
+
+func (s *ClientTestSuite) TestNatsClient_WatchKeyValueStore() {
+	s.createConfigKeyValueStore(5)
+
+	_, err := s.natsClient.PutKeyValue(_testConfigKeyValueStore, "key1", "before-watch")
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := make(chan entity.KeyValueEntry, 2)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- s.natsClient.WatchKeyValueStore(ctx, _testConfigKeyValueStore, updates)
+	}()
+
+	s.Eventually(func() bool {
+		_, err := s.natsClient.PutKeyValue(_testConfigKeyValueStore, "key1", "after-watch")
+		s.Require().NoError(err)
+
+		return len(updates) > 0
+	}, 5*time.Second, 100*time.Millisecond)
+
+	update := <-updates
+	s.Equal("key1", update.Key)
+	s.Equal("after-watch", update.Value)
+
+	cancel()
+	s.NoError(<-errCh)
+}
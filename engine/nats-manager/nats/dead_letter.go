@@ -0,0 +1,266 @@
+package nats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+const (
+	// _maxDeliveriesAdvisoryPrefix is the subject prefix of the advisories JetStream publishes
+	// when a consumer reaches its max deliveries for a message.
+	_maxDeliveriesAdvisoryPrefix = "$JS.EVENT.ADVISORY.CONSUMER.MAX_DELIVERIES"
+
+	// _maxDeliveriesAdvisoriesStream keeps the advisories until nats-manager copies the failed messages
+	// to the dead-letter streams, so the ones published while it's down aren't lost.
+	_maxDeliveriesAdvisoriesStream   = "KAI_MAX_DELIVERIES_ADVISORIES"
+	_maxDeliveriesAdvisoriesConsumer = "nats-manager"
+	_maxAdvisoryDeliveries           = 5
+
+	// Dead-letter messages keep the original headers along with these ones, describing the failure.
+	_deadLetterHeaderPrefix         = "Kai-Dead-Letter-"
+	_deadLetterStreamHeader         = _deadLetterHeaderPrefix + "Stream"
+	_deadLetterStreamSequenceHeader = _deadLetterHeaderPrefix + "Stream-Sequence"
+	_deadLetterSubjectHeader        = _deadLetterHeaderPrefix + "Subject"
+	_deadLetterConsumerHeader       = _deadLetterHeaderPrefix + "Consumer"
+	_deadLetterDeliveriesHeader     = _deadLetterHeaderPrefix + "Deliveries"
+)
+
+type maxDeliveriesAdvisory struct {
+	Stream     string `json:"stream"`
+	Consumer   string `json:"consumer"`
+	StreamSeq  uint64 `json:"stream_seq"`
+	Deliveries uint64 `json:"deliveries"`
+}
+
+// CreateDeadLetterStream creates a stream for the copies of the messages that the consumers of a workflow stream
+// could not handle. Copies are published to a subject named as the stream.
+func (n *NatsClient) CreateDeadLetterStream(deadLetterStream string) error {
+	n.logger.Info("Creating dead-letter stream", "stream", deadLetterStream)
+
+	_, err := n.js.AddStream(&nats.StreamConfig{
+		Name:      deadLetterStream,
+		Subjects:  []string{deadLetterStream},
+		Retention: nats.LimitsPolicy,
+		Storage:   nats.FileStorage,
+	})
+
+	return err
+}
+
+// ConsumeMaxDeliveriesAdvisories calls the handler with the max deliveries advisories of every stream.
+// Advisories are acknowledged once handled, and delivered again when the handler fails.
+func (n *NatsClient) ConsumeMaxDeliveriesAdvisories(handler func(advisory *entity.MaxDeliveriesAdvisory) error) error {
+	advisoriesSubject := _maxDeliveriesAdvisoryPrefix + ".>"
+
+	_, err := n.js.AddStream(&nats.StreamConfig{
+		Name:      _maxDeliveriesAdvisoriesStream,
+		Subjects:  []string{advisoriesSubject},
+		Retention: nats.WorkQueuePolicy,
+		Storage:   nats.FileStorage,
+	})
+	if err != nil && !errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		return fmt.Errorf("error creating stream %q: %w", _maxDeliveriesAdvisoriesStream, err)
+	}
+
+	_, err = n.js.QueueSubscribe(
+		advisoriesSubject,
+		_maxDeliveriesAdvisoriesConsumer,
+		func(msg *nats.Msg) {
+			n.handleMaxDeliveriesAdvisory(msg, handler)
+		},
+		nats.BindStream(_maxDeliveriesAdvisoriesStream),
+		nats.Durable(_maxDeliveriesAdvisoriesConsumer),
+		nats.ManualAck(),
+		nats.MaxDeliver(_maxAdvisoryDeliveries),
+	)
+	if err != nil {
+		return fmt.Errorf("error subscribing to the max deliveries advisories: %w", err)
+	}
+
+	return nil
+}
+
+func (n *NatsClient) handleMaxDeliveriesAdvisory(
+	msg *nats.Msg,
+	handler func(advisory *entity.MaxDeliveriesAdvisory) error,
+) {
+	var advisory maxDeliveriesAdvisory
+	if err := json.Unmarshal(msg.Data, &advisory); err != nil {
+		n.logger.Error(err, "Error decoding max deliveries advisory", "subject", msg.Subject)
+
+		if err := msg.Term(); err != nil {
+			n.logger.Error(err, "Error terminating max deliveries advisory", "subject", msg.Subject)
+		}
+
+		return
+	}
+
+	err := handler(&entity.MaxDeliveriesAdvisory{
+		Stream:         advisory.Stream,
+		StreamSequence: advisory.StreamSeq,
+		Consumer:       advisory.Consumer,
+		Deliveries:     advisory.Deliveries,
+	})
+	if err != nil {
+		n.logger.Error(err, "Error handling max deliveries advisory", "subject", msg.Subject)
+
+		if err := msg.Nak(); err != nil {
+			n.logger.Error(err, "Error rejecting max deliveries advisory", "subject", msg.Subject)
+		}
+
+		return
+	}
+
+	if err := msg.Ack(); err != nil {
+		n.logger.Error(err, "Error acknowledging max deliveries advisory", "subject", msg.Subject)
+	}
+}
+
+// CopyToDeadLetterStream publishes a copy of the message an advisory points to into the dead-letter stream, so it
+// outlives the message in the workflow stream. The copy keeps only the failure details if the workflow stream no
+// longer has the message.
+func (n *NatsClient) CopyToDeadLetterStream(deadLetterStream string, advisory *entity.MaxDeliveriesAdvisory) error {
+	msg := nats.NewMsg(deadLetterStream)
+
+	original, err := n.js.GetMsg(advisory.Stream, advisory.StreamSequence)
+	switch {
+	case errors.Is(err, nats.ErrMsgNotFound):
+		n.logger.Info("Dead-lettered message no longer in its stream",
+			"stream", advisory.Stream,
+			"sequence", advisory.StreamSequence,
+		)
+	case err != nil:
+		return fmt.Errorf("error getting message %d from stream %q: %w", advisory.StreamSequence, advisory.Stream, err)
+	default:
+		for header, values := range original.Header {
+			msg.Header[header] = values
+		}
+
+		msg.Header.Set(_deadLetterSubjectHeader, original.Subject)
+		msg.Data = original.Data
+	}
+
+	msg.Header.Set(_deadLetterStreamHeader, advisory.Stream)
+	msg.Header.Set(_deadLetterStreamSequenceHeader, strconv.FormatUint(advisory.StreamSequence, 10))
+	msg.Header.Set(_deadLetterConsumerHeader, advisory.Consumer)
+	msg.Header.Set(_deadLetterDeliveriesHeader, strconv.FormatUint(advisory.Deliveries, 10))
+
+	_, err = n.js.PublishMsg(msg)
+	if errors.Is(err, nats.ErrNoStreamResponse) {
+		return fmt.Errorf("%w: %q", internal.ErrDeadLetterStreamNotFound, deadLetterStream)
+	}
+
+	return err
+}
+
+// GetDeadLetterMessages returns the messages of the dead-letter stream, oldest first.
+func (n *NatsClient) GetDeadLetterMessages(deadLetterStream string) ([]entity.DeadLetterMessage, error) {
+	streamInfo, err := n.js.StreamInfo(deadLetterStream)
+	if err != nil {
+		return nil, fmt.Errorf("error getting stream %q info: %w", deadLetterStream, err)
+	}
+
+	messages := make([]entity.DeadLetterMessage, 0, streamInfo.State.Msgs)
+
+	if streamInfo.State.Msgs == 0 {
+		return messages, nil
+	}
+
+	for seq := streamInfo.State.FirstSeq; seq <= streamInfo.State.LastSeq; seq++ {
+		message, err := n.GetDeadLetterMessage(deadLetterStream, seq)
+		if errors.Is(err, internal.ErrDeadLetterMessageNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, *message)
+	}
+
+	return messages, nil
+}
+
+// GetDeadLetterMessage returns a message of the dead-letter stream, split into the original message and the
+// failure details. The original subject is left empty if the message was gone when it was dead-lettered.
+func (n *NatsClient) GetDeadLetterMessage(deadLetterStream string, sequence uint64) (*entity.DeadLetterMessage, error) {
+	rawMessage, err := n.js.GetMsg(deadLetterStream, sequence)
+	if errors.Is(err, nats.ErrMsgNotFound) {
+		return nil, fmt.Errorf("%w: sequence %d in stream %q", internal.ErrDeadLetterMessageNotFound, sequence, deadLetterStream)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting dead-letter message %d: %w", sequence, err)
+	}
+
+	streamSequence, err := strconv.ParseUint(rawMessage.Header.Get(_deadLetterStreamSequenceHeader), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding dead-letter message %d stream sequence: %w", sequence, err)
+	}
+
+	deliveries, err := strconv.ParseUint(rawMessage.Header.Get(_deadLetterDeliveriesHeader), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding dead-letter message %d deliveries: %w", sequence, err)
+	}
+
+	headers := make(map[string][]string, len(rawMessage.Header))
+
+	for header, values := range rawMessage.Header {
+		if !strings.HasPrefix(header, _deadLetterHeaderPrefix) {
+			headers[header] = values
+		}
+	}
+
+	return &entity.DeadLetterMessage{
+		Sequence:       rawMessage.Sequence,
+		Stream:         rawMessage.Header.Get(_deadLetterStreamHeader),
+		StreamSequence: streamSequence,
+		Consumer:       rawMessage.Header.Get(_deadLetterConsumerHeader),
+		Deliveries:     deliveries,
+		Subject:        rawMessage.Header.Get(_deadLetterSubjectHeader),
+		Headers:        headers,
+		Data:           rawMessage.Data,
+		Timestamp:      rawMessage.Time,
+	}, nil
+}
+
+// PublishMessage publishes a message with the given headers to a stream subject.
+func (n *NatsClient) PublishMessage(subject string, headers map[string][]string, data []byte) error {
+	_, err := n.js.PublishMsg(&nats.Msg{
+		Subject: subject,
+		Header:  headers,
+		Data:    data,
+	})
+
+	return err
+}
+
+func (n *NatsClient) DeleteMessage(stream string, sequence uint64) error {
+	n.logger.V(1).Info("Deleting message", "stream", stream, "sequence", sequence)
+	return n.js.DeleteMsg(stream, sequence)
+}
+
+// PurgeStream removes all the messages of the stream and returns how many there were.
+func (n *NatsClient) PurgeStream(stream string) (uint64, error) {
+	n.logger.Info("Purging stream", "stream", stream)
+
+	streamInfo, err := n.js.StreamInfo(stream)
+	if err != nil {
+		return 0, fmt.Errorf("error getting stream %q info: %w", stream, err)
+	}
+
+	if err := n.js.PurgeStream(stream); err != nil {
+		return 0, err
+	}
+
+	return streamInfo.State.Msgs, nil
+}
@@ -0,0 +1,155 @@
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+const (
+	// _maxScannedMessages bounds how far back GetLastMessages looks for messages of a subject, so peeking a
+	// subject that rarely receives messages doesn't read the whole stream.
+	_maxScannedMessages = 10000
+
+	_nextMessageTimeout = 5 * time.Second
+)
+
+// GetLastMessages returns up to count messages published to the subject or its subtopics, newest first.
+func (n *NatsClient) GetLastMessages(stream, subject string, count int) ([]entity.StreamMessage, error) {
+	streamInfo, err := n.js.StreamInfo(stream)
+	if err != nil {
+		return nil, fmt.Errorf("error getting stream %q info: %w", stream, err)
+	}
+
+	if streamInfo.State.Msgs == 0 {
+		return []entity.StreamMessage{}, nil
+	}
+
+	startSeq := streamInfo.State.FirstSeq
+	if streamInfo.State.LastSeq-startSeq >= _maxScannedMessages {
+		startSeq = streamInfo.State.LastSeq - _maxScannedMessages + 1
+	}
+
+	// Consumers on work queue streams can't overlap the processes' ones, and on interest streams they count
+	// as interest in the messages, so only limits streams are read with consumers.
+	if streamInfo.Config.Retention != nats.LimitsPolicy {
+		return n.getLastMessagesBySequence(stream, subject, startSeq, streamInfo.State.LastSeq, count)
+	}
+
+	messages := make([]entity.StreamMessage, 0, count)
+
+	// Stream subjects are the process subject and its subtopics, and a consumer filters a single subject.
+	for _, filter := range []string{subject, subject + ".*"} {
+		filteredMessages, err := n.getLastFilteredMessages(stream, filter, startSeq, count)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, filteredMessages...)
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].Sequence > messages[j].Sequence
+	})
+
+	if len(messages) > count {
+		messages = messages[:count]
+	}
+
+	return messages, nil
+}
+
+// getLastMessagesBySequence gets the stream messages one by one from the last sequence back to the start
+// sequence, until count of them match the subject. Direct gets don't create consumers on the stream.
+func (n *NatsClient) getLastMessagesBySequence(
+	stream, subject string,
+	startSeq, lastSeq uint64,
+	count int,
+) ([]entity.StreamMessage, error) {
+	messages := make([]entity.StreamMessage, 0, count)
+
+	for seq := lastSeq; seq >= startSeq && len(messages) < count; seq-- {
+		msg, err := n.js.GetMsg(stream, seq)
+		if errors.Is(err, nats.ErrMsgNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error getting message %d from stream %q: %w", seq, stream, err)
+		}
+
+		if msg.Subject != subject && !strings.HasPrefix(msg.Subject, subject+".") {
+			continue
+		}
+
+		messages = append(messages, entity.StreamMessage{
+			Sequence:  msg.Sequence,
+			Subject:   msg.Subject,
+			Headers:   msg.Header,
+			Data:      msg.Data,
+			Timestamp: msg.Time,
+		})
+	}
+
+	return messages, nil
+}
+
+// getLastFilteredMessages returns up to count of the messages matching the filter from the start sequence on,
+// oldest first. The server only sends the messages matching the filter to the ordered consumer.
+func (n *NatsClient) getLastFilteredMessages(
+	stream, filter string,
+	startSeq uint64,
+	count int,
+) ([]entity.StreamMessage, error) {
+	sub, err := n.js.SubscribeSync(filter, nats.BindStream(stream), nats.OrderedConsumer(), nats.StartSequence(startSeq))
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to %q in stream %q: %w", filter, stream, err)
+	}
+
+	defer func() {
+		if err := sub.Unsubscribe(); err != nil {
+			n.logger.Error(err, "Error unsubscribing", "stream", stream, "subject", filter)
+		}
+	}()
+
+	consumerInfo, err := sub.ConsumerInfo()
+	if err != nil {
+		return nil, fmt.Errorf("error getting consumer info of %q in stream %q: %w", filter, stream, err)
+	}
+
+	messages := make([]entity.StreamMessage, 0, count)
+
+	for pending := consumerInfo.NumPending; pending > 0; {
+		msg, err := sub.NextMsg(_nextMessageTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("error getting messages of %q from stream %q: %w", filter, stream, err)
+		}
+
+		metadata, err := msg.Metadata()
+		if err != nil {
+			return nil, fmt.Errorf("error getting message metadata: %w", err)
+		}
+
+		if len(messages) == count {
+			messages = messages[1:]
+		}
+
+		messages = append(messages, entity.StreamMessage{
+			Sequence:  metadata.Sequence.Stream,
+			Subject:   msg.Subject,
+			Headers:   msg.Header,
+			Data:      msg.Data,
+			Timestamp: metadata.Timestamp,
+		})
+
+		pending = metadata.NumPending
+	}
+
+	return messages, nil
+}
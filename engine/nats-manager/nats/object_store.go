@@ -0,0 +1,120 @@
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// ListObjects returns the objects stored in the object store, skipping deleted ones.
+func (n *NatsClient) ListObjects(objectStore string) ([]entity.ObjectInfo, error) {
+	objStore, err := n.js.ObjectStore(objectStore)
+	if err != nil {
+		return nil, fmt.Errorf("initializing object store %q: %w", objectStore, err)
+	}
+
+	infos, err := objStore.List()
+	if errors.Is(err, nats.ErrNoObjectsFound) {
+		return []entity.ObjectInfo{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("listing objects of object store %q: %w", objectStore, err)
+	}
+
+	objects := make([]entity.ObjectInfo, 0, len(infos))
+
+	for _, info := range infos {
+		if info.Deleted {
+			continue
+		}
+
+		objects = append(objects, mapObjectInfo(info))
+	}
+
+	return objects, nil
+}
+
+// GetObject writes the content of the object to w.
+func (n *NatsClient) GetObject(objectStore, name string, w io.Writer) error {
+	objStore, err := n.js.ObjectStore(objectStore)
+	if err != nil {
+		return fmt.Errorf("initializing object store %q: %w", objectStore, err)
+	}
+
+	object, err := objStore.Get(name)
+	if errors.Is(err, nats.ErrObjectNotFound) {
+		return fmt.Errorf("%w: %q", internal.ErrObjectNotFound, name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("getting object %q: %w", name, err)
+	}
+
+	defer object.Close()
+
+	if _, err := io.Copy(w, object); err != nil {
+		return fmt.Errorf("reading object %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// PutObject stores the content read from r as the object, replacing any previous content.
+func (n *NatsClient) PutObject(objectStore, name string, r io.Reader) (*entity.ObjectInfo, error) {
+	n.logger.V(2).Info("Putting object to object store", "object-store", objectStore, "object", name)
+
+	objStore, err := n.js.ObjectStore(objectStore)
+	if err != nil {
+		return nil, fmt.Errorf("initializing object store %q: %w", objectStore, err)
+	}
+
+	if _, err := objStore.Put(&nats.ObjectMeta{Name: name}, r); err != nil {
+		return nil, fmt.Errorf("putting object %q: %w", name, err)
+	}
+
+	// The info returned by Put doesn't carry the time the object was stored at.
+	info, err := objStore.GetInfo(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting object %q info: %w", name, err)
+	}
+
+	object := mapObjectInfo(info)
+
+	return &object, nil
+}
+
+// DeleteObject deletes the object from the object store.
+func (n *NatsClient) DeleteObject(objectStore, name string) error {
+	n.logger.V(2).Info("Deleting object from object store", "object-store", objectStore, "object", name)
+
+	objStore, err := n.js.ObjectStore(objectStore)
+	if err != nil {
+		return fmt.Errorf("initializing object store %q: %w", objectStore, err)
+	}
+
+	err = objStore.Delete(name)
+	if errors.Is(err, nats.ErrObjectNotFound) {
+		return fmt.Errorf("%w: %q", internal.ErrObjectNotFound, name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting object %q: %w", name, err)
+	}
+
+	return nil
+}
+
+func mapObjectInfo(info *nats.ObjectInfo) entity.ObjectInfo {
+	return entity.ObjectInfo{
+		Name:     info.Name,
+		Size:     info.Size,
+		Digest:   info.Digest,
+		Modified: info.ModTime,
+	}
+}
@@ -0,0 +1,79 @@
+//go:build integration
+
+package nats_test
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+)
+
+const _testObjectStore = "test-objects-objstore"
+
+func (s *ClientTestSuite) createTestObjectStore() {
+	err := s.natsClient.CreatePersistentObjectStore(_testObjectStore)
+	s.Require().NoError(err)
+}
+
+func (s *ClientTestSuite) TestNatsClient_PutObject() {
+	s.createTestObjectStore()
+
+	info, err := s.natsClient.PutObject(_testObjectStore, "object1", strings.NewReader("content"))
+	s.Require().NoError(err)
+
+	s.Equal("object1", info.Name)
+	s.Equal(uint64(len("content")), info.Size)
+	s.NotEmpty(info.Digest)
+	s.False(info.Modified.IsZero())
+}
+
+func (s *ClientTestSuite) TestNatsClient_ListObjects() {
+	s.createTestObjectStore()
+
+	_, err := s.natsClient.PutObject(_testObjectStore, "object1", strings.NewReader("content1"))
+	s.Require().NoError(err)
+	_, err = s.natsClient.PutObject(_testObjectStore, "object2", strings.NewReader("content2"))
+	s.Require().NoError(err)
+	s.Require().NoError(s.natsClient.DeleteObject(_testObjectStore, "object2"))
+
+	objects, err := s.natsClient.ListObjects(_testObjectStore)
+	s.Require().NoError(err)
+	s.Require().Len(objects, 1)
+	s.Equal("object1", objects[0].Name)
+}
+
+func (s *ClientTestSuite) TestNatsClient_ListObjects_EmptyObjectStore() {
+	s.createTestObjectStore()
+
+	objects, err := s.natsClient.ListObjects(_testObjectStore)
+	s.Require().NoError(err)
+	s.Empty(objects)
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetObject() {
+	s.createTestObjectStore()
+
+	_, err := s.natsClient.PutObject(_testObjectStore, "object1", strings.NewReader("content"))
+	s.Require().NoError(err)
+
+	var content bytes.Buffer
+
+	err = s.natsClient.GetObject(_testObjectStore, "object1", &content)
+	s.Require().NoError(err)
+	s.Equal("content", content.String())
+}
+
+func (s *ClientTestSuite) TestNatsClient_GetObject_ObjectNotFound() {
+	s.createTestObjectStore()
+
+	err := s.natsClient.GetObject(_testObjectStore, "object1", &bytes.Buffer{})
+	s.ErrorIs(err, internal.ErrObjectNotFound)
+}
+
+func (s *ClientTestSuite) TestNatsClient_DeleteObject_ObjectNotFound() {
+	s.createTestObjectStore()
+
+	err := s.natsClient.DeleteObject(_testObjectStore, "object1")
+	s.ErrorIs(err, internal.ErrObjectNotFound)
+}
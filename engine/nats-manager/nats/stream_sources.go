@@ -0,0 +1,97 @@
+package nats
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+// AddStreamSource makes the stream copy the messages stored in the source stream from now on. A previous
+// source from the same stream is replaced. Subject transforms in sources need NATS server 2.10 or later.
+func (n *NatsClient) AddStreamSource(stream string, source *entity.StreamSource) error {
+	n.logger.Info("Adding stream source", "stream", stream, "source", source.Stream)
+
+	streamInfo, err := n.js.StreamInfo(stream)
+	if err != nil {
+		return fmt.Errorf("error getting stream %q info: %w", stream, err)
+	}
+
+	startTime := time.Now()
+	streamCfg := streamInfo.Config
+	sources := make([]*nats.StreamSource, 0, len(streamCfg.Sources)+1)
+
+	for _, streamSource := range streamCfg.Sources {
+		if streamSource.Name != source.Stream {
+			sources = append(sources, streamSource)
+		}
+	}
+
+	streamCfg.Sources = append(sources, &nats.StreamSource{
+		Name:              source.Stream,
+		OptStartTime:      &startTime,
+		SubjectTransforms: n.getSubjectTransforms(source.SubjectTransforms),
+	})
+
+	_, err = n.js.UpdateStream(&streamCfg)
+
+	return err
+}
+
+// RemoveStreamSources stops copying into the stream the messages of the source streams matching the filter.
+func (n *NatsClient) RemoveStreamSources(stream string, sourceFilter *regexp.Regexp) error {
+	streamInfo, err := n.js.StreamInfo(stream)
+	if err != nil {
+		return fmt.Errorf("error getting stream %q info: %w", stream, err)
+	}
+
+	streamCfg := streamInfo.Config
+	sources := make([]*nats.StreamSource, 0, len(streamCfg.Sources))
+
+	for _, streamSource := range streamCfg.Sources {
+		if !sourceFilter.MatchString(streamSource.Name) {
+			sources = append(sources, streamSource)
+		}
+	}
+
+	if len(sources) == len(streamCfg.Sources) {
+		return nil
+	}
+
+	n.logger.Info("Removing stream sources", "stream", stream, "sourceFilter", sourceFilter.String())
+
+	streamCfg.Sources = sources
+
+	_, err = n.js.UpdateStream(&streamCfg)
+
+	return err
+}
+
+func (n *NatsClient) getStreamSources(sources []entity.StreamSource) []*nats.StreamSource {
+	streamSources := make([]*nats.StreamSource, 0, len(sources))
+
+	for _, source := range sources {
+		streamSources = append(streamSources, &nats.StreamSource{
+			Name:              source.Stream,
+			SubjectTransforms: n.getSubjectTransforms(source.SubjectTransforms),
+		})
+	}
+
+	return streamSources
+}
+
+func (n *NatsClient) getSubjectTransforms(transforms []entity.SubjectTransform) []nats.SubjectTransformConfig {
+	subjectTransforms := make([]nats.SubjectTransformConfig, 0, len(transforms))
+
+	for _, transform := range transforms {
+		subjectTransforms = append(subjectTransforms, nats.SubjectTransformConfig{
+			Source:      transform.Source,
+			Destination: transform.Destination,
+		})
+	}
+
+	return subjectTransforms
+}
@@ -18,7 +18,7 @@ func (s *ClientTestSuite) TestNatsClient_UpdateConfiguration() {
 		expectedKey2: expectedValue2,
 	}
 
-	err := s.natsClient.CreateKeyValueStore(testKeyValueStore)
+	err := s.natsClient.CreateKeyValueStore(testKeyValueStore, nil)
 	s.Require().NoError(err)
 
 	err = s.natsClient.UpdateConfiguration(testKeyValueStore, newConfig)
@@ -46,7 +46,7 @@ func (s *ClientTestSuite) TestNatsClient_UpdateConfiguration_NatsClientError() {
 		invalidKey: "test value",
 	}
 
-	err := s.natsClient.CreateKeyValueStore(testKeyValueStore)
+	err := s.natsClient.CreateKeyValueStore(testKeyValueStore, nil)
 	s.Require().NoError(err)
 
 	err = s.natsClient.UpdateConfiguration(testKeyValueStore, newConfig)
@@ -0,0 +1,285 @@
+package nats
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nkeys"
+	"github.com/spf13/viper"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/config"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+)
+
+const (
+	_systemRequestTimeout     = 5 * time.Second
+	_maxAccountUpdateAttempts = 3
+)
+
+var errUpdatingAccount = errors.New("error updating account claims")
+
+// CreateUser issues the credentials of a user of the configured account, allowed to use the given subjects.
+// User keys are derived from the account seed and the user name, so the same user can be revoked later
+// without storing its keys.
+func (n *NatsClient) CreateUser(name string, permissions *entity.UserPermissions) (string, error) {
+	accountKeys, err := n.getAccountKeys()
+	if err != nil {
+		return "", err
+	}
+
+	userKeys, err := n.getUserKeys(accountKeys, name)
+	if err != nil {
+		return "", err
+	}
+
+	userPublicKey, err := userKeys.PublicKey()
+	if err != nil {
+		return "", fmt.Errorf("getting public key of user %q: %w", name, err)
+	}
+
+	claims := jwt.NewUserClaims(userPublicKey)
+	claims.Name = name
+
+	if credentialsTTL := viper.GetDuration(config.NatsCredentialsTTL); credentialsTTL > 0 {
+		claims.Expires = time.Now().Add(credentialsTTL).Unix()
+	}
+	claims.Pub.Allow.Add(permissions.Publish...)
+	claims.Sub.Allow.Add(permissions.Subscribe...)
+
+	token, err := claims.Encode(accountKeys)
+	if err != nil {
+		return "", fmt.Errorf("signing user %q claims: %w", name, err)
+	}
+
+	seed, err := userKeys.Seed()
+	if err != nil {
+		return "", fmt.Errorf("getting seed of user %q: %w", name, err)
+	}
+
+	credentials, err := jwt.FormatUserConfig(token, seed)
+	if err != nil {
+		return "", fmt.Errorf("formatting user %q credentials: %w", name, err)
+	}
+
+	return string(credentials), nil
+}
+
+// RevokeUser revokes every credential issued to the user until now, updating the account claims
+// through the system account. Credentials issued afterwards to the same user are valid again.
+//
+// Account claims can only be replaced as a whole, so the update is read back and retried when another
+// update overwrote the revocation in between.
+func (n *NatsClient) RevokeUser(name string) error {
+	accountKeys, err := n.getAccountKeys()
+	if err != nil {
+		return err
+	}
+
+	userKeys, err := n.getUserKeys(accountKeys, name)
+	if err != nil {
+		return err
+	}
+
+	userPublicKey, err := userKeys.PublicKey()
+	if err != nil {
+		return fmt.Errorf("getting public key of user %q: %w", name, err)
+	}
+
+	accountPublicKey, err := accountKeys.PublicKey()
+	if err != nil {
+		return fmt.Errorf("getting account public key: %w", err)
+	}
+
+	operatorKeys, err := nkeys.FromSeed([]byte(viper.GetString(config.NatsOperatorSeed)))
+	if err != nil {
+		return fmt.Errorf("parsing operator seed: %w", err)
+	}
+
+	conn, err := nats.Connect(
+		viper.GetString(config.NatsURL),
+		nats.UserCredentials(viper.GetString(config.NatsSystemCredentials)),
+	)
+	if err != nil {
+		return fmt.Errorf("connecting to NATS with the system account: %w", err)
+	}
+	defer conn.Close()
+
+	n.accountMutex.Lock()
+	defer n.accountMutex.Unlock()
+
+	revokedAt := time.Now()
+
+	for attempt := 0; ; attempt++ {
+		accountClaims, err := lookupAccountClaims(conn, accountPublicKey)
+		if err != nil {
+			return err
+		}
+
+		if isRevoked(accountClaims, userPublicKey, revokedAt) {
+			return nil
+		}
+
+		if attempt == _maxAccountUpdateAttempts {
+			return fmt.Errorf("%w: revocation of user %q was overwritten by concurrent updates", errUpdatingAccount, name)
+		}
+
+		accountClaims.RevokeAt(userPublicKey, revokedAt)
+		pruneRevocations(accountClaims, viper.GetDuration(config.NatsCredentialsTTL), revokedAt)
+
+		if err := updateAccountClaims(conn, accountPublicKey, accountClaims, operatorKeys); err != nil {
+			return err
+		}
+	}
+}
+
+// CheckServerAuth checks that the NATS server resolves the account version users are created in. Otherwise the
+// server doesn't run in operator mode and would ignore the issued credentials. Nothing is checked when
+// credentials aren't issued.
+func CheckServerAuth() error {
+	seed := viper.GetString(config.NatsAccountSeed)
+	if seed == "" {
+		return nil
+	}
+
+	if viper.GetString(config.NatsCredentials) == "" {
+		return internal.ErrNatsCredentialsRequired
+	}
+
+	accountKeys, err := nkeys.FromSeed([]byte(seed))
+	if err != nil {
+		return fmt.Errorf("parsing account seed: %w", err)
+	}
+
+	accountPublicKey, err := accountKeys.PublicKey()
+	if err != nil {
+		return fmt.Errorf("getting account public key: %w", err)
+	}
+
+	conn, err := nats.Connect(
+		viper.GetString(config.NatsURL),
+		nats.UserCredentials(viper.GetString(config.NatsSystemCredentials)),
+	)
+	if err != nil {
+		return fmt.Errorf("connecting to NATS with the system account: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := lookupAccountClaims(conn, accountPublicKey); err != nil {
+		return fmt.Errorf("NATS server doesn't resolve the account of the version users: %w", err)
+	}
+
+	return nil
+}
+
+func lookupAccountClaims(conn *nats.Conn, accountPublicKey string) (*jwt.AccountClaims, error) {
+	lookup, err := conn.Request(fmt.Sprintf("$SYS.REQ.ACCOUNT.%s.CLAIMS.LOOKUP", accountPublicKey), nil, _systemRequestTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("looking up account claims: %w", err)
+	}
+
+	accountClaims, err := jwt.DecodeAccountClaims(string(lookup.Data))
+	if err != nil {
+		return nil, fmt.Errorf("decoding account claims: %w", err)
+	}
+
+	return accountClaims, nil
+}
+
+func updateAccountClaims(
+	conn *nats.Conn,
+	accountPublicKey string,
+	accountClaims *jwt.AccountClaims,
+	operatorKeys nkeys.KeyPair,
+) error {
+	token, err := accountClaims.Encode(operatorKeys)
+	if err != nil {
+		return fmt.Errorf("signing account claims: %w", err)
+	}
+
+	update, err := conn.Request(
+		fmt.Sprintf("$SYS.REQ.ACCOUNT.%s.CLAIMS.UPDATE", accountPublicKey), []byte(token), _systemRequestTimeout,
+	)
+	if err != nil {
+		return fmt.Errorf("updating account claims: %w", err)
+	}
+
+	return checkSystemResponse(update.Data)
+}
+
+func isRevoked(accountClaims *jwt.AccountClaims, userPublicKey string, revokedAt time.Time) bool {
+	revocation, ok := accountClaims.Revocations[userPublicKey]
+
+	return ok && revocation >= revokedAt.Unix()
+}
+
+// pruneRevocations removes the revocations older than the credentials TTL, as every credential they revoke
+// has already expired.
+func pruneRevocations(accountClaims *jwt.AccountClaims, credentialsTTL time.Duration, now time.Time) {
+	if credentialsTTL <= 0 {
+		return
+	}
+
+	expiredBefore := now.Add(-credentialsTTL).Unix()
+
+	for userPublicKey, revocation := range accountClaims.Revocations {
+		if revocation < expiredBefore {
+			delete(accountClaims.Revocations, userPublicKey)
+		}
+	}
+}
+
+func (n *NatsClient) getAccountKeys() (nkeys.KeyPair, error) {
+	seed := viper.GetString(config.NatsAccountSeed)
+	if seed == "" {
+		return nil, internal.ErrNatsAuthDisabled
+	}
+
+	accountKeys, err := nkeys.FromSeed([]byte(seed))
+	if err != nil {
+		return nil, fmt.Errorf("parsing account seed: %w", err)
+	}
+
+	return accountKeys, nil
+}
+
+func (n *NatsClient) getUserKeys(accountKeys nkeys.KeyPair, name string) (nkeys.KeyPair, error) {
+	accountSeed, err := accountKeys.Seed()
+	if err != nil {
+		return nil, fmt.Errorf("getting account seed: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, accountSeed)
+	mac.Write([]byte(name))
+
+	userKeys, err := nkeys.FromRawSeed(nkeys.PrefixByteUser, mac.Sum(nil))
+	if err != nil {
+		return nil, fmt.Errorf("generating keys of user %q: %w", name, err)
+	}
+
+	return userKeys, nil
+}
+
+func checkSystemResponse(data []byte) error {
+	var response struct {
+		Error *struct {
+			Description string `json:"description"`
+		} `json:"error"`
+	}
+
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("decoding system account response: %w", err)
+	}
+
+	if response.Error != nil {
+		return fmt.Errorf("%w: %s", errUpdatingAccount, response.Error.Description)
+	}
+
+	return nil
+}
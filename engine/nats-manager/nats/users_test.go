@@ -0,0 +1,113 @@
+//go:build integration
+
+package nats_test
+
+import (
+	"time"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+	"github.com/spf13/viper"
+
+	"github.com/konstellation-io/kai/engine/nats-manager/internal"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/config"
+	"github.com/konstellation-io/kai/engine/nats-manager/internal/entity"
+	"github.com/konstellation-io/kai/engine/nats-manager/nats"
+)
+
+func (s *ClientTestSuite) setTestAccountSeed() nkeys.KeyPair {
+	accountKeys, err := nkeys.CreateAccount()
+	s.Require().NoError(err)
+
+	seed, err := accountKeys.Seed()
+	s.Require().NoError(err)
+
+	viper.Set(config.NatsAccountSeed, string(seed))
+	s.T().Cleanup(func() { viper.Set(config.NatsAccountSeed, "") })
+
+	return accountKeys
+}
+
+func (s *ClientTestSuite) TestNatsClient_CreateUser() {
+	accountKeys := s.setTestAccountSeed()
+	accountPublicKey, err := accountKeys.PublicKey()
+	s.Require().NoError(err)
+
+	permissions := &entity.UserPermissions{
+		Publish:   []string{"test-stream.>"},
+		Subscribe: []string{"test-stream.>", "_INBOX.>"},
+	}
+
+	credentials, err := s.natsClient.CreateUser("test-user", permissions)
+	s.Require().NoError(err)
+
+	token, err := jwt.ParseDecoratedJWT([]byte(credentials))
+	s.Require().NoError(err)
+
+	claims, err := jwt.DecodeUserClaims(token)
+	s.Require().NoError(err)
+
+	s.Equal("test-user", claims.Name)
+	s.Equal(accountPublicKey, claims.Issuer)
+	s.ElementsMatch(permissions.Publish, claims.Pub.Allow)
+	s.ElementsMatch(permissions.Subscribe, claims.Sub.Allow)
+	s.Zero(claims.Expires, "credentials must not expire without a credentials TTL")
+
+	otherCredentials, err := s.natsClient.CreateUser("test-user", permissions)
+	s.Require().NoError(err)
+
+	otherToken, err := jwt.ParseDecoratedJWT([]byte(otherCredentials))
+	s.Require().NoError(err)
+
+	otherClaims, err := jwt.DecodeUserClaims(otherToken)
+	s.Require().NoError(err)
+	s.Equal(claims.Subject, otherClaims.Subject, "user keys must be derived from the user name")
+}
+
+func (s *ClientTestSuite) TestNatsClient_CreateUser_WithCredentialsTTL() {
+	s.setTestAccountSeed()
+
+	viper.Set(config.NatsCredentialsTTL, time.Hour)
+	s.T().Cleanup(func() { viper.Set(config.NatsCredentialsTTL, 0) })
+
+	credentials, err := s.natsClient.CreateUser("test-user", &entity.UserPermissions{})
+	s.Require().NoError(err)
+
+	token, err := jwt.ParseDecoratedJWT([]byte(credentials))
+	s.Require().NoError(err)
+
+	claims, err := jwt.DecodeUserClaims(token)
+	s.Require().NoError(err)
+
+	s.InDelta(time.Now().Add(time.Hour).Unix(), claims.Expires, 5)
+}
+
+func (s *ClientTestSuite) TestNatsClient_CreateUser_AuthDisabled() {
+	_, err := s.natsClient.CreateUser("test-user", &entity.UserPermissions{})
+	s.ErrorIs(err, internal.ErrNatsAuthDisabled)
+}
+
+func (s *ClientTestSuite) TestNatsClient_RevokeUser_AuthDisabled() {
+	err := s.natsClient.RevokeUser("test-user")
+	s.ErrorIs(err, internal.ErrNatsAuthDisabled)
+}
+
+func (s *ClientTestSuite) TestCheckServerAuth_AuthDisabled() {
+	s.NoError(nats.CheckServerAuth())
+}
+
+func (s *ClientTestSuite) TestCheckServerAuth_NatsManagerCredentialsRequired() {
+	s.setTestAccountSeed()
+
+	err := nats.CheckServerAuth()
+	s.ErrorIs(err, internal.ErrNatsCredentialsRequired)
+}
+
+func (s *ClientTestSuite) TestCheckServerAuth_ServerWithoutOperator() {
+	s.setTestAccountSeed()
+
+	viper.Set(config.NatsCredentials, "nats-manager.creds")
+	s.T().Cleanup(func() { viper.Set(config.NatsCredentials, "") })
+
+	s.Error(nats.CheckServerAuth())
+}
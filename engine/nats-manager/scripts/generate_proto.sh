@@ -14,3 +14,12 @@ if [ -d "../admin-api" ]; then
     cp "$PB_FILE" "../admin-api/adapter/service/proto/${DST}/"
   done
 fi
+
+# k8s-manager reads the consumer lag of the processes it autoscales.
+if [ -d "../k8s-manager" ]; then
+  for PB_FILE in $(find proto -iname "*.pb.go"); do
+    DST=$(basename "$(dirname "$PB_FILE")")
+    mkdir -p ../k8s-manager/internal/infrastructure/grpc/proto/${DST}
+    cp "$PB_FILE" "../k8s-manager/internal/infrastructure/grpc/proto/${DST}/"
+  done
+fi